@@ -209,6 +209,11 @@ func (d *Dev) TurnOff() error {
 	return d.Write(PowerCtl, 0x00)
 }
 
+// Halt implements conn.Resource. It turns off the measurement mode.
+func (d *Dev) Halt() error {
+	return d.TurnOff()
+}
+
 // Update reads the acceleration values from the ADXL345.
 // By reading the acceleration the 3 axes acceleration values.
 // This is a simple synchronous implementation.
@@ -282,6 +287,6 @@ func (s Sensitivity) String() string {
 	case S16G:
 		return "+/-16g"
 	default:
-		return fmt.Sprintf("unknown sensitivity: %#x", s)
+		return fmt.Sprintf("unknown sensitivity: %#x", byte(s))
 	}
 }
@@ -191,6 +191,10 @@ func (d *Dev) Halt() error {
 	return nil
 }
 
+func (d *Dev) String() string {
+	return fmt.Sprintf("AHT20{%s}", d.d)
+}
+
 // IsInitialized returns true if the sensor is initialized (calibrated)
 func (d *Dev) IsInitialized() (error, bool) {
 	data := make([]byte, 1)
@@ -11,6 +11,10 @@
 // This driver handles color intensity and temperature correction and uses the
 // full near 8000:1 dynamic range as supported by the device.
 //
+// NewFrame returns a frame buffer of colors with a brightness per LED, with the
+// same API as nrzled.NewFrame, so animations are portable between WS2812 and
+// APA102 strips.
+//
 // # More details
 //
 // See https://periph.io/device/apa102/ for more details about the device.
@@ -0,0 +1,103 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package apa102
+
+import (
+	"image/color"
+	"math"
+)
+
+// DefaultGamma is the gamma correction commonly used for APA102 LEDs, which
+// are linear, so that the steps of brightness look even.
+const DefaultGamma = 2.8
+
+// MaxLevel is the maximum value of the 5 bits per LED brightness.
+const MaxLevel = 31
+
+// Frame is a frame buffer for a strip, with one color per LED. The changes
+// are sent to the LEDs by Flush(). It has the same API as nrzled.Frame, so
+// animations can be shared between both kinds of strips.
+//
+// Frame sets the 8 bits channels and the 5 bits brightness of each LED as
+// specified, and ignores Dev.Intensity, Dev.Temperature and
+// Dev.DisableGlobalPWM.
+type Frame struct {
+	// Pixels is the color of each LED. The alpha channel is ignored.
+	Pixels []color.NRGBA
+	// Levels is the brightness of each LED, between 0 and MaxLevel. It uses
+	// the 5 bits PWM of the LED, which runs at 580Hz and may flicker visibly
+	// at low levels.
+	Levels []uint8
+	// Brightness scales all the channels, 255 is full brightness. Lowering it
+	// also limits the current drawn by the strip.
+	Brightness uint8
+	// Gamma is the exponent of the gamma correction. 0 or 1 disables it.
+	Gamma float64
+
+	d *Dev
+	// lut maps the channel values, for lutB and lutG.
+	lut   [256]byte
+	lutB  uint8
+	lutG  float64
+	lutOK bool
+}
+
+// NewFrame returns a frame buffer for d, with all LEDs off, full brightness
+// and DefaultGamma.
+func NewFrame(d *Dev) *Frame {
+	f := &Frame{
+		Pixels:     make([]color.NRGBA, d.numPixels),
+		Levels:     make([]uint8, d.numPixels),
+		Brightness: 255,
+		Gamma:      DefaultGamma,
+		d:          d,
+	}
+	for i := range f.Levels {
+		f.Levels[i] = MaxLevel
+	}
+	return f
+}
+
+// Fill sets all the LEDs to c.
+func (f *Frame) Fill(c color.NRGBA) {
+	for i := range f.Pixels {
+		f.Pixels[i] = c
+	}
+}
+
+// Clear turns all the LEDs off.
+func (f *Frame) Clear() {
+	f.Fill(color.NRGBA{})
+}
+
+// Flush sends the frame buffer to the LEDs.
+func (f *Frame) Flush() error {
+	f.initLUT()
+	n := min(len(f.Pixels), f.d.numPixels)
+	for i, c := range f.Pixels[:n] {
+		l := uint8(MaxLevel)
+		if i < len(f.Levels) {
+			l = min(f.Levels[i], MaxLevel)
+		}
+		j := 4 * i
+		f.d.pixels[j], f.d.pixels[j+1], f.d.pixels[j+2], f.d.pixels[j+3] = 0xE0|l, f.lut[c.B], f.lut[c.G], f.lut[c.R]
+	}
+	return f.d.s.Tx(f.d.rawBuf, nil)
+}
+
+// initLUT updates the lookup table if Brightness or Gamma changed.
+func (f *Frame) initLUT() {
+	if f.lutOK && f.lutB == f.Brightness && f.lutG == f.Gamma {
+		return
+	}
+	f.lutB, f.lutG, f.lutOK = f.Brightness, f.Gamma, true
+	for i := range f.lut {
+		v := float64(i) / 255
+		if f.Gamma > 0 && f.Gamma != 1 {
+			v = math.Pow(v, f.Gamma)
+		}
+		f.lut[i] = byte(math.Round(v * float64(f.Brightness)))
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package apa102
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func TestFrame_Flush(t *testing.T) {
+	buf := bytes.Buffer{}
+	o := PassThruOpts
+	o.NumPixels = 2
+	d, err := New(spitest.NewRecordRaw(&buf), &o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := NewFrame(d)
+	f.Gamma = 2
+	f.Pixels[0] = color.NRGBA{R: 0xFF, G: 0x80, B: 0x10}
+	f.Pixels[1] = color.NRGBA{R: 0x20, B: 0x30}
+	f.Levels[1] = 8
+	if err := f.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x00, 0x00, 0x00, 0x00,
+		0xFF, 0x01, 0x40, 0xFF,
+		0xE8, 0x09, 0x00, 0x04,
+		0xFF,
+	}
+	if got := buf.Bytes(); !bytes.Equal(got, want) {
+		t.Fatalf("\nGot:  %#02v\nWant: %#02v", got, want)
+	}
+
+	buf.Reset()
+	f.Brightness, f.Gamma = 128, 0
+	f.Levels[1] = 0xFF
+	f.Fill(color.NRGBA{R: 0xFF, G: 0x80, B: 0x02})
+	if err := f.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	want = []byte{
+		0x00, 0x00, 0x00, 0x00,
+		0xFF, 0x01, 0x40, 0x80,
+		0xFF, 0x01, 0x40, 0x80,
+		0xFF,
+	}
+	if got := buf.Bytes(); !bytes.Equal(got, want) {
+		t.Fatalf("\nGot:  %#02v\nWant: %#02v", got, want)
+	}
+}
@@ -0,0 +1,380 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// The APDS-9960 is an I²C proximity, ambient light, RGB color and gesture
+// sensor. Gestures are detected by four photodiodes, named up, down, left and
+// right, that receive the infrared light of the integrated LED reflected by a
+// hand moving above the sensor.
+//
+// # Gestures
+//
+// Dev.Start() starts a goroutine that reads the gesture FIFO of the device,
+// and sends the swipes it detects to a channel, for example to navigate a
+// menu without touching the device. Directions are named after the
+// photodiodes: a swipe toward the up photodiode is Up.
+//
+// # Datasheet
+//
+// https://docs.broadcom.com/doc/AV02-4191EN
+package apds9960
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/i2c"
+)
+
+const (
+	devName = "APDS-9960"
+	// DefaultAddress is the fixed I²C address of the device.
+	DefaultAddress uint16 = 0x39
+)
+
+// Registers of the device.
+const (
+	regEnable  byte = 0x80
+	regATime   byte = 0x81
+	regPPulse  byte = 0x8e
+	regControl byte = 0x8f
+	regID      byte = 0x92
+	regCData   byte = 0x94
+	regPData   byte = 0x9c
+	regGPEnTh  byte = 0xa0
+	regGExTh   byte = 0xa1
+	regGConf1  byte = 0xa2
+	regGConf2  byte = 0xa3
+	regGPulse  byte = 0xa6
+	regGConf4  byte = 0xab
+	regGFLvl   byte = 0xae
+	regGFIFO   byte = 0xfc
+)
+
+// Bits of the enable register.
+const (
+	enablePON byte = 1 << 0
+	enableAEN byte = 1 << 1
+	enablePEN byte = 1 << 2
+	enableGEN byte = 1 << 6
+)
+
+const (
+	// gconf4GMode is set while the gesture engine runs.
+	gconf4GMode byte = 1 << 0
+	// gestureThreshold is the minimum count of all the photodiodes for a
+	// dataset to be used to detect a gesture.
+	gestureThreshold = 10
+	// gestureSensitivity is the minimum change of the ratio between two
+	// opposite photodiodes, in percent, for a swipe.
+	gestureSensitivity = 20
+	// eventBufferSize is the size of the channel returned by Start().
+	eventBufferSize = 8
+)
+
+// Device IDs of the APDS-9960. Some modules report 0xa8.
+var deviceIDs = []byte{0xab, 0xa8}
+
+// Gesture is the direction of a swipe.
+type Gesture int
+
+const (
+	Up Gesture = iota + 1
+	Down
+	Left
+	Right
+)
+
+func (g Gesture) String() string {
+	switch g {
+	case Up:
+		return "Up"
+	case Down:
+		return "Down"
+	case Left:
+		return "Left"
+	case Right:
+		return "Right"
+	default:
+		return fmt.Sprintf("Gesture(%d)", int(g))
+	}
+}
+
+// Color is the light measured by the clear, red, green and blue photodiodes.
+// The counts aren't calibrated.
+type Color struct {
+	Clear, Red, Green, Blue uint16
+}
+
+// Dev is a handle to an APDS-9960.
+type Dev struct {
+	d  *i2c.Dev
+	mu sync.Mutex
+
+	// pollMu guards the state of the goroutine started by Start().
+	pollMu sync.Mutex
+	stop   chan struct{}
+	done   chan struct{}
+	err    error
+}
+
+// New returns a handle to an APDS-9960, and enables the proximity and
+// ambient light engines with the recommended gains.
+func New(bus i2c.Bus) (*Dev, error) {
+	d := &Dev{d: &i2c.Dev{Bus: bus, Addr: DefaultAddress}}
+	id, err := d.readReg(regID)
+	if err != nil {
+		return nil, err
+	}
+	if id != deviceIDs[0] && id != deviceIDs[1] {
+		return nil, fmt.Errorf("apds9960: unexpected device ID 0x%02x", id)
+	}
+	init := []struct{ reg, value byte }{
+		{regEnable, 0},
+		// 37 cycles of 2.78ms.
+		{regATime, 0xdb},
+		// 16µs pulses, 8 pulses.
+		{regPPulse, 0x87},
+		// LED drive 100mA, proximity gain 4x, ambient light gain 4x.
+		{regControl, 0x09},
+		// Gesture entry and exit thresholds.
+		{regGPEnTh, 40},
+		{regGExTh, 30},
+		// Interrupt after 4 datasets.
+		{regGConf1, 0x40},
+		// Gesture gain 4x, LED drive 100mA, 2.8ms between datasets.
+		{regGConf2, 0x41},
+		// 32µs pulses, 10 pulses.
+		{regGPulse, 0xc9},
+		{regEnable, enablePON | enableAEN | enablePEN},
+	}
+	for _, i := range init {
+		if err := d.writeReg(i.reg, i.value); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// Proximity returns the proximity count, which increases as an object gets
+// closer to the sensor.
+func (d *Dev) Proximity() (uint8, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readReg(regPData)
+}
+
+// Color returns the ambient light and its red, green and blue components.
+func (d *Dev) Color() (Color, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var r [8]byte
+	if err := d.read(regCData, r[:]); err != nil {
+		return Color{}, err
+	}
+	return Color{
+		Clear: uint16(r[1])<<8 | uint16(r[0]),
+		Red:   uint16(r[3])<<8 | uint16(r[2]),
+		Green: uint16(r[5])<<8 | uint16(r[4]),
+		Blue:  uint16(r[7])<<8 | uint16(r[6]),
+	}, nil
+}
+
+// Start enables the gesture engine, and starts a goroutine that reads the
+// gesture FIFO every interval, and sends the gestures it detects to the
+// returned channel. The channel is closed when Stop() is called, or a read
+// returns an error. See Err().
+func (d *Dev) Start(interval time.Duration) (<-chan Gesture, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("apds9960: invalid polling interval %s", interval)
+	}
+	d.pollMu.Lock()
+	defer d.pollMu.Unlock()
+	if d.stop != nil {
+		return nil, errors.New("apds9960: gestures already started")
+	}
+	if err := d.updateEnable(enableGEN, enableGEN); err != nil {
+		return nil, err
+	}
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
+	d.err = nil
+	events := make(chan Gesture, eventBufferSize)
+	go d.run(interval, events, d.stop, d.done)
+	return events, nil
+}
+
+// Stop stops the goroutine started by Start(), waits for it to exit, and
+// disables the gesture engine.
+func (d *Dev) Stop() error {
+	d.pollMu.Lock()
+	stop, done := d.stop, d.done
+	d.stop = nil
+	d.pollMu.Unlock()
+	if stop == nil {
+		return nil
+	}
+	close(stop)
+	<-done
+	return d.updateEnable(enableGEN, 0)
+}
+
+// Err returns the error that stopped the gesture goroutine, if any.
+func (d *Dev) Err() error {
+	d.pollMu.Lock()
+	defer d.pollMu.Unlock()
+	return d.err
+}
+
+// Halt stops the gesture goroutine, and powers the device off.
+func (d *Dev) Halt() error {
+	if err := d.Stop(); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.writeReg(regEnable, 0)
+}
+
+func (d *Dev) String() string {
+	return devName
+}
+
+func (d *Dev) run(interval time.Duration, events chan<- Gesture, stop, done chan struct{}) {
+	defer close(done)
+	defer close(events)
+	var datasets [][4]byte
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(interval):
+		}
+		read, active, err := d.readGestureFIFO()
+		if err != nil {
+			d.pollMu.Lock()
+			d.err = err
+			d.pollMu.Unlock()
+			return
+		}
+		datasets = append(datasets, read...)
+		if active || len(datasets) == 0 {
+			continue
+		}
+		// The gesture engine exited, the hand is gone.
+		g, ok := decodeGesture(datasets)
+		datasets = datasets[:0]
+		if !ok {
+			continue
+		}
+		select {
+		case events <- g:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// readGestureFIFO returns the datasets in the gesture FIFO, and whether the
+// gesture engine is still running. Each dataset is the count of the up, down,
+// left and right photodiodes.
+func (d *Dev) readGestureFIFO() ([][4]byte, bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	level, err := d.readReg(regGFLvl)
+	if err != nil {
+		return nil, false, err
+	}
+	var datasets [][4]byte
+	if level > 0 {
+		r := make([]byte, 4*int(level))
+		if err := d.read(regGFIFO, r); err != nil {
+			return nil, false, err
+		}
+		datasets = make([][4]byte, level)
+		for ix := range datasets {
+			copy(datasets[ix][:], r[4*ix:])
+		}
+	}
+	gconf4, err := d.readReg(regGConf4)
+	if err != nil {
+		return nil, false, err
+	}
+	return datasets, gconf4&gconf4GMode != 0, nil
+}
+
+// decodeGesture compares the ratios between opposite photodiodes of the
+// first and last datasets where all the photodiodes received enough light.
+func decodeGesture(datasets [][4]byte) (Gesture, bool) {
+	first, last := -1, -1
+	for ix, ds := range datasets {
+		if ds[0] > gestureThreshold && ds[1] > gestureThreshold && ds[2] > gestureThreshold && ds[3] > gestureThreshold {
+			if first == -1 {
+				first = ix
+			}
+			last = ix
+		}
+	}
+	if first == -1 || first == last {
+		return 0, false
+	}
+	ud := ratio(datasets[last][0], datasets[last][1]) - ratio(datasets[first][0], datasets[first][1])
+	lr := ratio(datasets[last][2], datasets[last][3]) - ratio(datasets[first][2], datasets[first][3])
+	switch {
+	case abs(ud) >= abs(lr) && ud >= gestureSensitivity:
+		return Up, true
+	case abs(ud) >= abs(lr) && ud <= -gestureSensitivity:
+		return Down, true
+	case abs(lr) > abs(ud) && lr >= gestureSensitivity:
+		return Left, true
+	case abs(lr) > abs(ud) && lr <= -gestureSensitivity:
+		return Right, true
+	default:
+		return 0, false
+	}
+}
+
+// ratio returns the difference between a and b, in percent of their sum.
+func ratio(a, b byte) int {
+	return (int(a) - int(b)) * 100 / (int(a) + int(b))
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// updateEnable sets the bits of mask in the enable register to value.
+func (d *Dev) updateEnable(mask, value byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.readReg(regEnable)
+	if err != nil {
+		return err
+	}
+	return d.writeReg(regEnable, v&^mask|value&mask)
+}
+
+func (d *Dev) read(reg byte, r []byte) error {
+	if err := d.d.Tx([]byte{reg}, r); err != nil {
+		return fmt.Errorf("apds9960: error reading register 0x%02x: %w", reg, err)
+	}
+	return nil
+}
+
+func (d *Dev) readReg(reg byte) (byte, error) {
+	var r [1]byte
+	err := d.read(reg, r[:])
+	return r[0], err
+}
+
+func (d *Dev) writeReg(reg, value byte) error {
+	if _, err := d.d.Write([]byte{reg, value}); err != nil {
+		return fmt.Errorf("apds9960: error writing register 0x%02x: %w", reg, err)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package apds9960
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// registerBus is an i2c.Bus that emulates the registers of an APDS-9960,
+// including the gesture FIFO.
+type registerBus struct {
+	mu   sync.Mutex
+	regs [256]byte
+	fifo [][4]byte
+}
+
+func newRegisterBus() *registerBus {
+	b := &registerBus{}
+	b.regs[regID] = 0xab
+	return b
+}
+
+func (b *registerBus) String() string { return "registerBus" }
+
+func (b *registerBus) SetSpeed(f physic.Frequency) error { return nil }
+
+func (b *registerBus) Tx(addr uint16, w, r []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if addr != DefaultAddress || len(w) == 0 {
+		return errors.New("registerBus: invalid transaction")
+	}
+	reg := int(w[0])
+	for ix, v := range w[1:] {
+		b.regs[reg+ix] = v
+	}
+	if byte(reg) == regGFIFO {
+		for ix := 0; ix+4 <= len(r) && len(b.fifo) > 0; ix += 4 {
+			copy(r[ix:], b.fifo[0][:])
+			b.fifo = b.fifo[1:]
+		}
+		return nil
+	}
+	for ix := range r {
+		switch byte(reg + ix) {
+		case regGFLvl:
+			r[ix] = byte(len(b.fifo))
+		default:
+			r[ix] = b.regs[reg+ix]
+		}
+	}
+	return nil
+}
+
+// gesture queues datasets in the FIFO, and clears GMODE as the gesture
+// engine does when the hand is gone.
+func (b *registerBus) gesture(datasets ...[4]byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.fifo = append(b.fifo, datasets...)
+	b.regs[regGConf4] &^= gconf4GMode
+}
+
+func TestNew(t *testing.T) {
+	bus := newRegisterBus()
+	if _, err := New(bus); err != nil {
+		t.Fatal(err)
+	}
+	if v := bus.regs[regEnable]; v != enablePON|enableAEN|enablePEN {
+		t.Errorf("enable = 0x%02x", v)
+	}
+	if v := bus.regs[regControl]; v != 0x09 {
+		t.Errorf("control = 0x%02x", v)
+	}
+	bus.regs[regID] = 0x12
+	if _, err := New(bus); err == nil {
+		t.Error("expected an error for an unexpected ID")
+	}
+}
+
+func TestSense(t *testing.T) {
+	bus := newRegisterBus()
+	d, err := New(bus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bus.regs[regPData] = 0x80
+	copy(bus.regs[regCData:], []byte{0x34, 0x12, 0x01, 0x00, 0x02, 0x01, 0xff, 0xff})
+	p, err := d.Proximity()
+	if err != nil || p != 0x80 {
+		t.Errorf("Proximity() = %d, %v", p, err)
+	}
+	c, err := d.Color()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Color{Clear: 0x1234, Red: 1, Green: 0x102, Blue: 0xffff}); c != want {
+		t.Errorf("Color() = %+v, want %+v", c, want)
+	}
+}
+
+func TestDecodeGesture(t *testing.T) {
+	tests := []struct {
+		name     string
+		datasets [][4]byte
+		want     Gesture
+		ok       bool
+	}{
+		{"up", [][4]byte{{20, 80, 50, 50}, {50, 50, 50, 50}, {80, 20, 50, 50}}, Up, true},
+		{"down", [][4]byte{{80, 20, 50, 50}, {80, 20, 50, 50}, {20, 80, 50, 50}}, Down, true},
+		{"left", [][4]byte{{50, 50, 20, 80}, {50, 50, 80, 20}}, Left, true},
+		{"right", [][4]byte{{55, 50, 80, 20}, {50, 55, 20, 80}}, Right, true},
+		{"still", [][4]byte{{50, 50, 50, 50}, {52, 50, 50, 51}}, 0, false},
+		// Datasets under the threshold are ignored.
+		{"dim", [][4]byte{{5, 80, 50, 50}, {50, 50, 50, 50}, {80, 5, 50, 50}}, 0, false},
+		{"single", [][4]byte{{20, 80, 50, 50}}, 0, false},
+	}
+	for _, test := range tests {
+		g, ok := decodeGesture(test.datasets)
+		if g != test.want || ok != test.ok {
+			t.Errorf("%s: decodeGesture() = %s, %t, want %s, %t", test.name, g, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestStart(t *testing.T) {
+	bus := newRegisterBus()
+	d, err := New(bus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Start(0); err == nil {
+		t.Error("expected an error for an invalid interval")
+	}
+	events, err := d.Start(time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Start(time.Millisecond); err == nil {
+		t.Error("expected an error starting twice")
+	}
+	bus.mu.Lock()
+	if bus.regs[regEnable]&enableGEN == 0 {
+		t.Error("gesture engine not enabled")
+	}
+	bus.mu.Unlock()
+	bus.gesture([4]byte{50, 50, 20, 80}, [4]byte{50, 50, 50, 50}, [4]byte{50, 50, 80, 20})
+	select {
+	case g := <-events:
+		if g != Left {
+			t.Errorf("gesture = %s, want %s", g, Left)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the gesture")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-events; ok {
+		t.Error("expected the channel to be closed")
+	}
+	if err := d.Err(); err != nil {
+		t.Error(err)
+	}
+	if v := bus.regs[regEnable]; v != 0 {
+		t.Errorf("enable = 0x%02x after Halt()", v)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package apds9960_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/apds9960"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	sensor, err := apds9960.New(bus)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer sensor.Halt()
+
+	color, err := sensor.Color()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%+v\n", color)
+
+	gestures, err := sensor.Start(20 * time.Millisecond)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for g := range gestures {
+		fmt.Println(g)
+	}
+	if err := sensor.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package as5600 controls an ams AS5600 12 bit contactless absolute rotary
+// position sensor, which measures the angle of a diametrically magnetized
+// magnet above it, over I²C.
+//
+// The output angle is scaled over a configurable range, from a zero position
+// to a maximum angle, with a resolution of 4096 steps. The range is set in
+// volatile registers by this package: the OTP burn commands, which can only
+// be used 3 times, aren't supported.
+//
+// Knob turns the sensor into a knob with detents, sending the same events as
+// a rotary encoder.
+//
+// # Wiring
+//
+// Place the magnet 0.5mm to 3mm above the center of the chip. Connect DIR to
+// GND for the angle to increase clockwise, seen from above, or to VDD for
+// counterclockwise.
+//
+// # Datasheet
+//
+// https://ams-osram.com/products/sensor-solutions/position-sensors/ams-as5600-position-sensor
+package as5600
+
+import (
+	"fmt"
+	"sync"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Address is the I²C address of the AS5600. The AS5600L uses 0x40.
+const Address uint16 = 0x36
+
+const (
+	regZPos      = 0x01
+	regMPos      = 0x03
+	regMAng      = 0x05
+	regConf      = 0x07
+	regStatus    = 0x0b
+	regRawAngle  = 0x0c
+	regAngle     = 0x0e
+	regAGC       = 0x1a
+	regMagnitude = 0x1b
+
+	// steps is the number of steps of a revolution.
+	steps = 4096
+	// fullCircle is 360°.
+	fullCircle = 360 * physic.Degree
+	// minRange is the minimum range of the output angle.
+	minRange = 18 * physic.Degree
+)
+
+// Status is the status of the magnet.
+type Status struct {
+	// Detected is true when a magnet is detected.
+	Detected bool
+	// TooWeak and TooStrong are true when the magnet is too far or too
+	// close.
+	TooWeak   bool
+	TooStrong bool
+	// AGC is the gain of the automatic gain control, from 0 to 255 at 5V or
+	// 128 at 3.3V, ideally in the middle of its range.
+	AGC uint8
+	// Magnitude is the magnitude of the magnetic field, in internal units.
+	Magnitude uint16
+}
+
+// Dev is a handle to an AS5600.
+type Dev struct {
+	c *i2c.Dev
+
+	mu sync.Mutex
+	// span is the range of the output angle, in steps.
+	span int
+}
+
+// NewI2C returns a handle to an AS5600 at addr on b, and checks that it
+// responds. The range of the output is the one configured in the chip.
+func NewI2C(b i2c.Bus, addr uint16) (*Dev, error) {
+	d := &Dev{c: &i2c.Dev{Bus: b, Addr: addr}}
+	if err := d.loadSpan(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Angle returns the output angle, from 0 at the zero position to the range
+// of the output. See SetRange().
+func (d *Dev) Angle() (physic.Angle, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.read16(regAngle)
+	if err != nil {
+		return 0, err
+	}
+	return physic.Angle(int64(v) * int64(toAngle(d.span)) / steps), nil
+}
+
+// RawAngle returns the angle of the magnet, unscaled and unaffected by the
+// zero position, from 0 to 360°.
+func (d *Dev) RawAngle() (physic.Angle, error) {
+	v, err := d.rawSteps()
+	if err != nil {
+		return 0, err
+	}
+	return toAngle(v), nil
+}
+
+// SetRange sets the zero position of the output, as a raw angle, and its
+// range, from 18° to 360°. The output angle wraps at zero+span. It's lost
+// at power down.
+func (d *Dev) SetRange(zero, span physic.Angle) error {
+	if zero < 0 || zero >= fullCircle || span < minRange || span > fullCircle {
+		return fmt.Errorf("as5600: invalid range %s, %s", zero, span)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	// MANG is ignored when MPOS is set, so it's cleared.
+	s := toSteps(span)
+	if s == steps {
+		s = 0
+	}
+	if err := d.write16(regZPos, toSteps(zero)%steps); err != nil {
+		return err
+	}
+	if err := d.write16(regMPos, 0); err != nil {
+		return err
+	}
+	if err := d.write16(regMAng, s); err != nil {
+		return err
+	}
+	return d.loadSpanLocked()
+}
+
+// SetZero sets the current position as the zero position, keeping the range.
+func (d *Dev) SetZero() error {
+	raw, err := d.rawSteps()
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.write16(regZPos, raw)
+}
+
+// Status returns the status of the magnet.
+func (d *Dev) Status() (Status, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var r [1]byte
+	if err := d.c.Tx([]byte{regStatus}, r[:]); err != nil {
+		return Status{}, fmt.Errorf("as5600: %w", err)
+	}
+	var agc [1]byte
+	if err := d.c.Tx([]byte{regAGC}, agc[:]); err != nil {
+		return Status{}, fmt.Errorf("as5600: %w", err)
+	}
+	m, err := d.read16(regMagnitude)
+	if err != nil {
+		return Status{}, err
+	}
+	return Status{
+		Detected:  r[0]&0x20 != 0,
+		TooWeak:   r[0]&0x10 != 0,
+		TooStrong: r[0]&0x08 != 0,
+		AGC:       agc[0],
+		Magnitude: m,
+	}, nil
+}
+
+// Halt implements conn.Resource. It does nothing, since the sensor measures
+// continuously.
+func (d *Dev) Halt() error {
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("AS5600{%s}", d.c)
+}
+
+func (d *Dev) rawSteps() (uint16, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.read16(regRawAngle)
+}
+
+func (d *Dev) loadSpan() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.loadSpanLocked()
+}
+
+// loadSpanLocked reads the range of the output. It's from ZPOS to MPOS if
+// MPOS is set, else MANG, else 360°.
+func (d *Dev) loadSpanLocked() error {
+	var r [6]byte
+	if err := d.c.Tx([]byte{regZPos}, r[:]); err != nil {
+		return fmt.Errorf("as5600: %w", err)
+	}
+	zpos := int(r[0]&0x0f)<<8 | int(r[1])
+	mpos := int(r[2]&0x0f)<<8 | int(r[3])
+	mang := int(r[4]&0x0f)<<8 | int(r[5])
+	switch {
+	case mpos != 0:
+		d.span = (mpos - zpos + steps) % steps
+	case mang != 0:
+		d.span = mang
+	default:
+		d.span = steps
+	}
+	if d.span == 0 {
+		d.span = steps
+	}
+	return nil
+}
+
+func (d *Dev) read16(reg byte) (uint16, error) {
+	var r [2]byte
+	if err := d.c.Tx([]byte{reg}, r[:]); err != nil {
+		return 0, fmt.Errorf("as5600: %w", err)
+	}
+	return uint16(r[0]&0x0f)<<8 | uint16(r[1]), nil
+}
+
+func (d *Dev) write16(reg byte, v uint16) error {
+	if err := d.c.Tx([]byte{reg, byte(v >> 8), byte(v)}, nil); err != nil {
+		return fmt.Errorf("as5600: %w", err)
+	}
+	return nil
+}
+
+func toAngle[T int | uint16](s T) physic.Angle {
+	return physic.Angle(int64(s) * int64(fullCircle) / steps)
+}
+
+func toSteps(a physic.Angle) uint16 {
+	return uint16((int64(a)*steps + int64(fullCircle)/2) / int64(fullCircle))
+}
+
+var _ conn.Resource = &Dev{}
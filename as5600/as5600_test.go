@@ -0,0 +1,125 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package as5600
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/rotary"
+)
+
+// rangeIO is the read of ZPOS, MPOS and MANG with zpos, no MPOS and mang.
+func rangeIO(zpos, mang uint16) i2ctest.IO {
+	return i2ctest.IO{Addr: Address, W: []byte{regZPos}, R: []byte{byte(zpos >> 8), byte(zpos), 0, 0, byte(mang >> 8), byte(mang)}}
+}
+
+func rawIO(v uint16) i2ctest.IO {
+	return i2ctest.IO{Addr: Address, W: []byte{regRawAngle}, R: []byte{byte(v >> 8), byte(v)}}
+}
+
+func newTest(t *testing.T, ops ...i2ctest.IO) (*Dev, *i2ctest.Playback) {
+	b := &i2ctest.Playback{Ops: append([]i2ctest.IO{rangeIO(0, 0)}, ops...), DontPanic: true}
+	d, err := NewI2C(b, Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d, b
+}
+
+func TestAngle(t *testing.T) {
+	d, b := newTest(t,
+		rawIO(1024),
+		i2ctest.IO{Addr: Address, W: []byte{regAngle}, R: []byte{0x08, 0x00}},
+		// SetRange(90°, 180°).
+		i2ctest.IO{Addr: Address, W: []byte{regZPos, 0x04, 0x00}},
+		i2ctest.IO{Addr: Address, W: []byte{regMPos, 0x00, 0x00}},
+		i2ctest.IO{Addr: Address, W: []byte{regMAng, 0x08, 0x00}},
+		rangeIO(1024, 2048),
+		i2ctest.IO{Addr: Address, W: []byte{regAngle}, R: []byte{0x04, 0x00}},
+		// SetZero().
+		rawIO(100),
+		i2ctest.IO{Addr: Address, W: []byte{regZPos, 0x00, 0x64}},
+	)
+	if a, err := d.RawAngle(); err != nil || a != 90*physic.Degree {
+		t.Fatal(a, err)
+	}
+	if a, err := d.Angle(); err != nil || a != 180*physic.Degree {
+		t.Fatal(a, err)
+	}
+	if err := d.SetRange(90*physic.Degree, 180*physic.Degree); err != nil {
+		t.Fatal(err)
+	}
+	if a, err := d.Angle(); err != nil || a != 45*physic.Degree {
+		t.Fatal(a, err)
+	}
+	if err := d.SetZero(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetRange(0, 10*physic.Degree); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.SetRange(360*physic.Degree, 90*physic.Degree); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	d, b := newTest(t,
+		i2ctest.IO{Addr: Address, W: []byte{regStatus}, R: []byte{0x30}},
+		i2ctest.IO{Addr: Address, W: []byte{regAGC}, R: []byte{200}},
+		i2ctest.IO{Addr: Address, W: []byte{regMagnitude}, R: []byte{0x01, 0x23}},
+	)
+	s, err := d.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Status{Detected: true, TooWeak: true, AGC: 200, Magnitude: 0x123}); s != want {
+		t.Fatalf("got %+v, want %+v", s, want)
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKnob(t *testing.T) {
+	d, _ := newTest(t, rawIO(4000), rawIO(4090), rawIO(500), rawIO(700), rawIO(100), rawIO(3000))
+	k, err := NewKnob(d, &KnobOpts{Detents: 4, Interval: time.Millisecond, Hysteresis: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := k.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int
+	for ev := range events {
+		if ev.Kind != rotary.Turn {
+			t.Fatal(ev)
+		}
+		got = append(got, ev.Steps)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != -1 || got[2] != -1 {
+		t.Fatal(got)
+	}
+	if p := k.Position(); p != -1 {
+		t.Fatal(p)
+	}
+	// The playback ran out of operations.
+	if k.Err() == nil {
+		t.Fatal("expected error")
+	}
+	if err := k.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewKnob(d, &KnobOpts{Detents: 0, Interval: time.Millisecond}); err == nil {
+		t.Fatal("expected error")
+	}
+}
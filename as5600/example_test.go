@@ -0,0 +1,68 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package as5600_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/as5600"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+	b, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer b.Close()
+	d, err := as5600.NewI2C(b, as5600.Address)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s, err := d.Status()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !s.Detected || s.TooWeak || s.TooStrong {
+		log.Fatalf("misplaced magnet: %+v", s)
+	}
+	a, err := d.RawAngle()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("angle:", a)
+}
+
+func ExampleKnob() {
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+	b, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer b.Close()
+	d, err := as5600.NewI2C(b, as5600.Address)
+	if err != nil {
+		log.Fatal(err)
+	}
+	k, err := as5600.NewKnob(d, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer k.Halt()
+	events, err := k.Start()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for ev := range events {
+		fmt.Println(ev, "position:", k.Position())
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package as5600
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/devices/v3/rotary"
+)
+
+// KnobOpts holds the configuration options of a Knob.
+type KnobOpts struct {
+	// Detents is the number of virtual detents per revolution.
+	Detents int
+	// Interval is the polling interval.
+	Interval time.Duration
+	// Hysteresis is the fraction of a detent, in percent, the knob must
+	// move past the middle between two detents to step, so that the noise
+	// of the sensor doesn't toggle between them.
+	Hysteresis int
+}
+
+// DefaultKnobOpts are 24 detents per revolution, like most encoders.
+var DefaultKnobOpts = KnobOpts{
+	Detents:    24,
+	Interval:   10 * time.Millisecond,
+	Hysteresis: 10,
+}
+
+// eventBufferSize is the size of the buffer of the events channel.
+const eventBufferSize = 16
+
+// Knob polls an AS5600, and sends its turns as rotary.Event, so that a
+// magnet on a shaft replaces a rotary encoder. The raw angle is used, so the
+// range configured with SetRange() doesn't apply.
+type Knob struct {
+	d    *Dev
+	opts KnobOpts
+
+	mu       sync.Mutex
+	position int
+	stop     chan struct{}
+	done     chan struct{}
+	err      error
+}
+
+// NewKnob returns a Knob reading d. If opts is nil, DefaultKnobOpts is used.
+func NewKnob(d *Dev, opts *KnobOpts) (*Knob, error) {
+	if opts == nil {
+		opts = &DefaultKnobOpts
+	}
+	if opts.Detents < 1 || opts.Detents > steps/4 || opts.Interval <= 0 || opts.Hysteresis < 0 || opts.Hysteresis >= 50 {
+		return nil, fmt.Errorf("as5600: invalid knob options %+v", *opts)
+	}
+	return &Knob{d: d, opts: *opts}, nil
+}
+
+// Start starts a goroutine polling the sensor, and sends the turns to the
+// returned channel, in detents, positive in the direction set by the DIR
+// pin. The channel is closed when Stop() is called, or a read fails. See
+// Err().
+func (k *Knob) Start() (<-chan rotary.Event, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.stop != nil {
+		return nil, errors.New("as5600: already started")
+	}
+	raw, err := k.d.rawSteps()
+	if err != nil {
+		return nil, err
+	}
+	k.stop = make(chan struct{})
+	k.done = make(chan struct{})
+	k.err = nil
+	events := make(chan rotary.Event, eventBufferSize)
+	go k.run(int(raw), events, k.stop, k.done)
+	return events, nil
+}
+
+// Stop stops the goroutine started by Start(), and waits for it to exit.
+func (k *Knob) Stop() {
+	k.mu.Lock()
+	stop, done := k.stop, k.done
+	k.stop = nil
+	k.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Err returns the error that stopped the goroutine, if any.
+func (k *Knob) Err() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.err
+}
+
+// Position returns the number of detents turned since Start().
+func (k *Knob) Position() int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.position
+}
+
+// Halt implements conn.Resource. It stops the goroutine started by Start().
+func (k *Knob) Halt() error {
+	k.Stop()
+	return nil
+}
+
+func (k *Knob) String() string {
+	return fmt.Sprintf("as5600.Knob{%s}", k.d)
+}
+
+func (k *Knob) run(raw int, events chan<- rotary.Event, stop, done chan struct{}) {
+	defer close(done)
+	defer close(events)
+	t := time.NewTicker(k.opts.Interval)
+	defer t.Stop()
+	// abs is the position in steps since Start(), unwrapped, and detent the
+	// current detent. Positions are compared scaled by Detents*100, in which
+	// a detent is steps*100, so that the detents don't drift when they
+	// aren't a divisor of steps.
+	abs, detent := 0, 0
+	threshold := steps * (50 + k.opts.Hysteresis)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+		v, err := k.d.rawSteps()
+		if err != nil {
+			k.mu.Lock()
+			k.err = err
+			k.mu.Unlock()
+			return
+		}
+		// The shortest move is assumed, so the knob must turn less than half
+		// a revolution between two polls.
+		delta := (int(v)-raw+steps+steps/2)%steps - steps/2
+		raw = int(v)
+		abs += delta
+		n := 0
+		for abs*k.opts.Detents*100-(detent+n)*steps*100 > threshold {
+			n++
+		}
+		for (detent+n)*steps*100-abs*k.opts.Detents*100 > threshold {
+			n--
+		}
+		detent += n
+		if n == 0 {
+			continue
+		}
+		k.mu.Lock()
+		k.position += n
+		k.mu.Unlock()
+		select {
+		case events <- rotary.Event{Kind: rotary.Turn, Steps: n, Time: time.Now()}:
+		case <-stop:
+			return
+		}
+	}
+}
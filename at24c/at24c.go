@@ -0,0 +1,200 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package at24c drives the AT24C32, AT24C64 and AT24C256 I²C EEPROMs, which
+// are found on many real time clock modules, for example alongside a DS3231.
+//
+// Dev implements io.ReaderAt and io.WriterAt. Writes are split at page
+// boundaries, since the device wraps around within a page, and each page
+// write waits for the write cycle of the device to complete by polling for
+// an acknowledge.
+//
+// # Datasheet
+//
+// https://ww1.microchip.com/downloads/en/DeviceDoc/doc0336.pdf
+//
+// https://ww1.microchip.com/downloads/en/DeviceDoc/doc0670.pdf
+package at24c
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/i2c"
+)
+
+// Variant is a model of the AT24C series.
+type Variant string
+
+const (
+	// AT24C32 has 4 KiB, in pages of 32 bytes.
+	AT24C32 Variant = "AT24C32"
+	// AT24C64 has 8 KiB, in pages of 32 bytes.
+	AT24C64 Variant = "AT24C64"
+	// AT24C256 has 32 KiB, in pages of 64 bytes.
+	AT24C256 Variant = "AT24C256"
+)
+
+// DefaultAddress is the address of the device with A0, A1 and A2 tied low.
+// On DS3231 modules, the address pins are usually pulled up, which gives
+// 0x57.
+const DefaultAddress uint16 = 0x50
+
+const (
+	// readChunk is the maximum number of bytes read in one transaction, to
+	// stay within the limits of I²C adapters.
+	readChunk = 256
+	// writeCycleTimeout is how long a page write is polled for. The device
+	// takes up to 10ms.
+	writeCycleTimeout = 20 * time.Millisecond
+	// writeCyclePoll is the interval between polls.
+	writeCyclePoll = 500 * time.Microsecond
+)
+
+var (
+	// ErrWriteTimeout is returned when the device doesn't complete a write
+	// cycle in time.
+	ErrWriteTimeout = errors.New("at24c: timeout waiting for the write cycle")
+	// ErrOutOfRange is returned when a write goes past the end of the
+	// device.
+	ErrOutOfRange = errors.New("at24c: write past the end of the device")
+)
+
+var variants = map[Variant]struct{ size, pageSize int }{
+	AT24C32:  {4096, 32},
+	AT24C64:  {8192, 32},
+	AT24C256: {32768, 64},
+}
+
+// Dev is a handle to an AT24C EEPROM.
+type Dev struct {
+	d        *i2c.Dev
+	variant  Variant
+	size     int
+	pageSize int
+	// mu serializes the accesses, which set the address pointer of the
+	// device.
+	mu sync.Mutex
+	// sleep is replaced by tests.
+	sleep func(time.Duration)
+}
+
+var (
+	_ io.ReaderAt = &Dev{}
+	_ io.WriterAt = &Dev{}
+)
+
+// New returns a handle to an EEPROM of the given variant at address addr,
+// which is in the range 0x50 to 0x57.
+func New(bus i2c.Bus, addr uint16, variant Variant) (*Dev, error) {
+	v, ok := variants[variant]
+	if !ok {
+		return nil, fmt.Errorf("at24c: unknown variant %q", variant)
+	}
+	if addr < 0x50 || addr > 0x57 {
+		return nil, fmt.Errorf("at24c: invalid address 0x%02x", addr)
+	}
+	return &Dev{
+		d:        &i2c.Dev{Bus: bus, Addr: addr},
+		variant:  variant,
+		size:     v.size,
+		pageSize: v.pageSize,
+		sleep:    time.Sleep,
+	}, nil
+}
+
+// Size returns the capacity of the device in bytes.
+func (d *Dev) Size() int {
+	return d.size
+}
+
+// PageSize returns the size of the pages of the device in bytes.
+func (d *Dev) PageSize() int {
+	return d.pageSize
+}
+
+// ReadAt implements io.ReaderAt. If p goes past the end of the device, the
+// bytes up to the end are read and io.EOF is returned.
+func (d *Dev) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("at24c: invalid offset %d", off)
+	}
+	if off >= int64(d.size) {
+		return 0, io.EOF
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	n := 0
+	for n < len(p) && int(off)+n < d.size {
+		l := min(len(p)-n, readChunk, d.size-int(off)-n)
+		if err := d.d.Tx(address(int(off)+n), p[n:n+l]); err != nil {
+			return n, fmt.Errorf("at24c: error reading at 0x%04x: %w", int(off)+n, err)
+		}
+		n += l
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// WriteAt implements io.WriterAt. The write is split at page boundaries, and
+// returns once the device completed the last write cycle. If p goes past the
+// end of the device, the bytes up to the end are written and ErrOutOfRange is
+// returned.
+func (d *Dev) WriteAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("at24c: invalid offset %d", off)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	n := 0
+	for n < len(p) && int(off)+n < d.size {
+		a := int(off) + n
+		l := min(len(p)-n, d.pageSize-a%d.pageSize)
+		if _, err := d.d.Write(append(address(a), p[n:n+l]...)); err != nil {
+			return n, fmt.Errorf("at24c: error writing at 0x%04x: %w", a, err)
+		}
+		if err := d.waitWriteCycle(a); err != nil {
+			return n, err
+		}
+		n += l
+	}
+	if n < len(p) {
+		return n, ErrOutOfRange
+	}
+	return n, nil
+}
+
+// Halt implements conn.Resource. It is a noop.
+func (d *Dev) Halt() error {
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("%s(%s)", d.variant, d.d)
+}
+
+// waitWriteCycle polls the device until it acknowledges its address, which
+// it doesn't during a write cycle. The poll sets the address pointer to a.
+func (d *Dev) waitWriteCycle(a int) error {
+	start := time.Now()
+	for {
+		if _, err := d.d.Write(address(a)); err == nil {
+			return nil
+		}
+		if time.Since(start) > writeCycleTimeout {
+			return ErrWriteTimeout
+		}
+		d.sleep(writeCyclePoll)
+	}
+}
+
+// address returns the two address bytes sent before a read or a write.
+func address(a int) []byte {
+	return []byte{byte(a >> 8), byte(a)}
+}
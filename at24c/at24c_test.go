@@ -0,0 +1,163 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package at24c
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// eepromBus is an i2c.Bus that emulates an AT24C EEPROM, including the page
+// wrap around and the write cycle.
+type eepromBus struct {
+	mem      []byte
+	pageSize int
+	ptr      int
+	// cycle is the number of transactions not acknowledged after a write.
+	cycle int
+	busy  int
+	// writes records the length of each page write.
+	writes []int
+	// polls is the number of transactions not acknowledged.
+	polls int
+}
+
+func (b *eepromBus) String() string { return "eepromBus" }
+
+func (b *eepromBus) SetSpeed(f physic.Frequency) error { return nil }
+
+func (b *eepromBus) Tx(addr uint16, w, r []byte) error {
+	if b.busy > 0 {
+		b.busy--
+		b.polls++
+		return errors.New("eepromBus: nack")
+	}
+	if len(w) < 2 {
+		return errors.New("eepromBus: missing address")
+	}
+	b.ptr = (int(w[0])<<8 | int(w[1])) % len(b.mem)
+	if data := w[2:]; len(data) > 0 {
+		page := b.ptr - b.ptr%b.pageSize
+		for ix, v := range data {
+			b.mem[page+(b.ptr-page+ix)%b.pageSize] = v
+		}
+		b.writes = append(b.writes, len(data))
+		b.busy = b.cycle
+	}
+	for ix := range r {
+		r[ix] = b.mem[b.ptr]
+		b.ptr = (b.ptr + 1) % len(b.mem)
+	}
+	return nil
+}
+
+func newTestDev(t *testing.T, variant Variant, cycle int) (*Dev, *eepromBus) {
+	v := variants[variant]
+	bus := &eepromBus{mem: make([]byte, v.size), pageSize: v.pageSize, cycle: cycle}
+	d, err := New(bus, DefaultAddress, variant)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.sleep = func(time.Duration) {}
+	return d, bus
+}
+
+func TestNew(t *testing.T) {
+	bus := &eepromBus{}
+	if _, err := New(bus, DefaultAddress, "AT24C01"); err == nil {
+		t.Error("expected an error for an unknown variant")
+	}
+	if _, err := New(bus, 0x68, AT24C32); err == nil {
+		t.Error("expected an error for an invalid address")
+	}
+	d, err := New(bus, 0x57, AT24C256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Size() != 32768 || d.PageSize() != 64 {
+		t.Errorf("Size() = %d, PageSize() = %d", d.Size(), d.PageSize())
+	}
+	if s := d.String(); s != "AT24C256(eepromBus(87))" {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestWriteAt(t *testing.T) {
+	d, bus := newTestDev(t, AT24C32, 3)
+	data := make([]byte, 70)
+	for ix := range data {
+		data[ix] = byte(ix + 1)
+	}
+	// Starting in the middle of a page, 70 bytes span 3 pages.
+	n, err := d.WriteAt(data, 20)
+	if err != nil || n != len(data) {
+		t.Fatalf("WriteAt() = %d, %v", n, err)
+	}
+	if want := []int{12, 32, 26}; !slices.Equal(bus.writes, want) {
+		t.Errorf("page writes = %v, want %v", bus.writes, want)
+	}
+	if bus.polls != 9 {
+		t.Errorf("polls = %d, want 9", bus.polls)
+	}
+	if !bytes.Equal(bus.mem[20:90], data) {
+		t.Errorf("memory = %v, want %v", bus.mem[20:90], data)
+	}
+	got := make([]byte, len(data))
+	if n, err := d.ReadAt(got, 20); err != nil || n != len(got) {
+		t.Fatalf("ReadAt() = %d, %v", n, err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("ReadAt() read %v, want %v", got, data)
+	}
+}
+
+func TestWriteAt_end(t *testing.T) {
+	d, bus := newTestDev(t, AT24C32, 0)
+	n, err := d.WriteAt([]byte{1, 2, 3, 4}, 4094)
+	if n != 2 || err != ErrOutOfRange {
+		t.Errorf("WriteAt() = %d, %v, want 2, %v", n, err, ErrOutOfRange)
+	}
+	if bus.mem[4094] != 1 || bus.mem[4095] != 2 || bus.mem[0] != 0 {
+		t.Errorf("unexpected memory content")
+	}
+}
+
+func TestWriteAt_timeout(t *testing.T) {
+	d, _ := newTestDev(t, AT24C64, 1<<30)
+	d.sleep = time.Sleep
+	if _, err := d.WriteAt([]byte{1}, 0); err != ErrWriteTimeout {
+		t.Errorf("WriteAt() = %v, want %v", err, ErrWriteTimeout)
+	}
+}
+
+func TestReadAt(t *testing.T) {
+	d, bus := newTestDev(t, AT24C256, 0)
+	for ix := range bus.mem {
+		bus.mem[ix] = byte(ix)
+	}
+	// Reads are split in chunks.
+	got := make([]byte, 600)
+	if n, err := d.ReadAt(got, 1000); err != nil || n != len(got) {
+		t.Fatalf("ReadAt() = %d, %v", n, err)
+	}
+	if !bytes.Equal(got, bus.mem[1000:1600]) {
+		t.Error("unexpected content")
+	}
+	if n, err := d.ReadAt(got, 32768-10); n != 10 || err != io.EOF {
+		t.Errorf("ReadAt() = %d, %v, want 10, %v", n, err, io.EOF)
+	}
+	if n, err := d.ReadAt(got, 32768); n != 0 || err != io.EOF {
+		t.Errorf("ReadAt() = %d, %v, want 0, %v", n, err, io.EOF)
+	}
+	if _, err := d.ReadAt(got, -1); err == nil {
+		t.Error("expected an error for a negative offset")
+	}
+}
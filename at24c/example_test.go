@@ -0,0 +1,42 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package at24c_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/at24c"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	// The EEPROM of a DS3231 module.
+	eeprom, err := at24c.New(bus, 0x57, at24c.AT24C32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := eeprom.WriteAt([]byte("settings"), 0x100); err != nil {
+		log.Fatal(err)
+	}
+	b := make([]byte, 8)
+	if _, err := eeprom.ReadAt(b, 0x100); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(b))
+}
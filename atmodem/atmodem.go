@@ -0,0 +1,478 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package atmodem controls cellular modems, such as the SIMCom SIM800 and
+// the Quectel M95, BG95 and EC25, with AT commands over their serial port,
+// to send and receive SMS and monitor the network registration.
+//
+// A goroutine reads the modem continuously, passing the responses to the
+// command being run and the unsolicited result codes (URC), such as the
+// notification of an incoming SMS, to the Events() channel.
+//
+// # Wiring
+//
+// Connect the TX and RX pins of the modem to the RX and TX pins of the UART
+// of the host, through level shifters if the modem uses 2.8V or 1.8V levels.
+// The modems need bursts of 2A while transmitting, so power them from a
+// supply able to provide it, with a large capacitor close to the modem.
+//
+// # References
+//
+// https://www.3gpp.org/DynaReport/27005.htm
+//
+// https://www.3gpp.org/DynaReport/27007.htm
+package atmodem
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrTimeout is returned when the modem doesn't complete a command in time.
+var ErrTimeout = errors.New("atmodem: timeout")
+
+// Registration is the network registration status.
+type Registration int
+
+const (
+	NotRegistered     Registration = 0
+	RegisteredHome    Registration = 1
+	Searching         Registration = 2
+	Denied            Registration = 3
+	UnknownStatus     Registration = 4
+	RegisteredRoaming Registration = 5
+)
+
+var registrationNames = [...]string{"NotRegistered", "RegisteredHome", "Searching", "Denied", "UnknownStatus", "RegisteredRoaming"}
+
+func (r Registration) String() string {
+	if r < 0 || int(r) >= len(registrationNames) {
+		return fmt.Sprintf("Registration(%d)", int(r))
+	}
+	return registrationNames[r]
+}
+
+// Registered returns true if the modem is registered, at home or roaming.
+func (r Registration) Registered() bool {
+	return r == RegisteredHome || r == RegisteredRoaming
+}
+
+// Kind is the kind of an Event.
+type Kind int
+
+const (
+	// URC is an unsolicited result code without a more specific kind.
+	URC Kind = iota
+	// NetworkStatus is a change of the registration, in Event.Status.
+	NetworkStatus
+	// NewSMS is an SMS received and stored at Event.Index, to be read with
+	// ReadSMS().
+	NewSMS
+	// Ring is an incoming call.
+	Ring
+)
+
+func (k Kind) String() string {
+	switch k {
+	case URC:
+		return "URC"
+	case NetworkStatus:
+		return "NetworkStatus"
+	case NewSMS:
+		return "NewSMS"
+	case Ring:
+		return "Ring"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Event is an unsolicited result code sent by the modem.
+type Event struct {
+	Kind Kind
+	// Line is the URC as received.
+	Line string
+	// Status is the registration status of a NetworkStatus event.
+	Status Registration
+	// Index is the storage index of a NewSMS event.
+	Index int
+	Time  time.Time
+}
+
+// Opts holds the configuration options.
+type Opts struct {
+	// Timeout is the timeout of the commands. SendSMS() waits up to 60s,
+	// the maximum specified.
+	Timeout time.Duration
+}
+
+// DefaultOpts are the recommended options.
+var DefaultOpts = Opts{
+	Timeout: 5 * time.Second,
+}
+
+const (
+	// eventBufferSize is the size of the buffer of the events channel.
+	eventBufferSize = 16
+	// prompt is sent by the modem when it waits for the text of an SMS.
+	prompt = "> "
+	// ctrlZ ends the text of an SMS.
+	ctrlZ = 0x1A
+)
+
+// urcPrefixes are the prefixes of the URCs. The same prefix may be a
+// response, such as +CREG: to AT+CREG?, so lines with the prefix of the
+// command being run are passed to it.
+var urcPrefixes = []string{"+CREG:", "+CGREG:", "+CEREG:", "+CMTI:", "+CMT:", "+CDSI:", "+CLIP:", "RING", "NO CARRIER", "+CPIN:", "Call Ready", "SMS Ready", "RDY", "+CFUN:", "UNDER-VOLTAGE", "OVER-VOLTAGE", "NORMAL POWER DOWN", "POWERED DOWN"}
+
+// command is the command being run.
+type command struct {
+	// prefix is the prefix of the response lines, such as "+CSQ:".
+	prefix string
+	// lines is the lines received and not yet handled, and closed is true
+	// once the modem can't be read anymore. They're guarded by Dev.mu, so
+	// that no line of a long response is dropped.
+	lines  []string
+	closed bool
+	// ready is signaled when lines are received, or closed is set.
+	ready chan struct{}
+}
+
+// signal wakes up the goroutine running c. The caller must hold Dev.mu.
+func (c *command) signal() {
+	select {
+	case c.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Dev is a handle to a modem.
+type Dev struct {
+	rw   io.ReadWriter
+	opts Opts
+
+	// cmdMu serializes the commands.
+	cmdMu sync.Mutex
+
+	mu      sync.Mutex
+	pending *command
+	err     error
+	events  chan Event
+	done    chan struct{}
+}
+
+// New returns a handle to a modem connected to rw, usually a serial port,
+// and initializes it: echo off, verbose errors, SMS in text mode and
+// notifications of the registration changes and of the SMS received. If
+// opts is nil, DefaultOpts is used.
+func New(rw io.ReadWriter, opts *Opts) (*Dev, error) {
+	if rw == nil {
+		return nil, errors.New("atmodem: port is required")
+	}
+	if opts == nil {
+		opts = &DefaultOpts
+	}
+	if opts.Timeout <= 0 {
+		return nil, fmt.Errorf("atmodem: invalid timeout %s", opts.Timeout)
+	}
+	d := &Dev{rw: rw, opts: *opts, events: make(chan Event, eventBufferSize), done: make(chan struct{})}
+	go d.run()
+	// The first command may be lost while the modem detects the baud rate,
+	// or echoed back.
+	var err error
+	for i := 0; i < 3; i++ {
+		if _, err = d.Command("AT"); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		_ = d.Halt()
+		return nil, err
+	}
+	for _, cmd := range []string{"ATE0", "AT+CMEE=2", "AT+CMGF=1", "AT+CREG=1", `AT+CNMI=2,1,0,0,0`} {
+		if _, err := d.Command(cmd); err != nil {
+			_ = d.Halt()
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// Command sends cmd, such as "AT+CSQ", and returns the lines of the
+// response, without the final result code. It returns an error for the
+// ERROR, +CME ERROR and +CMS ERROR result codes.
+func (d *Dev) Command(cmd string) ([]string, error) {
+	return d.CommandContext(context.Background(), cmd)
+}
+
+// CommandContext is Command(), returning an error wrapping ctx.Err() if ctx
+// is done before the response. A response received later is discarded.
+func (d *Dev) CommandContext(ctx context.Context, cmd string) ([]string, error) {
+	return d.command(ctx, cmd, "", d.opts.Timeout)
+}
+
+// Events returns the channel of the URCs. Events are dropped when the
+// channel's buffer is full. The channel is closed when the modem can't be
+// read anymore, such as after Halt(). See Err().
+func (d *Dev) Events() <-chan Event {
+	return d.events
+}
+
+// Err returns the error that stopped the reading of the modem, if any.
+func (d *Dev) Err() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.err
+}
+
+// Registration returns the network registration status.
+func (d *Dev) Registration() (Registration, error) {
+	l, err := d.query("AT+CREG?")
+	if err != nil {
+		return 0, err
+	}
+	f := fields(l)
+	if len(f) < 2 {
+		return 0, fmt.Errorf("atmodem: invalid response %q", l)
+	}
+	s, err := strconv.Atoi(f[1])
+	if err != nil {
+		return 0, fmt.Errorf("atmodem: invalid response %q", l)
+	}
+	return Registration(s), nil
+}
+
+// SignalQuality returns the received signal strength in dBm, from -113 to
+// -51. ok is false when it's not known, such as before the registration.
+func (d *Dev) SignalQuality() (dBm int, ok bool, err error) {
+	l, err := d.query("AT+CSQ")
+	if err != nil {
+		return 0, false, err
+	}
+	f := fields(l)
+	rssi, err := strconv.Atoi(f[0])
+	if err != nil {
+		return 0, false, fmt.Errorf("atmodem: invalid response %q", l)
+	}
+	if rssi == 99 {
+		return 0, false, nil
+	}
+	return -113 + 2*rssi, true, nil
+}
+
+// Halt implements conn.Resource. It closes the port if it implements
+// io.Closer, which stops the goroutine reading it.
+func (d *Dev) Halt() error {
+	if c, ok := d.rw.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			return fmt.Errorf("atmodem: %w", err)
+		}
+		<-d.done
+	}
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("atmodem{%v}", d.rw)
+}
+
+// query runs cmd and returns the fields of its response line, after its
+// prefix.
+func (d *Dev) query(cmd string) (string, error) {
+	lines, err := d.Command(cmd)
+	if err != nil {
+		return "", err
+	}
+	p := responsePrefix(cmd)
+	for _, l := range lines {
+		if strings.HasPrefix(l, p) {
+			return strings.TrimSpace(l[len(p):]), nil
+		}
+	}
+	return "", fmt.Errorf("atmodem: %s: no response", cmd)
+}
+
+// command sends cmd, and text after the prompt if not empty.
+func (d *Dev) command(ctx context.Context, cmd, text string, timeout time.Duration) ([]string, error) {
+	d.cmdMu.Lock()
+	defer d.cmdMu.Unlock()
+	c := &command{prefix: responsePrefix(cmd), ready: make(chan struct{}, 1)}
+	d.mu.Lock()
+	if d.err != nil {
+		err := d.err
+		d.mu.Unlock()
+		return nil, err
+	}
+	d.pending = c
+	d.mu.Unlock()
+	defer func() {
+		d.mu.Lock()
+		d.pending = nil
+		d.mu.Unlock()
+	}()
+	if _, err := io.WriteString(d.rw, cmd+"\r"); err != nil {
+		return nil, fmt.Errorf("atmodem: %w", err)
+	}
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	var lines []string
+	for {
+		select {
+		case <-c.ready:
+			d.mu.Lock()
+			received, closed := c.lines, c.closed
+			c.lines = nil
+			d.mu.Unlock()
+			for _, l := range received {
+				switch {
+				case l == prompt:
+					if text == "" {
+						continue
+					}
+					if _, err := io.WriteString(d.rw, text+string(rune(ctrlZ))); err != nil {
+						return nil, fmt.Errorf("atmodem: %w", err)
+					}
+				case l == "OK":
+					return lines, nil
+				case l == "ERROR" || strings.HasPrefix(l, "+CME ERROR:") || strings.HasPrefix(l, "+CMS ERROR:"):
+					return nil, fmt.Errorf("atmodem: %s: %s", cmd, l)
+				case l == cmd:
+					// Echo.
+				default:
+					lines = append(lines, l)
+				}
+			}
+			if closed {
+				return nil, d.Err()
+			}
+		case <-t.C:
+			return nil, fmt.Errorf("%w: %s", ErrTimeout, cmd)
+		case <-ctx.Done():
+			return nil, fmt.Errorf("atmodem: %s: %w", cmd, ctx.Err())
+		}
+	}
+}
+
+// run reads the modem, until it fails.
+func (d *Dev) run() {
+	defer close(d.done)
+	r := bufio.NewReader(d.rw)
+	var line []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			d.mu.Lock()
+			d.err = fmt.Errorf("atmodem: %w", err)
+			if d.pending != nil {
+				d.pending.closed = true
+				d.pending.signal()
+				d.pending = nil
+			}
+			d.mu.Unlock()
+			close(d.events)
+			return
+		}
+		switch {
+		case b == '\n':
+			if l := strings.TrimSpace(string(line)); l != "" {
+				d.dispatch(l)
+			}
+			line = line[:0]
+		case b == ' ' && string(line) == ">":
+			// The prompt isn't followed by a line ending.
+			d.dispatch(prompt)
+			line = line[:0]
+		default:
+			line = append(line, b)
+		}
+	}
+}
+
+// dispatch passes l to the command being run, or to the events channel.
+func (d *Dev) dispatch(l string) {
+	d.mu.Lock()
+	if c := d.pending; c != nil && (strings.HasPrefix(l, c.prefix) || !isURC(l)) {
+		c.lines = append(c.lines, l)
+		c.signal()
+		d.mu.Unlock()
+		return
+	}
+	d.mu.Unlock()
+	if !isURC(l) {
+		return
+	}
+	select {
+	case d.events <- parseURC(l):
+	default:
+	}
+}
+
+func isURC(l string) bool {
+	for _, p := range urcPrefixes {
+		if strings.HasPrefix(l, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseURC(l string) Event {
+	e := Event{Kind: URC, Line: l, Index: -1, Time: time.Now()}
+	f := fields(l[strings.IndexByte(l, ':')+1:])
+	switch {
+	case strings.HasPrefix(l, "+CREG:") || strings.HasPrefix(l, "+CGREG:") || strings.HasPrefix(l, "+CEREG:"):
+		if s, err := strconv.Atoi(f[0]); err == nil {
+			e.Kind, e.Status = NetworkStatus, Registration(s)
+		}
+	case strings.HasPrefix(l, "+CMTI:"):
+		if len(f) >= 2 {
+			if i, err := strconv.Atoi(f[1]); err == nil {
+				e.Kind, e.Index = NewSMS, i
+			}
+		}
+	case l == "RING":
+		e.Kind = Ring
+	}
+	return e
+}
+
+// responsePrefix returns the prefix of the response lines of cmd, such as
+// "+CSQ:" for "AT+CSQ" or "+CMGR:" for "AT+CMGR=1".
+func responsePrefix(cmd string) string {
+	c := strings.TrimPrefix(strings.ToUpper(cmd), "AT")
+	if !strings.HasPrefix(c, "+") {
+		return "\x00"
+	}
+	if i := strings.IndexAny(c, "=?"); i >= 0 {
+		c = c[:i]
+	}
+	return c + ":"
+}
+
+// fields splits a comma separated list, removing the quotes of quoted
+// values, which may contain commas.
+func fields(s string) []string {
+	var f []string
+	var cur strings.Builder
+	quoted := false
+	for _, r := range strings.TrimSpace(s) {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ',' && !quoted:
+			f = append(f, strings.TrimSpace(cur.String()))
+			cur.Reset()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	return append(f, strings.TrimSpace(cur.String()))
+}
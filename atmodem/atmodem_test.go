@@ -0,0 +1,230 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package atmodem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeModem answers the commands written to it with the responses of
+// replies, "OK" by default.
+type fakeModem struct {
+	r *io.PipeReader
+	w *io.PipeWriter
+
+	mu      sync.Mutex
+	buf     []byte
+	cmds    []string
+	replies map[string]string
+}
+
+func newFakeModem(replies map[string]string) *fakeModem {
+	r, w := io.Pipe()
+	return &fakeModem{r: r, w: w, replies: replies}
+}
+
+func (f *fakeModem) Read(p []byte) (int, error) {
+	return f.r.Read(p)
+}
+
+func (f *fakeModem) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, b := range p {
+		if b != '\r' && b != ctrlZ {
+			f.buf = append(f.buf, b)
+			continue
+		}
+		cmd := string(f.buf)
+		f.buf = f.buf[:0]
+		if b == ctrlZ {
+			cmd = "text:" + cmd
+		}
+		f.cmds = append(f.cmds, cmd)
+		reply, ok := f.replies[cmd]
+		if !ok {
+			reply = "OK"
+		}
+		if reply == "" {
+			continue
+		}
+		f.send("\r\n" + strings.ReplaceAll(reply, "\n", "\r\n") + "\r\n")
+	}
+	return len(p), nil
+}
+
+// send writes s to the host asynchronously, since the pipe blocks until it's
+// read.
+func (f *fakeModem) send(s string) {
+	go func() {
+		_, _ = io.WriteString(f.w, s)
+	}()
+}
+
+func (f *fakeModem) Close() error {
+	return f.r.Close()
+}
+
+func (f *fakeModem) commands() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.cmds...)
+}
+
+func TestNew(t *testing.T) {
+	m := newFakeModem(nil)
+	d, err := New(m, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"AT", "ATE0", "AT+CMEE=2", "AT+CMGF=1", "AT+CREG=1", "AT+CNMI=2,1,0,0,0"}
+	if got := m.commands(); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-d.Events(); ok {
+		t.Fatal("events not closed")
+	}
+	if _, err := d.Command("AT"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNew_Timeout(t *testing.T) {
+	m := newFakeModem(map[string]string{"AT": ""})
+	if _, err := New(m, &Opts{Timeout: time.Millisecond}); !errors.Is(err, ErrTimeout) {
+		t.Fatal(err)
+	}
+}
+
+func TestCommand(t *testing.T) {
+	m := newFakeModem(map[string]string{
+		"AT+CSQ":    "+CSQ: 20,0\nOK",
+		"AT+CREG?":  "+CREG: 1,5\nOK",
+		"AT+CPIN?":  "+CME ERROR: SIM not inserted",
+		"AT+COPS=?": "",
+	})
+	d, err := New(m, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Halt()
+	if dBm, ok, err := d.SignalQuality(); err != nil || !ok || dBm != -73 {
+		t.Fatal(dBm, ok, err)
+	}
+	if r, err := d.Registration(); err != nil || r != RegisteredRoaming || !r.Registered() {
+		t.Fatal(r, err)
+	}
+	if _, err := d.Command("AT+CPIN?"); err == nil || !strings.Contains(err.Error(), "SIM not inserted") {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := d.CommandContext(ctx, "AT+COPS=?"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatal(err)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	m := newFakeModem(nil)
+	d, err := New(m, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Halt()
+	m.send("\r\n+CREG: 2\r\n")
+	if e := <-d.Events(); e.Kind != NetworkStatus || e.Status != Searching {
+		t.Fatalf("%+v", e)
+	}
+	m.send("\r\n+CMTI: \"SM\",3\r\n")
+	if e := <-d.Events(); e.Kind != NewSMS || e.Index != 3 {
+		t.Fatalf("%+v", e)
+	}
+	m.send("\r\nRING\r\n")
+	if e := <-d.Events(); e.Kind != Ring {
+		t.Fatalf("%+v", e)
+	}
+}
+
+func TestSMS(t *testing.T) {
+	m := newFakeModem(map[string]string{
+		`AT+CMGS="+15551234567"`: "> ",
+		"text:Alarm: door open":  "+CMGS: 42\nOK",
+		"AT+CMGR=3":              "+CMGR: \"REC UNREAD\",\"+15557654321\",\"\",\"25/10/17,08:30:00-16\"\nHello,\nworld\nOK",
+		`AT+CMGL="ALL"`:          "+CMGL: 1,\"REC READ\",\"+1555\",\"\",\"25/10/17,08:30:00+00\"\nOne\n+CMGL: 2,\"STO UNSENT\",\"+1556\",\"\",\nTwo\nOK",
+		"AT+CMGD=3":              "+CMS ERROR: 321",
+	})
+	d, err := New(m, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Halt()
+	if ref, err := d.SendSMS("+15551234567", "Alarm: door open"); err != nil || ref != 42 {
+		t.Fatal(ref, err)
+	}
+	s, err := d.ReadSMS(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Index != 3 || s.Status != "REC UNREAD" || s.From != "+15557654321" || s.Text != "Hello,\nworld" {
+		t.Fatalf("%+v", s)
+	}
+	if want := time.Date(2025, 10, 17, 12, 30, 0, 0, time.UTC); !s.Time.Equal(want) {
+		t.Fatalf("got %s, want %s", s.Time, want)
+	}
+	all, err := d.ListSMS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].Index != 1 || all[0].Text != "One" || all[1].Index != 2 || all[1].Text != "Two" || !all[1].Time.IsZero() {
+		t.Fatalf("%+v", all)
+	}
+	if err := d.DeleteSMS(3); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := d.SendSMS("+1555", strings.Repeat("x", 161)); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := d.SendSMS(`+1555"`, "x"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestListSMS_long(t *testing.T) {
+	// More lines than a response used to buffer.
+	const n = 100
+	var b strings.Builder
+	for i := range n {
+		fmt.Fprintf(&b, "+CMGL: %d,\"REC READ\",\"+1555\",\"\",\"25/10/17,08:30:00+00\"\nMessage %d\n", i, i)
+	}
+	b.WriteString("OK")
+	m := newFakeModem(map[string]string{`AT+CMGL="ALL"`: b.String()})
+	d, err := New(m, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Halt()
+	all, err := d.ListSMS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != n {
+		t.Fatalf("got %d messages, want %d", len(all), n)
+	}
+	for i, s := range all {
+		if s.Index != i || s.Text != fmt.Sprintf("Message %d", i) {
+			t.Fatalf("%+v", s)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package atmodem_test
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"periph.io/x/devices/v3/atmodem"
+)
+
+func Example() {
+	// The UART must be configured beforehand, for example with
+	// `stty -F /dev/serial0 115200 raw`.
+	f, err := os.OpenFile("/dev/serial0", os.O_RDWR, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	d, err := atmodem.New(f, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+	if _, err := d.SendSMS("+15551234567", "Alarm: door open"); err != nil {
+		log.Fatal(err)
+	}
+	for e := range d.Events() {
+		switch e.Kind {
+		case atmodem.NetworkStatus:
+			fmt.Println("network:", e.Status)
+		case atmodem.NewSMS:
+			s, err := d.ReadSMS(e.Index)
+			if err != nil {
+				log.Fatal(err)
+			}
+			fmt.Printf("%s from %s: %s\n", s.Time, s.From, s.Text)
+			if err := d.DeleteSMS(e.Index); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+}
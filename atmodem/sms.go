@@ -0,0 +1,156 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package atmodem
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// smsTimeout is the maximum time to send an SMS, from 3GPP TS 27.005.
+const smsTimeout = 60 * time.Second
+
+// SMS is a stored SMS.
+type SMS struct {
+	Index int
+	// Status is "REC UNREAD", "REC READ", "STO UNSENT" or "STO SENT".
+	Status string
+	// From is the number of the sender, or the recipient of a stored SMS.
+	From string
+	// Time is the time stamp of the service center, zero for stored SMS.
+	Time time.Time
+	Text string
+}
+
+// SendSMS sends text to number, in international format such as
+// "+15551234567", and returns the message reference. The text is sent in
+// text mode, so it must only contain characters of the GSM 7-bit alphabet,
+// and be up to 160 characters.
+func (d *Dev) SendSMS(number, text string) (int, error) {
+	return d.SendSMSContext(context.Background(), number, text)
+}
+
+// SendSMSContext is SendSMS(), returning an error wrapping ctx.Err() if ctx
+// is done before the modem confirms that the SMS was sent. The SMS may
+// still be sent.
+func (d *Dev) SendSMSContext(ctx context.Context, number, text string) (int, error) {
+	if len(text) > 160 || strings.ContainsRune(text, ctrlZ) || strings.ContainsRune(text, 0x1B) {
+		return 0, fmt.Errorf("atmodem: invalid SMS text %q", text)
+	}
+	if number == "" || strings.ContainsAny(number, "\"\r\n") {
+		return 0, fmt.Errorf("atmodem: invalid number %q", number)
+	}
+	lines, err := d.command(ctx, fmt.Sprintf("AT+CMGS=%q", number), text, smsTimeout)
+	if err != nil {
+		return 0, err
+	}
+	for _, l := range lines {
+		if v, ok := strings.CutPrefix(l, "+CMGS:"); ok {
+			ref, err := strconv.Atoi(strings.TrimSpace(v))
+			if err != nil {
+				return 0, fmt.Errorf("atmodem: invalid response %q", l)
+			}
+			return ref, nil
+		}
+	}
+	return 0, fmt.Errorf("atmodem: AT+CMGS: no response")
+}
+
+// ReadSMS reads the SMS stored at index, such as the one of a NewSMS event.
+// It's marked read.
+func (d *Dev) ReadSMS(index int) (SMS, error) {
+	lines, err := d.Command(fmt.Sprintf("AT+CMGR=%d", index))
+	if err != nil {
+		return SMS{}, err
+	}
+	if len(lines) == 0 {
+		return SMS{}, fmt.Errorf("atmodem: no SMS at %d", index)
+	}
+	l, ok := strings.CutPrefix(lines[0], "+CMGR:")
+	if !ok {
+		return SMS{}, fmt.Errorf("atmodem: invalid response %q", lines[0])
+	}
+	s, err := parseSMS(strings.TrimSpace(l), lines[1:])
+	s.Index = index
+	return s, err
+}
+
+// ListSMS returns the SMS stored.
+func (d *Dev) ListSMS() ([]SMS, error) {
+	lines, err := d.Command(`AT+CMGL="ALL"`)
+	if err != nil {
+		return nil, err
+	}
+	var all []SMS
+	for i := 0; i < len(lines); {
+		h, ok := strings.CutPrefix(lines[i], "+CMGL:")
+		if !ok {
+			return nil, fmt.Errorf("atmodem: invalid response %q", lines[i])
+		}
+		// The text spans the lines up to the next header.
+		j := i + 1
+		for j < len(lines) && !strings.HasPrefix(lines[j], "+CMGL:") {
+			j++
+		}
+		f := strings.SplitN(strings.TrimSpace(h), ",", 2)
+		index, err := strconv.Atoi(f[0])
+		if err != nil || len(f) < 2 {
+			return nil, fmt.Errorf("atmodem: invalid response %q", lines[i])
+		}
+		s, err := parseSMS(f[1], lines[i+1:j])
+		if err != nil {
+			return nil, err
+		}
+		s.Index = index
+		all = append(all, s)
+		i = j
+	}
+	return all, nil
+}
+
+// DeleteSMS deletes the SMS stored at index.
+func (d *Dev) DeleteSMS(index int) error {
+	_, err := d.Command(fmt.Sprintf("AT+CMGD=%d", index))
+	return err
+}
+
+// parseSMS parses the header of an SMS, "status","number","name","time",
+// followed by the lines of its text.
+func parseSMS(header string, text []string) (SMS, error) {
+	f := fields(header)
+	if len(f) < 2 {
+		return SMS{}, fmt.Errorf("atmodem: invalid SMS header %q", header)
+	}
+	s := SMS{Status: f[0], From: f[1], Text: strings.Join(text, "\n")}
+	if len(f) >= 4 && f[3] != "" {
+		t, err := parseTime(f[3])
+		if err != nil {
+			return SMS{}, err
+		}
+		s.Time = t
+	}
+	return s, nil
+}
+
+// parseTime parses a time stamp, "yy/MM/dd,hh:mm:ss±zz", where zz is the
+// time zone in quarters of an hour.
+func parseTime(s string) (time.Time, error) {
+	if len(s) != 20 {
+		return time.Time{}, fmt.Errorf("atmodem: invalid time stamp %q", s)
+	}
+	t, err := time.Parse("06/01/02,15:04:05", s[:17])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("atmodem: invalid time stamp %q", s)
+	}
+	q, err := strconv.Atoi(s[17:])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("atmodem: invalid time stamp %q", s)
+	}
+	offset := q * 15 * 60
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.FixedZone("", offset)), nil
+}
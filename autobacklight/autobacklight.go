@@ -0,0 +1,206 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package autobacklight adjusts the backlight of a display to the ambient
+// light measured by a sensor, such as a BH1750, so that the display is
+// readable in daylight without glaring in the dark.
+//
+// The light is smoothed, so that a shadow passing over the sensor doesn't
+// flicker the backlight, and the backlight is only changed when the
+// intensity moves by a step, since many displays write it to EEPROM.
+package autobacklight
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/display"
+	"periph.io/x/conn/v3/physic"
+)
+
+// LightSensor measures the ambient light. *bh1750.Dev implements it.
+type LightSensor interface {
+	Sense() (physic.LuminousFlux, error)
+}
+
+// Point maps a level of ambient light to a backlight intensity.
+type Point struct {
+	Light     physic.LuminousFlux
+	Intensity display.Intensity
+}
+
+// Opts holds the configuration options.
+type Opts struct {
+	// Interval is the time between measurements.
+	Interval time.Duration
+	// Curve maps the light to the intensity, interpolating linearly between
+	// points, and clamping to the first and the last point.
+	Curve []Point
+	// Smoothing is the weight of the previous light level, from 0 to 1, in
+	// the exponential moving average of the measurements.
+	Smoothing float64
+	// MinStep is the smallest change of intensity applied.
+	MinStep display.Intensity
+}
+
+// DefaultOpts suits displays with intensities from 0 to 255, from a dim
+// room to indirect daylight.
+var DefaultOpts = Opts{
+	Interval: time.Second,
+	Curve: []Point{
+		{0, 16},
+		{10 * physic.Lumen, 48},
+		{100 * physic.Lumen, 128},
+		{1000 * physic.Lumen, 255},
+	},
+	Smoothing: 0.7,
+	MinStep:   8,
+}
+
+// Controller sets the backlight of a display from the measurements of a
+// light sensor.
+type Controller struct {
+	sensor LightSensor
+	bl     display.DisplayBacklight
+	opts   Opts
+
+	mu sync.Mutex
+	// light is the smoothed light, valid if measured is true.
+	light     float64
+	measured  bool
+	intensity display.Intensity
+	applied   bool
+	stop      chan struct{}
+	done      chan struct{}
+	err       error
+}
+
+// New returns a Controller setting the backlight bl from the light measured
+// by sensor. If opts is nil, DefaultOpts is used.
+func New(sensor LightSensor, bl display.DisplayBacklight, opts *Opts) (*Controller, error) {
+	if sensor == nil || bl == nil {
+		return nil, errors.New("autobacklight: sensor and backlight are required")
+	}
+	if opts == nil {
+		opts = &DefaultOpts
+	}
+	if opts.Interval <= 0 || len(opts.Curve) == 0 || opts.Smoothing < 0 || opts.Smoothing >= 1 || opts.MinStep < 0 {
+		return nil, fmt.Errorf("autobacklight: invalid options %+v", *opts)
+	}
+	o := *opts
+	o.Curve = append([]Point(nil), opts.Curve...)
+	sort.Slice(o.Curve, func(i, j int) bool { return o.Curve[i].Light < o.Curve[j].Light })
+	return &Controller{sensor: sensor, bl: bl, opts: o}, nil
+}
+
+// Update measures the light once, and sets the backlight if the intensity
+// changed by at least Opts.MinStep. It returns the intensity of the
+// backlight.
+func (c *Controller) Update() (display.Intensity, error) {
+	lux, err := c.sensor.Sense()
+	if err != nil {
+		return 0, fmt.Errorf("autobacklight: %w", err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.measured {
+		c.light = c.opts.Smoothing*c.light + (1-c.opts.Smoothing)*float64(lux)
+	} else {
+		c.light, c.measured = float64(lux), true
+	}
+	i := c.Intensity(physic.LuminousFlux(c.light))
+	if c.applied && max(i-c.intensity, c.intensity-i) < c.opts.MinStep {
+		return c.intensity, nil
+	}
+	if err := c.bl.Backlight(i); err != nil {
+		return c.intensity, fmt.Errorf("autobacklight: %w", err)
+	}
+	c.intensity, c.applied = i, true
+	return i, nil
+}
+
+// Intensity returns the intensity of the curve for light.
+func (c *Controller) Intensity(light physic.LuminousFlux) display.Intensity {
+	curve := c.opts.Curve
+	i := sort.Search(len(curve), func(i int) bool { return curve[i].Light > light })
+	if i == 0 {
+		return curve[0].Intensity
+	}
+	if i == len(curve) {
+		return curve[len(curve)-1].Intensity
+	}
+	a, b := curve[i-1], curve[i]
+	f := float64(light-a.Light) / float64(b.Light-a.Light)
+	return a.Intensity + display.Intensity(f*float64(b.Intensity-a.Intensity)+0.5)
+}
+
+// Start starts a goroutine that calls Update() every Opts.Interval. The
+// goroutine exits when Stop() is called, or Update() returns an error. See
+// Err().
+func (c *Controller) Start() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stop != nil {
+		return errors.New("autobacklight: already started")
+	}
+	c.stop = make(chan struct{})
+	c.done = make(chan struct{})
+	c.err = nil
+	go c.run(c.stop, c.done)
+	return nil
+}
+
+// Stop stops the goroutine started by Start(), and waits for it to exit. The
+// backlight keeps its intensity.
+func (c *Controller) Stop() {
+	c.mu.Lock()
+	stop, done := c.stop, c.done
+	c.stop = nil
+	c.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Err returns the error that stopped the goroutine, if any.
+func (c *Controller) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// Halt implements conn.Resource. It stops the goroutine started by Start().
+// The sensor and the display aren't halted.
+func (c *Controller) Halt() error {
+	c.Stop()
+	return nil
+}
+
+func (c *Controller) String() string {
+	return fmt.Sprintf("autobacklight{%v, %v}", c.sensor, c.bl)
+}
+
+func (c *Controller) run(stop, done chan struct{}) {
+	defer close(done)
+	t := time.NewTicker(c.opts.Interval)
+	defer t.Stop()
+	for {
+		if _, err := c.Update(); err != nil {
+			c.mu.Lock()
+			c.err = err
+			c.mu.Unlock()
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package autobacklight
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/display"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/bh1750"
+)
+
+var _ LightSensor = &bh1750.Dev{}
+
+type fakeSensor struct {
+	mu    sync.Mutex
+	light physic.LuminousFlux
+	err   error
+}
+
+func (s *fakeSensor) Sense() (physic.LuminousFlux, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.light, s.err
+}
+
+func (s *fakeSensor) String() string { return "sensor" }
+
+type fakeBacklight struct {
+	mu     sync.Mutex
+	writes []display.Intensity
+}
+
+func (b *fakeBacklight) Backlight(i display.Intensity) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.writes = append(b.writes, i)
+	return nil
+}
+
+func (b *fakeBacklight) count() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.writes)
+}
+
+func (b *fakeBacklight) String() string { return "backlight" }
+
+func TestIntensity(t *testing.T) {
+	c, err := New(&fakeSensor{}, &fakeBacklight{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []struct {
+		light physic.LuminousFlux
+		want  display.Intensity
+	}{
+		{0, 16},
+		{5 * physic.Lumen, 32},
+		{10 * physic.Lumen, 48},
+		{55 * physic.Lumen, 88},
+		{1000 * physic.Lumen, 255},
+		{100000 * physic.Lumen, 255},
+	} {
+		if got := c.Intensity(line.light); got != line.want {
+			t.Errorf("Intensity(%s) = %d, want %d", line.light, got, line.want)
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s, b := &fakeSensor{light: 10 * physic.Lumen}, &fakeBacklight{}
+	c, err := New(s, b, &Opts{
+		Interval: time.Second,
+		// Unsorted.
+		Curve:     []Point{{100 * physic.Lumen, 100}, {0, 0}},
+		Smoothing: 0.5,
+		MinStep:   10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []struct {
+		light physic.LuminousFlux
+		want  display.Intensity
+	}{
+		{10 * physic.Lumen, 10},
+		// Smoothed to 15lx, a change below MinStep.
+		{20 * physic.Lumen, 10},
+		// Smoothed to 57lx.
+		{99 * physic.Lumen, 57},
+		{99 * physic.Lumen, 78},
+	} {
+		s.light = line.light
+		if got, err := c.Update(); err != nil || got != line.want {
+			t.Errorf("Update() at %s = %d, %v, want %d", line.light, got, err, line.want)
+		}
+	}
+	if len(b.writes) != 3 {
+		t.Errorf("writes %v", b.writes)
+	}
+	s.err = errors.New("sensor failure")
+	if _, err := c.Update(); !errors.Is(err, s.err) {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestStart(t *testing.T) {
+	s, b := &fakeSensor{light: 1000 * physic.Lumen}, &fakeBacklight{}
+	opts := DefaultOpts
+	opts.Interval = time.Millisecond
+	c, err := New(s, b, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Start(); err == nil {
+		t.Error("expected an error when started twice")
+	}
+	// Wait for the first update.
+	for start := time.Now(); b.count() == 0; time.Sleep(time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("no update")
+		}
+	}
+	s.mu.Lock()
+	s.err = errors.New("sensor failure")
+	s.mu.Unlock()
+	for start := time.Now(); c.Err() == nil; time.Sleep(time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("goroutine didn't stop")
+		}
+	}
+	if err := c.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.writes) != 1 || b.writes[0] != 255 {
+		t.Errorf("writes %v", b.writes)
+	}
+	if s := c.String(); s != "autobacklight{sensor, backlight}" {
+		t.Error(s)
+	}
+}
+
+func TestNew_errors(t *testing.T) {
+	if _, err := New(nil, &fakeBacklight{}, nil); err == nil {
+		t.Error("expected an error without a sensor")
+	}
+	if _, err := New(&fakeSensor{}, &fakeBacklight{}, &Opts{Interval: time.Second}); err == nil {
+		t.Error("expected an error without a curve")
+	}
+}
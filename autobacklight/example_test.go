@@ -0,0 +1,55 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package autobacklight_test
+
+import (
+	"log"
+	"os"
+	"os/signal"
+
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/autobacklight"
+	"periph.io/x/devices/v3/bh1750"
+	"periph.io/x/devices/v3/serlcd"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer bus.Close()
+
+	sensor, err := bh1750.NewI2C(bus, bh1750.I2CAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer sensor.Halt()
+	lcd := serlcd.NewConn(&i2c.Dev{Bus: bus, Addr: serlcd.DefaultI2CAddress}, 4, 20)
+
+	c, err := autobacklight.New(sensor, lcd, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := c.Start(); err != nil {
+		log.Fatal(err)
+	}
+	defer c.Halt()
+
+	// Run until interrupted.
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	<-sig
+	if err := c.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -6,6 +6,7 @@ package bh1750
 
 import (
 	"encoding/binary"
+	"fmt"
 	"time"
 
 	"periph.io/x/conn/v3/i2c"
@@ -138,3 +139,7 @@ func (d *Dev) Sense() (physic.LuminousFlux, error) {
 func (d *Dev) Halt() error {
 	return d.SetMode(PowerDown)
 }
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("BH1750{%s}", &d.dev)
+}
@@ -4,6 +4,9 @@
 
 // Package bh1750 controls a ROHM BH1750 ambient light sensor, over an i2c bus.
 //
+// Package autobacklight uses it to adjust the backlight of a display to the
+// ambient light.
+//
 // # Datasheet
 //
 // http://cpre.kmutnb.ac.th/esl/learning/bh1750-light-sensor/bh1750fvi-e_datasheet.pdf
@@ -74,6 +74,17 @@ func (i *I2C) Close() error {
 	return nil
 }
 
+// Halt implements conn.Resource. It releases the lines as inputs with
+// pull-ups, so the bus is idle. The next transaction drives them again.
+func (i *I2C) Halt() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if err := i.scl.In(gpio.PullUp, gpio.NoEdge); err != nil {
+		return err
+	}
+	return i.sda.In(gpio.PullUp, gpio.NoEdge)
+}
+
 // Tx implements i2c.Bus.
 func (i *I2C) Tx(addr uint16, w, r []byte) error {
 	i.mu.Lock()
@@ -0,0 +1,232 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Specification
+//
+// https://www.analog.com/en/resources/technical-articles/1wire-communication-through-software.html
+
+package bitbang
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/onewire"
+	"periph.io/x/host/v3/cpu"
+)
+
+// Standard speed timings, in the names of the specification.
+const (
+	owA = 6 * time.Microsecond
+	owB = 64 * time.Microsecond
+	owC = 60 * time.Microsecond
+	owD = 10 * time.Microsecond
+	owE = 9 * time.Microsecond
+	owF = 55 * time.Microsecond
+	owH = 480 * time.Microsecond
+	owI = 70 * time.Microsecond
+	owJ = 410 * time.Microsecond
+)
+
+// NewOneWire returns an object that communicates 1-wire over one pin.
+//
+// The line is pulled low by driving the pin low, and released by setting it
+// as an input, so it needs an external pull up, typically 4.7kΩ. The strong
+// pull up used to power parasitic devices drives the pin high.
+//
+// The timings are close to the tolerances of the protocol, so this works
+// best with a GPIO driver that accesses the registers directly.
+func NewOneWire(p gpio.PinIO) (*OneWire, error) {
+	if err := p.In(gpio.PullUp, gpio.NoEdge); err != nil {
+		return nil, err
+	}
+	return &OneWire{pin: p}, nil
+}
+
+// OneWire represents a 1-wire master implemented as bit-banging on a GPIO
+// pin.
+type OneWire struct {
+	mu  sync.Mutex
+	pin gpio.PinIO
+}
+
+func (o *OneWire) String() string {
+	return fmt.Sprintf("bitbang/onewire(%s)", o.pin)
+}
+
+// Close implements onewire.BusCloser.
+func (o *OneWire) Close() error {
+	return nil
+}
+
+// Halt implements conn.Resource. It ends the strong pull up, if any, and
+// releases the line as an input.
+func (o *OneWire) Halt() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.pin.In(gpio.PullUp, gpio.NoEdge)
+}
+
+// Tx implements onewire.Bus.
+//
+// It resets the bus, writes w, reads r, and ends with the strong pull up if
+// requested, until the next transaction.
+func (o *OneWire) Tx(w, r []byte, power onewire.Pullup) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	present, err := o.reset()
+	if err != nil {
+		return err
+	}
+	if !present {
+		return noDevicesError("bitbang-onewire: no device present")
+	}
+	for _, b := range w {
+		for x := 0; x < 8; x++ {
+			if err := o.writeBit(b&(1<<x) != 0); err != nil {
+				return err
+			}
+		}
+	}
+	for i := range r {
+		var b byte
+		for x := 0; x < 8; x++ {
+			bit, err := o.readBit()
+			if err != nil {
+				return err
+			}
+			if bit {
+				b |= 1 << x
+			}
+		}
+		r[i] = b
+	}
+	if power == onewire.StrongPullup {
+		return o.pin.Out(gpio.High)
+	}
+	return nil
+}
+
+// Search implements onewire.Bus.
+func (o *OneWire) Search(alarmOnly bool) ([]onewire.Address, error) {
+	return onewire.Search(o, alarmOnly)
+}
+
+// SearchTriplet implements onewire.BusSearcher.
+func (o *OneWire) SearchTriplet(direction byte) (onewire.TripletResult, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	// The devices send the bit of their address, then its complement. The line
+	// is low if any device sends a 0.
+	bit, err := o.readBit()
+	if err != nil {
+		return onewire.TripletResult{}, err
+	}
+	cmp, err := o.readBit()
+	if err != nil {
+		return onewire.TripletResult{}, err
+	}
+	tr := onewire.TripletResult{GotZero: !bit, GotOne: !cmp, Taken: direction}
+	switch {
+	case !tr.GotZero && !tr.GotOne:
+		return tr, busError("bitbang-onewire: no device responded to the search")
+	case !tr.GotOne:
+		tr.Taken = 0
+	case !tr.GotZero:
+		tr.Taken = 1
+	}
+	return tr, o.writeBit(tr.Taken == 1)
+}
+
+// Q implements onewire.Pins.
+func (o *OneWire) Q() gpio.PinIO {
+	return o.pin
+}
+
+// reset sends a reset pulse and returns true if a device answered with a
+// presence pulse.
+func (o *OneWire) reset() (bool, error) {
+	if err := o.pin.Out(gpio.Low); err != nil {
+		return false, err
+	}
+	cpu.Nanospin(owH)
+	if err := o.release(); err != nil {
+		return false, err
+	}
+	cpu.Nanospin(owI)
+	present := o.pin.Read() == gpio.Low
+	cpu.Nanospin(owJ)
+	if o.pin.Read() == gpio.Low {
+		return false, shortedBusError("bitbang-onewire: bus has a short")
+	}
+	return present, nil
+}
+
+// writeBit sends one bit in a time slot.
+func (o *OneWire) writeBit(bit bool) error {
+	low, high := owC, owD
+	if bit {
+		low, high = owA, owB
+	}
+	if err := o.pin.Out(gpio.Low); err != nil {
+		return err
+	}
+	cpu.Nanospin(low)
+	if err := o.release(); err != nil {
+		return err
+	}
+	cpu.Nanospin(high)
+	return nil
+}
+
+// readBit reads one bit in a time slot.
+func (o *OneWire) readBit() (bool, error) {
+	if err := o.pin.Out(gpio.Low); err != nil {
+		return false, err
+	}
+	cpu.Nanospin(owA)
+	if err := o.release(); err != nil {
+		return false, err
+	}
+	cpu.Nanospin(owE)
+	bit := o.pin.Read() == gpio.High
+	cpu.Nanospin(owF)
+	return bit, nil
+}
+
+// release lets the pull up raise the line.
+func (o *OneWire) release() error {
+	return o.pin.In(gpio.PullUp, gpio.NoEdge)
+}
+
+// noDevicesError implements error and onewire.NoDevicesError.
+type noDevicesError string
+
+func (e noDevicesError) Error() string   { return string(e) }
+func (e noDevicesError) NoDevices() bool { return true }
+
+// shortedBusError implements error and onewire.ShortedBusError.
+type shortedBusError string
+
+func (e shortedBusError) Error() string   { return string(e) }
+func (e shortedBusError) IsShorted() bool { return true }
+func (e shortedBusError) BusError() bool  { return true }
+
+// busError implements error and onewire.BusError.
+type busError string
+
+func (e busError) Error() string  { return string(e) }
+func (e busError) BusError() bool { return true }
+
+var _ onewire.Bus = &OneWire{}
+var _ onewire.BusSearcher = &OneWire{}
@@ -55,6 +55,12 @@ func (s *SPI) Close() error {
 	return nil
 }
 
+// Halt implements conn.Resource. The pins are left as outputs, so that chip
+// select stays deasserted.
+func (s *SPI) Halt() error {
+	return nil
+}
+
 // Connect implements spi.PortCloser.
 func (s *SPI) Connect(f physic.Frequency, mode spi.Mode, bits int) (spi.Conn, error) {
 	if f < 0 {
@@ -8,7 +8,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"strings"
 	"sync"
 	"time"
@@ -18,8 +18,19 @@ import (
 	"periph.io/x/conn/v3/mmr"
 	"periph.io/x/conn/v3/physic"
 	"periph.io/x/conn/v3/spi"
+	"periph.io/x/devices/v3/internal/logging"
 )
 
+// logger receives the errors of SenseContinuous(), which has no other way to
+// report them.
+var logger logging.Logger
+
+// SetLogger sets the logger of the package. It discards the records by
+// default.
+func SetLogger(l *slog.Logger) {
+	logger.Set(l)
+}
+
 // Oversampling affects how much time is taken to measure each of temperature,
 // pressure and humidity.
 //
@@ -458,7 +469,7 @@ func (d *Dev) sensingContinuous(interval time.Duration, sensing chan<- physic.En
 		}
 		d.mu.Unlock()
 		if err != nil {
-			log.Printf("%s: failed to sense: %v", d, err)
+			logger.Get().Error("bmxx80: failed to sense", "dev", d.String(), "err", err)
 			return
 		}
 		select {
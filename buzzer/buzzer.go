@@ -0,0 +1,239 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package buzzer plays tones and melodies on a passive piezo buzzer driven by
+// the PWM of a GPIO.
+//
+// Tone() plays a single tone and returns when it ends. Play() plays a Melody
+// in the background, such as one of the alert patterns of this package, or
+// a tune parsed by ParseRTTTL().
+//
+// # Wiring
+//
+// A small piezo buzzer can be connected directly between the GPIO and
+// ground. Magnetic buzzers draw more current and need a transistor and a
+// flyback diode.
+package buzzer
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Note is a tone of a Melody. A Frequency of 0 is a rest.
+type Note struct {
+	Frequency physic.Frequency
+	Duration  time.Duration
+}
+
+// Melody is a sequence of notes.
+type Melody []Note
+
+// Duration returns the total duration of the melody.
+func (m Melody) Duration() time.Duration {
+	var d time.Duration
+	for _, n := range m {
+		d += n.Duration
+	}
+	return d
+}
+
+// Alert patterns.
+var (
+	// Click is a short tick, to acknowledge a button press.
+	Click = Melody{{4 * physic.KiloHertz, 5 * time.Millisecond}}
+	// Beep is a single beep.
+	Beep = Melody{{2 * physic.KiloHertz, 100 * time.Millisecond}}
+	// Success is a rising pair of tones.
+	Success = Melody{{1319 * physic.Hertz, 80 * time.Millisecond}, {0, 20 * time.Millisecond}, {1760 * physic.Hertz, 120 * time.Millisecond}}
+	// Failure is a falling pair of low tones.
+	Failure = Melody{{440 * physic.Hertz, 150 * time.Millisecond}, {0, 50 * time.Millisecond}, {330 * physic.Hertz, 300 * time.Millisecond}}
+	// Alarm is a series of fast beeps, to play repeatedly with Loop().
+	Alarm = Melody{
+		{2500 * physic.Hertz, 100 * time.Millisecond}, {0, 50 * time.Millisecond},
+		{2500 * physic.Hertz, 100 * time.Millisecond}, {0, 50 * time.Millisecond},
+		{2500 * physic.Hertz, 100 * time.Millisecond}, {0, 400 * time.Millisecond},
+	}
+)
+
+// Dev is a handle to a buzzer.
+type Dev struct {
+	pin gpio.PinOut
+
+	// mu serializes the access to the pin.
+	mu sync.Mutex
+
+	playMu sync.Mutex
+	stop   chan struct{}
+	done   chan struct{}
+	err    error
+}
+
+// New returns a handle to a buzzer driven by pin, which must support PWM.
+func New(pin gpio.PinOut) (*Dev, error) {
+	if pin == nil {
+		return nil, errors.New("buzzer: pin is required")
+	}
+	if err := pin.Out(gpio.Low); err != nil {
+		return nil, fmt.Errorf("buzzer: %w", err)
+	}
+	return &Dev{pin: pin}, nil
+}
+
+// Tone plays a tone of frequency f for d, and returns when it ends. It stops
+// the melody being played, if any.
+func (d *Dev) Tone(f physic.Frequency, duration time.Duration) error {
+	if err := d.Stop(); err != nil {
+		return err
+	}
+	return d.play(Melody{{f, duration}}, nil)
+}
+
+// Play plays m in the background, stopping the melody being played, if any.
+func (d *Dev) Play(m Melody) error {
+	return d.start(m, false)
+}
+
+// Loop plays m repeatedly in the background until Stop() is called.
+func (d *Dev) Loop(m Melody) error {
+	if m.Duration() <= 0 {
+		return errors.New("buzzer: can't loop an empty melody")
+	}
+	return d.start(m, true)
+}
+
+// Playing returns true while a melody is being played in the background.
+func (d *Dev) Playing() bool {
+	d.playMu.Lock()
+	done := d.done
+	d.playMu.Unlock()
+	if done == nil {
+		return false
+	}
+	select {
+	case <-done:
+		return false
+	default:
+		return true
+	}
+}
+
+// Wait waits for the melody being played in the background to end, and
+// returns its error.
+func (d *Dev) Wait() error {
+	d.playMu.Lock()
+	done := d.done
+	d.playMu.Unlock()
+	if done != nil {
+		<-done
+	}
+	return d.Err()
+}
+
+// Err returns the error of the last melody played in the background, if
+// any.
+func (d *Dev) Err() error {
+	d.playMu.Lock()
+	defer d.playMu.Unlock()
+	return d.err
+}
+
+// Stop stops the melody being played in the background, and silences the
+// buzzer.
+func (d *Dev) Stop() error {
+	d.playMu.Lock()
+	stop, done := d.stop, d.done
+	d.stop, d.done = nil, nil
+	d.playMu.Unlock()
+	if stop == nil {
+		return nil
+	}
+	close(stop)
+	<-done
+	return nil
+}
+
+// Halt implements conn.Resource. It stops the melody being played, if any.
+func (d *Dev) Halt() error {
+	if err := d.Stop(); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.pin.Out(gpio.Low); err != nil {
+		return fmt.Errorf("buzzer: %w", err)
+	}
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("buzzer{%s}", d.pin)
+}
+
+func (d *Dev) start(m Melody, loop bool) error {
+	if err := d.Stop(); err != nil {
+		return err
+	}
+	d.playMu.Lock()
+	defer d.playMu.Unlock()
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
+	d.err = nil
+	go d.run(m, loop, d.stop, d.done)
+	return nil
+}
+
+func (d *Dev) run(m Melody, loop bool, stop, done chan struct{}) {
+	defer close(done)
+	for {
+		if err := d.play(m, stop); err != nil {
+			if !errors.Is(err, errStopped) {
+				d.playMu.Lock()
+				d.err = err
+				d.playMu.Unlock()
+			}
+			return
+		}
+		if !loop {
+			return
+		}
+	}
+}
+
+// errStopped is returned by play() when stop is closed.
+var errStopped = errors.New("buzzer: stopped")
+
+// play plays m, until it ends or stop is closed. The buzzer is silent when
+// it returns.
+func (d *Dev) play(m Melody, stop <-chan struct{}) (err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	defer func() {
+		if err2 := d.pin.Out(gpio.Low); err == nil && err2 != nil {
+			err = fmt.Errorf("buzzer: %w", err2)
+		}
+	}()
+	for _, n := range m {
+		if n.Frequency > 0 {
+			if err := d.pin.PWM(gpio.DutyHalf, n.Frequency); err != nil {
+				return fmt.Errorf("buzzer: %w", err)
+			}
+		} else if err := d.pin.Out(gpio.Low); err != nil {
+			return fmt.Errorf("buzzer: %w", err)
+		}
+		t := time.NewTimer(n.Duration)
+		select {
+		case <-t.C:
+		case <-stop:
+			t.Stop()
+			return errStopped
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package buzzer
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// pin records the frequencies played, with 0 when the pin is driven low.
+type pin struct {
+	gpiotest.Pin
+	mu    sync.Mutex
+	freqs []physic.Frequency
+}
+
+func (p *pin) Out(l gpio.Level) error {
+	p.mu.Lock()
+	p.freqs = append(p.freqs, 0)
+	p.mu.Unlock()
+	return p.Pin.Out(l)
+}
+
+func (p *pin) PWM(duty gpio.Duty, f physic.Frequency) error {
+	p.mu.Lock()
+	p.freqs = append(p.freqs, f)
+	p.mu.Unlock()
+	return p.Pin.PWM(duty, f)
+}
+
+func (p *pin) played() []physic.Frequency {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]physic.Frequency(nil), p.freqs...)
+}
+
+func TestTone(t *testing.T) {
+	p := &pin{Pin: gpiotest.Pin{N: "GPIO12"}}
+	d, err := New(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Tone(440*physic.Hertz, time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	got := p.played()
+	if len(got) != 3 || got[0] != 0 || got[1] != 440*physic.Hertz || got[2] != 0 {
+		t.Errorf("played %v", got)
+	}
+	if p.D != gpio.DutyHalf {
+		t.Errorf("duty %s", p.D)
+	}
+	if s := d.String(); s != "buzzer{GPIO12(0)}" {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestPlay(t *testing.T) {
+	p := &pin{Pin: gpiotest.Pin{N: "GPIO12"}}
+	d, err := New(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := Melody{{1 * physic.KiloHertz, time.Millisecond}, {0, time.Millisecond}, {2 * physic.KiloHertz, time.Millisecond}}
+	if err := d.Play(m); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if d.Playing() {
+		t.Error("still playing")
+	}
+	want := []physic.Frequency{0, physic.KiloHertz, 0, 2 * physic.KiloHertz, 0}
+	got := p.played()
+	if len(got) != len(want) {
+		t.Fatalf("played %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("played %v, want %v", got, want)
+		}
+	}
+}
+
+func TestLoop(t *testing.T) {
+	p := &pin{Pin: gpiotest.Pin{N: "GPIO12"}}
+	d, err := New(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Loop(nil); err == nil {
+		t.Error("expected an error for an empty melody")
+	}
+	if err := d.Loop(Melody{{physic.KiloHertz, time.Millisecond}}); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for len(p.played()) < 5 {
+		if time.Now().After(deadline) {
+			t.Fatal("not looping")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if !d.Playing() {
+		t.Error("not playing")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if d.Playing() || p.L != gpio.Low {
+		t.Errorf("playing after Halt, level %s", p.L)
+	}
+}
+
+func TestNoteFrequency(t *testing.T) {
+	data := []struct {
+		semitone, octave int
+		want             physic.Frequency
+	}{
+		{9, 4, 440 * physic.Hertz},
+		{9, 5, 880 * physic.Hertz},
+		{0, 4, 261625565 * physic.MicroHertz},
+	}
+	for _, line := range data {
+		if got := NoteFrequency(line.semitone, line.octave); got != line.want {
+			t.Errorf("NoteFrequency(%d, %d) = %s, want %s", line.semitone, line.octave, got, line.want)
+		}
+	}
+}
+
+func TestParseRTTTL(t *testing.T) {
+	name, m, err := ParseRTTTL("test:d=4,o=5,b=120:8a,p,c#6.,2g4,16p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "test" {
+		t.Errorf("name %q", name)
+	}
+	// A whole note is 2s at 120 bpm.
+	want := Melody{
+		{880 * physic.Hertz, 250 * time.Millisecond},
+		{0, 500 * time.Millisecond},
+		{NoteFrequency(1, 6), 750 * time.Millisecond},
+		{NoteFrequency(7, 4), time.Second},
+		{0, 125 * time.Millisecond},
+	}
+	if len(m) != len(want) {
+		t.Fatalf("got %v, want %v", m, want)
+	}
+	for i := range want {
+		if m[i] != want[i] {
+			t.Errorf("note %d = %v, want %v", i, m[i], want[i])
+		}
+	}
+	for _, s := range []string{"", "a:b", "x:d=0:c", "x:z=4:c", "x::q", "x::4c$", "x::"} {
+		if _, _, err := ParseRTTTL(s); err == nil {
+			t.Errorf("ParseRTTTL(%q) succeeded", s)
+		}
+	}
+}
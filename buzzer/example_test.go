@@ -0,0 +1,48 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package buzzer_test
+
+import (
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/buzzer"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	b, err := buzzer.New(gpioreg.ByName("GPIO12"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer b.Halt()
+
+	if err := b.Tone(1*physic.KiloHertz, 200*time.Millisecond); err != nil {
+		log.Fatal(err)
+	}
+	_, m, err := buzzer.ParseRTTTL("scale:d=8,o=5,b=140:c,d,e,f,g,a,b,c6")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := b.Play(m); err != nil {
+		log.Fatal(err)
+	}
+	if err := b.Wait(); err != nil {
+		log.Fatal(err)
+	}
+	if err := b.Play(buzzer.Success); err != nil {
+		log.Fatal(err)
+	}
+	if err := b.Wait(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package buzzer
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// semitones is the offset of the notes from C in an octave.
+var semitones = map[byte]int{'c': 0, 'd': 2, 'e': 4, 'f': 5, 'g': 7, 'a': 9, 'b': 11, 'h': 11}
+
+// NoteFrequency returns the frequency of a note of the equal tempered scale,
+// where semitone is the number of semitones from C and octave 4 contains
+// A4 at 440Hz.
+func NoteFrequency(semitone, octave int) physic.Frequency {
+	n := float64(octave*12+semitone) - 57
+	return physic.Frequency(math.Round(440 * math.Pow(2, n/12) * float64(physic.Hertz)))
+}
+
+// ParseRTTTL parses a melody in the Ring Tone Text Transfer Language, such as
+// "beep:d=8,o=5,b=120:c,e,g,p,c6". It returns the name of the melody.
+func ParseRTTTL(s string) (string, Melody, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 {
+		return "", nil, fmt.Errorf("buzzer: invalid RTTTL %q, expected name:defaults:notes", s)
+	}
+	name := strings.TrimSpace(parts[0])
+	duration, octave, bpm := 4, 6, 63
+	for _, d := range strings.Split(parts[1], ",") {
+		d = strings.TrimSpace(d)
+		if d == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(d, "=")
+		if !ok {
+			return "", nil, fmt.Errorf("buzzer: invalid RTTTL default %q", d)
+		}
+		i, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil || i <= 0 {
+			return "", nil, fmt.Errorf("buzzer: invalid RTTTL default %q", d)
+		}
+		switch strings.TrimSpace(strings.ToLower(k)) {
+		case "d":
+			duration = i
+		case "o":
+			octave = i
+		case "b":
+			bpm = i
+		default:
+			return "", nil, fmt.Errorf("buzzer: unknown RTTTL default %q", d)
+		}
+	}
+	// The tempo is in quarter notes per minute.
+	whole := 4 * time.Minute / time.Duration(bpm)
+	var m Melody
+	for _, n := range strings.Split(parts[2], ",") {
+		note, err := parseRTTTLNote(strings.ToLower(strings.TrimSpace(n)), duration, octave, whole)
+		if err != nil {
+			return "", nil, err
+		}
+		m = append(m, note)
+	}
+	return name, m, nil
+}
+
+// parseRTTTLNote parses a note such as "8c#6.".
+func parseRTTTLNote(s string, duration, octave int, whole time.Duration) (Note, error) {
+	i := 0
+	digits := func() (int, bool) {
+		j := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == j {
+			return 0, false
+		}
+		v, err := strconv.Atoi(s[j:i])
+		return v, err == nil
+	}
+	if v, ok := digits(); ok {
+		duration = v
+	}
+	if i >= len(s) || duration <= 0 {
+		return Note{}, fmt.Errorf("buzzer: invalid RTTTL note %q", s)
+	}
+	semitone, isNote := semitones[s[i]]
+	if !isNote && s[i] != 'p' {
+		return Note{}, fmt.Errorf("buzzer: invalid RTTTL note %q", s)
+	}
+	i++
+	if i < len(s) && s[i] == '#' {
+		semitone++
+		i++
+	}
+	dotted := false
+	if i < len(s) && s[i] == '.' {
+		dotted = true
+		i++
+	}
+	if v, ok := digits(); ok {
+		octave = v
+	}
+	if i < len(s) && s[i] == '.' {
+		dotted = true
+		i++
+	}
+	if i != len(s) {
+		return Note{}, fmt.Errorf("buzzer: invalid RTTTL note %q", s)
+	}
+	n := Note{Duration: whole / time.Duration(duration)}
+	if dotted {
+		n.Duration += n.Duration / 2
+	}
+	if isNote {
+		n.Frequency = NoteFrequency(semitone, octave)
+	}
+	return n, nil
+}
@@ -37,7 +37,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"strconv"
 	"time"
 
@@ -45,8 +45,19 @@ import (
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/i2c"
 	"periph.io/x/conn/v3/mmr"
+	"periph.io/x/devices/v3/internal/logging"
 )
 
+// logger receives the configuration written to the device, at
+// slog.LevelDebug.
+var logger logging.Logger
+
+// SetLogger sets the logger of the package. It discards the records by
+// default.
+func SetLogger(l *slog.Logger) {
+	logger.Set(l)
+}
+
 // TouchStatus is the status of an input sensor.
 type TouchStatus int8
 
@@ -221,9 +232,7 @@ func (d *Dev) SetLED(idx int, state bool) error {
 	if idx >= d.numLEDs || idx < 0 {
 		return wrapf("invalid led idx %d", idx)
 	}
-	if d.opts.Debug {
-		log.Printf("cap1xxx: Set LED state %d - %t", idx, state)
-	}
+	logger.Get().Debug("cap1xxx: set LED state", "led", idx, "state", state)
 	// TODO(maruel): support > 8 LEDs.
 	if state {
 		if err := d.setBit(regLEDOutputControl, idx); err != nil {
@@ -242,9 +251,7 @@ func (d *Dev) Reset() error {
 		return err
 	}
 	if d.opts.ResetPin != nil {
-		if d.opts.Debug {
-			log.Println("cap1xxx: Resetting the device using the reset pin")
-		}
+		logger.Get().Debug("cap1xxx: resetting the device using the reset pin")
 		if err := d.opts.ResetPin.Out(gpio.Low); err != nil {
 			return wrapf("failed to set reset pin low: %v", err)
 		}
@@ -407,9 +414,7 @@ func makeDev(c conn.Conn, isSPI bool, opts *Opts) (*Dev, error) {
 		// TODO(mattetti): use d.opts.CycleTime
 		byte(0)<<1 |
 		byte(0)<<0)
-	if d.opts.Debug {
-		log.Printf("cap1xxx: Sampling config mask: %08b", samplingConfig)
-	}
+	logger.Get().Debug("cap1xxx: sampling config", "mask", fmt.Sprintf("%08b", samplingConfig))
 	if err := d.c.WriteUint8(0x24, samplingConfig); err != nil {
 		return nil, wrapf("failed to enable multitouch: %v", err)
 	}
@@ -428,9 +433,7 @@ func makeDev(c conn.Conn, isSPI bool, opts *Opts) (*Dev, error) {
 		// TODO(mattetti): make that configurable.
 		byte(1)<<6 | byte(0)<<5 | byte(1)<<4 |
 		byte(0)<<3 | byte(0)<<2 | byte(0)<<1 | byte(0)<<0)
-	if d.opts.Debug {
-		log.Printf("cap1xxx: Sensitivity mask: %08b", sensitivity)
-	}
+	logger.Get().Debug("cap1xxx: sensitivity", "mask", fmt.Sprintf("%08b", sensitivity))
 	if err := d.c.WriteUint8(0x1F, sensitivity); err != nil {
 		return nil, wrapf("failed to set sensitivity: %v", err)
 	}
@@ -486,9 +489,7 @@ func makeDev(c conn.Conn, isSPI bool, opts *Opts) (*Dev, error) {
 		byte(0)<<2 |
 		byte(0)<<1 |
 		byte(0)<<0)
-	if d.opts.Debug {
-		log.Printf("cap1xxx: Config mask: %08b", config)
-	}
+	logger.Get().Debug("cap1xxx: config", "mask", fmt.Sprintf("%08b", config))
 	if err := d.c.WriteUint8(0x20, config); err != nil {
 		return nil, wrapf("failed to set the device configuration: %v", err)
 	}
@@ -535,9 +536,7 @@ func makeDev(c conn.Conn, isSPI bool, opts *Opts) (*Dev, error) {
 		// - 1: An interrupt is generated when a press is detected and at the
 		//   repeat rate but not when a release is detected.
 		intOnRel<<0)
-	if d.opts.Debug {
-		log.Printf("cap1xxx: Config2 mask: %08b", config2)
-	}
+	logger.Get().Debug("cap1xxx: config2", "mask", fmt.Sprintf("%08b", config2))
 	if err := d.c.WriteUint8(0x44, config2); err != nil {
 		return nil, wrapf("failed to set the device configuration 2: %v", err)
 	}
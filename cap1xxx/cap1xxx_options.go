@@ -76,7 +76,10 @@ const (
 
 // Opts is options to pass to the constructor.
 type Opts struct {
-	// Debug turns on extra logging capabilities.
+	// Debug is ignored.
+	//
+	// Deprecated: the configuration is logged at slog.LevelDebug to the
+	// logger set with SetLogger().
 	Debug bool
 	// I2CAddr is the I²C slave address to use. It can only used on creation of
 	// an I²C-device. Its default value is 0x28. It can be set to other values
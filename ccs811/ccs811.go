@@ -155,6 +155,12 @@ func (d *Dev) String() string {
 	return "CCS811"
 }
 
+// Halt implements conn.Resource. It puts the sensor in the idle, low
+// current mode, with the interrupts disabled.
+func (d *Dev) Halt() error {
+	return d.SetMeasurementModeRegister(MeasurementModeParams{MeasurementMode: MeasurementModeIdle})
+}
+
 // StartSensorApp initializes sensor to application mode.
 func (d *Dev) StartSensorApp() error {
 	return d.c.Tx([]byte{0xf4}, nil)
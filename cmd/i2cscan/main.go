@@ -0,0 +1,63 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// i2cscan lists the devices that respond on an I²C bus, and identifies the
+// chips supported by this repository, to discover what's wired to a bus.
+//
+// The addresses that respond are printed in a table like i2cdetect's,
+// followed by a line per device with the chip identified, or the chips it
+// may be. With -identify=false, no register is read.
+//
+// Identifying a device reads its registers, which can clear flags like an
+// interrupt status, so the drivers of the devices shouldn't be running.
+//
+// Usage:
+//
+//	i2cscan -bus 1
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/i2cscan"
+	"periph.io/x/host/v3"
+)
+
+func mainImpl() error {
+	bus := flag.String("bus", "", "I²C bus name")
+	identify := flag.Bool("identify", true, "identify the chips by their registers")
+	flag.Parse()
+	if flag.NArg() != 0 {
+		return fmt.Errorf("unexpected argument %q", flag.Arg(0))
+	}
+
+	if _, err := host.Init(); err != nil {
+		return err
+	}
+	b, err := i2creg.Open(*bus)
+	if err != nil {
+		return err
+	}
+	defer b.Close()
+	found := i2cscan.Scan(b)
+	printTable(os.Stdout, found)
+	if *identify && len(found) != 0 {
+		fmt.Println()
+		for _, addr := range found {
+			d := i2cscan.Identify(b, addr)
+			fmt.Println(d.String())
+		}
+	}
+	return nil
+}
+
+func main() {
+	if err := mainImpl(); err != nil {
+		fmt.Fprintf(os.Stderr, "i2cscan: %s.\n", err)
+		os.Exit(1)
+	}
+}
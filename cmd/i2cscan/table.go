@@ -0,0 +1,41 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"periph.io/x/devices/v3/i2cscan"
+)
+
+// printTable prints the addresses scanned in rows of 16, with the addresses
+// in found shown, "--" for the others, and blanks for the addresses that
+// aren't scanned.
+func printTable(w io.Writer, found []uint16) {
+	var b strings.Builder
+	b.WriteString("   ")
+	for col := range 16 {
+		fmt.Fprintf(&b, "  %x", col)
+	}
+	b.WriteString("\n")
+	for row := uint16(0); row < 0x80; row += 16 {
+		fmt.Fprintf(&b, "%02x:", row)
+		for addr := row; addr < row+16; addr++ {
+			switch {
+			case addr < i2cscan.FirstAddr || addr > i2cscan.LastAddr:
+				b.WriteString("   ")
+			case slices.Contains(found, addr):
+				fmt.Fprintf(&b, " %02x", addr)
+			default:
+				b.WriteString(" --")
+			}
+		}
+		b.WriteString("\n")
+	}
+	_, _ = io.WriteString(w, b.String())
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrintTable(t *testing.T) {
+	var out strings.Builder
+	printTable(&out, []uint16{0x08, 0x27, 0x77})
+	lines := strings.Split(out.String(), "\n")
+	want := map[int]string{
+		0: "     0  1  2  3  4  5  6  7  8  9  a  b  c  d  e  f",
+		1: "00:                         08 -- -- -- -- -- -- --",
+		3: "20: -- -- -- -- -- -- -- 27 -- -- -- -- -- -- -- --",
+		8: "70: -- -- -- -- -- -- -- 77                        ",
+	}
+	for ix, w := range want {
+		if lines[ix] != w {
+			t.Errorf("line %d is %q, want %q", ix, lines[ix], w)
+		}
+	}
+	if len(lines) != 10 {
+		t.Errorf("got %d lines", len(lines))
+	}
+}
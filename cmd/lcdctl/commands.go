@@ -0,0 +1,192 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"periph.io/x/conn/v3/display"
+)
+
+// customCharSetter is implemented by displays with user defined characters,
+// such as the HD44780.
+type customCharSetter interface {
+	SetCustomChar(index int, pattern [8]byte) error
+}
+
+// command is a subcommand.
+type command struct {
+	usage string
+	run   func(d display.TextDisplay, args []string, stdin io.Reader) error
+}
+
+var commands = map[string]command{
+	"write":       {"write [-row n] [-col n] text...", cmdWrite},
+	"clear":       {"clear", cmdClear},
+	"backlight":   {"backlight on|off|<intensity>|<red> <green> <blue>", cmdBacklight},
+	"contrast":    {"contrast <value>", cmdContrast},
+	"cursor":      {"cursor off|underline|block|blink", cmdCursor},
+	"custom-char": {"custom-char <index> <row0> ... <row7>", cmdCustomChar},
+	"follow":      {"follow", cmdFollow},
+}
+
+// cmdWrite writes its arguments, separated by spaces, at -row and -col.
+func cmdWrite(d display.TextDisplay, args []string, stdin io.Reader) error {
+	fs := flag.NewFlagSet("write", flag.ContinueOnError)
+	row := fs.Int("row", 1, "row to write at, starting at 1")
+	col := fs.Int("col", 1, "column to write at, starting at 1")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	// The cursor position of a display that wasn't initialized is unknown.
+	if err := d.MoveTo(max(*row, d.MinRow()), max(*col, d.MinCol())); err != nil {
+		return err
+	}
+	_, err := d.WriteString(strings.Join(fs.Args(), " "))
+	return err
+}
+
+func cmdClear(d display.TextDisplay, args []string, stdin io.Reader) error {
+	if len(args) != 0 {
+		return errors.New("clear takes no argument")
+	}
+	return d.Clear()
+}
+
+func cmdBacklight(d display.TextDisplay, args []string, stdin io.Reader) error {
+	var values []display.Intensity
+	for _, a := range args {
+		switch a {
+		case "on":
+			values = append(values, 255)
+		case "off":
+			values = append(values, 0)
+		default:
+			v, err := strconv.ParseUint(a, 0, 8)
+			if err != nil {
+				return fmt.Errorf("invalid intensity %q", a)
+			}
+			values = append(values, display.Intensity(v))
+		}
+	}
+	switch len(values) {
+	case 1:
+		if bl, ok := d.(display.DisplayBacklight); ok {
+			return bl.Backlight(values[0])
+		}
+		if bl, ok := d.(display.DisplayRGBBacklight); ok {
+			return bl.RGBBacklight(values[0], values[0], values[0])
+		}
+	case 3:
+		if bl, ok := d.(display.DisplayRGBBacklight); ok {
+			return bl.RGBBacklight(values[0], values[1], values[2])
+		}
+	default:
+		return errors.New("backlight takes 1 or 3 values")
+	}
+	return fmt.Errorf("%s: %w", d, display.ErrNotImplemented)
+}
+
+func cmdContrast(d display.TextDisplay, args []string, stdin io.Reader) error {
+	if len(args) != 1 {
+		return errors.New("contrast takes 1 value")
+	}
+	v, err := strconv.ParseUint(args[0], 0, 8)
+	if err != nil {
+		return fmt.Errorf("invalid contrast %q", args[0])
+	}
+	c, ok := d.(display.DisplayContrast)
+	if !ok {
+		return fmt.Errorf("%s: %w", d, display.ErrNotImplemented)
+	}
+	return c.Contrast(display.Contrast(v))
+}
+
+var cursorModes = map[string]display.CursorMode{
+	"off":       display.CursorOff,
+	"underline": display.CursorUnderline,
+	"block":     display.CursorBlock,
+	"blink":     display.CursorBlink,
+}
+
+func cmdCursor(d display.TextDisplay, args []string, stdin io.Reader) error {
+	if len(args) == 0 {
+		return errors.New("cursor takes at least 1 mode")
+	}
+	modes := make([]display.CursorMode, len(args))
+	for ix, a := range args {
+		m, ok := cursorModes[a]
+		if !ok {
+			return fmt.Errorf("invalid cursor mode %q", a)
+		}
+		modes[ix] = m
+	}
+	return d.Cursor(modes...)
+}
+
+// cmdCustomChar sets a custom character from 8 rows of 5 bits, top to
+// bottom, such as 0x1f or 0b10001.
+func cmdCustomChar(d display.TextDisplay, args []string, stdin io.Reader) error {
+	if len(args) != 9 {
+		return errors.New("custom-char takes an index and 8 rows")
+	}
+	index, err := strconv.ParseUint(args[0], 0, 8)
+	if err != nil {
+		return fmt.Errorf("invalid index %q", args[0])
+	}
+	var pattern [8]byte
+	for ix, a := range args[1:] {
+		v, err := strconv.ParseUint(a, 0, 8)
+		if err != nil {
+			return fmt.Errorf("invalid row %q", a)
+		}
+		pattern[ix] = byte(v)
+	}
+	cs, ok := d.(customCharSetter)
+	if !ok {
+		return fmt.Errorf("%s: %w", d, display.ErrNotImplemented)
+	}
+	return cs.SetCustomChar(int(index), pattern)
+}
+
+// cmdFollow writes each line read from stdin to the last row of the
+// display, scrolling the previous lines up, until the end of stdin.
+func cmdFollow(d display.TextDisplay, args []string, stdin io.Reader) error {
+	if len(args) != 0 {
+		return errors.New("follow takes no argument")
+	}
+	if err := d.Clear(); err != nil {
+		return err
+	}
+	lines := make([]string, d.Rows())
+	s := bufio.NewScanner(stdin)
+	for s.Scan() {
+		copy(lines, lines[1:])
+		lines[len(lines)-1] = s.Text()
+		for ix, l := range lines {
+			if err := d.MoveTo(d.MinRow()+ix, d.MinCol()); err != nil {
+				return err
+			}
+			if _, err := d.WriteString(fit(l, d.Cols())); err != nil {
+				return err
+			}
+		}
+	}
+	return s.Err()
+}
+
+// fit truncates or pads s to n characters, so that it overwrites a row.
+func fit(s string, n int) string {
+	if len(s) >= n {
+		return s[:n]
+	}
+	return s + strings.Repeat(" ", n-len(s))
+}
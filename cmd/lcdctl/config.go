@@ -0,0 +1,148 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+
+	"periph.io/x/conn/v3/display"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/devices/v3/hd44780"
+	"periph.io/x/devices/v3/matrixorbital"
+	"periph.io/x/devices/v3/serlcd"
+	"periph.io/x/devices/v3/waveshare1602"
+)
+
+// config describes the display to open. It's read from the file passed to
+// -config, and the flags override its fields.
+type config struct {
+	// Type is the kind of display, one of the keys of displayTypes.
+	Type string `json:"type"`
+	// Bus is the name of the I²C bus, the default bus if empty.
+	Bus string `json:"bus"`
+	// Address is the I²C address, such as "0x27". The default of the type is
+	// used if empty.
+	Address string `json:"address"`
+	// Port is the serial port of the types connected to one, such as
+	// "/dev/ttyUSB0".
+	Port string `json:"port"`
+	Rows int    `json:"rows"`
+	Cols int    `json:"cols"`
+}
+
+// displayType opens a kind of display.
+type displayType struct {
+	// address is the default I²C address.
+	address uint16
+	// contrast is true if the contrast of the display can be set.
+	contrast bool
+	// open opens the display on an I²C bus. HD44780 displays are only
+	// initialized, which clears them, if init is true.
+	open func(b i2c.Bus, addr uint16, rows, cols int, init bool) (display.TextDisplay, error)
+	// openSerial opens a display connected to a serial port, instead of open.
+	openSerial func(rw io.ReadWriter, rows, cols int) (display.TextDisplay, error)
+}
+
+var displayTypes = map[string]displayType{
+	"pcf8574": {address: 0x27, open: func(b i2c.Bus, addr uint16, rows, cols int, init bool) (display.TextDisplay, error) {
+		return hd44780.NewPCF857xBackpack(b, addr, rows, cols, hd44780Options(init)...)
+	}},
+	"adafruit": {address: 0x20, open: func(b i2c.Bus, addr uint16, rows, cols int, init bool) (display.TextDisplay, error) {
+		return hd44780.NewAdafruitI2CBackpack(b, addr, rows, cols, hd44780Options(init)...)
+	}},
+	"waveshare1602": {address: 0x3e, open: func(b i2c.Bus, addr uint16, rows, cols int, init bool) (display.TextDisplay, error) {
+		return waveshare1602.NewWithAddress(b, waveshare1602.LCD1602MonoBacklight, addr, rows, cols)
+	}},
+	"waveshare1602-rgb": {address: 0x3e, open: func(b i2c.Bus, addr uint16, rows, cols int, init bool) (display.TextDisplay, error) {
+		return waveshare1602.NewWithAddress(b, waveshare1602.LCD1602RGBBacklight, addr, rows, cols)
+	}},
+	"serlcd": {address: serlcd.DefaultI2CAddress, contrast: true, open: func(b i2c.Bus, addr uint16, rows, cols int, init bool) (display.TextDisplay, error) {
+		return serlcd.NewConn(&i2c.Dev{Bus: b, Addr: addr}, rows, cols), nil
+	}},
+	"lk2047t": {address: 0x28, contrast: true, open: func(b i2c.Bus, addr uint16, rows, cols int, init bool) (display.TextDisplay, error) {
+		return matrixorbital.NewConnLK2047T(&i2c.Dev{Bus: b, Addr: addr}, rows, cols), nil
+	}},
+	// The model is detected, if the display answers queries.
+	"matrixorbital-serial": {contrast: true, openSerial: func(rw io.ReadWriter, rows, cols int) (display.TextDisplay, error) {
+		d, err := matrixorbital.OpenWriter(rw, matrixorbital.ModelLK2047T, rows, cols)
+		// The display uses the size passed if it doesn't match the model.
+		if err != nil && !errors.Is(err, matrixorbital.ErrSizeMismatch) {
+			return nil, err
+		}
+		return d, nil
+	}},
+}
+
+// hd44780Options returns the options of the HD44780 based types.
+func hd44780Options(init bool) []hd44780.Option {
+	if init {
+		return nil
+	}
+	return []hd44780.Option{hd44780.WithoutInit()}
+}
+
+// typeNames returns the sorted names of the display types.
+func typeNames() []string {
+	names := make([]string, 0, len(displayTypes))
+	for name := range displayTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// readConfig reads a configuration file.
+func readConfig(name string) (*config, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseConfig(f)
+}
+
+// parseConfig parses a configuration in JSON, rejecting unknown fields.
+func parseConfig(r io.Reader) (*config, error) {
+	cfg := &config{}
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// resolve checks the configuration, and returns its display type and I²C
+// address.
+func (cfg *config) resolve() (displayType, uint16, error) {
+	dt, ok := displayTypes[cfg.Type]
+	if !ok {
+		return displayType{}, 0, fmt.Errorf("unknown display type %q, expected one of %v", cfg.Type, typeNames())
+	}
+	if cfg.Rows <= 0 || cfg.Cols <= 0 {
+		return displayType{}, 0, fmt.Errorf("invalid display size %dx%d", cfg.Rows, cfg.Cols)
+	}
+	if dt.openSerial != nil {
+		if cfg.Port == "" {
+			return displayType{}, 0, fmt.Errorf("display type %q requires a serial port", cfg.Type)
+		}
+		return dt, 0, nil
+	}
+	addr := dt.address
+	if cfg.Address != "" {
+		v, err := strconv.ParseUint(cfg.Address, 0, 16)
+		if err != nil || v > 0x7f {
+			return displayType{}, 0, fmt.Errorf("invalid I²C address %q", cfg.Address)
+		}
+		addr = uint16(v)
+	}
+	return dt, addr, nil
+}
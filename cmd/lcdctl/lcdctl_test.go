@@ -0,0 +1,179 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"periph.io/x/conn/v3/display"
+)
+
+// fakeDisplay records the text of each row, and the other settings.
+type fakeDisplay struct {
+	rows     [][]byte
+	row, col int
+	cursor   []display.CursorMode
+	light    []display.Intensity
+	chars    map[int][8]byte
+}
+
+func newFakeDisplay(rows, cols int) *fakeDisplay {
+	f := &fakeDisplay{rows: make([][]byte, rows), chars: map[int][8]byte{}}
+	for ix := range f.rows {
+		f.rows[ix] = []byte(strings.Repeat(" ", cols))
+	}
+	return f
+}
+
+func (f *fakeDisplay) AutoScroll(enabled bool) error { return display.ErrNotImplemented }
+func (f *fakeDisplay) Cols() int                     { return len(f.rows[0]) }
+func (f *fakeDisplay) Rows() int                     { return len(f.rows) }
+func (f *fakeDisplay) MinCol() int                   { return 1 }
+func (f *fakeDisplay) MinRow() int                   { return 1 }
+func (f *fakeDisplay) Home() error                   { return f.MoveTo(1, 1) }
+func (f *fakeDisplay) Move(display.CursorDirection) error {
+	return display.ErrNotImplemented
+}
+func (f *fakeDisplay) Display(on bool) error { return nil }
+func (f *fakeDisplay) String() string        { return "fake" }
+
+func (f *fakeDisplay) Clear() error {
+	for _, r := range f.rows {
+		copy(r, strings.Repeat(" ", len(r)))
+	}
+	return f.Home()
+}
+
+func (f *fakeDisplay) Cursor(modes ...display.CursorMode) error {
+	f.cursor = modes
+	return nil
+}
+
+func (f *fakeDisplay) MoveTo(row, col int) error {
+	if row < 1 || row > f.Rows() || col < 1 || col > f.Cols() {
+		return fmt.Errorf("MoveTo(%d,%d) out of range", row, col)
+	}
+	f.row, f.col = row-1, col-1
+	return nil
+}
+
+func (f *fakeDisplay) Write(p []byte) (int, error) {
+	for _, c := range p {
+		if f.col < f.Cols() {
+			f.rows[f.row][f.col] = c
+			f.col++
+		}
+	}
+	return len(p), nil
+}
+
+func (f *fakeDisplay) WriteString(s string) (int, error) { return f.Write([]byte(s)) }
+
+func (f *fakeDisplay) RGBBacklight(r, g, b display.Intensity) error {
+	f.light = []display.Intensity{r, g, b}
+	return nil
+}
+
+func (f *fakeDisplay) SetCustomChar(index int, pattern [8]byte) error {
+	f.chars[index] = pattern
+	return nil
+}
+
+func (f *fakeDisplay) text() string {
+	lines := make([]string, len(f.rows))
+	for ix, r := range f.rows {
+		lines[ix] = string(r)
+	}
+	return strings.Join(lines, "|")
+}
+
+func run(d display.TextDisplay, line, stdin string) error {
+	args := strings.Fields(line)
+	return commands[args[0]].run(d, args[1:], strings.NewReader(stdin))
+}
+
+func TestCommands(t *testing.T) {
+	d := newFakeDisplay(2, 8)
+	if err := run(d, "write -row 2 -col 3 hi there", ""); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.text(); got != "        |  hi the" {
+		t.Errorf("got %q", got)
+	}
+	if err := run(d, "clear", ""); err != nil || d.text() != "        |        " {
+		t.Errorf("clear: %v %q", err, d.text())
+	}
+	if err := run(d, "backlight on", ""); err != nil || fmt.Sprint(d.light) != "[255 255 255]" {
+		t.Errorf("backlight: %v %v", err, d.light)
+	}
+	if err := run(d, "backlight 1 2 0x10", ""); err != nil || fmt.Sprint(d.light) != "[1 2 16]" {
+		t.Errorf("backlight: %v %v", err, d.light)
+	}
+	if err := run(d, "backlight 300", ""); err == nil {
+		t.Error("expected error for an invalid intensity")
+	}
+	if err := run(d, "contrast 10", ""); !errors.Is(err, display.ErrNotImplemented) {
+		t.Errorf("contrast: %v", err)
+	}
+	if err := run(d, "cursor underline blink", ""); err != nil || len(d.cursor) != 2 || d.cursor[1] != display.CursorBlink {
+		t.Errorf("cursor: %v %v", err, d.cursor)
+	}
+	if err := run(d, "cursor sideways", ""); err == nil {
+		t.Error("expected error for an invalid cursor mode")
+	}
+	if err := run(d, "custom-char 1 0 0x0a 0 0x11 0b01110 0 0 0", ""); err != nil {
+		t.Fatal(err)
+	}
+	if p := d.chars[1]; p != [8]byte{0, 0x0a, 0, 0x11, 0x0e} {
+		t.Errorf("custom char % x", p)
+	}
+}
+
+func TestFollow(t *testing.T) {
+	d := newFakeDisplay(2, 6)
+	if err := run(d, "follow", "one\ntwo\nthree is long\n"); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.text(); got != "two   |three " {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestConfig(t *testing.T) {
+	cfg, err := parseConfig(strings.NewReader(`{"type": "pcf8574", "address": "0x3f", "rows": 4, "cols": 20}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, addr, err := cfg.resolve(); err != nil || addr != 0x3f {
+		t.Errorf("got 0x%x %v", addr, err)
+	}
+	cfg.Address = ""
+	if _, addr, err := cfg.resolve(); err != nil || addr != 0x27 {
+		t.Errorf("got 0x%x %v, expected the default address", addr, err)
+	}
+	cfg.Type = "vfd"
+	if _, _, err := cfg.resolve(); err == nil {
+		t.Error("expected error for an unknown type")
+	}
+	cfg.Type = "matrixorbital-serial"
+	if _, _, err := cfg.resolve(); err == nil {
+		t.Error("expected error for a missing serial port")
+	}
+	cfg.Port = "/dev/ttyUSB0"
+	if dt, _, err := cfg.resolve(); err != nil || dt.openSerial == nil || !dt.contrast {
+		t.Errorf("got %v", err)
+	}
+	for _, typ := range []string{"pcf8574", "adafruit", "waveshare1602"} {
+		if displayTypes[typ].contrast {
+			t.Errorf("%s: expected contrast to be rejected", typ)
+		}
+	}
+	if _, err := parseConfig(strings.NewReader(`{"typ": "pcf8574"}`)); err == nil {
+		t.Error("expected error for an unknown field")
+	}
+}
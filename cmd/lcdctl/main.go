@@ -0,0 +1,151 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// lcdctl controls a character display connected to an I²C bus or a serial
+// port, for use in shell scripts and systemd units.
+//
+// The display is described by the flags, or by a JSON file passed to
+// -config, whose fields the flags override:
+//
+//	{"type": "pcf8574", "bus": "1", "address": "0x27", "rows": 2, "cols": 16}
+//	{"type": "matrixorbital-serial", "port": "/dev/ttyUSB0", "rows": 4, "cols": 20}
+//
+// HD44780 based displays aren't initialized, so that each invocation keeps
+// what the previous ones wrote. Pass -init the first time after the display
+// is powered on, which clears it. Text is written at row 1, column 1 unless
+// -row or -col is passed, since the cursor position isn't known.
+//
+// The serial port must be set up first, for example with
+// "stty -F /dev/ttyUSB0 19200 raw -echo".
+//
+// Usage:
+//
+//	lcdctl [flags] write [-row n] [-col n] text...
+//	lcdctl [flags] clear
+//	lcdctl [flags] backlight on|off|<intensity>|<red> <green> <blue>
+//	lcdctl [flags] contrast <value>
+//	lcdctl [flags] cursor off|underline|block|blink
+//	lcdctl [flags] custom-char <index> <row0> ... <row7>
+//	lcdctl [flags] follow
+//
+// follow writes each line read from stdin to the last row, scrolling the
+// previous lines up:
+//
+//	journalctl -f -o cat | lcdctl -type pcf8574 follow
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"periph.io/x/conn/v3/display"
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/host/v3"
+)
+
+func mainImpl() error {
+	configFile := flag.String("config", "", "JSON file describing the display")
+	typ := flag.String("type", "", fmt.Sprintf("display type, one of %v", typeNames()))
+	bus := flag.String("bus", "", "I²C bus name")
+	addr := flag.String("addr", "", "I²C address, the default of the display type if empty")
+	port := flag.String("port", "", "serial port, for the display types connected to one")
+	initialize := flag.Bool("init", false, "initialize an HD44780 based display, which clears it")
+	rows := flag.Int("rows", 2, "number of rows")
+	cols := flag.Int("cols", 16, "number of columns")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		return errors.New("missing command")
+	}
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		return fmt.Errorf("unknown command %q", flag.Arg(0))
+	}
+
+	cfg := &config{Rows: *rows, Cols: *cols}
+	if *configFile != "" {
+		var err error
+		if cfg, err = readConfig(*configFile); err != nil {
+			return err
+		}
+		if cfg.Rows == 0 && cfg.Cols == 0 {
+			cfg.Rows, cfg.Cols = *rows, *cols
+		}
+	}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "type":
+			cfg.Type = *typ
+		case "bus":
+			cfg.Bus = *bus
+		case "addr":
+			cfg.Address = *addr
+		case "port":
+			cfg.Port = *port
+		case "rows":
+			cfg.Rows = *rows
+		case "cols":
+			cfg.Cols = *cols
+		}
+	})
+	dt, address, err := cfg.resolve()
+	if err != nil {
+		return err
+	}
+	// Rejected before the display is opened, which may initialize it.
+	if flag.Arg(0) == "contrast" && !dt.contrast {
+		return fmt.Errorf("display type %q can't set the contrast", cfg.Type)
+	}
+
+	var d display.TextDisplay
+	if dt.openSerial != nil {
+		f, err := os.OpenFile(cfg.Port, os.O_RDWR, 0)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if d, err = dt.openSerial(f, cfg.Rows, cfg.Cols); err != nil {
+			return err
+		}
+	} else {
+		if _, err := host.Init(); err != nil {
+			return err
+		}
+		b, err := i2creg.Open(cfg.Bus)
+		if err != nil {
+			return err
+		}
+		defer b.Close()
+		if d, err = dt.open(b, address, cfg.Rows, cfg.Cols, *initialize); err != nil {
+			return err
+		}
+	}
+	return cmd.run(d, flag.Args()[1:], os.Stdin)
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "usage: lcdctl [flags] <command> [args]\n\nCommands:\n")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(out, "  %s\n", commands[name].usage)
+	}
+	fmt.Fprintf(out, "\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	if err := mainImpl(); err != nil {
+		fmt.Fprintf(os.Stderr, "lcdctl: %s.\n", err)
+		os.Exit(1)
+	}
+}
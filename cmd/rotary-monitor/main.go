@@ -0,0 +1,147 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// rotary-monitor prints the events decoded from a rotary encoder, to debug
+// its wiring and its bounce.
+//
+// Each step is printed with its direction. With -raw, each transition of the
+// outputs is printed too, with the time since the previous one, and the
+// transitions that skip a state, from bounce or missed edges, are flagged.
+// On interrupt, the counts of steps, of invalid transitions and of the
+// bounces of the switch are printed, with a histogram of the time between
+// the edges of the outputs.
+//
+// If turning the knob clockwise is decoded as counter-clockwise, A and B are
+// swapped. Many invalid transitions call for capacitors on the outputs, or a
+// lower -steps for encoders with fewer transitions per detent.
+//
+// Usage:
+//
+//	rotary-monitor -a GPIO17 -b GPIO27 -sw GPIO22 -raw
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/host/v3"
+)
+
+// edgeTimeout is the longest time the goroutines wait for an edge between
+// checks of the interrupt.
+const edgeTimeout = 100 * time.Millisecond
+
+// openPin opens the pin name as an input with a pull-up, detecting both
+// edges. An empty name returns nil.
+func openPin(name string) (gpio.PinIn, error) {
+	if name == "" {
+		return nil, nil
+	}
+	p := gpioreg.ByName(name)
+	if p == nil {
+		return nil, fmt.Errorf("unknown pin %q", name)
+	}
+	if err := p.In(gpio.PullUp, gpio.BothEdges); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return p, nil
+}
+
+func mainImpl() error {
+	aName := flag.String("a", "", "pin connected to output A")
+	bName := flag.String("b", "", "pin connected to output B")
+	swName := flag.String("sw", "", "pin connected to the switch, if any")
+	steps := flag.Int("steps", 4, "transitions per detent")
+	debounce := flag.Duration("debounce", 10*time.Millisecond, "time the switch must be stable")
+	raw := flag.Bool("raw", false, "print the transitions of the outputs")
+	flag.Parse()
+	if *aName == "" || *bName == "" {
+		return errors.New("-a and -b are required")
+	}
+	if *steps < 1 || *steps > 4 {
+		return fmt.Errorf("invalid -steps %d", *steps)
+	}
+
+	if _, err := host.Init(); err != nil {
+		return err
+	}
+	a, err := openPin(*aName)
+	if err != nil {
+		return err
+	}
+	b, err := openPin(*bName)
+	if err != nil {
+		return err
+	}
+	sw, err := openPin(*swName)
+	if err != nil {
+		return err
+	}
+	swLevel := gpio.High
+	if sw != nil {
+		swLevel = sw.Read()
+	}
+	m := newMonitor(a.Read(), b.Read(), swLevel, *steps, *debounce)
+	fmt.Printf("AB %s, turn the knob\n", m.state)
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	var printMu sync.Mutex
+	printLines := func(lines []string) {
+		printMu.Lock()
+		defer printMu.Unlock()
+		for _, l := range lines {
+			fmt.Printf("%s %s\n", time.Now().Format("15:04:05.000000"), l)
+		}
+	}
+	watch := func(p gpio.PinIn, handle func(t time.Time) []string) {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if p.WaitForEdge(edgeTimeout) {
+				printLines(handle(time.Now()))
+			}
+		}
+	}
+	// Both outputs are read after an edge of either, since the other may
+	// have changed without its edge being processed yet.
+	outputs := func(t time.Time) []string {
+		return m.output(a.Read(), b.Read(), t, *raw)
+	}
+	wg.Add(2)
+	go watch(a, outputs)
+	go watch(b, outputs)
+	if sw != nil {
+		wg.Add(1)
+		go watch(sw, func(t time.Time) []string {
+			return m.button(sw.Read(), t, *raw)
+		})
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	<-interrupt
+	close(stop)
+	wg.Wait()
+	fmt.Print("\n", m.summary())
+	return nil
+}
+
+func main() {
+	if err := mainImpl(); err != nil {
+		fmt.Fprintf(os.Stderr, "rotary-monitor: %s.\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/devices/v3/rotary"
+)
+
+// histogram counts intervals in buckets doubling from 100µs, the last one
+// holding everything longer.
+type histogram struct {
+	counts [12]int
+}
+
+// bucketStart is the lower bound of the first bucket but one.
+const bucketStart = 100 * time.Microsecond
+
+func (h *histogram) add(d time.Duration) {
+	ix := 0
+	for limit := bucketStart; d >= limit && ix < len(h.counts)-1; limit *= 2 {
+		ix++
+	}
+	h.counts[ix]++
+}
+
+// String renders the histogram with a bar per bucket, scaled to 40
+// characters.
+func (h *histogram) String() string {
+	peak := 0
+	for _, c := range h.counts {
+		peak = max(peak, c)
+	}
+	if peak == 0 {
+		return "  (empty)\n"
+	}
+	var b strings.Builder
+	var low time.Duration
+	limit := bucketStart
+	for ix, c := range h.counts {
+		label := fmt.Sprintf("%v-%v", low, limit)
+		if ix == len(h.counts)-1 {
+			label = fmt.Sprintf(">=%v", low)
+		}
+		fmt.Fprintf(&b, "  %-18s %6d %s\n", label, c, strings.Repeat("#", (c*40+peak-1)/peak))
+		low, limit = limit, limit*2
+	}
+	return b.String()
+}
+
+// monitor decodes the levels of the outputs and of the switch, and keeps
+// statistics.
+type monitor struct {
+	mu       sync.Mutex
+	dec      *rotary.Decoder
+	state    string
+	lastEdge time.Time
+	edges    histogram
+	// cw and ccw are the steps decoded in each direction.
+	cw, ccw int
+	// position is the sum of the steps.
+	position int
+
+	debounce  time.Duration
+	swLevel   gpio.Level
+	swEdge    time.Time
+	swBounces int
+	presses   int
+}
+
+func newMonitor(a, b, sw gpio.Level, perStep int, debounce time.Duration) *monitor {
+	return &monitor{
+		dec:      rotary.NewDecoder(a, b, perStep),
+		state:    levels(a, b),
+		debounce: debounce,
+		swLevel:  sw,
+	}
+}
+
+// levels formats the levels of A and B, as "AB".
+func levels(a, b gpio.Level) string {
+	s := []byte("00")
+	if a {
+		s[0] = '1'
+	}
+	if b {
+		s[1] = '1'
+	}
+	return string(s)
+}
+
+// output processes the levels of A and B read after an edge at t, and
+// returns the lines to print: the raw transition if raw is true, and the
+// steps decoded.
+func (m *monitor) output(a, b gpio.Level, t time.Time, raw bool) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	next := levels(a, b)
+	if next == m.state {
+		return nil
+	}
+	var since time.Duration
+	if !m.lastEdge.IsZero() {
+		since = t.Sub(m.lastEdge)
+		m.edges.add(since)
+	}
+	m.lastEdge = t
+	invalid := m.dec.Invalid
+	steps := m.dec.Update(a, b)
+	var lines []string
+	if raw {
+		note := ""
+		if m.dec.Invalid != invalid {
+			note = " invalid (bounce or missed edge)"
+		}
+		lines = append(lines, fmt.Sprintf("AB %s -> %s after %v%s", m.state, next, since, note))
+	}
+	m.state = next
+	if steps == 0 {
+		return lines
+	}
+	if steps > 0 {
+		m.cw += steps
+	} else {
+		m.ccw -= steps
+	}
+	m.position += steps
+	dir := "clockwise"
+	if steps < 0 {
+		dir = "counter-clockwise"
+	}
+	return append(lines, fmt.Sprintf("turn %+d %s, position %d", steps, dir, m.position))
+}
+
+// button processes the level of the switch read after an edge at t. An edge
+// within the debounce time of the previous one is counted as bounce.
+func (m *monitor) button(l gpio.Level, t time.Time, raw bool) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if l == m.swLevel {
+		return nil
+	}
+	bounce := !m.swEdge.IsZero() && t.Sub(m.swEdge) < m.debounce
+	since := t.Sub(m.swEdge)
+	m.swLevel, m.swEdge = l, t
+	if bounce {
+		m.swBounces++
+		if raw {
+			return []string{fmt.Sprintf("SW %s after %v, bounce", l, since)}
+		}
+		return nil
+	}
+	if l == gpio.Low {
+		m.presses++
+		return []string{"press"}
+	}
+	return []string{"release"}
+}
+
+// summary returns the statistics.
+func (m *monitor) summary() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var b strings.Builder
+	fmt.Fprintf(&b, "steps: %d clockwise, %d counter-clockwise, position %d\n", m.cw, m.ccw, m.position)
+	fmt.Fprintf(&b, "invalid transitions: %d\n", m.dec.Invalid)
+	fmt.Fprintf(&b, "switch: %d presses, %d bounces\n", m.presses, m.swBounces)
+	fmt.Fprintf(&b, "time between edges of A and B:\n%s", m.edges.String())
+	if m.ccw > 0 && m.cw == 0 {
+		b.WriteString("only counter-clockwise steps were decoded: if the knob was turned clockwise, swap A and B\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+func TestMonitor(t *testing.T) {
+	m := newMonitor(gpio.High, gpio.High, gpio.High, 4, 10*time.Millisecond)
+	now := time.Unix(1000, 0)
+	// A clockwise detent, A leading B.
+	var lines []string
+	for _, s := range []struct{ a, b gpio.Level }{{gpio.Low, gpio.High}, {gpio.Low, gpio.Low}, {gpio.High, gpio.Low}, {gpio.High, gpio.High}} {
+		now = now.Add(time.Millisecond)
+		lines = append(lines, m.output(s.a, s.b, now, true)...)
+	}
+	want := []string{
+		"AB 11 -> 01 after 0s",
+		"AB 01 -> 00 after 1ms",
+		"AB 00 -> 10 after 1ms",
+		"AB 10 -> 11 after 1ms",
+		"turn +1 clockwise, position 1",
+	}
+	if strings.Join(lines, "|") != strings.Join(want, "|") {
+		t.Errorf("got %q", lines)
+	}
+	// Both outputs changing is invalid.
+	now = now.Add(time.Millisecond)
+	if l := m.output(gpio.Low, gpio.Low, now, true); len(l) != 1 || !strings.HasSuffix(l[0], "invalid (bounce or missed edge)") {
+		t.Errorf("got %q", l)
+	}
+	if l := m.output(gpio.Low, gpio.Low, now, true); l != nil {
+		t.Errorf("got %q without a change", l)
+	}
+
+	if l := m.button(gpio.Low, now, false); len(l) != 1 || l[0] != "press" {
+		t.Errorf("got %q", l)
+	}
+	if l := m.button(gpio.High, now.Add(time.Millisecond), true); len(l) != 1 || !strings.HasSuffix(l[0], "bounce") {
+		t.Errorf("got %q", l)
+	}
+	if l := m.button(gpio.Low, now.Add(50*time.Millisecond), false); len(l) != 1 || l[0] != "press" {
+		t.Errorf("got %q", l)
+	}
+
+	s := m.summary()
+	for _, w := range []string{"1 clockwise, 0 counter-clockwise", "invalid transitions: 1", "2 presses, 1 bounces", "800µs-1.6ms"} {
+		if !strings.Contains(s, w) {
+			t.Errorf("summary without %q:\n%s", w, s)
+		}
+	}
+}
+
+func TestMonitor_swapped(t *testing.T) {
+	m := newMonitor(gpio.High, gpio.High, gpio.High, 1, 0)
+	now := time.Unix(1000, 0)
+	if l := m.output(gpio.High, gpio.Low, now, false); len(l) != 1 || l[0] != "turn -1 counter-clockwise, position -1" {
+		t.Errorf("got %q", l)
+	}
+	if s := m.summary(); !strings.Contains(s, "swap A and B") {
+		t.Errorf("summary without a hint:\n%s", s)
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package connmetrics counts the transactions of a device, its errors and
+// retries, and measures the latency of its writes, so that a long running
+// application can detect a degrading bus before a device misbehaves.
+//
+// The bus or connection passed to the driver of a device is wrapped by its
+// Metrics. Each transaction is reported to an optional hook, and the totals
+// are returned by Stats() or written in the Prometheus text format by
+// WritePrometheus(), to be served to a scraper without further dependencies.
+package connmetrics
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Sample describes a transaction.
+type Sample struct {
+	// Device is the name of the Metrics.
+	Device string
+	// Addr is the I²C address, 0 for a conn.Conn.
+	Addr uint16
+	// Written and Read are the number of bytes written and read.
+	Written, Read int
+	// Latency is the duration of the transaction.
+	Latency time.Duration
+	// Retry is true if the previous transaction of the device failed.
+	Retry bool
+	// Err is the error returned by the transaction.
+	Err error
+}
+
+// Stats is the totals of the transactions of a device.
+type Stats struct {
+	// Transactions is the number of transactions, including those that
+	// failed.
+	Transactions uint64
+	// Errors is the number of transactions that failed.
+	Errors uint64
+	// Retries is the number of transactions that followed a failed one.
+	// Drivers retry after a failure, so they're the recovery attempts.
+	Retries uint64
+	// Writes is the number of transactions that wrote bytes.
+	Writes uint64
+	// WriteLatency is the total duration of the writes, and
+	// MaxWriteLatency the longest.
+	WriteLatency    time.Duration
+	MaxWriteLatency time.Duration
+	// LastError is the error of the last transaction that failed.
+	LastError error
+}
+
+// MeanWriteLatency returns the mean duration of the writes, or 0 if there
+// were none.
+func (s *Stats) MeanWriteLatency() time.Duration {
+	if s.Writes == 0 {
+		return 0
+	}
+	return s.WriteLatency / time.Duration(s.Writes)
+}
+
+func (s *Stats) String() string {
+	return fmt.Sprintf("%d transactions, %d errors, %d retries, write latency %s mean %s max", s.Transactions, s.Errors, s.Retries, s.MeanWriteLatency(), s.MaxWriteLatency)
+}
+
+// Option is a configuration option passed to New().
+type Option func(*options)
+
+type options struct {
+	hook func(Sample)
+}
+
+// WithHook configures hook to be called after each transaction. It's called
+// by the goroutine of the driver, and must return quickly.
+func WithHook(hook func(Sample)) Option {
+	return func(o *options) {
+		o.hook = hook
+	}
+}
+
+// Metrics records the transactions of a device instance. It's safe for
+// concurrent use.
+type Metrics struct {
+	name string
+	hook func(Sample)
+
+	mu     sync.Mutex
+	stats  Stats
+	failed bool
+
+	// now is replaced by tests.
+	now func() time.Time
+}
+
+// New returns the Metrics of the device name. The name identifies the
+// device in samples and in the Prometheus output, for example "lcd".
+func New(name string, opts ...Option) *Metrics {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return &Metrics{name: name, hook: o.hook, now: time.Now}
+}
+
+// Name returns the name of the device.
+func (m *Metrics) Name() string {
+	return m.name
+}
+
+func (m *Metrics) String() string {
+	return "connmetrics(" + m.name + ")"
+}
+
+// Bus returns a bus that records the transactions on b. It's passed to the
+// driver of the device instead of b.
+func (m *Metrics) Bus(b i2c.Bus) i2c.Bus {
+	return &metricsBus{m: m, b: b}
+}
+
+// Conn returns a connection that records the transactions on c.
+func (m *Metrics) Conn(c conn.Conn) conn.Conn {
+	return &metricsConn{m: m, c: c}
+}
+
+// Stats returns the totals of the transactions recorded.
+func (m *Metrics) Stats() Stats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stats
+}
+
+// Reset sets the totals to zero, for example after a bus was repaired.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stats = Stats{}
+	m.failed = false
+}
+
+// tx runs f, and records it as a transaction.
+func (m *Metrics) tx(addr uint16, w, r []byte, f func() error) error {
+	start := m.now()
+	err := f()
+	s := Sample{Device: m.name, Addr: addr, Written: len(w), Read: len(r), Latency: m.now().Sub(start), Err: err}
+	m.mu.Lock()
+	s.Retry = m.failed
+	m.failed = err != nil
+	st := &m.stats
+	st.Transactions++
+	if s.Retry {
+		st.Retries++
+	}
+	if err != nil {
+		st.Errors++
+		st.LastError = err
+	}
+	if len(w) != 0 {
+		st.Writes++
+		st.WriteLatency += s.Latency
+		st.MaxWriteLatency = max(st.MaxWriteLatency, s.Latency)
+	}
+	m.mu.Unlock()
+	if m.hook != nil {
+		m.hook(s)
+	}
+	return err
+}
+
+type metricsBus struct {
+	m *Metrics
+	b i2c.Bus
+}
+
+func (mb *metricsBus) String() string {
+	return "connmetrics(" + mb.b.String() + ")"
+}
+
+func (mb *metricsBus) Tx(addr uint16, w, r []byte) error {
+	return mb.m.tx(addr, w, r, func() error { return mb.b.Tx(addr, w, r) })
+}
+
+func (mb *metricsBus) SetSpeed(f physic.Frequency) error {
+	return mb.b.SetSpeed(f)
+}
+
+type metricsConn struct {
+	m *Metrics
+	c conn.Conn
+}
+
+func (mc *metricsConn) String() string {
+	return "connmetrics(" + mc.c.String() + ")"
+}
+
+func (mc *metricsConn) Tx(w, r []byte) error {
+	return mc.m.tx(0, w, r, func() error { return mc.c.Tx(w, r) })
+}
+
+func (mc *metricsConn) Duplex() conn.Duplex {
+	return mc.c.Duplex()
+}
+
+// WritePrometheus writes the totals of ms in the Prometheus text exposition
+// format, with a device label set to the name of each Metrics.
+func WritePrometheus(w io.Writer, ms ...*Metrics) error {
+	stats := make([]Stats, len(ms))
+	for ix, m := range ms {
+		stats[ix] = m.Stats()
+	}
+	var b strings.Builder
+	for _, metric := range []struct {
+		name, help, kind string
+		value            func(s *Stats) string
+	}{
+		{"periph_device_transactions_total", "Transactions of the device.", "counter",
+			func(s *Stats) string { return strconv.FormatUint(s.Transactions, 10) }},
+		{"periph_device_errors_total", "Transactions of the device that failed.", "counter",
+			func(s *Stats) string { return strconv.FormatUint(s.Errors, 10) }},
+		{"periph_device_retries_total", "Transactions of the device that followed a failed one.", "counter",
+			func(s *Stats) string { return strconv.FormatUint(s.Retries, 10) }},
+		{"periph_device_writes_total", "Transactions of the device that wrote bytes.", "counter",
+			func(s *Stats) string { return strconv.FormatUint(s.Writes, 10) }},
+		{"periph_device_write_seconds_total", "Total duration of the writes to the device.", "counter",
+			func(s *Stats) string { return formatSeconds(s.WriteLatency) }},
+		{"periph_device_write_seconds_max", "Longest write to the device.", "gauge",
+			func(s *Stats) string { return formatSeconds(s.MaxWriteLatency) }},
+	} {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", metric.name, metric.help, metric.name, metric.kind)
+		for ix, m := range ms {
+			fmt.Fprintf(&b, "%s{device=%q} %s\n", metric.name, m.name, metric.value(&stats[ix]))
+		}
+	}
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("connmetrics: %w", err)
+	}
+	return nil
+}
+
+func formatSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'g', -1, 64)
+}
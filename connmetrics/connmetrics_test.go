@@ -0,0 +1,91 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package connmetrics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestMetrics(t *testing.T) {
+	bus := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			{Addr: 0x27, W: []byte{0x08, 0x0c}},
+			{Addr: 0x27, R: []byte{0xf0}},
+		},
+		DontPanic: true,
+	}
+	var samples []Sample
+	m := New("lcd", WithHook(func(s Sample) { samples = append(samples, s) }))
+	var now time.Time
+	latency := time.Millisecond
+	m.now = func() time.Time {
+		now = now.Add(latency)
+		return now
+	}
+	b := m.Bus(bus)
+	// The wrong address fails, and the write is retried.
+	if err := b.Tx(0x3f, []byte{0x08, 0x0c}, nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	latency = 3 * time.Millisecond
+	if err := b.Tx(0x27, []byte{0x08, 0x0c}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Tx(0x27, nil, make([]byte, 1)); err != nil {
+		t.Fatal(err)
+	}
+	s := m.Stats()
+	if s.Transactions != 3 || s.Errors != 1 || s.Retries != 1 || s.Writes != 2 || s.LastError == nil {
+		t.Fatalf("got %+v", s)
+	}
+	if s.WriteLatency != 4*time.Millisecond || s.MaxWriteLatency != 3*time.Millisecond || s.MeanWriteLatency() != 2*time.Millisecond {
+		t.Fatalf("got %s", &s)
+	}
+	if len(samples) != 3 || samples[0].Err == nil || !samples[1].Retry || samples[2].Retry || samples[2].Read != 1 {
+		t.Fatalf("got %+v", samples)
+	}
+	if samples[1].Device != "lcd" || samples[1].Addr != 0x27 || samples[1].Written != 2 || samples[1].Latency != 3*time.Millisecond {
+		t.Fatalf("got %+v", samples[1])
+	}
+
+	c := m.Conn(&conntest.Playback{Ops: []conntest.IO{{W: []byte{0x01}}}})
+	if err := c.Tx([]byte{0x01}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := m.Stats(); s.Transactions != 4 || samples[3].Addr != 0 {
+		t.Fatalf("got %+v", s)
+	}
+	m.Reset()
+	if s := m.Stats(); s != (Stats{}) {
+		t.Fatalf("got %+v", s)
+	}
+}
+
+func TestWritePrometheus(t *testing.T) {
+	lcd, expander := New("lcd"), New("expander")
+	lcd.stats = Stats{Transactions: 10, Errors: 2, Retries: 1, Writes: 8, WriteLatency: 20 * time.Millisecond, MaxWriteLatency: 5 * time.Millisecond}
+	var out bytes.Buffer
+	if err := WritePrometheus(&out, lcd, expander); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"# TYPE periph_device_transactions_total counter\n",
+		"periph_device_transactions_total{device=\"lcd\"} 10\nperiph_device_transactions_total{device=\"expander\"} 0\n",
+		"periph_device_errors_total{device=\"lcd\"} 2\n",
+		"periph_device_retries_total{device=\"lcd\"} 1\n",
+		"periph_device_write_seconds_total{device=\"lcd\"} 0.02\n",
+		"periph_device_write_seconds_max{device=\"lcd\"} 0.005\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("missing %q in:\n%s", want, out.String())
+		}
+	}
+}
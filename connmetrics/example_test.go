@@ -0,0 +1,47 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package connmetrics_test
+
+import (
+	"log"
+	"net/http"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/connmetrics"
+	"periph.io/x/devices/v3/hd44780"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer bus.Close()
+
+	// Log the failures of the display as they happen.
+	m := connmetrics.New("lcd", connmetrics.WithHook(func(s connmetrics.Sample) {
+		if s.Err != nil {
+			log.Printf("%s: %v", s.Device, s.Err)
+		}
+	}))
+	lcd, err := hd44780.NewPCF857xBackpack(m.Bus(bus), 0x27, 2, 16)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := lcd.WriteString("Hello"); err != nil {
+		log.Fatal(err)
+	}
+
+	// Serve the totals to Prometheus.
+	http.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_ = connmetrics.WritePrometheus(w, m)
+	})
+	log.Fatal(http.ListenAndServe(":9100", nil))
+}
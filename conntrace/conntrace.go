@@ -0,0 +1,322 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package conntrace records the transactions of an I²C bus or of a
+// connection to a trace, saves it to a file, and replays it, so that the
+// bytes written by a driver can be compared to a golden trace when its write
+// paths are changed.
+//
+// # Format
+//
+// A trace file has a transaction per line. Each line has the fields:
+//
+//	@0x27     the I²C address, omitted for a conn.Conn
+//	+1.5ms    the time since the start of the recording, if recorded
+//	W 080c08  the bytes written, in hex, if any
+//	R 00      the bytes read, in hex, if any
+//
+// Empty lines, and lines starting with #, are ignored.
+package conntrace
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Tx is a transaction.
+type Tx struct {
+	// Addr is the I²C address, 0 for a conn.Conn.
+	Addr uint16
+	W, R []byte
+	// At is the time of the transaction since the start of the recording.
+	// It's zero in traces without timing.
+	At time.Duration
+}
+
+// Trace is a sequence of transactions.
+type Trace struct {
+	Txs []Tx
+}
+
+// Recorder records the transactions of the buses and connections it wraps.
+// Transactions that fail aren't recorded.
+type Recorder struct {
+	mu    sync.Mutex
+	trace Trace
+	start time.Time
+
+	// now is replaced by tests.
+	now func() time.Time
+}
+
+// NewRecorder returns a Recorder. The time of the transactions is relative
+// to its creation.
+func NewRecorder() *Recorder {
+	return &Recorder{start: time.Now(), now: time.Now}
+}
+
+// Bus returns a bus that records the transactions on b.
+func (r *Recorder) Bus(b i2c.Bus) i2c.Bus {
+	return &recordBus{r: r, b: b}
+}
+
+// Conn returns a connection that records the transactions on c.
+func (r *Recorder) Conn(c conn.Conn) conn.Conn {
+	return &recordConn{r: r, c: c}
+}
+
+// Trace returns a copy of the transactions recorded.
+func (r *Recorder) Trace() *Trace {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t := &Trace{Txs: make([]Tx, len(r.trace.Txs))}
+	copy(t.Txs, r.trace.Txs)
+	return t
+}
+
+func (r *Recorder) record(addr uint16, w, rd []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.trace.Txs = append(r.trace.Txs, Tx{Addr: addr, W: bytes.Clone(w), R: bytes.Clone(rd), At: r.now().Sub(r.start)})
+}
+
+type recordBus struct {
+	r *Recorder
+	b i2c.Bus
+}
+
+func (rb *recordBus) String() string {
+	return "conntrace(" + rb.b.String() + ")"
+}
+
+func (rb *recordBus) Tx(addr uint16, w, r []byte) error {
+	if err := rb.b.Tx(addr, w, r); err != nil {
+		return err
+	}
+	rb.r.record(addr, w, r)
+	return nil
+}
+
+func (rb *recordBus) SetSpeed(f physic.Frequency) error {
+	return rb.b.SetSpeed(f)
+}
+
+type recordConn struct {
+	r *Recorder
+	c conn.Conn
+}
+
+func (rc *recordConn) String() string {
+	return "conntrace(" + rc.c.String() + ")"
+}
+
+func (rc *recordConn) Tx(w, r []byte) error {
+	if err := rc.c.Tx(w, r); err != nil {
+		return err
+	}
+	rc.r.record(0, w, r)
+	return nil
+}
+
+func (rc *recordConn) Duplex() conn.Duplex {
+	return rc.c.Duplex()
+}
+
+// WithoutTiming returns a copy of t without the time of the transactions.
+func (t *Trace) WithoutTiming() *Trace {
+	c := &Trace{Txs: make([]Tx, len(t.Txs))}
+	for ix, tx := range t.Txs {
+		tx.At = 0
+		c.Txs[ix] = tx
+	}
+	return c
+}
+
+// Playback returns an I²C bus that replays t. Its Close() returns an error if
+// some transactions weren't replayed.
+func (t *Trace) Playback() *i2ctest.Playback {
+	p := &i2ctest.Playback{Ops: make([]i2ctest.IO, len(t.Txs))}
+	for ix, tx := range t.Txs {
+		p.Ops[ix] = i2ctest.IO{Addr: tx.Addr, W: tx.W, R: tx.R}
+	}
+	return p
+}
+
+// ConnPlayback returns a connection that replays t, ignoring the addresses.
+func (t *Trace) ConnPlayback() *conntest.Playback {
+	p := &conntest.Playback{Ops: make([]conntest.IO, len(t.Txs))}
+	for ix, tx := range t.Txs {
+		p.Ops[ix] = conntest.IO{W: tx.W, R: tx.R}
+	}
+	return p
+}
+
+// Diff returns an error describing the first transaction that differs
+// between t and want, ignoring their time, or nil if they're the same.
+func (t *Trace) Diff(want *Trace) error {
+	for ix := range min(len(t.Txs), len(want.Txs)) {
+		g, w := t.Txs[ix], want.Txs[ix]
+		if g.Addr != w.Addr || !bytes.Equal(g.W, w.W) || !bytes.Equal(g.R, w.R) {
+			return fmt.Errorf("conntrace: transaction %d is %q, expected %q", ix+1, formatTx(g.WithoutTiming()), formatTx(w.WithoutTiming()))
+		}
+	}
+	if len(t.Txs) != len(want.Txs) {
+		return fmt.Errorf("conntrace: %d transactions, expected %d", len(t.Txs), len(want.Txs))
+	}
+	return nil
+}
+
+// WithoutTiming returns tx without its time.
+func (tx Tx) WithoutTiming() Tx {
+	tx.At = 0
+	return tx
+}
+
+// WriteTo implements io.WriterTo. It writes t in the trace format.
+func (t *Trace) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	for _, tx := range t.Txs {
+		m, err := io.WriteString(w, formatTx(tx)+"\n")
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func formatTx(tx Tx) string {
+	var f []string
+	if tx.Addr != 0 {
+		f = append(f, "@0x"+strconv.FormatUint(uint64(tx.Addr), 16))
+	}
+	if tx.At != 0 {
+		f = append(f, "+"+tx.At.String())
+	}
+	if len(tx.W) != 0 {
+		f = append(f, "W", hex.EncodeToString(tx.W))
+	}
+	if len(tx.R) != 0 {
+		f = append(f, "R", hex.EncodeToString(tx.R))
+	}
+	return strings.Join(f, " ")
+}
+
+// Parse reads a trace in the trace format.
+func Parse(r io.Reader) (*Trace, error) {
+	t := &Trace{}
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		l := strings.TrimSpace(s.Text())
+		if l == "" || l[0] == '#' {
+			continue
+		}
+		tx, err := parseTx(l)
+		if err != nil {
+			return nil, fmt.Errorf("conntrace: line %d: %w", line, err)
+		}
+		t.Txs = append(t.Txs, tx)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("conntrace: %w", err)
+	}
+	return t, nil
+}
+
+func parseTx(l string) (Tx, error) {
+	var tx Tx
+	f := strings.Fields(l)
+	for ix := 0; ix < len(f); ix++ {
+		switch v := f[ix]; {
+		case v[0] == '@':
+			a, err := strconv.ParseUint(v[1:], 0, 16)
+			if err != nil {
+				return tx, fmt.Errorf("invalid address %q", v)
+			}
+			tx.Addr = uint16(a)
+		case v[0] == '+':
+			d, err := time.ParseDuration(v[1:])
+			if err != nil {
+				return tx, fmt.Errorf("invalid time %q", v)
+			}
+			tx.At = d
+		case v == "W" || v == "R":
+			if ix++; ix == len(f) {
+				return tx, fmt.Errorf("%s without bytes", v)
+			}
+			b, err := hex.DecodeString(f[ix])
+			if err != nil {
+				return tx, fmt.Errorf("invalid bytes %q", f[ix])
+			}
+			if v == "W" {
+				tx.W = b
+			} else {
+				tx.R = b
+			}
+		default:
+			return tx, fmt.Errorf("unexpected field %q", v)
+		}
+	}
+	return tx, nil
+}
+
+// ReadFile reads the trace file name.
+func ReadFile(name string) (*Trace, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("conntrace: %w", err)
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// WriteFile writes t to the trace file name, creating its directory if
+// needed.
+func (t *Trace) WriteFile(name string) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return fmt.Errorf("conntrace: %w", err)
+	}
+	var b bytes.Buffer
+	_, _ = t.WriteTo(&b)
+	if err := os.WriteFile(name, b.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("conntrace: %w", err)
+	}
+	return nil
+}
+
+// Golden compares got to the golden trace file name, ignoring the time of
+// the transactions. If update is true, the file is written with got instead,
+// without timing. Tests usually pass the value of an -update flag.
+func Golden(name string, got *Trace, update bool) error {
+	if update {
+		return got.WithoutTiming().WriteFile(name)
+	}
+	want, err := ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w; run the test with -update to create it", err)
+	}
+	if err != nil {
+		return err
+	}
+	if err := got.Diff(want); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
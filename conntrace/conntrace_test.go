@@ -0,0 +1,102 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package conntrace
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestRecorder(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: 0x27, W: []byte{0x08, 0x0c}},
+		{Addr: 0x27, R: []byte{0xf0}},
+	}}
+	conn := &conntest.Playback{Ops: []conntest.IO{{W: []byte{0x01}, R: []byte{0x02}}}}
+	r := NewRecorder()
+	var now time.Time
+	r.start = now
+	r.now = func() time.Time {
+		now = now.Add(time.Millisecond)
+		return now
+	}
+	b := r.Bus(bus)
+	if err := b.Tx(0x27, []byte{0x08, 0x0c}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Tx(0x27, nil, make([]byte, 1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Conn(conn).Tx([]byte{0x01}, make([]byte, 1)); err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if _, err := r.Trace().WriteTo(&out); err != nil {
+		t.Fatal(err)
+	}
+	const want = "@0x27 +1ms W 080c\n@0x27 +2ms R f0\n+3ms W 01 R 02\n"
+	if out.String() != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	trace, err := Parse(strings.NewReader("# comment\n\n" + want))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := trace.Diff(r.Trace()); err != nil {
+		t.Fatal(err)
+	}
+	if trace.Txs[2].At != 3*time.Millisecond {
+		t.Fatalf("got %s", trace.Txs[2].At)
+	}
+
+	// The trace replays as a bus.
+	p := trace.Playback()
+	if err := p.Tx(0x27, []byte{0x08, 0x0c}, nil); err != nil {
+		t.Fatal(err)
+	}
+	rd := make([]byte, 1)
+	if err := p.Tx(0x27, nil, rd); err != nil || rd[0] != 0xf0 {
+		t.Fatal(rd, err)
+	}
+}
+
+func TestParse_errors(t *testing.T) {
+	for _, l := range []string{"@zz W 00", "+soon W 00", "W", "W 0g", "X 00"} {
+		if _, err := Parse(strings.NewReader(l)); err == nil {
+			t.Errorf("%q: expected error", l)
+		}
+	}
+}
+
+func TestGolden(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "testdata", "golden.trace")
+	got := &Trace{Txs: []Tx{{Addr: 0x20, W: []byte{0x00, 0xff}, At: time.Second}}}
+	if err := Golden(name, got, false); err == nil || !strings.Contains(err.Error(), "-update") {
+		t.Fatalf("expected a missing file, got %v", err)
+	}
+	if err := Golden(name, got, true); err != nil {
+		t.Fatal(err)
+	}
+	got.Txs[0].At = 2 * time.Second
+	if err := Golden(name, got, false); err != nil {
+		t.Fatal(err)
+	}
+	got.Txs[0].W[1] = 0xfe
+	if err := Golden(name, got, false); err == nil {
+		t.Fatal("expected a difference")
+	}
+	got.Txs = append(got.Txs, Tx{W: []byte{0}})
+	got.Txs[0].W[1] = 0xff
+	if err := Golden(name, got, false); err == nil || !strings.Contains(err.Error(), "2 transactions, expected 1") {
+		t.Fatalf("got %v", err)
+	}
+}
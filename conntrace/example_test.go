@@ -0,0 +1,34 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package conntrace_test
+
+import (
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/conntrace"
+	"periph.io/x/devices/v3/hd44780"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer bus.Close()
+
+	// Record the initialization of a display, to replay it in tests.
+	r := conntrace.NewRecorder()
+	if _, err := hd44780.NewPCF857xBackpack(r.Bus(bus), 0x27, 2, 16); err != nil {
+		log.Fatal(err)
+	}
+	if err := r.Trace().WriteFile("testdata/init.trace"); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package dcmotor drives brushed DC motors through an H-bridge, such as the
+// L298N or the DRV8833.
+//
+// The L298N has two direction inputs and an enable input per motor, which is
+// driven with PWM to set the speed. The DRV8833 has two inputs per motor,
+// and the input of the direction is driven with PWM.
+//
+// When the motor is stopped, it can either coast, with the bridge off, or
+// brake, with both terminals of the motor connected together.
+//
+// # Watchdog
+//
+// StartWatchdog() stops the motor if no command is received for some time,
+// for example because the controlling application hung, or lost the link to
+// a remote control.
+//
+// # Datasheets
+//
+// https://www.st.com/resource/en/datasheet/l298.pdf
+//
+// https://www.ti.com/lit/ds/symlink/drv8833.pdf
+package dcmotor
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// StopMode is how the motor is stopped.
+type StopMode int
+
+const (
+	// Coast turns the bridge off, so that the motor spins freely.
+	Coast StopMode = iota
+	// Brake shorts the terminals of the motor, so that it stops quickly.
+	Brake
+)
+
+func (m StopMode) String() string {
+	switch m {
+	case Coast:
+		return "Coast"
+	case Brake:
+		return "Brake"
+	default:
+		return fmt.Sprintf("StopMode(%d)", int(m))
+	}
+}
+
+// bridge is the wiring of an H-bridge.
+type bridge int
+
+const (
+	l298n bridge = iota
+	drv8833
+)
+
+// Dev is a handle to a motor driven by an H-bridge.
+type Dev struct {
+	mu     sync.Mutex
+	bridge bridge
+	in1    gpio.PinOut
+	in2    gpio.PinOut
+	en     gpio.PinOut
+	freq   physic.Frequency
+	// last is when the last command was received.
+	last time.Time
+
+	// watchdog is nil unless StartWatchdog() was called.
+	watchdog *time.Timer
+	timeout  time.Duration
+	mode     StopMode
+	tripped  bool
+}
+
+// NewL298N returns a handle to a motor driven by an L298N or a similar
+// bridge, such as the L293D or the TB6612FNG, with the direction inputs
+// connected to in1 and in2, and the enable input to en, which must support
+// PWM at frequency f. The motor is stopped with Coast.
+func NewL298N(in1, in2, en gpio.PinOut, f physic.Frequency) (*Dev, error) {
+	if in1 == nil || in2 == nil || en == nil {
+		return nil, errors.New("dcmotor: in1, in2 and en are required")
+	}
+	return newDev(l298n, in1, in2, en, f)
+}
+
+// NewDRV8833 returns a handle to a motor driven by a DRV8833 or a similar
+// bridge, with the inputs connected to in1 and in2, which must both support
+// PWM at frequency f. The motor is stopped with Coast.
+func NewDRV8833(in1, in2 gpio.PinOut, f physic.Frequency) (*Dev, error) {
+	if in1 == nil || in2 == nil {
+		return nil, errors.New("dcmotor: in1 and in2 are required")
+	}
+	return newDev(drv8833, in1, in2, nil, f)
+}
+
+func newDev(b bridge, in1, in2, en gpio.PinOut, f physic.Frequency) (*Dev, error) {
+	if f <= 0 {
+		return nil, fmt.Errorf("dcmotor: invalid PWM frequency %s", f)
+	}
+	d := &Dev{bridge: b, in1: in1, in2: in2, en: en, freq: f}
+	if err := d.stop(Coast); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Forward drives the motor forward with duty.
+func (d *Dev) Forward(duty gpio.Duty) error {
+	return d.drive(true, duty)
+}
+
+// Backward drives the motor backward with duty.
+func (d *Dev) Backward(duty gpio.Duty) error {
+	return d.drive(false, duty)
+}
+
+// Stop stops driving the motor.
+func (d *Dev) Stop(mode StopMode) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.refresh()
+	return d.stop(mode)
+}
+
+// Refresh resets the watchdog timer without changing the command.
+func (d *Dev) Refresh() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.last = time.Now()
+}
+
+// StartWatchdog stops the motor with mode if no command is received, and
+// Refresh() isn't called, for timeout. The motor can be driven again after
+// the watchdog stopped it, see Tripped().
+func (d *Dev) StartWatchdog(timeout time.Duration, mode StopMode) error {
+	if timeout <= 0 {
+		return fmt.Errorf("dcmotor: invalid watchdog timeout %s", timeout)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.watchdog != nil {
+		return errors.New("dcmotor: watchdog already started")
+	}
+	d.timeout, d.mode = timeout, mode
+	d.last = time.Now()
+	d.watchdog = time.AfterFunc(timeout, d.expire)
+	return nil
+}
+
+// StopWatchdog stops the watchdog started by StartWatchdog().
+func (d *Dev) StopWatchdog() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.watchdog != nil {
+		d.watchdog.Stop()
+		d.watchdog = nil
+	}
+}
+
+// Tripped returns true if the watchdog stopped the motor since the last
+// command.
+func (d *Dev) Tripped() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.tripped
+}
+
+// Halt stops the watchdog and lets the motor coast.
+func (d *Dev) Halt() error {
+	d.StopWatchdog()
+	return d.Stop(Coast)
+}
+
+func (d *Dev) String() string {
+	if d.bridge == l298n {
+		return fmt.Sprintf("L298N{%s, %s, %s}", d.in1, d.in2, d.en)
+	}
+	return fmt.Sprintf("DRV8833{%s, %s}", d.in1, d.in2)
+}
+
+func (d *Dev) drive(forward bool, duty gpio.Duty) error {
+	if !duty.Valid() {
+		return fmt.Errorf("dcmotor: invalid duty %d", duty)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.refresh()
+	// pwm is the pin driven with PWM, and low the other direction input.
+	pwm, low := d.in1, d.in2
+	if !forward {
+		pwm, low = d.in2, d.in1
+	}
+	if d.bridge == l298n {
+		if err := low.Out(gpio.Low); err != nil {
+			return fmt.Errorf("dcmotor: %w", err)
+		}
+		if err := pwm.Out(gpio.High); err != nil {
+			return fmt.Errorf("dcmotor: %w", err)
+		}
+		pwm = d.en
+	} else if err := low.Out(gpio.Low); err != nil {
+		return fmt.Errorf("dcmotor: %w", err)
+	}
+	if err := pwm.PWM(duty, d.freq); err != nil {
+		return fmt.Errorf("dcmotor: %w", err)
+	}
+	return nil
+}
+
+// stop implements Stop(). d.mu must be held.
+func (d *Dev) stop(mode StopMode) error {
+	var in, en gpio.Level
+	switch mode {
+	case Coast:
+		// The L298N ignores the inputs with en low.
+		in, en = gpio.Low, gpio.Low
+	case Brake:
+		// Both inputs low with en high connect both terminals to ground. The
+		// DRV8833 brakes with both inputs high.
+		in, en = d.bridge == drv8833, gpio.High
+	default:
+		return fmt.Errorf("dcmotor: invalid stop mode %s", mode)
+	}
+	if d.en != nil {
+		if err := d.en.Out(gpio.Low); err != nil {
+			return fmt.Errorf("dcmotor: %w", err)
+		}
+	}
+	if err := d.in1.Out(in); err != nil {
+		return fmt.Errorf("dcmotor: %w", err)
+	}
+	if err := d.in2.Out(in); err != nil {
+		return fmt.Errorf("dcmotor: %w", err)
+	}
+	if d.en != nil && en == gpio.High {
+		if err := d.en.Out(gpio.High); err != nil {
+			return fmt.Errorf("dcmotor: %w", err)
+		}
+	}
+	return nil
+}
+
+// refresh records a command, which resets the watchdog. d.mu must be held.
+func (d *Dev) refresh() {
+	d.last = time.Now()
+	d.tripped = false
+}
+
+// expire is called by the watchdog timer.
+func (d *Dev) expire() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.watchdog == nil {
+		return
+	}
+	// Re-arm the timer if a command was received since it was set.
+	if remaining := d.timeout - time.Since(d.last); remaining > 0 {
+		d.watchdog.Reset(remaining)
+		return
+	}
+	// Errors can't be reported, the motor is stopped again at the next
+	// expiry.
+	if d.stop(d.mode) == nil {
+		d.tripped = true
+	}
+	d.watchdog.Reset(d.timeout)
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dcmotor
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// pin is a gpiotest.Pin that records whether it is driven with PWM.
+type pin struct {
+	gpiotest.Pin
+	pwm bool
+}
+
+func (p *pin) Out(l gpio.Level) error {
+	p.Lock()
+	p.pwm = false
+	p.Unlock()
+	return p.Pin.Out(l)
+}
+
+func (p *pin) PWM(duty gpio.Duty, f physic.Frequency) error {
+	p.Lock()
+	p.pwm = true
+	p.Unlock()
+	return p.Pin.PWM(duty, f)
+}
+
+// state returns "H", "L" or "PWM".
+func (p *pin) state() string {
+	p.Lock()
+	defer p.Unlock()
+	switch {
+	case p.pwm:
+		return "PWM"
+	case p.L == gpio.High:
+		return "H"
+	default:
+		return "L"
+	}
+}
+
+func states(pins ...*pin) string {
+	s := ""
+	for ix, p := range pins {
+		if ix > 0 {
+			s += " "
+		}
+		s += p.state()
+	}
+	return s
+}
+
+func TestL298N(t *testing.T) {
+	in1, in2, en := &pin{Pin: gpiotest.Pin{N: "IN1"}}, &pin{Pin: gpiotest.Pin{N: "IN2"}}, &pin{Pin: gpiotest.Pin{N: "ENA"}}
+	d, err := NewL298N(in1, in2, en, 20*physic.KiloHertz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := states(in1, in2, en); s != "L L L" {
+		t.Errorf("after New: %s", s)
+	}
+	if err := d.Forward(gpio.DutyHalf); err != nil {
+		t.Fatal(err)
+	}
+	if s := states(in1, in2, en); s != "H L PWM" || en.D != gpio.DutyHalf || en.F != 20*physic.KiloHertz {
+		t.Errorf("Forward: %s %s %s", s, en.D, en.F)
+	}
+	if err := d.Backward(gpio.DutyMax); err != nil {
+		t.Fatal(err)
+	}
+	if s := states(in1, in2, en); s != "L H PWM" || en.D != gpio.DutyMax {
+		t.Errorf("Backward: %s %s", s, en.D)
+	}
+	if err := d.Stop(Brake); err != nil {
+		t.Fatal(err)
+	}
+	if s := states(in1, in2, en); s != "L L H" {
+		t.Errorf("Brake: %s", s)
+	}
+	if err := d.Stop(Coast); err != nil {
+		t.Fatal(err)
+	}
+	if s := states(in1, in2, en); s != "L L L" {
+		t.Errorf("Coast: %s", s)
+	}
+	if err := d.Forward(gpio.DutyMax + 1); err == nil {
+		t.Error("expected an error for an invalid duty")
+	}
+}
+
+func TestDRV8833(t *testing.T) {
+	in1, in2 := &pin{Pin: gpiotest.Pin{N: "AIN1"}}, &pin{Pin: gpiotest.Pin{N: "AIN2"}}
+	d, err := NewDRV8833(in1, in2, 20*physic.KiloHertz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Forward(gpio.DutyHalf); err != nil {
+		t.Fatal(err)
+	}
+	if s := states(in1, in2); s != "PWM L" {
+		t.Errorf("Forward: %s", s)
+	}
+	if err := d.Backward(gpio.DutyHalf); err != nil {
+		t.Fatal(err)
+	}
+	if s := states(in1, in2); s != "L PWM" {
+		t.Errorf("Backward: %s", s)
+	}
+	if err := d.Stop(Brake); err != nil {
+		t.Fatal(err)
+	}
+	if s := states(in1, in2); s != "H H" {
+		t.Errorf("Brake: %s", s)
+	}
+	if err := d.Stop(Coast); err != nil {
+		t.Fatal(err)
+	}
+	if s := states(in1, in2); s != "L L" {
+		t.Errorf("Coast: %s", s)
+	}
+	if s := d.String(); s != "DRV8833{AIN1(0), AIN2(0)}" {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestWatchdog(t *testing.T) {
+	in1, in2 := &pin{Pin: gpiotest.Pin{N: "AIN1"}}, &pin{Pin: gpiotest.Pin{N: "AIN2"}}
+	d, err := NewDRV8833(in1, in2, 20*physic.KiloHertz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.StartWatchdog(0, Brake); err == nil {
+		t.Error("expected an error for an invalid timeout")
+	}
+	if err := d.StartWatchdog(50*time.Millisecond, Brake); err != nil {
+		t.Fatal(err)
+	}
+	defer d.Halt()
+	if err := d.Forward(gpio.DutyHalf); err != nil {
+		t.Fatal(err)
+	}
+	// Refreshing keeps the motor running.
+	for i := 0; i < 5; i++ {
+		time.Sleep(20 * time.Millisecond)
+		d.Refresh()
+	}
+	if d.Tripped() || states(in1, in2) != "PWM L" {
+		t.Fatalf("stopped while refreshed: %s", states(in1, in2))
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for !d.Tripped() {
+		if time.Now().After(deadline) {
+			t.Fatal("watchdog didn't trip")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if s := states(in1, in2); s != "H H" {
+		t.Errorf("after trip: %s", s)
+	}
+	// A new command clears the trip.
+	if err := d.Forward(gpio.DutyHalf); err != nil {
+		t.Fatal(err)
+	}
+	if d.Tripped() {
+		t.Error("still tripped after a command")
+	}
+}
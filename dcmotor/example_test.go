@@ -0,0 +1,40 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dcmotor_test
+
+import (
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/dcmotor"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	motor, err := dcmotor.NewL298N(gpioreg.ByName("GPIO23"), gpioreg.ByName("GPIO24"), gpioreg.ByName("GPIO18"), 1*physic.KiloHertz)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer motor.Halt()
+
+	// Brake if the loop below stops sending commands.
+	if err := motor.StartWatchdog(500*time.Millisecond, dcmotor.Brake); err != nil {
+		log.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if err := motor.Forward(gpio.DutyMax * 3 / 4); err != nil {
+			log.Fatal(err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// The DHT22, also sold as the AM2302, is a temperature and relative humidity
+// sensor with a proprietary single-wire interface, which is bit banged on a
+// GPIO.
+//
+// The host pulls the data line low for at least 1ms to start a measurement.
+// The sensor answers with an 80µs low and an 80µs high pulse, then sends 40
+// bits, each a 50µs low pulse followed by a high pulse of about 27µs for a 0,
+// or 70µs for a 1. The bits are 16 bits of humidity, 16 bits of temperature,
+// and a checksum.
+//
+// The pulses are timed by polling the GPIO, so reads can fail when the host
+// is busy. Failed reads are retried, and reads are spaced by at least 2s, the
+// sampling period of the sensor.
+//
+// # Wiring
+//
+// The data line needs a pull up, typically 4.7kΩ to 10kΩ. Modules usually
+// include it.
+//
+// # Datasheet
+//
+// https://www.sparkfun.com/datasheets/Sensors/Temperature/DHT22.pdf
+package dht22
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+const (
+	devName = "DHT22"
+	// startPulse is how long the host pulls the line low.
+	startPulse = 1100 * time.Microsecond
+	// idleTimeout is how long the line must not change for the transmission
+	// to be over.
+	idleTimeout = 200 * time.Microsecond
+	// readTimeout bounds the polling of one transmission, which takes about
+	// 5ms.
+	readTimeout = 10 * time.Millisecond
+	// bitThreshold is the high pulse width that separates a 0 and a 1 when
+	// all the bits have the same value.
+	bitThreshold = 48 * time.Microsecond
+	// minSpread is the minimum difference between the shortest and longest
+	// high pulses for the bits to be decoded with their midpoint.
+	minSpread = 20 * time.Microsecond
+	// numBits is the number of bits of a transmission.
+	numBits = 40
+	// MinInterval is the sampling period of the sensor.
+	MinInterval = 2 * time.Second
+)
+
+var (
+	// ErrTimeout is returned when the sensor didn't send all the bits.
+	ErrTimeout = errors.New("dht22: timeout reading the sensor")
+	// ErrChecksum is returned when the checksum of the data doesn't match.
+	ErrChecksum = errors.New("dht22: checksum mismatch")
+)
+
+// Opts holds the configuration options.
+type Opts struct {
+	// Retries is the number of times a failed read is retried.
+	Retries int
+}
+
+// DefaultOpts is the recommended default options.
+var DefaultOpts = Opts{
+	Retries: 3,
+}
+
+// Dev is a handle to a DHT22.
+type Dev struct {
+	pin  gpio.PinIO
+	opts Opts
+
+	mu sync.Mutex
+	// last is when the last transmission started.
+	last time.Time
+	// now and sleep are replaced by tests.
+	now   func() time.Time
+	sleep func(time.Duration)
+
+	// senseMu guards the state of the goroutine started by
+	// SenseContinuous().
+	senseMu sync.Mutex
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// New returns a handle to a DHT22 with its data line connected to pin. If
+// opts is nil, DefaultOpts is used.
+func New(pin gpio.PinIO, opts *Opts) (*Dev, error) {
+	if opts == nil {
+		opts = &DefaultOpts
+	}
+	if opts.Retries < 0 {
+		return nil, fmt.Errorf("dht22: invalid number of retries %d", opts.Retries)
+	}
+	if err := pin.In(gpio.PullUp, gpio.NoEdge); err != nil {
+		return nil, fmt.Errorf("dht22: %w", err)
+	}
+	return &Dev{pin: pin, opts: *opts, now: time.Now, sleep: time.Sleep}, nil
+}
+
+// Sense reads the temperature and relative humidity. If the previous read was
+// less than MinInterval ago, it first waits. The pressure isn't measured.
+func (d *Dev) Sense(e *physic.Env) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var err error
+	for i := 0; i <= d.opts.Retries; i++ {
+		var data [5]byte
+		if data, err = d.read(); err == nil {
+			e.Temperature, e.Humidity = decode(data)
+			e.Pressure = 0
+			return nil
+		}
+	}
+	return err
+}
+
+// SenseContinuous returns measurements every interval, which must be at least
+// MinInterval. Failed reads are dropped. The channel is closed by Halt().
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if interval < MinInterval {
+		return nil, fmt.Errorf("dht22: interval %s shorter than %s", interval, MinInterval)
+	}
+	d.senseMu.Lock()
+	defer d.senseMu.Unlock()
+	if d.stop != nil {
+		return nil, errors.New("dht22: already sensing")
+	}
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
+	env := make(chan physic.Env)
+	go d.sense(interval, env, d.stop, d.done)
+	return env, nil
+}
+
+// Precision returns the resolution of the measurements.
+func (d *Dev) Precision(e *physic.Env) {
+	e.Temperature = physic.Celsius / 10
+	e.Pressure = 0
+	e.Humidity = physic.MilliRH
+}
+
+// Halt stops the goroutine started by SenseContinuous(), and waits for it to
+// exit.
+func (d *Dev) Halt() error {
+	d.senseMu.Lock()
+	stop, done := d.stop, d.done
+	d.stop = nil
+	d.senseMu.Unlock()
+	if stop == nil {
+		return nil
+	}
+	close(stop)
+	<-done
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("%s{%s}", devName, d.pin)
+}
+
+func (d *Dev) sense(interval time.Duration, env chan<- physic.Env, stop, done chan struct{}) {
+	defer close(done)
+	defer close(env)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		var e physic.Env
+		if d.Sense(&e) == nil {
+			select {
+			case env <- e:
+			case <-stop:
+				return
+			}
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// read starts a transmission, and returns the 5 bytes sent by the sensor.
+// d.mu must be held.
+func (d *Dev) read() ([5]byte, error) {
+	if wait := MinInterval - d.now().Sub(d.last); !d.last.IsZero() && wait > 0 {
+		d.sleep(wait)
+	}
+	d.last = d.now()
+	if err := d.pin.Out(gpio.Low); err != nil {
+		return [5]byte{}, fmt.Errorf("dht22: %w", err)
+	}
+	d.sleep(startPulse)
+	if err := d.pin.In(gpio.PullUp, gpio.NoEdge); err != nil {
+		return [5]byte{}, fmt.Errorf("dht22: %w", err)
+	}
+	highs := d.capture()
+	if len(highs) < numBits {
+		return [5]byte{}, ErrTimeout
+	}
+	data := decodeBits(highs[len(highs)-numBits:])
+	if data[0]+data[1]+data[2]+data[3] != data[4] {
+		return data, ErrChecksum
+	}
+	return data, nil
+}
+
+// capture polls the line until it stays high, and returns the widths of the
+// high pulses. The line is high when it is released, and the sensor ends the
+// transmission by releasing it.
+func (d *Dev) capture() []time.Duration {
+	var highs []time.Duration
+	start := d.now()
+	level := d.pin.Read()
+	edge := start
+	for {
+		l := d.pin.Read()
+		t := d.now()
+		if l != level {
+			if level == gpio.High {
+				highs = append(highs, t.Sub(edge))
+			}
+			level, edge = l, t
+			continue
+		}
+		if level == gpio.High && t.Sub(edge) > idleTimeout && len(highs) > 0 {
+			return highs
+		}
+		if t.Sub(start) > readTimeout {
+			return highs
+		}
+	}
+}
+
+// decodeBits returns the bytes encoded by the widths of the high pulses. The
+// threshold between a 0 and a 1 is the midpoint of the pulses, so that the
+// decoding tolerates a polling latency that stretches all of them.
+func decodeBits(highs []time.Duration) [5]byte {
+	shortest, longest := highs[0], highs[0]
+	for _, h := range highs {
+		shortest = min(shortest, h)
+		longest = max(longest, h)
+	}
+	threshold := bitThreshold
+	if longest-shortest >= minSpread {
+		threshold = (shortest + longest) / 2
+	}
+	var data [5]byte
+	for ix, h := range highs {
+		if h > threshold {
+			data[ix/8] |= 0x80 >> (ix % 8)
+		}
+	}
+	return data
+}
+
+// decode returns the temperature and relative humidity in data.
+func decode(data [5]byte) (physic.Temperature, physic.RelativeHumidity) {
+	h := physic.RelativeHumidity(uint16(data[0])<<8|uint16(data[1])) * physic.MilliRH
+	t := physic.Temperature(uint16(data[2]&0x7f)<<8|uint16(data[3])) * physic.Celsius / 10
+	if data[2]&0x80 != 0 {
+		t = -t
+	}
+	return physic.ZeroCelsius + t, h
+}
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
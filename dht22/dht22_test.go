@@ -0,0 +1,203 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dht22
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// sensor emulates the data line of a DHT22 on a simulated clock, which
+// advances on each call to now().
+type sensor struct {
+	gpiotest.Pin
+	clock time.Time
+	step  time.Duration
+	// frames are the transmissions sent after each start pulse.
+	frames [][5]byte
+	// scale stretches the high pulses, like a slow host would.
+	scale float64
+	// released is when the host released the line, and frame the
+	// transmission in progress, or nil.
+	released time.Time
+	frame    []level
+	starts   int
+}
+
+type level struct {
+	l gpio.Level
+	d time.Duration
+}
+
+func (s *sensor) now() time.Time {
+	s.clock = s.clock.Add(s.step)
+	return s.clock
+}
+
+func (s *sensor) sleep(d time.Duration) {
+	s.clock = s.clock.Add(d)
+}
+
+func (s *sensor) In(pull gpio.Pull, edge gpio.Edge) error {
+	if s.L == gpio.Low && s.released.IsZero() && s.starts > 0 {
+		s.released = s.clock
+		s.frame = nil
+		if len(s.frames) > 0 {
+			s.frame = waveform(s.frames[0], s.scale)
+			s.frames = s.frames[1:]
+		}
+	}
+	return s.Pin.In(pull, edge)
+}
+
+func (s *sensor) Out(l gpio.Level) error {
+	if l == gpio.Low {
+		s.starts++
+		s.released = time.Time{}
+	}
+	return s.Pin.Out(l)
+}
+
+func (s *sensor) Read() gpio.Level {
+	if s.released.IsZero() {
+		return s.L
+	}
+	t := s.clock.Sub(s.released)
+	for _, p := range s.frame {
+		if t < p.d {
+			return p.l
+		}
+		t -= p.d
+	}
+	return gpio.High
+}
+
+// waveform returns the levels of the line for a transmission of data.
+func waveform(data [5]byte, scale float64) []level {
+	high := func(d time.Duration) level {
+		return level{gpio.High, time.Duration(float64(d) * scale)}
+	}
+	w := []level{high(30 * time.Microsecond), {gpio.Low, 80 * time.Microsecond}, high(80 * time.Microsecond)}
+	for ix := 0; ix < numBits; ix++ {
+		w = append(w, level{gpio.Low, 50 * time.Microsecond})
+		if data[ix/8]&(0x80>>(ix%8)) != 0 {
+			w = append(w, high(70*time.Microsecond))
+		} else {
+			w = append(w, high(27*time.Microsecond))
+		}
+	}
+	return append(w, level{gpio.Low, 50 * time.Microsecond})
+}
+
+func newTestDev(t *testing.T, frames ...[5]byte) (*Dev, *sensor) {
+	s := &sensor{Pin: gpiotest.Pin{N: "data"}, step: 2 * time.Microsecond, frames: frames, scale: 1, clock: time.Unix(1000, 0)}
+	d, err := New(s, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.now, d.sleep = s.now, s.sleep
+	return d, s
+}
+
+func TestSense(t *testing.T) {
+	// 65.2%rH, 35.1°C.
+	d, _ := newTestDev(t, [5]byte{0x02, 0x8c, 0x01, 0x5f, 0xee})
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if want := physic.ZeroCelsius + 351*physic.Celsius/10; e.Temperature != want {
+		t.Errorf("Temperature = %s, want %s", e.Temperature, want)
+	}
+	if want := 652 * physic.MilliRH; e.Humidity != want {
+		t.Errorf("Humidity = %s, want %s", e.Humidity, want)
+	}
+}
+
+func TestSense_negative(t *testing.T) {
+	// -10.1°C.
+	d, _ := newTestDev(t, [5]byte{0x02, 0x8c, 0x80, 0x65, 0x73})
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if want := physic.ZeroCelsius - 101*physic.Celsius/10; e.Temperature != want {
+		t.Errorf("Temperature = %s, want %s", e.Temperature, want)
+	}
+}
+
+func TestSense_slowHost(t *testing.T) {
+	d, s := newTestDev(t, [5]byte{0x02, 0x8c, 0x01, 0x5f, 0xee})
+	// All the high pulses are stretched, 0s are decoded with the midpoint.
+	s.scale = 1.8
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if want := 652 * physic.MilliRH; e.Humidity != want {
+		t.Errorf("Humidity = %s, want %s", e.Humidity, want)
+	}
+}
+
+func TestSense_retry(t *testing.T) {
+	d, s := newTestDev(t, [5]byte{0x02, 0x8c, 0x01, 0x5f, 0x00}, [5]byte{0x02, 0x8c, 0x01, 0x5f, 0xee})
+	start := s.clock
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if s.starts != 2 {
+		t.Errorf("starts = %d, want 2", s.starts)
+	}
+	// The retry waited for the minimum interval.
+	if elapsed := s.clock.Sub(start); elapsed < MinInterval {
+		t.Errorf("elapsed %s, want at least %s", elapsed, MinInterval)
+	}
+}
+
+func TestSense_errors(t *testing.T) {
+	d, s := newTestDev(t, [5]byte{1, 2, 3, 4, 0}, [5]byte{1, 2, 3, 4, 0}, [5]byte{1, 2, 3, 4, 0}, [5]byte{1, 2, 3, 4, 0})
+	var e physic.Env
+	if err := d.Sense(&e); err != ErrChecksum {
+		t.Errorf("Sense() = %v, want %v", err, ErrChecksum)
+	}
+	if s.starts != 1+DefaultOpts.Retries {
+		t.Errorf("starts = %d, want %d", s.starts, 1+DefaultOpts.Retries)
+	}
+	// The sensor doesn't answer.
+	if err := d.Sense(&e); err != ErrTimeout {
+		t.Errorf("Sense() = %v, want %v", err, ErrTimeout)
+	}
+}
+
+func TestDecodeBits(t *testing.T) {
+	highs := make([]time.Duration, numBits)
+	for ix := range highs {
+		highs[ix] = 27 * time.Microsecond
+	}
+	if got := decodeBits(highs); got != [5]byte{} {
+		t.Errorf("decodeBits() = %v", got)
+	}
+	for ix := range highs {
+		highs[ix] = 70 * time.Microsecond
+	}
+	if got := decodeBits(highs); got != [5]byte{0xff, 0xff, 0xff, 0xff, 0xff} {
+		t.Errorf("decodeBits() = %v", got)
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(&gpiotest.Pin{N: "data"}, &Opts{Retries: -1}); err == nil {
+		t.Error("expected an error for negative retries")
+	}
+	d, _ := newTestDev(t)
+	if _, err := d.SenseContinuous(time.Second); err == nil {
+		t.Error("expected an error for a short interval")
+	}
+}
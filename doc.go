@@ -6,4 +6,9 @@
 //
 // Subpackages contain the concrete implementations. Devices accept port
 // interface, constructors return concrete type.
+//
+// Drivers never write to the default logger of the process. Those that log
+// diagnostics, such as errors of background goroutines or traces of the bus,
+// export a SetLogger(*slog.Logger) function, and discard the records until
+// it's called.
 package devices
@@ -11,6 +11,11 @@
 // as long as the bus driver can provide sufficient power using an active
 // pull-up.
 //
+// Enumerate() and NewAll() find the sensors on a bus, so that several probes
+// can share one. When the Linux w1_therm kernel driver is loaded, W1Devices()
+// reads the sensors it found instead. Otherwise, the bus can be bit banged on
+// a GPIO with periph.io/x/devices/v3/bitbang.NewOneWire().
+//
 // The DS18B20/DS18S20 alarm functionality and reading/writing the 2 alarm
 // bytes in the EEPROM are not supported.
 //
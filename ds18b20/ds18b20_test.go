@@ -6,6 +6,8 @@ package ds18b20
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -242,3 +244,110 @@ func init() {
 	record = flag.Bool("record", false, "record real hardware accesses")
 }
 */
+
+func TestEnumerate(t *testing.T) {
+	var ds18b20 onewire.Address = 0x740000070e41ac28
+	var ds18s20 onewire.Address = 0x4800080228a5b010
+	var ds2431 onewire.Address = 0xe90000010203042d
+	search := onewiretest.IO{W: []uint8{0xf0}}
+	bus := &onewiretest.Playback{
+		Ops: []onewiretest.IO{
+			search, search, search,
+			search, search, search,
+			// Read Scratchpad of the DS18B20.
+			{
+				W: []uint8{0x55, 0x28, 0xac, 0x41, 0xe, 0x7, 0x0, 0x0, 0x74, 0xbe},
+				R: []uint8{0xe0, 0x1, 0x0, 0x0, 0x3f, 0xff, 0x10, 0x10, 0x3f},
+			},
+		},
+		Devices: []onewire.Address{ds18b20, ds18s20, ds2431},
+	}
+	addrs, err := Enumerate(bus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addrs) != 2 {
+		t.Fatalf("Enumerate() = %v", addrs)
+	}
+	devs, err := NewAll(bus, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devs) != 2 {
+		t.Fatalf("NewAll() = %v", devs)
+	}
+	for _, d := range devs {
+		if d.Family() == DS18S20 && d.resolution != 12 {
+			t.Errorf("%s: resolution %d", d, d.resolution)
+		}
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestW1Dev(t *testing.T) {
+	defer func(root string) { w1Root = root }(w1Root)
+	w1Root = t.TempDir()
+	dir := filepath.Join(w1Root, "28-00000e41ac00")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	slave := "72 01 4b 46 7f ff 0e 10 57 : crc=57 YES\n72 01 4b 46 7f ff 0e 10 57 t=23125\n"
+	if err := os.WriteFile(filepath.Join(dir, "w1_slave"), []byte(slave), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(w1Root, "2d-000001020304"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	devs, err := W1Devices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devs) != 1 {
+		t.Fatalf("W1Devices() = %v", devs)
+	}
+	d := devs[0]
+	if s := d.String(); s != "DS18B20{w1:28-00000e41ac00}" {
+		t.Errorf("String() = %q", s)
+	}
+	if a := d.Address(); a != 0x9700000e41ac0028 {
+		t.Errorf("Address() = %#x", uint64(a))
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil {
+		t.Fatal(err)
+	}
+	if want := physic.ZeroCelsius + 23125*physic.MilliKelvin; e.Temperature != want {
+		t.Errorf("Sense() = %s, want %s", e.Temperature, want)
+	}
+	if err := d.SetResolution(10); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := os.ReadFile(filepath.Join(dir, "resolution")); string(b) != "10" {
+		t.Errorf("resolution = %q", b)
+	}
+	if err := d.SetResolution(13); err == nil {
+		t.Error("expected an error for an invalid resolution")
+	}
+	if _, err := NewW1("28-nothere0000"); err == nil {
+		t.Error("expected an error for an invalid id")
+	}
+}
+
+func TestParseW1Slave(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"72 01 4b 46 7f ff 0e 10 57 : crc=00 NO\n72 01 4b 46 7f ff 0e 10 57 t=23125\n",
+		"72 01 4b 46 7f ff 0e 10 57 : crc=57 YES\n72 01 4b 46 7f ff 0e 10 57\n",
+		"50 05 4b 46 7f ff 0c 10 1c : crc=1c YES\n50 05 4b 46 7f ff 0c 10 1c t=85000\n",
+	} {
+		if _, err := parseW1Slave(s); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+	v, err := parseW1Slave("f0 ff 4b 46 7f ff 0c 10 46 : crc=46 YES\nf0 ff 4b 46 7f ff 0c 10 46 t=-1000\n")
+	if err != nil || v != physic.ZeroCelsius-physic.Kelvin {
+		t.Errorf("parseW1Slave() = %s, %v", v, err)
+	}
+}
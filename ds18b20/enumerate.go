@@ -0,0 +1,50 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ds18b20
+
+import (
+	"periph.io/x/conn/v3/onewire"
+)
+
+// Enumerate searches the bus and returns the addresses of the DS18B20,
+// DS18S20 and MAX31820 devices on it. Other devices are ignored.
+//
+// If the search fails, the devices already found are returned with the error.
+func Enumerate(o onewire.Bus) ([]onewire.Address, error) {
+	all, err := o.Search(false)
+	var addrs []onewire.Address
+	for _, addr := range all {
+		if f := Family(addr & 0xff); f == DS18B20 || f == DS18S20 {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs, err
+}
+
+// NewAll returns a handle to each device found by Enumerate(), configured
+// with resolutionBits as for New(). DS18S20 devices, which only support 12
+// bits, are left at 12 bits.
+//
+// Use ConvertAll() and LastTemp() to read all the devices with a single
+// conversion.
+func NewAll(o onewire.Bus, resolutionBits int) ([]*Dev, error) {
+	addrs, err := Enumerate(o)
+	if err != nil {
+		return nil, err
+	}
+	devs := make([]*Dev, 0, len(addrs))
+	for _, addr := range addrs {
+		bits := resolutionBits
+		if Family(addr&0xff) == DS18S20 {
+			bits = 12
+		}
+		d, err := New(o, addr, bits)
+		if err != nil {
+			return nil, err
+		}
+		devs = append(devs, d)
+	}
+	return devs, nil
+}
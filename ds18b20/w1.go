@@ -0,0 +1,168 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ds18b20
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/onewire"
+	"periph.io/x/conn/v3/physic"
+)
+
+// w1Root is the directory where the Linux w1 subsystem lists the devices. It
+// is replaced by tests.
+var w1Root = "/sys/bus/w1/devices"
+
+// W1Dev is a handle to a sensor driven by the Linux w1_therm kernel driver,
+// for example with the w1-gpio overlay of a Raspberry Pi. The kernel does
+// the bus transactions, and checks the CRC of the scratchpad.
+//
+// When the kernel driver isn't available, use Dev with a bus such as the
+// one of periph.io/x/devices/v3/bitbang or periph.io/x/devices/v3/ds248x.
+type W1Dev struct {
+	dir  string
+	addr onewire.Address
+}
+
+// W1Devices returns a handle to each DS18B20, DS18S20 and MAX31820 device
+// found by the kernel.
+func W1Devices() ([]*W1Dev, error) {
+	var devs []*W1Dev
+	for _, f := range []Family{DS18B20, DS18S20} {
+		matches, err := filepath.Glob(filepath.Join(w1Root, fmt.Sprintf("%02x-*", byte(f))))
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range matches {
+			d, err := NewW1(filepath.Base(m))
+			if err != nil {
+				return nil, err
+			}
+			devs = append(devs, d)
+		}
+	}
+	return devs, nil
+}
+
+// NewW1 returns a handle to the device with the kernel identifier id, for
+// example "28-0316a27942ff".
+func NewW1(id string) (*W1Dev, error) {
+	family, serial, ok := strings.Cut(id, "-")
+	f, err1 := strconv.ParseUint(family, 16, 8)
+	s, err2 := strconv.ParseUint(serial, 16, 48)
+	if !ok || err1 != nil || err2 != nil {
+		return nil, fmt.Errorf("ds18b20: invalid w1 device %q", id)
+	}
+	if Family(f) != DS18B20 && Family(f) != DS18S20 {
+		return nil, fmt.Errorf("ds18b20: unsupported family of w1 device %q", id)
+	}
+	dir := filepath.Join(w1Root, id)
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("ds18b20: %w", err)
+	}
+	var rom [8]byte
+	binary.LittleEndian.PutUint64(rom[:], s<<8|f)
+	rom[7] = onewire.CalcCRC(rom[:7])
+	return &W1Dev{dir: dir, addr: onewire.Address(binary.LittleEndian.Uint64(rom[:]))}, nil
+}
+
+// Address returns the 1-wire address of the device.
+func (d *W1Dev) Address() onewire.Address {
+	return d.addr
+}
+
+// Family returns the family of the device.
+func (d *W1Dev) Family() Family {
+	return Family(d.addr & 0xff)
+}
+
+func (d *W1Dev) String() string {
+	return fmt.Sprintf("%s{w1:%s}", d.Family(), filepath.Base(d.dir))
+}
+
+// Halt implements conn.Resource.
+func (d *W1Dev) Halt() error {
+	return nil
+}
+
+// Sense implements physic.SenseEnv. The kernel performs a conversion, which
+// takes up to 750ms depending on the resolution.
+func (d *W1Dev) Sense(e *physic.Env) error {
+	b, err := os.ReadFile(filepath.Join(d.dir, "w1_slave"))
+	if err != nil {
+		return fmt.Errorf("ds18b20: %w", err)
+	}
+	t, err := parseW1Slave(string(b))
+	if err != nil {
+		return err
+	}
+	e.Temperature = t
+	return nil
+}
+
+// SenseContinuous implements physic.SenseEnv.
+func (d *W1Dev) SenseContinuous(time.Duration) (<-chan physic.Env, error) {
+	return nil, errors.New("ds18b20: not implemented")
+}
+
+// Precision implements physic.SenseEnv.
+func (d *W1Dev) Precision(e *physic.Env) {
+	e.Temperature = physic.Kelvin / 16
+}
+
+// SetResolution sets the resolution of a DS18B20 or MAX31820, in the range
+// 9..12 bits. It requires a kernel that supports the resolution attribute,
+// and write access to it.
+func (d *W1Dev) SetResolution(bits int) error {
+	if bits < 9 || bits > 12 {
+		return errors.New("ds18b20: invalid resolutionBits")
+	}
+	if d.Family() == DS18S20 {
+		return errors.New("ds18b20: DS18S20 only supports 12 resolutionBits")
+	}
+	if err := os.WriteFile(filepath.Join(d.dir, "resolution"), []byte(strconv.Itoa(bits)), 0); err != nil {
+		return fmt.Errorf("ds18b20: %w", err)
+	}
+	return nil
+}
+
+// parseW1Slave parses the content of the w1_slave file of the w1_therm
+// driver, which looks like:
+//
+//	72 01 4b 46 7f ff 0e 10 57 : crc=57 YES
+//	72 01 4b 46 7f ff 0e 10 57 t=23125
+func parseW1Slave(s string) (physic.Temperature, error) {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	if len(lines) != 2 {
+		return 0, busError("ds18b20: invalid w1_slave content")
+	}
+	if !strings.HasSuffix(lines[0], "YES") {
+		return 0, busError("ds18b20: incorrect scratchpad CRC")
+	}
+	_, v, ok := strings.Cut(lines[1], "t=")
+	if !ok {
+		return 0, busError("ds18b20: invalid w1_slave content")
+	}
+	milli, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil {
+		return 0, busError("ds18b20: invalid w1_slave content")
+	}
+	// See LastTemp().
+	if milli == 85000 {
+		return 0, busError("ds18b20: has not performed a temperature conversion (insufficient pull-up?)")
+	}
+	return physic.Temperature(milli)*physic.MilliKelvin + physic.ZeroCelsius, nil
+}
+
+var _ conn.Resource = &W1Dev{}
+var _ physic.SenseEnv = &W1Dev{}
@@ -0,0 +1,235 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ds3231
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// Alarm is a set of alarms of the device.
+type Alarm byte
+
+const (
+	// Alarm1 can match seconds, minutes, hours and the day.
+	Alarm1 Alarm = 1 << 0
+	// Alarm2 fires at second 00, and can match minutes, hours and the day.
+	Alarm2 Alarm = 1 << 1
+)
+
+func (a Alarm) String() string {
+	var s []string
+	if a&Alarm1 != 0 {
+		s = append(s, "Alarm1")
+	}
+	if a&Alarm2 != 0 {
+		s = append(s, "Alarm2")
+	}
+	if len(s) == 0 {
+		return "None"
+	}
+	return strings.Join(s, "|")
+}
+
+// AlarmMode selects the fields of the time of an alarm that must match the
+// time of the device for the alarm to fire.
+type AlarmMode byte
+
+const (
+	// EverySecond fires Alarm1 every second.
+	EverySecond AlarmMode = iota
+	// EveryMinute fires Alarm2 every minute, at second 00.
+	EveryMinute
+	// MatchSeconds fires Alarm1 when the seconds match, once per minute.
+	MatchSeconds
+	// MatchMinutes fires when the minutes, and the seconds for Alarm1, match.
+	MatchMinutes
+	// MatchHours fires when the hours, minutes, and seconds for Alarm1,
+	// match, once per day.
+	MatchHours
+	// MatchDate fires when the day of the month and the time match.
+	MatchDate
+	// MatchWeekday fires when the day of the week and the time match.
+	MatchWeekday
+)
+
+const (
+	// alarmMask is set in an alarm register to ignore the field.
+	alarmMask byte = 1 << 7
+	// alarmDay is set in the day register of an alarm to match the day of
+	// the week instead of the day of the month.
+	alarmDay byte = 1 << 6
+	// watchInterval is how often the goroutine started by WatchAlarms() reads
+	// the flags without an edge, in case one was missed.
+	watchInterval = time.Second
+	// eventBufferSize is the size of the channel returned by WatchAlarms().
+	eventBufferSize = 4
+)
+
+// AlarmEvent is sent by the goroutine started by WatchAlarms() when alarms
+// fire.
+type AlarmEvent struct {
+	// Alarms is the set of alarms that fired.
+	Alarms Alarm
+	// Time is when the flags were read.
+	Time time.Time
+}
+
+// SetAlarm sets the time of one alarm, converted to UTC, and enables its
+// interrupt on the INT/SQW pin, which disables the square wave output. The
+// flag of the alarm is cleared. The fields of t not selected by mode are
+// ignored.
+func (d *Dev) SetAlarm(a Alarm, mode AlarmMode, t time.Time) error {
+	t = t.UTC()
+	day := toBCD(t.Day())
+	if mode == MatchWeekday {
+		day = byte(t.Weekday()) + 1 | alarmDay
+	}
+	// The fields of the alarm, seconds first. Alarm2 has no seconds.
+	fields := []byte{toBCD(t.Second()), toBCD(t.Minute()), toBCD(t.Hour()), day}
+	reg := regAlarm1
+	var matched int
+	switch a {
+	case Alarm1:
+		switch mode {
+		case EverySecond:
+			matched = 0
+		case MatchSeconds:
+			matched = 1
+		case MatchMinutes:
+			matched = 2
+		case MatchHours:
+			matched = 3
+		case MatchDate, MatchWeekday:
+			matched = 4
+		default:
+			return fmt.Errorf("ds3231: invalid mode %d for %s", mode, a)
+		}
+	case Alarm2:
+		reg = regAlarm2
+		fields = fields[1:]
+		switch mode {
+		case EveryMinute:
+			matched = 0
+		case MatchMinutes:
+			matched = 1
+		case MatchHours:
+			matched = 2
+		case MatchDate, MatchWeekday:
+			matched = 3
+		default:
+			return fmt.Errorf("ds3231: invalid mode %d for %s", mode, a)
+		}
+	default:
+		return fmt.Errorf("ds3231: invalid alarm %s", a)
+	}
+	for ix := matched; ix < len(fields); ix++ {
+		fields[ix] |= alarmMask
+	}
+	if err := d.write(reg, fields...); err != nil {
+		return err
+	}
+	if err := d.updateStatus(byte(a), 0); err != nil {
+		return err
+	}
+	return d.updateControl(controlINTCN|byte(a), controlINTCN|byte(a))
+}
+
+// DisableAlarm disables the interrupt of the alarms in a. Their flags are
+// still set when they fire.
+func (d *Dev) DisableAlarm(a Alarm) error {
+	return d.updateControl(byte(a&(Alarm1|Alarm2)), 0)
+}
+
+// FiredAlarms returns the set of alarms whose flag is set.
+func (d *Dev) FiredAlarms() (Alarm, error) {
+	v, err := d.readReg(regStatus)
+	return Alarm(v) & (Alarm1 | Alarm2), err
+}
+
+// ClearAlarm clears the flag of the alarms in a, which releases the INT/SQW
+// pin if no other enabled alarm fired.
+func (d *Dev) ClearAlarm(a Alarm) error {
+	return d.updateStatus(byte(a&(Alarm1|Alarm2)), 0)
+}
+
+// watcher is the state of the goroutine started by WatchAlarms().
+type watcher struct {
+	pin  gpio.PinIn
+	stop chan struct{}
+	done chan struct{}
+}
+
+// WatchAlarms starts a goroutine that waits for a falling edge on pin, which
+// is wired to the INT/SQW pin of the device, and sends the alarms that fired
+// to the returned channel. Their flags are cleared. If the channel is full,
+// the event is dropped. The pin is configured as an input with a pull up,
+// since INT/SQW is open drain.
+//
+// The flags are also read periodically, in case an edge is missed. The
+// channel is closed by Halt().
+func (d *Dev) WatchAlarms(pin gpio.PinIn) (<-chan AlarmEvent, error) {
+	d.watchMu.Lock()
+	defer d.watchMu.Unlock()
+	if d.watch.stop != nil {
+		return nil, errors.New("ds3231: alarms already watched")
+	}
+	if err := pin.In(gpio.PullUp, gpio.FallingEdge); err != nil {
+		return nil, fmt.Errorf("ds3231: %w", err)
+	}
+	events := make(chan AlarmEvent, eventBufferSize)
+	d.watch = watcher{pin: pin, stop: make(chan struct{}), done: make(chan struct{})}
+	go d.runWatch(pin, events, d.watch.stop, d.watch.done)
+	return events, nil
+}
+
+// stopWatch stops the goroutine started by WatchAlarms(), and waits for it
+// to exit.
+func (d *Dev) stopWatch() {
+	d.watchMu.Lock()
+	w := d.watch
+	d.watch = watcher{}
+	d.watchMu.Unlock()
+	if w.stop == nil {
+		return
+	}
+	close(w.stop)
+	// Interrupt a pending WaitForEdge().
+	_ = w.pin.Halt()
+	<-w.done
+}
+
+func (d *Dev) runWatch(pin gpio.PinIn, events chan<- AlarmEvent, stop, done chan struct{}) {
+	defer close(done)
+	defer close(events)
+	for {
+		// The pin stays low while a flag is set, so the flags are read
+		// before waiting for the next edge. Bus errors are ignored, the
+		// flags are read again at the next edge or interval.
+		if fired, err := d.FiredAlarms(); err == nil && fired != 0 {
+			if d.ClearAlarm(fired) == nil {
+				select {
+				case events <- AlarmEvent{Alarms: fired, Time: time.Now()}:
+				default:
+				}
+			}
+		}
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		pin.WaitForEdge(watchInterval)
+		select {
+		case <-stop:
+			return
+		default:
+		}
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// The DS3231 is an I²C real time clock with an integrated temperature
+// compensated crystal oscillator, and a battery backup input. It keeps the
+// date and time, has two alarms, and measures its temperature to compensate
+// the oscillator.
+//
+// The device doesn't store a time zone. This driver keeps the clock in UTC,
+// and the times returned by Time() and passed to alarms are converted as
+// needed.
+//
+// # Alarms
+//
+// When an alarm is enabled, the open drain INT/SQW pin is driven low when it
+// fires, until its flag is cleared. Wire it to a GPIO and pass the pin to
+// WatchAlarms() to receive wake events.
+//
+// # Datasheet
+//
+// https://www.analog.com/media/en/technical-documentation/data-sheets/DS3231.pdf
+package ds3231
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+const (
+	devName = "DS3231"
+	// DefaultAddress is the fixed I²C address of the device.
+	DefaultAddress uint16 = 0x68
+)
+
+// Registers of the device.
+const (
+	regSeconds     byte = 0x00
+	regAlarm1      byte = 0x07
+	regAlarm2      byte = 0x0b
+	regControl     byte = 0x0e
+	regStatus      byte = 0x0f
+	regTemperature byte = 0x11
+)
+
+// Bits of the control and status registers.
+const (
+	controlINTCN byte = 1 << 2
+	statusOSF    byte = 1 << 7
+)
+
+const (
+	// Bits of the hours register.
+	hour12 byte = 1 << 6
+	hourPM byte = 1 << 5
+	// centuryBit is set in the month register when the year rolls over from
+	// 99 to 00.
+	centuryBit byte = 1 << 7
+	// minYear and maxYear are the range of years the device can keep.
+	minYear = 2000
+	maxYear = 2199
+)
+
+// Dev is a handle to a DS3231.
+type Dev struct {
+	d *i2c.Dev
+	// mu serializes the read-modify-write sequences of the control and
+	// status registers.
+	mu sync.Mutex
+
+	watchMu sync.Mutex
+	watch   watcher
+}
+
+// New returns a handle to a DS3231 on bus. The device is not modified.
+func New(bus i2c.Bus) (*Dev, error) {
+	d := &Dev{d: &i2c.Dev{Bus: bus, Addr: DefaultAddress}}
+	// Check the device responds.
+	if _, err := d.readReg(regStatus); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Time returns the date and time kept by the device, in UTC.
+//
+// If the oscillator was stopped, for example because the device lost power
+// without a backup battery, the time is not valid. Use OscillatorStopped() to
+// check.
+func (d *Dev) Time() (time.Time, error) {
+	var r [7]byte
+	if err := d.read(regSeconds, r[:]); err != nil {
+		return time.Time{}, err
+	}
+	year := minYear + int(fromBCD(r[6]))
+	if r[5]&centuryBit != 0 {
+		year += 100
+	}
+	return time.Date(
+		year,
+		time.Month(fromBCD(r[5]&^centuryBit)),
+		int(fromBCD(r[4])),
+		decodeHours(r[2]),
+		int(fromBCD(r[1])),
+		int(fromBCD(r[0])),
+		0, time.UTC), nil
+}
+
+// SetTime sets the date and time of the device to t, converted to UTC, and
+// clears the oscillator stop flag. The fraction of second is truncated. The
+// device can keep years 2000 to 2199.
+func (d *Dev) SetTime(t time.Time) error {
+	t = t.UTC()
+	if t.Year() < minYear || t.Year() > maxYear {
+		return fmt.Errorf("ds3231: year %d out of range [%d, %d]", t.Year(), minYear, maxYear)
+	}
+	month := toBCD(int(t.Month()))
+	if t.Year() >= minYear+100 {
+		month |= centuryBit
+	}
+	if err := d.write(regSeconds,
+		toBCD(t.Second()),
+		toBCD(t.Minute()),
+		toBCD(t.Hour()),
+		byte(t.Weekday())+1,
+		toBCD(t.Day()),
+		month,
+		toBCD(t.Year()%100)); err != nil {
+		return err
+	}
+	return d.updateStatus(statusOSF, 0)
+}
+
+// OscillatorStopped returns true if the oscillator was stopped since the time
+// was last set, in which case the time kept by the device is not valid.
+func (d *Dev) OscillatorStopped() (bool, error) {
+	v, err := d.readReg(regStatus)
+	return v&statusOSF != 0, err
+}
+
+// Temperature returns the temperature of the device, with a resolution of
+// 0.25°C. It is updated every 64 seconds.
+func (d *Dev) Temperature() (physic.Temperature, error) {
+	var r [2]byte
+	if err := d.read(regTemperature, r[:]); err != nil {
+		return 0, err
+	}
+	// The value is a 10 bit two's complement number of quarters of degree,
+	// left aligned.
+	quarters := int16(uint16(r[0])<<8|uint16(r[1])) >> 6
+	return physic.ZeroCelsius + physic.Temperature(quarters)*250*physic.MilliKelvin, nil
+}
+
+// SyncSystemTime sets the system clock to the time kept by the device. This
+// usually requires elevated privileges. An error is returned without changing
+// the system clock if the oscillator was stopped.
+func (d *Dev) SyncSystemTime() error {
+	stopped, err := d.OscillatorStopped()
+	if err != nil {
+		return err
+	}
+	if stopped {
+		return errors.New("ds3231: the oscillator was stopped, the time is not valid")
+	}
+	t, err := d.Time()
+	if err != nil {
+		return err
+	}
+	return setSystemTime(t)
+}
+
+// Halt stops the goroutine started by WatchAlarms(), if any. The alarms are
+// not changed.
+func (d *Dev) Halt() error {
+	d.stopWatch()
+	return nil
+}
+
+func (d *Dev) String() string {
+	return devName
+}
+
+func (d *Dev) read(reg byte, r []byte) error {
+	if err := d.d.Tx([]byte{reg}, r); err != nil {
+		return fmt.Errorf("ds3231: error reading register 0x%02x: %w", reg, err)
+	}
+	return nil
+}
+
+func (d *Dev) readReg(reg byte) (byte, error) {
+	var r [1]byte
+	err := d.read(reg, r[:])
+	return r[0], err
+}
+
+func (d *Dev) write(reg byte, values ...byte) error {
+	if _, err := d.d.Write(append([]byte{reg}, values...)); err != nil {
+		return fmt.Errorf("ds3231: error writing register 0x%02x: %w", reg, err)
+	}
+	return nil
+}
+
+// updateControl sets the bits of mask in the control register to value.
+func (d *Dev) updateControl(mask, value byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.readReg(regControl)
+	if err != nil {
+		return err
+	}
+	n := v&^mask | value&mask
+	if n == v {
+		return nil
+	}
+	return d.write(regControl, n)
+}
+
+// updateStatus sets the bits of mask in the status register to value. The
+// alarm flags can only be cleared, so they are written as 1 unless they are
+// in mask, so that an alarm that fires in between isn't lost.
+func (d *Dev) updateStatus(mask, value byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, err := d.readReg(regStatus)
+	if err != nil {
+		return err
+	}
+	return d.write(regStatus, (v|byte(Alarm1|Alarm2))&^mask|value&mask)
+}
+
+// decodeHours returns the hour of the hours register, in 12 or 24 hour mode.
+func decodeHours(v byte) int {
+	if v&hour12 == 0 {
+		return int(fromBCD(v & 0x3f))
+	}
+	h := int(fromBCD(v&0x1f)) % 12
+	if v&hourPM != 0 {
+		h += 12
+	}
+	return h
+}
+
+func toBCD(v int) byte {
+	return byte(v/10<<4 | v%10)
+}
+
+func fromBCD(v byte) byte {
+	return v>>4*10 + v&0x0f
+}
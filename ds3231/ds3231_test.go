@@ -0,0 +1,274 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ds3231
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// registerBus is an i2c.Bus that emulates the registers of a DS3231.
+type registerBus struct {
+	mu   sync.Mutex
+	regs [0x13]byte
+}
+
+func (b *registerBus) String() string { return "registerBus" }
+
+func (b *registerBus) SetSpeed(f physic.Frequency) error { return nil }
+
+func (b *registerBus) Tx(addr uint16, w, r []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if addr != DefaultAddress || len(w) == 0 {
+		return errors.New("registerBus: invalid transaction")
+	}
+	reg := int(w[0])
+	for ix, v := range w[1:] {
+		if reg+ix == int(regStatus) {
+			// The alarm flags can only be cleared.
+			v &= b.regs[regStatus] | ^byte(Alarm1|Alarm2)
+		}
+		b.regs[reg+ix] = v
+	}
+	copy(r, b.regs[reg:])
+	return nil
+}
+
+func (b *registerBus) setFlags(a Alarm) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.regs[regStatus] |= byte(a)
+}
+
+func (b *registerBus) flags() Alarm {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return Alarm(b.regs[regStatus]) & (Alarm1 | Alarm2)
+}
+
+func TestTime(t *testing.T) {
+	tests := []struct {
+		r    []byte
+		want time.Time
+	}{
+		{[]byte{0x56, 0x34, 0x12, 0x05, 0x31, 0x12, 0x25}, time.Date(2025, 12, 31, 12, 34, 56, 0, time.UTC)},
+		// 12 hour mode, 11 PM.
+		{[]byte{0x00, 0x00, 0x71, 0x01, 0x01, 0x01, 0x00}, time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC)},
+		// 12 hour mode, 12 AM.
+		{[]byte{0x00, 0x00, 0x52, 0x01, 0x01, 0x01, 0x00}, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+		// Century.
+		{[]byte{0x00, 0x00, 0x00, 0x01, 0x01, 0x81, 0x01}, time.Date(2101, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		bus := &i2ctest.Playback{
+			Ops: []i2ctest.IO{
+				{Addr: DefaultAddress, W: []byte{regStatus}, R: []byte{0x00}},
+				{Addr: DefaultAddress, W: []byte{regSeconds}, R: test.r},
+			},
+		}
+		d, err := New(bus)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := d.Time()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("Time() = %s, want %s", got, test.want)
+		}
+		if err := bus.Close(); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestSetTime(t *testing.T) {
+	bus := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			{Addr: DefaultAddress, W: []byte{regStatus}, R: []byte{0x88}},
+			{Addr: DefaultAddress, W: []byte{regSeconds, 0x56, 0x34, 0x12, 0x04, 0x31, 0x12, 0x25}},
+			{Addr: DefaultAddress, W: []byte{regStatus}, R: []byte{0x88}},
+			{Addr: DefaultAddress, W: []byte{regStatus, 0x0b}},
+		},
+	}
+	d, err := New(bus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The time is converted to UTC.
+	zone := time.FixedZone("UTC+2", 2*3600)
+	if err := d.SetTime(time.Date(2025, 12, 31, 14, 34, 56, 0, zone)); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetTime(time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected an error for a year out of range")
+	}
+	if err := bus.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTemperature(t *testing.T) {
+	tests := []struct {
+		r    []byte
+		want physic.Temperature
+	}{
+		{[]byte{0x19, 0x40}, physic.ZeroCelsius + 25250*physic.MilliKelvin},
+		{[]byte{0xff, 0x00}, physic.ZeroCelsius - 1*physic.Kelvin},
+		{[]byte{0xe7, 0xc0}, physic.ZeroCelsius - 24250*physic.MilliKelvin},
+	}
+	for _, test := range tests {
+		bus := &i2ctest.Playback{
+			Ops: []i2ctest.IO{
+				{Addr: DefaultAddress, W: []byte{regStatus}, R: []byte{0x00}},
+				{Addr: DefaultAddress, W: []byte{regTemperature}, R: test.r},
+			},
+		}
+		d, err := New(bus)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := d.Temperature()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("Temperature() = %s, want %s", got, test.want)
+		}
+	}
+}
+
+func TestSetAlarm(t *testing.T) {
+	at := time.Date(2025, 6, 15, 7, 30, 45, 0, time.UTC)
+	tests := []struct {
+		a    Alarm
+		mode AlarmMode
+		regs []byte
+	}{
+		{Alarm1, EverySecond, []byte{0xc5, 0xb0, 0x87, 0x95}},
+		{Alarm1, MatchSeconds, []byte{0x45, 0xb0, 0x87, 0x95}},
+		{Alarm1, MatchHours, []byte{0x45, 0x30, 0x07, 0x95}},
+		{Alarm1, MatchDate, []byte{0x45, 0x30, 0x07, 0x15}},
+		// June 15th 2025 is a Sunday.
+		{Alarm1, MatchWeekday, []byte{0x45, 0x30, 0x07, 0x41}},
+		{Alarm2, EveryMinute, []byte{0xb0, 0x87, 0x95}},
+		{Alarm2, MatchMinutes, []byte{0x30, 0x87, 0x95}},
+		{Alarm2, MatchDate, []byte{0x30, 0x07, 0x15}},
+	}
+	for _, test := range tests {
+		bus := &registerBus{}
+		bus.regs[regControl] = 0x1c
+		d, err := New(bus)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bus.setFlags(test.a)
+		if err := d.SetAlarm(test.a, test.mode, at); err != nil {
+			t.Fatal(err)
+		}
+		reg := regAlarm1
+		if test.a == Alarm2 {
+			reg = regAlarm2
+		}
+		for ix, want := range test.regs {
+			if got := bus.regs[int(reg)+ix]; got != want {
+				t.Errorf("%s %d: register 0x%02x = 0x%02x, want 0x%02x", test.a, test.mode, int(reg)+ix, got, want)
+			}
+		}
+		if want := 0x1c | byte(test.a); bus.regs[regControl] != want {
+			t.Errorf("%s %d: control = 0x%02x, want 0x%02x", test.a, test.mode, bus.regs[regControl], want)
+		}
+		if bus.flags() != 0 {
+			t.Errorf("%s %d: flag not cleared", test.a, test.mode)
+		}
+	}
+
+	d, err := New(&registerBus{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetAlarm(Alarm2, EverySecond, at); err == nil {
+		t.Error("expected an error for EverySecond on Alarm2")
+	}
+	if err := d.SetAlarm(Alarm1, EveryMinute, at); err == nil {
+		t.Error("expected an error for EveryMinute on Alarm1")
+	}
+	if err := d.SetAlarm(Alarm1|Alarm2, MatchHours, at); err == nil {
+		t.Error("expected an error for two alarms")
+	}
+}
+
+func TestWatchAlarms(t *testing.T) {
+	bus := &registerBus{}
+	d, err := New(bus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pin := &gpiotest.Pin{N: "INT", EdgesChan: make(chan gpio.Level, 1)}
+	events, err := d.WatchAlarms(pin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.WatchAlarms(pin); err == nil {
+		t.Error("expected an error watching twice")
+	}
+	if pin.P != gpio.PullUp {
+		t.Errorf("pull = %s, want %s", pin.P, gpio.PullUp)
+	}
+	bus.setFlags(Alarm2)
+	pin.EdgesChan <- gpio.Low
+	select {
+	case e := <-events:
+		if e.Alarms != Alarm2 {
+			t.Errorf("Alarms = %s, want %s", e.Alarms, Alarm2)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the alarm")
+	}
+	if f := bus.flags(); f != 0 {
+		t.Errorf("flags = %s, want cleared", f)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-events; ok {
+		t.Error("expected the channel to be closed")
+	}
+}
+
+func TestSyncSystemTime(t *testing.T) {
+	defer func(f func(time.Time) error) { setSystemTime = f }(setSystemTime)
+	var got time.Time
+	setSystemTime = func(t time.Time) error {
+		got = t
+		return nil
+	}
+	bus := &registerBus{}
+	copy(bus.regs[:], []byte{0x56, 0x34, 0x12, 0x05, 0x31, 0x12, 0x25})
+	bus.regs[regStatus] = byte(statusOSF)
+	d, err := New(bus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SyncSystemTime(); err == nil {
+		t.Error("expected an error with the oscillator stop flag set")
+	}
+	bus.regs[regStatus] = 0
+	if err := d.SyncSystemTime(); err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2025, 12, 31, 12, 34, 56, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("system time set to %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ds3231_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/ds3231"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	rtc, err := ds3231.New(bus)
+	if err != nil {
+		log.Fatal(err)
+	}
+	now, err := rtc.Time()
+	if err != nil {
+		log.Fatal(err)
+	}
+	temp, err := rtc.Temperature()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(now, temp)
+}
+
+func ExampleDev_WatchAlarms() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open default I²C bus.
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatalf("failed to open I²C: %v", err)
+	}
+	defer bus.Close()
+
+	rtc, err := ds3231.New(bus)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer rtc.Halt()
+
+	// Wake up every day at 7:30 UTC.
+	at := time.Date(2000, 1, 1, 7, 30, 0, 0, time.UTC)
+	if err := rtc.SetAlarm(ds3231.Alarm1, ds3231.MatchHours, at); err != nil {
+		log.Fatal(err)
+	}
+	// INT/SQW is wired to GPIO17.
+	events, err := rtc.WatchAlarms(gpioreg.ByName("GPIO17"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for e := range events {
+		fmt.Println(e.Alarms, "fired at", e.Time)
+	}
+}
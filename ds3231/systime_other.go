@@ -0,0 +1,18 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+//go:build !(linux || darwin || freebsd || netbsd || openbsd)
+
+package ds3231
+
+import (
+	"errors"
+	"time"
+)
+
+// setSystemTime sets the system clock. It is a variable so that tests can
+// replace it.
+var setSystemTime = func(t time.Time) error {
+	return errors.New("ds3231: setting the system time is not supported on this platform")
+}
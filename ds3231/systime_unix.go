@@ -0,0 +1,23 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+//go:build linux || darwin || freebsd || netbsd || openbsd
+
+package ds3231
+
+import (
+	"fmt"
+	"syscall"
+	"time"
+)
+
+// setSystemTime sets the system clock. It is a variable so that tests can
+// replace it.
+var setSystemTime = func(t time.Time) error {
+	tv := syscall.NsecToTimeval(t.UnixNano())
+	if err := syscall.Settimeofday(&tv); err != nil {
+		return fmt.Errorf("ds3231: error setting the system time: %w", err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package ep0099
 
 import (
 	"errors"
+	"fmt"
 
 	"periph.io/x/conn/v3/i2c"
 )
@@ -54,10 +55,15 @@ func NewWithOpts(bus i2c.Bus, address uint16, opts *Opts) (*Dev, error) {
 	return d, nil
 }
 
+// Halt implements conn.Resource. It turns off all the relays.
 func (d *Dev) Halt() error {
 	return d.Reset()
 }
 
+func (d *Dev) String() string {
+	return fmt.Sprintf("EP-0099{%s}", &d.i2c)
+}
+
 func (d *Dev) On(channel uint8) error {
 	if !isValidChannel(channel) {
 		return errInvalidChannel
@@ -0,0 +1,118 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package escpos
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Symbology is a barcode symbology.
+type Symbology byte
+
+const (
+	UPCA    Symbology = 65
+	UPCE    Symbology = 66
+	EAN13   Symbology = 67
+	EAN8    Symbology = 68
+	Code39  Symbology = 69
+	ITF     Symbology = 70
+	Codabar Symbology = 71
+	Code93  Symbology = 72
+	Code128 Symbology = 73
+)
+
+// HRI is the position of the human readable interpretation of a barcode.
+type HRI byte
+
+const (
+	HRINone HRI = iota
+	HRIAbove
+	HRIBelow
+	HRIBoth
+)
+
+// BarcodeOpts holds the options of a barcode.
+type BarcodeOpts struct {
+	// Height is the height in dots, from 1 to 255.
+	Height int
+	// Width is the width of the narrowest bar in dots, from 2 to 6.
+	Width int
+	HRI   HRI
+}
+
+// DefaultBarcodeOpts prints a 15mm high barcode on 203dpi printers, with its
+// text below.
+var DefaultBarcodeOpts = BarcodeOpts{
+	Height: 120,
+	Width:  3,
+	HRI:    HRIBelow,
+}
+
+// Barcode prints data as a barcode. Code128 data is printed with the code
+// set B, unless it starts with a code set selection, such as "{C". If opts
+// is nil, DefaultBarcodeOpts is used.
+func (dev *Dev) Barcode(sym Symbology, data string, opts *BarcodeOpts) error {
+	if opts == nil {
+		opts = &DefaultBarcodeOpts
+	}
+	if sym < UPCA || sym > Code128 {
+		return fmt.Errorf("escpos: invalid symbology %d", sym)
+	}
+	if opts.Height < 1 || opts.Height > 255 || opts.Width < 2 || opts.Width > 6 || opts.HRI > HRIBoth {
+		return fmt.Errorf("escpos: invalid barcode options %+v", *opts)
+	}
+	if sym == Code128 && !strings.HasPrefix(data, "{") {
+		data = "{B" + data
+	}
+	if len(data) == 0 || len(data) > 255 {
+		return fmt.Errorf("escpos: invalid barcode length %d", len(data))
+	}
+	return dev.command(
+		append(setBarcodeHeight, byte(opts.Height)),
+		append(setBarcodeWidth, byte(opts.Width)),
+		append(setBarcodeHRI, byte(opts.HRI)),
+		append(printBarcode, byte(sym), byte(len(data))),
+		[]byte(data),
+	)
+}
+
+// QRLevel is the error correction level of a QR code.
+type QRLevel byte
+
+const (
+	// QRLow recovers 7% of the data.
+	QRLow QRLevel = 48 + iota
+	// QRMedium recovers 15% of the data.
+	QRMedium
+	// QRQuartile recovers 25% of the data.
+	QRQuartile
+	// QRHigh recovers 30% of the data.
+	QRHigh
+)
+
+// QR prints data as a QR code, with modules of size dots, from 1 to 16.
+func (dev *Dev) QR(data string, size int, level QRLevel) error {
+	if size < 1 || size > 16 {
+		return fmt.Errorf("escpos: invalid QR code size %d", size)
+	}
+	if level < QRLow || level > QRHigh {
+		return fmt.Errorf("escpos: invalid QR code level %d", level)
+	}
+	if len(data) == 0 || len(data) > 7089 {
+		return fmt.Errorf("escpos: invalid QR code length %d", len(data))
+	}
+	// Each function is preceded by the length of its parameters, pL pH.
+	store := len(data) + 3
+	return dev.command(
+		// Model 2.
+		append(qrCode, 4, 0, 49, 65, 50, 0),
+		append(qrCode, 3, 0, 49, 67, byte(size)),
+		append(qrCode, 3, 0, 49, 69, byte(level)),
+		append(qrCode, byte(store), byte(store>>8), 49, 80, 48),
+		[]byte(data),
+		append(qrCode, 3, 0, 49, 81, 48),
+	)
+}
@@ -0,0 +1,223 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// This package provides a driver for thermal receipt printers using the
+// Epson ESC/POS command set, such as the Epson TM-T20, and the many 58mm and
+// 80mm printers compatible with it, including the panel printers sold by
+// Adafruit and SparkFun.
+//
+// The printer is written through an io.Writer: the USB printer device, such
+// as /dev/usb/lp0, or a serial port. Commands a printer doesn't support are
+// usually printed as garbage, or ignored.
+//
+// Text is sent as is, so it must be encoded in the code page selected with
+// CodePage(), by default PC437, of which ASCII is a subset.
+//
+// # Datasheet
+//
+// https://download4.epson.biz/sec_pubs/pos/reference_en/escpos/index.html
+package escpos
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"periph.io/x/conn/v3"
+)
+
+var esc byte = 0x1b
+var gs byte = 0x1d
+
+var initialize = []byte{esc, '@'}
+var setAlign = []byte{esc, 'a'}
+var setBold = []byte{esc, 'E'}
+var setCodePage = []byte{esc, 't'}
+var setFont = []byte{esc, 'M'}
+var setInverse = []byte{gs, 'B'}
+var setSize = []byte{gs, '!'}
+var setUnderline = []byte{esc, '-'}
+var feedLines = []byte{esc, 'd'}
+var cutPaper = []byte{gs, 'V'}
+var setBarcodeHeight = []byte{gs, 'h'}
+var setBarcodeWidth = []byte{gs, 'w'}
+var setBarcodeHRI = []byte{gs, 'H'}
+var printBarcode = []byte{gs, 'k'}
+var qrCode = []byte{gs, '(', 'k'}
+
+func wrapErr(err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("escpos: %w", err)
+}
+
+// Align is the horizontal alignment of the lines.
+type Align byte
+
+const (
+	Left Align = iota
+	Center
+	Right
+)
+
+// Font is a character font. Font B is smaller than font A.
+type Font byte
+
+const (
+	FontA Font = iota
+	FontB
+)
+
+// Underline is the thickness of the underline.
+type Underline byte
+
+const (
+	NoUnderline Underline = iota
+	ThinUnderline
+	ThickUnderline
+)
+
+// Style is the style of the text. The zero value is the style after Init().
+type Style struct {
+	Bold      bool
+	Underline Underline
+	// Inverse prints white on black.
+	Inverse bool
+	Font    Font
+	// Width and Height are the magnification of the characters, from 1 to
+	// 8. 0 is 1.
+	Width  int
+	Height int
+}
+
+// Dev is a handle to a printer.
+type Dev struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriter returns a handle to a printer written through w, and initializes
+// it.
+func NewWriter(w io.Writer) (*Dev, error) {
+	if w == nil {
+		return nil, errors.New("escpos: writer is required")
+	}
+	dev := &Dev{w: w}
+	if err := dev.Init(); err != nil {
+		return nil, err
+	}
+	return dev, nil
+}
+
+// Init resets the style, alignment and code page, and clears the buffer of
+// the printer.
+func (dev *Dev) Init() error {
+	return dev.command(initialize)
+}
+
+// Write sends p to the printer. It's printed when a line is complete, or
+// when the buffer is full.
+func (dev *Dev) Write(p []byte) (n int, err error) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	n, err = dev.w.Write(p)
+	return n, wrapErr(err)
+}
+
+// WriteString sends text to the printer.
+func (dev *Dev) WriteString(text string) (int, error) {
+	return dev.Write([]byte(text))
+}
+
+// Println prints text followed by a line feed.
+func (dev *Dev) Println(text string) error {
+	_, err := dev.WriteString(text + "\n")
+	return err
+}
+
+// SetStyle sets the style of the text that follows.
+func (dev *Dev) SetStyle(s Style) error {
+	if s.Width < 0 || s.Width > 8 || s.Height < 0 || s.Height > 8 || s.Underline > ThickUnderline || s.Font > FontB {
+		return fmt.Errorf("escpos: invalid style %+v", s)
+	}
+	size := byte(max(s.Width, 1)-1)<<4 | byte(max(s.Height, 1)-1)
+	return dev.command(
+		append(setBold, boolByte(s.Bold)),
+		append(setUnderline, byte(s.Underline)),
+		append(setInverse, boolByte(s.Inverse)),
+		append(setFont, byte(s.Font)),
+		append(setSize, size),
+	)
+}
+
+// SetAlign sets the alignment of the lines that follow. It must be called at
+// the beginning of a line.
+func (dev *Dev) SetAlign(a Align) error {
+	if a > Right {
+		return fmt.Errorf("escpos: invalid alignment %d", a)
+	}
+	return dev.command(append(setAlign, byte(a)))
+}
+
+// CodePage selects the code page of the text, such as 0 for PC437, 2 for
+// PC850 or 16 for WPC1252. The numbers vary by manufacturer.
+func (dev *Dev) CodePage(n byte) error {
+	return dev.command(append(setCodePage, n))
+}
+
+// Feed prints the buffer and feeds the paper by lines.
+func (dev *Dev) Feed(lines int) error {
+	if lines < 0 || lines > 255 {
+		return fmt.Errorf("escpos: invalid number of lines %d", lines)
+	}
+	return dev.command(append(feedLines, byte(lines)))
+}
+
+// Cut feeds the paper to the cutter and cuts it, leaving a small uncut part
+// if partial is true. Printers without a cutter only feed the paper.
+func (dev *Dev) Cut(partial bool) error {
+	m := byte(65)
+	if partial {
+		m = 66
+	}
+	return dev.command(append(cutPaper, m, 0))
+}
+
+// Halt implements conn.Resource. It closes the writer if it implements
+// io.Closer.
+func (dev *Dev) Halt() error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if cl, ok := dev.w.(io.Closer); ok {
+		return wrapErr(cl.Close())
+	}
+	return nil
+}
+
+func (dev *Dev) String() string {
+	return fmt.Sprintf("escpos{%v}", dev.w)
+}
+
+// command writes the commands cmds at once, so that they aren't interleaved
+// with the writes of other goroutines.
+func (dev *Dev) command(cmds ...[]byte) error {
+	var b []byte
+	for _, c := range cmds {
+		b = append(b, c...)
+	}
+	_, err := dev.Write(b)
+	return err
+}
+
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+var _ conn.Resource = &Dev{}
+var _ io.Writer = &Dev{}
@@ -0,0 +1,134 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package escpos
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+type closeBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (c *closeBuffer) Close() error {
+	c.closed = true
+	return nil
+}
+
+func newTestDev(t *testing.T) (*Dev, *closeBuffer) {
+	buf := &closeBuffer{}
+	dev, err := NewWriter(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), []byte{0x1b, '@'}) {
+		t.Fatalf("got % x", buf.Bytes())
+	}
+	buf.Reset()
+	return dev, buf
+}
+
+func check(t *testing.T, buf *closeBuffer, want []byte) {
+	t.Helper()
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("got  % x\nwant % x", buf.Bytes(), want)
+	}
+	buf.Reset()
+}
+
+func TestText(t *testing.T) {
+	dev, buf := newTestDev(t)
+	if err := dev.SetAlign(Center); err != nil {
+		t.Fatal(err)
+	}
+	check(t, buf, []byte{0x1b, 'a', 1})
+	if err := dev.SetStyle(Style{Bold: true, Underline: ThickUnderline, Width: 2, Height: 3}); err != nil {
+		t.Fatal(err)
+	}
+	check(t, buf, []byte{0x1b, 'E', 1, 0x1b, '-', 2, 0x1d, 'B', 0, 0x1b, 'M', 0, 0x1d, '!', 0x12})
+	if err := dev.SetStyle(Style{}); err != nil {
+		t.Fatal(err)
+	}
+	check(t, buf, []byte{0x1b, 'E', 0, 0x1b, '-', 0, 0x1d, 'B', 0, 0x1b, 'M', 0, 0x1d, '!', 0})
+	if err := dev.Println("Total"); err != nil {
+		t.Fatal(err)
+	}
+	check(t, buf, []byte("Total\n"))
+	if err := dev.Feed(3); err != nil {
+		t.Fatal(err)
+	}
+	if err := dev.Cut(true); err != nil {
+		t.Fatal(err)
+	}
+	check(t, buf, []byte{0x1b, 'd', 3, 0x1d, 'V', 66, 0})
+	for _, s := range []Style{{Width: 9}, {Height: -1}, {Underline: 3}, {Font: 2}} {
+		if err := dev.SetStyle(s); err == nil {
+			t.Fatalf("%+v: expected error", s)
+		}
+	}
+	if err := dev.SetAlign(3); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := dev.Halt(); err != nil || !buf.closed {
+		t.Fatal(err, buf.closed)
+	}
+}
+
+func TestBarcode(t *testing.T) {
+	dev, buf := newTestDev(t)
+	if err := dev.Barcode(Code128, "A1", nil); err != nil {
+		t.Fatal(err)
+	}
+	check(t, buf, []byte{0x1d, 'h', 120, 0x1d, 'w', 3, 0x1d, 'H', 2, 0x1d, 'k', 73, 4, '{', 'B', 'A', '1'})
+	if err := dev.Barcode(EAN13, "400638133393", &BarcodeOpts{Height: 50, Width: 2}); err != nil {
+		t.Fatal(err)
+	}
+	check(t, buf, append([]byte{0x1d, 'h', 50, 0x1d, 'w', 2, 0x1d, 'H', 0, 0x1d, 'k', 67, 12}, "400638133393"...))
+	if err := dev.Barcode(64, "1", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := dev.Barcode(EAN8, "1", &BarcodeOpts{Height: 50, Width: 7}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestQR(t *testing.T) {
+	dev, buf := newTestDev(t)
+	if err := dev.QR("hi", 6, QRMedium); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x1d, '(', 'k', 4, 0, 49, 65, 50, 0,
+		0x1d, '(', 'k', 3, 0, 49, 67, 6,
+		0x1d, '(', 'k', 3, 0, 49, 69, 49,
+		0x1d, '(', 'k', 5, 0, 49, 80, 48, 'h', 'i',
+		0x1d, '(', 'k', 3, 0, 49, 81, 48,
+	}
+	check(t, buf, want)
+	if err := dev.QR("hi", 17, QRLow); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := dev.QR("", 1, QRLow); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+type failWriter struct{}
+
+func (failWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("paper out")
+}
+
+func TestWriteError(t *testing.T) {
+	if _, err := NewWriter(failWriter{}); err == nil || err.Error() != "escpos: paper out" {
+		t.Fatal(err)
+	}
+	if _, err := NewWriter(nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
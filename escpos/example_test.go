@@ -0,0 +1,36 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package escpos_test
+
+import (
+	"log"
+	"os"
+
+	"periph.io/x/devices/v3/escpos"
+)
+
+func Example() {
+	f, err := os.OpenFile("/dev/usb/lp0", os.O_WRONLY, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	p, err := escpos.NewWriter(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer p.Halt()
+	_ = p.SetAlign(escpos.Center)
+	_ = p.SetStyle(escpos.Style{Bold: true, Width: 2, Height: 2})
+	_ = p.Println("RECEIPT")
+	_ = p.SetStyle(escpos.Style{})
+	_ = p.SetAlign(escpos.Left)
+	_ = p.Println("Coffee            3.50")
+	_ = p.Barcode(escpos.Code128, "ORDER-1042", nil)
+	_ = p.QR("https://periph.io", 6, escpos.QRMedium)
+	_ = p.Feed(3)
+	if err := p.Cut(true); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package expandertest provides a conformance suite for the I²C GPIO
+// expander drivers, so that they're verified against the same behavior.
+//
+// The suite drives the pins of an expander through the gpio.PinIO interface,
+// and a Script supplies the bus operations each device performs, as an
+// i2ctest.Playback recording. The scripts of the expanders of this module are
+// provided.
+package expandertest
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+// Opener creates the expander under test on bus, and returns its pins, in
+// the order of the pin numbers of the driver.
+type Opener func(bus i2c.Bus) ([]gpio.PinIO, error)
+
+// Script is the bus operations an expander performs when the suite is run.
+//
+// The suite creates the expander, and then:
+//
+//   - sets pin 0 as an output, and writes it high, and then low
+//   - sets pin 1 as an input, with no pull and no edge detection
+//   - reads pin 1 twice, and expects it to be high, and then low
+//
+// Ops must contain the operations of each step, including those of the
+// constructor, in order.
+type Script struct {
+	// Name identifies the device in test failures.
+	Name string
+	// Pins is the number of pins of the device.
+	Pins int
+	Ops  []i2ctest.IO
+}
+
+// Run runs the conformance suite for the expander created by open, with the
+// bus operations of s.
+func Run(t *testing.T, s Script, open Opener) {
+	t.Helper()
+	bus := &i2ctest.Playback{Ops: s.Ops, DontPanic: true}
+	pins, err := open(bus)
+	if err != nil {
+		t.Fatalf("%s: %v", s.Name, err)
+	}
+	if len(pins) != s.Pins {
+		t.Fatalf("%s: expected %d pins, got %d", s.Name, s.Pins, len(pins))
+	}
+	for _, l := range []gpio.Level{gpio.High, gpio.Low} {
+		if err = pins[0].Out(l); err != nil {
+			t.Errorf("%s: %s.Out(%s): %v", s.Name, pins[0], l, err)
+		}
+	}
+	if err = pins[1].In(gpio.Float, gpio.NoEdge); err != nil {
+		t.Errorf("%s: %s.In(): %v", s.Name, pins[1], err)
+	}
+	for _, expected := range []gpio.Level{gpio.High, gpio.Low} {
+		if l := pins[1].Read(); l != expected {
+			t.Errorf("%s: %s.Read() returned %s, expected %s", s.Name, pins[1], l, expected)
+		}
+	}
+	if err = bus.Close(); err != nil {
+		t.Errorf("%s: %v", s.Name, err)
+	}
+}
+
+// MCP23008 is the Script of an MCP23008 at address 0x20.
+var MCP23008 = Script{
+	Name: "MCP23008",
+	Pins: 8,
+	Ops: []i2ctest.IO{
+		// IODIR is read on creation.
+		{Addr: 0x20, W: []byte{0x00}, R: []byte{0xff}},
+		// Pin 0 is an output, and OLAT is read once.
+		{Addr: 0x20, W: []byte{0x00, 0xfe}},
+		{Addr: 0x20, W: []byte{0x0a}, R: []byte{0x00}},
+		{Addr: 0x20, W: []byte{0x0a, 0x01}},
+		{Addr: 0x20, W: []byte{0x0a, 0x00}},
+		// Pin 1 is already an input. GPPU is read, and left unchanged.
+		{Addr: 0x20, W: []byte{0x06}, R: []byte{0x00}},
+		{Addr: 0x20, W: []byte{0x09}, R: []byte{0x02}},
+		{Addr: 0x20, W: []byte{0x09}, R: []byte{0x00}},
+	},
+}
+
+// MCP23017 is the Script of an MCP23017 at address 0x20.
+var MCP23017 = Script{
+	Name: "MCP23017",
+	Pins: 16,
+	Ops: []i2ctest.IO{
+		// IODIRA and IODIRB are read on creation.
+		{Addr: 0x20, W: []byte{0x00}, R: []byte{0xff}},
+		{Addr: 0x20, W: []byte{0x01}, R: []byte{0xff}},
+		{Addr: 0x20, W: []byte{0x00, 0xfe}},
+		{Addr: 0x20, W: []byte{0x14}, R: []byte{0x00}},
+		{Addr: 0x20, W: []byte{0x14, 0x01}},
+		{Addr: 0x20, W: []byte{0x14, 0x00}},
+		{Addr: 0x20, W: []byte{0x0c}, R: []byte{0x00}},
+		{Addr: 0x20, W: []byte{0x12}, R: []byte{0x02}},
+		{Addr: 0x20, W: []byte{0x12}, R: []byte{0x00}},
+	},
+}
+
+// PCF8574 is the Script of a PCF8574 at address 0x20. The device has no
+// registers, and a pin is an input when it's written high.
+var PCF8574 = Script{
+	Name: "PCF8574",
+	Pins: 8,
+	Ops: []i2ctest.IO{
+		{Addr: 0x20, W: []byte{0x01}},
+		{Addr: 0x20, W: []byte{0x00}},
+		{Addr: 0x20, W: []byte{0x02}},
+		{Addr: 0x20, R: []byte{0x02}},
+		{Addr: 0x20, R: []byte{0x00}},
+	},
+}
+
+// PCA9555 is the Script of a PCA9555 at address 0x20.
+var PCA9555 = Script{
+	Name: "PCA9555",
+	Pins: 16,
+	Ops: []i2ctest.IO{
+		// The configuration registers are read on creation.
+		{Addr: 0x20, W: []byte{0x06}, R: []byte{0xff}},
+		{Addr: 0x20, W: []byte{0x07}, R: []byte{0xff}},
+		{Addr: 0x20, W: []byte{0x06, 0xfe}},
+		{Addr: 0x20, W: []byte{0x02}, R: []byte{0x00}},
+		{Addr: 0x20, W: []byte{0x02, 0x01}},
+		{Addr: 0x20, W: []byte{0x02, 0x00}},
+		{Addr: 0x20, W: []byte{0x00}, R: []byte{0x02}},
+		{Addr: 0x20, W: []byte{0x00}, R: []byte{0x00}},
+	},
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package expandertest_test
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/devices/v3/expandertest"
+	"periph.io/x/devices/v3/mcp23xxx"
+	"periph.io/x/devices/v3/pcf857x"
+	"periph.io/x/devices/v3/tca95xx"
+)
+
+func openMCP23xxx(variant mcp23xxx.Variant) expandertest.Opener {
+	return func(bus i2c.Bus) ([]gpio.PinIO, error) {
+		dev, err := mcp23xxx.NewI2C(bus, variant, 0x20)
+		if err != nil {
+			return nil, err
+		}
+		var pins []gpio.PinIO
+		for _, port := range dev.Pins {
+			for _, p := range port {
+				pins = append(pins, p)
+			}
+		}
+		return pins, nil
+	}
+}
+
+func TestConformance(t *testing.T) {
+	expandertest.Run(t, expandertest.MCP23008, openMCP23xxx(mcp23xxx.MCP23008))
+	expandertest.Run(t, expandertest.MCP23017, openMCP23xxx(mcp23xxx.MCP23017))
+	expandertest.Run(t, expandertest.PCF8574, func(bus i2c.Bus) ([]gpio.PinIO, error) {
+		dev, err := pcf857x.New(bus, 0x20, pcf857x.PCF8574)
+		if err != nil {
+			return nil, err
+		}
+		return dev.Pins, nil
+	})
+	expandertest.Run(t, expandertest.PCA9555, func(bus i2c.Bus) ([]gpio.PinIO, error) {
+		dev, err := tca95xx.New(bus, tca95xx.PCA9555, 0x20)
+		if err != nil {
+			return nil, err
+		}
+		var pins []gpio.PinIO
+		for _, port := range dev.Pins {
+			for _, p := range port {
+				pins = append(pins, p)
+			}
+		}
+		return pins, nil
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package fan_test
+
+import (
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/devices/v3/fan"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// GPIO18 supports hardware PWM on a Raspberry Pi.
+	f, err := fan.New(gpioreg.ByName("GPIO18"), gpioreg.ByName("GPIO23"), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Halt()
+
+	events, err := f.Start(time.Second)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := f.SetRPM(1200); err != nil {
+		log.Fatal(err)
+	}
+	for ev := range events {
+		if ev.Stalled {
+			log.Printf("%s stalled", f)
+		} else {
+			log.Printf("%s spins again at %d RPM", f, ev.RPM)
+		}
+	}
+	if err := f.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package fan controls 4 wire PC fans, with a PWM input setting their speed,
+// and a tachometer output pulsing as they spin.
+//
+// The speed is measured by counting the pulses of the tachometer in a
+// goroutine started by Start(), which also regulates the speed set with
+// SetRPM(), and reports stalls: a fan driven but not spinning.
+//
+// # Wiring
+//
+// The PWM input of the fan is pulled up inside the fan, to up to 5.25V.
+// Drive it from a GPIO through a level shifter that doesn't invert, or
+// directly if the fan pulls it up to 3.3V at most. The tachometer output is
+// open collector; connect it to a GPIO with a pull-up to 3.3V, never to the
+// 12V supply of the fan.
+//
+// # Specification
+//
+// https://www.intel.com/content/dam/support/us/en/documents/intel-nuc/intel-4wire-pwm-fans-specs.pdf
+package fan
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Opts holds the configuration options.
+type Opts struct {
+	// Frequency is the frequency of the PWM, 25kHz for 4 wire fans.
+	Frequency physic.Frequency
+	// PulsesPerRev is the number of pulses of the tachometer per revolution.
+	PulsesPerRev int
+	// MaxRPM is the speed of the fan at full duty cycle. It scales the gain of
+	// the regulation of SetRPM().
+	MaxRPM int
+	// MinDuty is the lowest duty cycle used by SetRPM(), below which the fan
+	// may stop.
+	MinDuty gpio.Duty
+	// StallTimeout is how long a driven fan can stay still, such as while
+	// spinning up, before it's reported stalled.
+	StallTimeout time.Duration
+}
+
+// DefaultOpts is the configuration of most PC fans.
+var DefaultOpts = Opts{
+	Frequency:    25 * physic.KiloHertz,
+	PulsesPerRev: 2,
+	MaxRPM:       2000,
+	MinDuty:      gpio.DutyMax / 5,
+	StallTimeout: 3 * time.Second,
+}
+
+// Event is a change of the stall state of the fan.
+type Event struct {
+	// Stalled is true if the fan stalled, and false if it spins again.
+	Stalled bool
+	// RPM is the speed measured when the change was detected.
+	RPM int
+	// Time is when the change was detected.
+	Time time.Time
+}
+
+const (
+	// edgeTimeout is the longest time the goroutine waits for a tachometer
+	// pulse between checks of Stop().
+	edgeTimeout = 100 * time.Millisecond
+	// eventBufferSize is the size of the buffer of the events channel.
+	eventBufferSize = 16
+)
+
+// Dev is a handle to a fan.
+type Dev struct {
+	pwm  gpio.PinOut
+	tach gpio.PinIn
+	opts Opts
+
+	mu sync.Mutex
+	// duty is the duty cycle driven, and target the speed set with SetRPM(),
+	// or 0 if the duty cycle is set directly.
+	duty   gpio.Duty
+	target int
+	rpm    int
+	stop   chan struct{}
+	done   chan struct{}
+	err    error
+
+	// now is replaced by tests.
+	now func() time.Time
+}
+
+// New returns a handle to a fan with its PWM input driven by pwm, and its
+// tachometer connected to tach. If opts is nil, DefaultOpts is used. The fan
+// is stopped.
+func New(pwm gpio.PinOut, tach gpio.PinIn, opts *Opts) (*Dev, error) {
+	if pwm == nil || tach == nil {
+		return nil, errors.New("fan: pwm and tach are required")
+	}
+	if opts == nil {
+		opts = &DefaultOpts
+	}
+	if opts.Frequency <= 0 || opts.PulsesPerRev <= 0 || opts.MaxRPM <= 0 {
+		return nil, fmt.Errorf("fan: invalid options %+v", *opts)
+	}
+	if !opts.MinDuty.Valid() {
+		return nil, fmt.Errorf("fan: invalid minimum duty %d", opts.MinDuty)
+	}
+	if err := tach.In(gpio.PullUp, gpio.FallingEdge); err != nil {
+		return nil, fmt.Errorf("fan: %w", err)
+	}
+	d := &Dev{pwm: pwm, tach: tach, opts: *opts, now: time.Now}
+	if err := d.setDuty(0); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// SetDuty drives the fan with duty, and stops the regulation of SetRPM().
+func (d *Dev) SetDuty(duty gpio.Duty) error {
+	if !duty.Valid() {
+		return fmt.Errorf("fan: invalid duty %d", duty)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.target = 0
+	return d.setDuty(duty)
+}
+
+// Duty returns the duty cycle driven.
+func (d *Dev) Duty() gpio.Duty {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.duty
+}
+
+// SetRPM regulates the speed of the fan at rpm, which requires the goroutine
+// started by Start(). A speed of 0 stops the fan.
+func (d *Dev) SetRPM(rpm int) error {
+	if rpm < 0 || rpm > d.opts.MaxRPM {
+		return fmt.Errorf("fan: invalid speed %d RPM", rpm)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.target = rpm
+	if rpm == 0 {
+		return d.setDuty(0)
+	}
+	if d.duty == 0 {
+		// Start from the open loop estimate.
+		return d.setDuty(max(d.opts.MinDuty, gpio.Duty(int64(gpio.DutyMax)*int64(rpm)/int64(d.opts.MaxRPM))))
+	}
+	return nil
+}
+
+// RPM returns the speed measured during the last interval of the goroutine
+// started by Start().
+func (d *Dev) RPM() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.rpm
+}
+
+// Start starts a goroutine that measures the speed of the fan every
+// interval, regulates it if SetRPM() was called, and sends stalls to the
+// returned channel. The channel is closed when Stop() is called, or setting
+// the duty cycle returns an error. See Err().
+func (d *Dev) Start(interval time.Duration) (<-chan Event, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("fan: invalid interval %s", interval)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return nil, errors.New("fan: already started")
+	}
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
+	d.err = nil
+	events := make(chan Event, eventBufferSize)
+	go d.run(interval, events, d.stop, d.done)
+	return events, nil
+}
+
+// Stop stops the goroutine started by Start(), and waits for it to exit. The
+// fan keeps its duty cycle.
+func (d *Dev) Stop() {
+	d.mu.Lock()
+	stop, done := d.stop, d.done
+	d.stop = nil
+	d.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Err returns the error that stopped the goroutine, if any.
+func (d *Dev) Err() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.err
+}
+
+// Halt stops the goroutine started by Start(), and stops the fan.
+func (d *Dev) Halt() error {
+	d.Stop()
+	return d.SetDuty(0)
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("fan{%s, %s}", d.pwm, d.tach)
+}
+
+func (d *Dev) run(interval time.Duration, events chan<- Event, stop, done chan struct{}) {
+	defer close(done)
+	defer close(events)
+	start := d.now()
+	// still is when the fan was first seen still while driven, or zero.
+	var still time.Time
+	stalled := false
+	pulses := 0
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		if d.tach.WaitForEdge(edgeTimeout) {
+			pulses++
+		}
+		now := d.now()
+		elapsed := now.Sub(start)
+		if elapsed < interval {
+			continue
+		}
+		rpm := int(int64(pulses) * int64(time.Minute) / (int64(d.opts.PulsesPerRev) * int64(elapsed)))
+		start, pulses = now, 0
+		driven, err := d.update(rpm)
+		if err != nil {
+			d.mu.Lock()
+			d.err = err
+			d.mu.Unlock()
+			return
+		}
+		// Detect the changes of stall state.
+		var changed bool
+		switch {
+		case rpm != 0 || !driven:
+			still = time.Time{}
+			changed = stalled
+			stalled = false
+		case still.IsZero():
+			still = now
+		case !stalled && now.Sub(still) >= d.opts.StallTimeout:
+			changed, stalled = true, true
+		}
+		if changed {
+			select {
+			case events <- Event{Stalled: stalled, RPM: rpm, Time: now}:
+			case <-stop:
+				return
+			}
+		}
+	}
+}
+
+// update records the speed measured, and regulates it. It returns true if
+// the fan is driven.
+func (d *Dev) update(rpm int) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.rpm = rpm
+	if d.target != 0 {
+		// Integral control, with a gain of half the inverse of the slope of
+		// the speed of a linear fan, which converges without overshooting.
+		delta := int64(d.target-rpm) * int64(gpio.DutyMax) / int64(2*d.opts.MaxRPM)
+		duty := min(max(int64(d.duty)+delta, int64(d.opts.MinDuty)), int64(gpio.DutyMax))
+		if err := d.setDuty(gpio.Duty(duty)); err != nil {
+			return false, err
+		}
+	}
+	return d.duty != 0, nil
+}
+
+// setDuty drives the PWM. d.mu must be held.
+func (d *Dev) setDuty(duty gpio.Duty) error {
+	var err error
+	if duty == 0 {
+		err = d.pwm.Out(gpio.Low)
+	} else {
+		err = d.pwm.PWM(duty, d.opts.Frequency)
+	}
+	if err != nil {
+		return fmt.Errorf("fan: %w", err)
+	}
+	d.duty = duty
+	return nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package fan
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// fakeFan emulates a fan, with a speed proportional to the duty cycle, and a
+// fake clock advanced by the waits for tachometer pulses.
+type fakeFan struct {
+	mu     sync.Mutex
+	clock  time.Time
+	duty   gpio.Duty
+	maxRPM int
+	// stuck stops the fan regardless of the duty cycle.
+	stuck bool
+	// phase is the time since the last pulse.
+	phase time.Duration
+}
+
+type pwmPin struct {
+	gpiotest.Pin
+	*fakeFan
+}
+
+func (p *pwmPin) Out(l gpio.Level) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.duty = 0
+	if l {
+		p.duty = gpio.DutyMax
+	}
+	return nil
+}
+
+func (p *pwmPin) PWM(d gpio.Duty, f physic.Frequency) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.duty = d
+	return nil
+}
+
+type tachPin struct {
+	gpiotest.Pin
+	*fakeFan
+}
+
+func (p *tachPin) In(gpio.Pull, gpio.Edge) error { return nil }
+
+func (p *tachPin) WaitForEdge(timeout time.Duration) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	rpm := int64(p.maxRPM) * int64(p.duty) / int64(gpio.DutyMax)
+	if p.stuck || rpm == 0 {
+		p.clock = p.clock.Add(timeout)
+		return false
+	}
+	// Two pulses per revolution.
+	period := time.Duration(int64(time.Minute) / (2 * rpm))
+	if wait := period - p.phase; wait <= timeout {
+		p.clock = p.clock.Add(wait)
+		p.phase = 0
+		return true
+	}
+	p.clock = p.clock.Add(timeout)
+	p.phase += timeout
+	return false
+}
+
+func (f *fakeFan) now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.clock
+}
+
+func (f *fakeFan) setStuck(stuck bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stuck = stuck
+}
+
+func newTestFan(t *testing.T, maxRPM int) (*Dev, *fakeFan) {
+	f := &fakeFan{clock: time.Unix(0, 0), maxRPM: maxRPM}
+	d, err := New(&pwmPin{gpiotest.Pin{N: "PWM"}, f}, &tachPin{gpiotest.Pin{N: "TACH"}, f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.now = f.now
+	return d, f
+}
+
+// waitFor polls cond, since the goroutine runs on a fake clock.
+func waitFor(t *testing.T, cond func() bool) {
+	for start := time.Now(); !cond(); time.Sleep(time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("timed out")
+		}
+	}
+}
+
+func TestDev_RPM(t *testing.T) {
+	d, _ := newTestFan(t, 2000)
+	if err := d.SetDuty(gpio.DutyHalf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Start(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	defer d.Halt()
+	waitFor(t, func() bool {
+		rpm := d.RPM()
+		return rpm >= 990 && rpm <= 1010
+	})
+}
+
+func TestDev_SetRPM(t *testing.T) {
+	// The fan is faster than its nominal speed.
+	d, _ := newTestFan(t, 3000)
+	if _, err := d.Start(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	defer d.Halt()
+	if err := d.SetRPM(1200); err != nil {
+		t.Fatal(err)
+	}
+	if duty := d.Duty(); duty != gpio.DutyMax*6/10 {
+		t.Errorf("open loop duty %s", duty)
+	}
+	waitFor(t, func() bool {
+		rpm := d.RPM()
+		return rpm >= 1180 && rpm <= 1220
+	})
+	if duty := d.Duty(); duty < gpio.DutyMax*39/100 || duty > gpio.DutyMax*41/100 {
+		t.Errorf("regulated duty %s", duty)
+	}
+	if err := d.SetRPM(0); err != nil {
+		t.Fatal(err)
+	}
+	if d.Duty() != 0 {
+		t.Error("fan not stopped")
+	}
+	if err := d.SetRPM(2001); err == nil {
+		t.Error("expected an error above MaxRPM")
+	}
+}
+
+func TestDev_stall(t *testing.T) {
+	d, f := newTestFan(t, 2000)
+	events, err := d.Start(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A fan stopped isn't stalled.
+	waitFor(t, func() bool { return f.now().Sub(time.Unix(0, 0)) > 10*time.Second })
+	f.setStuck(true)
+	if err := d.SetDuty(gpio.DutyMax); err != nil {
+		t.Fatal(err)
+	}
+	ev := <-events
+	if !ev.Stalled || ev.RPM != 0 {
+		t.Errorf("got %+v", ev)
+	}
+	f.setStuck(false)
+	ev = <-events
+	if ev.Stalled || ev.RPM == 0 {
+		t.Errorf("got %+v", ev)
+	}
+	d.Stop()
+	if _, ok := <-events; ok {
+		t.Error("channel not closed")
+	}
+	if err := d.Err(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNew_errors(t *testing.T) {
+	f := &fakeFan{}
+	if _, err := New(nil, &tachPin{fakeFan: f}, nil); err == nil {
+		t.Error("expected an error without pwm")
+	}
+	if _, err := New(&pwmPin{fakeFan: f}, &tachPin{fakeFan: f}, &Opts{Frequency: physic.KiloHertz}); err == nil {
+		t.Error("expected an error for invalid options")
+	}
+	d, _ := newTestFan(t, 2000)
+	if err := d.SetDuty(gpio.DutyMax + 1); err == nil {
+		t.Error("expected an error for an invalid duty")
+	}
+	if _, err := d.Start(0); err == nil {
+		t.Error("expected an error for an invalid interval")
+	}
+	if s := d.String(); s != "fan{PWM(0), TACH(0)}" {
+		t.Error(s)
+	}
+}
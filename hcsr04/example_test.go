@@ -0,0 +1,36 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hcsr04_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/devices/v3/hcsr04"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	dev, err := hcsr04.New(gpioreg.ByName("GPIO23"), gpioreg.ByName("GPIO24"), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer dev.Halt()
+
+	readings, err := dev.SenseContinuous(time.Second)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for d := range readings {
+		fmt.Println(d)
+	}
+}
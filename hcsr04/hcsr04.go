@@ -0,0 +1,286 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// The HC-SR04 is an ultrasonic distance sensor. A 10µs pulse on its trigger
+// pin makes it send a burst of ultrasound, and it then holds its echo pin
+// high for the time the sound takes to come back, or about 38ms if nothing
+// was detected. Its range is about 2cm to 4m.
+//
+// The distance is computed from the speed of sound at the temperature set
+// with SetTemperature(). The timing of the echo is measured with the edges
+// of a GPIO, so its accuracy depends on the latency of the host; Sense()
+// takes the median of several measurements to reject outliers.
+//
+// # Wiring
+//
+// The echo pin of the sensor is 5V. Use a voltage divider to connect it to a
+// 3.3V GPIO.
+//
+// # Datasheet
+//
+// https://cdn.sparkfun.com/datasheets/Sensors/Proximity/HCSR04.pdf
+package hcsr04
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+const (
+	devName = "HC-SR04"
+	// triggerPulse is the length of the trigger pulse.
+	triggerPulse = 10 * time.Microsecond
+	// echoStartTimeout is how long to wait for the echo pin to rise after
+	// the trigger pulse. The sensor sends the burst in about 200µs.
+	echoStartTimeout = 10 * time.Millisecond
+	// minBurstInterval is the minimum interval between measurements, so that
+	// the echoes of a burst don't disturb the next one.
+	minBurstInterval = 60 * time.Millisecond
+)
+
+var (
+	// ErrNoEcho is returned when nothing was detected within the maximum
+	// distance.
+	ErrNoEcho = errors.New("hcsr04: no echo")
+	// ErrTimeout is returned when the echo pin doesn't change.
+	ErrTimeout = errors.New("hcsr04: timeout waiting for the echo pin")
+)
+
+// Opts holds the configuration options.
+type Opts struct {
+	// Temperature of the air, used to compensate the speed of sound.
+	Temperature physic.Temperature
+	// MaxDistance is the maximum distance measured. Echoes that take longer
+	// return ErrNoEcho.
+	MaxDistance physic.Distance
+	// Samples is the number of measurements whose median is returned by
+	// Sense().
+	Samples int
+	// BurstInterval is the interval between the measurements of Sense(). It
+	// must be at least 60ms.
+	BurstInterval time.Duration
+}
+
+// DefaultOpts is the recommended default options.
+var DefaultOpts = Opts{
+	Temperature:   physic.ZeroCelsius + 20*physic.Kelvin,
+	MaxDistance:   4 * physic.Metre,
+	Samples:       5,
+	BurstInterval: minBurstInterval,
+}
+
+// Dev is a handle to an HC-SR04.
+type Dev struct {
+	trig gpio.PinOut
+	echo gpio.PinIn
+	opts Opts
+
+	mu          sync.Mutex
+	temperature physic.Temperature
+	// now is replaced by tests.
+	now func() time.Time
+
+	// senseMu guards the state of the goroutine started by
+	// SenseContinuous().
+	senseMu sync.Mutex
+	stop    chan struct{}
+	done    chan struct{}
+	err     error
+}
+
+// New returns a handle to an HC-SR04 with its trigger pin connected to trig,
+// and its echo pin connected to echo. If opts is nil, DefaultOpts is used.
+func New(trig gpio.PinOut, echo gpio.PinIn, opts *Opts) (*Dev, error) {
+	if opts == nil {
+		opts = &DefaultOpts
+	}
+	if opts.Samples < 1 {
+		return nil, fmt.Errorf("hcsr04: invalid number of samples %d", opts.Samples)
+	}
+	if opts.BurstInterval < minBurstInterval {
+		return nil, fmt.Errorf("hcsr04: burst interval %s shorter than %s", opts.BurstInterval, minBurstInterval)
+	}
+	if opts.MaxDistance <= 0 {
+		return nil, fmt.Errorf("hcsr04: invalid maximum distance %s", opts.MaxDistance)
+	}
+	if err := trig.Out(gpio.Low); err != nil {
+		return nil, fmt.Errorf("hcsr04: %w", err)
+	}
+	if err := echo.In(gpio.PullDown, gpio.BothEdges); err != nil {
+		return nil, fmt.Errorf("hcsr04: %w", err)
+	}
+	return &Dev{trig: trig, echo: echo, opts: *opts, temperature: opts.Temperature, now: time.Now}, nil
+}
+
+// SetTemperature sets the temperature of the air used to compute the speed
+// of sound, for example as read from a temperature sensor.
+func (d *Dev) SetTemperature(t physic.Temperature) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.temperature = t
+}
+
+// Measure triggers one measurement and returns the distance.
+func (d *Dev) Measure() (physic.Distance, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	speed := speedOfSound(d.temperature)
+	// The sound travels to the object and back.
+	maxEcho := time.Duration(2 * float64(d.opts.MaxDistance) / speed * float64(time.Second))
+
+	// Discard edges left by a previous measurement.
+	for d.echo.WaitForEdge(0) {
+	}
+	if err := d.trig.Out(gpio.High); err != nil {
+		return 0, fmt.Errorf("hcsr04: %w", err)
+	}
+	time.Sleep(triggerPulse)
+	if err := d.trig.Out(gpio.Low); err != nil {
+		return 0, fmt.Errorf("hcsr04: %w", err)
+	}
+	if !d.waitLevel(gpio.High, echoStartTimeout) {
+		return 0, ErrTimeout
+	}
+	start := d.now()
+	// The sensor releases the echo pin by itself after about 38ms.
+	if !d.waitLevel(gpio.Low, maxEcho+40*time.Millisecond) {
+		return 0, ErrTimeout
+	}
+	echo := d.now().Sub(start)
+	if echo > maxEcho {
+		return 0, ErrNoEcho
+	}
+	return physic.Distance(echo.Seconds() * speed / 2), nil
+}
+
+// Sense takes Opts.Samples measurements, Opts.BurstInterval apart, and
+// returns their median. Failed measurements are ignored, unless they all
+// failed.
+func (d *Dev) Sense() (physic.Distance, error) {
+	var distances []physic.Distance
+	var err error
+	for ix := 0; ix < d.opts.Samples; ix++ {
+		if ix > 0 {
+			time.Sleep(d.opts.BurstInterval)
+		}
+		v, e := d.Measure()
+		if e != nil {
+			err = e
+			continue
+		}
+		distances = append(distances, v)
+	}
+	if len(distances) == 0 {
+		return 0, err
+	}
+	return median(distances), nil
+}
+
+// SenseContinuous starts a goroutine that sends the result of Sense() every
+// interval to the returned channel. Failed readings are dropped, see Err().
+// The channel is closed by Halt().
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Distance, error) {
+	if interval < d.opts.BurstInterval {
+		return nil, fmt.Errorf("hcsr04: interval %s shorter than %s", interval, d.opts.BurstInterval)
+	}
+	d.senseMu.Lock()
+	defer d.senseMu.Unlock()
+	if d.stop != nil {
+		return nil, errors.New("hcsr04: already sensing")
+	}
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
+	d.err = nil
+	readings := make(chan physic.Distance)
+	go d.sense(interval, readings, d.stop, d.done)
+	return readings, nil
+}
+
+// Err returns the error of the last failed reading of the goroutine started
+// by SenseContinuous(), if any.
+func (d *Dev) Err() error {
+	d.senseMu.Lock()
+	defer d.senseMu.Unlock()
+	return d.err
+}
+
+// Halt stops the goroutine started by SenseContinuous(), and waits for it to
+// exit.
+func (d *Dev) Halt() error {
+	d.senseMu.Lock()
+	stop, done := d.stop, d.done
+	d.stop = nil
+	d.senseMu.Unlock()
+	if stop == nil {
+		return nil
+	}
+	close(stop)
+	<-done
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("%s{%s, %s}", devName, d.trig, d.echo)
+}
+
+func (d *Dev) sense(interval time.Duration, readings chan<- physic.Distance, stop, done chan struct{}) {
+	defer close(done)
+	defer close(readings)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		v, err := d.Sense()
+		if err != nil {
+			d.senseMu.Lock()
+			d.err = err
+			d.senseMu.Unlock()
+		} else {
+			select {
+			case readings <- v:
+			case <-stop:
+				return
+			}
+		}
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// waitLevel waits for the echo pin to reach level.
+func (d *Dev) waitLevel(level gpio.Level, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for d.echo.Read() != level {
+		remaining := time.Until(deadline)
+		if remaining <= 0 || !d.echo.WaitForEdge(remaining) {
+			return false
+		}
+	}
+	return true
+}
+
+// speedOfSound returns the speed of sound in air at temperature t, in
+// nanometres per second.
+func speedOfSound(t physic.Temperature) float64 {
+	// 331.3 m/s at 0°C, plus 0.606 m/s per °C.
+	celsius := float64(t-physic.ZeroCelsius) / float64(physic.Kelvin)
+	return (331.3 + 0.606*celsius) * float64(physic.Metre)
+}
+
+// median returns the median of v, which is sorted.
+func median(v []physic.Distance) physic.Distance {
+	slices.Sort(v)
+	if len(v)%2 == 1 {
+		return v[len(v)/2]
+	}
+	return (v[len(v)/2-1] + v[len(v)/2]) / 2
+}
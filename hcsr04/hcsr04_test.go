@@ -0,0 +1,159 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hcsr04
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// sensor emulates an HC-SR04: the falling edge of the trigger pulse makes
+// the echo pin rise and fall, and the clock advances by the next echo time.
+type sensor struct {
+	gpiotest.Pin
+	echo   *gpiotest.Pin
+	echoes []time.Duration
+	now    time.Time
+	// pending is the echo time added to the clock at the falling edge of
+	// the echo pin.
+	pending time.Duration
+	reads   int
+}
+
+func (s *sensor) Out(l gpio.Level) error {
+	if s.L == gpio.High && l == gpio.Low && len(s.echoes) > 0 {
+		s.pending = s.echoes[0]
+		s.echoes = s.echoes[1:]
+		if s.pending >= 0 {
+			s.echo.EdgesChan <- gpio.High
+			s.echo.EdgesChan <- gpio.Low
+		}
+	}
+	return s.Pin.Out(l)
+}
+
+// clock returns the start time, then the start time plus the echo time.
+func (s *sensor) clock() time.Time {
+	s.reads++
+	if s.reads%2 == 0 {
+		return s.now.Add(s.pending)
+	}
+	return s.now
+}
+
+func newTestDev(t *testing.T, opts *Opts, echoes ...time.Duration) *Dev {
+	echo := &gpiotest.Pin{N: "echo", EdgesChan: make(chan gpio.Level, 2)}
+	s := &sensor{Pin: gpiotest.Pin{N: "trig"}, echo: echo, echoes: echoes}
+	d, err := New(s, echo, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.now = s.clock
+	return d
+}
+
+func TestMeasure(t *testing.T) {
+	d := newTestDev(t, nil, 5830*time.Microsecond)
+	v, err := d.Measure()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 343.42 m/s at 20°C.
+	if want := 1001 * physic.MilliMetre; v < want-physic.MilliMetre || v > want+physic.MilliMetre {
+		t.Errorf("Measure() = %s, want %s", v, want)
+	}
+}
+
+func TestMeasure_temperature(t *testing.T) {
+	d := newTestDev(t, nil, 5830*time.Microsecond)
+	d.SetTemperature(physic.ZeroCelsius)
+	v, err := d.Measure()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 331.3 m/s at 0°C.
+	if want := 965743 * physic.MicroMetre; v < want-physic.MilliMetre || v > want+physic.MilliMetre {
+		t.Errorf("Measure() = %s, want %s", v, want)
+	}
+}
+
+func TestMeasure_errors(t *testing.T) {
+	d := newTestDev(t, nil, 38*time.Millisecond, -1)
+	if _, err := d.Measure(); err != ErrNoEcho {
+		t.Errorf("Measure() = %v, want %v", err, ErrNoEcho)
+	}
+	if _, err := d.Measure(); err != ErrTimeout {
+		t.Errorf("Measure() = %v, want %v", err, ErrTimeout)
+	}
+}
+
+func TestSense(t *testing.T) {
+	opts := DefaultOpts
+	opts.Samples = 5
+	d := newTestDev(t, &opts,
+		5830*time.Microsecond,
+		// Outliers and failures are rejected by the median.
+		100*time.Microsecond,
+		-1,
+		5800*time.Microsecond,
+		5860*time.Microsecond)
+	v, err := d.Sense()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The median of 4 measurements is the average of the middle two.
+	if want := 1001 * physic.MilliMetre; v < want-3*physic.MilliMetre || v > want+3*physic.MilliMetre {
+		t.Errorf("Sense() = %s, want %s", v, want)
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	opts := DefaultOpts
+	opts.Samples = 1
+	d := newTestDev(t, &opts, 5830*time.Microsecond, 11660*time.Microsecond)
+	if _, err := d.SenseContinuous(time.Millisecond); err == nil {
+		t.Error("expected an error for a short interval")
+	}
+	readings, err := d.SenseContinuous(minBurstInterval)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, second := <-readings, <-readings
+	if second < 2*first-physic.MilliMetre || second > 2*first+physic.MilliMetre {
+		t.Errorf("readings %s and %s", first, second)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	for range readings {
+	}
+}
+
+func TestNew(t *testing.T) {
+	trig := &gpiotest.Pin{N: "trig"}
+	echo := &gpiotest.Pin{N: "echo"}
+	for _, opts := range []Opts{
+		{Samples: 0, BurstInterval: time.Second, MaxDistance: physic.Metre},
+		{Samples: 1, BurstInterval: time.Millisecond, MaxDistance: physic.Metre},
+		{Samples: 1, BurstInterval: time.Second},
+	} {
+		if _, err := New(trig, echo, &opts); err == nil {
+			t.Errorf("expected an error for %+v", opts)
+		}
+	}
+}
+
+func TestMedian(t *testing.T) {
+	if v := median([]physic.Distance{3, 1, 2}); v != 2 {
+		t.Errorf("median() = %d", v)
+	}
+	if v := median([]physic.Distance{4, 1, 2, 10}); v != 3 {
+		t.Errorf("median() = %d", v)
+	}
+}
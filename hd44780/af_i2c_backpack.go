@@ -5,7 +5,8 @@
 package hd44780
 
 import (
-	"periph.io/x/conn/v3/gpio"
+	"fmt"
+
 	"periph.io/x/conn/v3/i2c"
 	"periph.io/x/conn/v3/spi"
 	"periph.io/x/devices/v3/mcp23xxx"
@@ -30,25 +31,83 @@ const (
 //
 // https://www.adafruit.com/product/292
 //
-// The I2C side of this backpack uses an MCP23008 I/O expander. This function
-// creates an MCP23008 device with the required pin configuration. To use this,
+// The I2C side of this backpack uses an MCP23008 I/O expander. To use this,
 // get an I2C bus, and call this function with the bus, i2c address, number of
-// rows, and columns.
-func NewAdafruitI2CBackpack(bus i2c.Bus, address uint16, rows, cols int) (*HD44780, error) {
-	mcp, err := mcp23xxx.NewI2C(bus, mcp23xxx.MCP23008, address)
+// rows, and columns. Each byte written to the display is sent in a single I2C
+// transaction using mcp23xxx.Dev.WritePortSequence().
+//
+// If the MCP23008 does not respond at address, an error wrapping
+// ErrDeviceNotFound is returned.
+//
+// The backpack ties the R/W line of the display to ground, so the controller
+// can't be read back. Initialization can't be verified, and is only retried
+// if a bus write fails.
+//
+// For clone backpacks that wire the LCD to different MCP23008 pins, pass
+// WithPinMap().
+func NewAdafruitI2CBackpack(bus i2c.Bus, address uint16, rows, cols int, opts ...Option) (*HD44780, error) {
+	pm := applyOptions(opts).pinMap(AdafruitPinMap)
+	if err := pm.validate(8); err != nil {
+		return nil, err
+	}
+	if address&0xfff8 != 0x20 {
+		return nil, fmt.Errorf("hd44780: invalid MCP23008 address 0x%x", address)
+	}
+	dev, err := mcp23xxx.NewI2C(bus, mcp23xxx.MCP23008, address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: MCP23008 at 0x%x: %w", ErrDeviceNotFound, address, err)
+	}
+	return newMCPBackpack(fmt.Sprintf("MCP23008_%x", address), dev, pm, rows, cols, opts)
+}
+
+// NewMCP23S08Backpack returns a display connected to an MCP23S08 SPI I/O
+// expander with the same wiring as the Adafruit I2C backpack. address is the
+// hardware address (0-3) set by the A0 and A1 pins of the MCP23S08. For
+// other wiring, pass WithPinMap().
+func NewMCP23S08Backpack(conn spi.Conn, address uint8, rows, cols int, opts ...Option) (*HD44780, error) {
+	pm := applyOptions(opts).pinMap(AdafruitPinMap)
+	if err := pm.validate(8); err != nil {
+		return nil, err
+	}
+	if address > 3 {
+		return nil, fmt.Errorf("hd44780: invalid MCP23S08 hardware address %d", address)
+	}
+	dev, err := mcp23xxx.NewSPIAddress(conn, mcp23xxx.MCP23S08, address)
+	if err != nil {
+		return nil, err
+	}
+	return newMCPBackpack(fmt.Sprintf("MCP23S08_%d", address), dev, pm, rows, cols, opts)
+}
+
+// NewMCP23xxxBackpack returns a display connected to port 0 of an MCP23xxx
+// expander that has already been created, for example on a bus or with a
+// hardware address the other backpack functions don't handle. The display is
+// wired as the Adafruit I2C backpack, unless WithPinMap() is passed. As for
+// the Adafruit backpack, initialization can't be verified.
+func NewMCP23xxxBackpack(exp mcp23xxx.Expander, rows, cols int, opts ...Option) (*HD44780, error) {
+	pm := applyOptions(opts).pinMap(AdafruitPinMap)
+	if err := pm.validate(8); err != nil {
+		return nil, err
+	}
+	return newMCPBackpack(exp.String(), exp, pm, rows, cols, opts)
+}
+
+// newMCPBackpack returns a display connected to port 0 of exp, with the
+// pins in pm.
+func newMCPBackpack(name string, exp mcp23xxx.Expander, pm PinMap, rows, cols int, opts []Option) (*HD44780, error) {
+	mcp, err := newMCPExpander(exp, pm.mask())
 	if err != nil {
 		return nil, err
 	}
-	gr := *mcp.Group(0, []int{d4, d5, d6, d7, rsPin, enablePin, backlightPin})
-	reset, _ := gr.ByOffset(4).(gpio.PinOut)
-	enable, _ := gr.ByOffset(5).(gpio.PinOut)
-	bl := gr.ByOffset(6).(gpio.PinOut)
-	return NewHD44780(gr, reset, enable, NewBacklight(bl), rows, cols)
+	ew := newExpanderWriter(name, mcp, pm)
+	return NewHD44780CommandWriter(ew, ew.backlight(), rows, cols, opts...)
 }
 
 // This function returns a display configured to use the SPI side of the Adafruit
 // I2c/SPI backpack. The SPI side uses a 74HC595 Serial->Parallel shift register.
-func NewAdafruitSPIBackpack(conn spi.Conn, rows, cols int) (*HD44780, error) {
+// The shift register can't be read, so as for the I2C side, initialization is
+// only retried if a write fails.
+func NewAdafruitSPIBackpack(conn spi.Conn, rows, cols int, opts ...Option) (*HD44780, error) {
 	chip, err := nxp74hc595.New(conn)
 	if err != nil {
 		return nil, err
@@ -58,5 +117,5 @@ func NewAdafruitSPIBackpack(conn spi.Conn, rows, cols int) (*HD44780, error) {
 	rs := chip.Pins[rsPin]
 	e := chip.Pins[enablePin]
 	bl := chip.Pins[backlightPin]
-	return NewHD44780(gr, rs, e, NewBacklight(bl), rows, cols)
+	return NewHD44780(gr, rs, e, NewBacklight(bl), rows, cols, opts...)
 }
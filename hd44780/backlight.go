@@ -33,8 +33,13 @@ func (bl *GPIOMonoBacklight) Backlight(intensity display.Intensity) (err error)
 	return err
 }
 
+// Halt implements conn.Resource. It turns the backlight off.
+func (bl *GPIOMonoBacklight) Halt() error {
+	return bl.Backlight(0)
+}
+
 func (bl *GPIOMonoBacklight) String() string {
-	return fmt.Sprintf("%#v", bl)
+	return fmt.Sprintf("GPIOMonoBacklight{%s}", bl.blPin)
 }
 
 var _ display.DisplayBacklight = &GPIOMonoBacklight{}
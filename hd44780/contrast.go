@@ -0,0 +1,107 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hd44780
+
+import (
+	"fmt"
+
+	"periph.io/x/conn/v3/analog"
+	"periph.io/x/conn/v3/display"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// The maximum contrast value. Contrast values are in the range 0-255, the
+// same as the Matrix Orbital displays.
+const maxContrast display.Contrast = 0xff
+
+// DefaultContrastFrequency is the PWM frequency used by NewPWMContrast() when
+// freq is 0. The V0 pin must be filtered with an RC low pass filter to
+// produce a steady voltage.
+const DefaultContrastFrequency = 10 * physic.KiloHertz
+
+// PWMContrast sets the display contrast using a PWM pin connected to the V0
+// pin of the display through an RC filter.
+//
+// Implements display.DisplayContrast
+type PWMContrast struct {
+	pin  gpio.PinOut
+	freq physic.Frequency
+}
+
+// NewPWMContrast returns a PWMContrast that drives pin at freq. If freq is 0,
+// DefaultContrastFrequency is used.
+func NewPWMContrast(pin gpio.PinOut, freq physic.Frequency) *PWMContrast {
+	if freq == 0 {
+		freq = DefaultContrastFrequency
+	}
+	return &PWMContrast{pin: pin, freq: freq}
+}
+
+// Contrast sets the contrast. The range of the value is 0-255. The contrast
+// of the display increases as the voltage on V0 decreases, so the duty cycle
+// is the inverse of contrast.
+func (pc *PWMContrast) Contrast(contrast display.Contrast) error {
+	if err := checkContrast(contrast); err != nil {
+		return err
+	}
+	duty := gpio.Duty(int64(gpio.DutyMax) * int64(maxContrast-contrast) / int64(maxContrast))
+	return pc.pin.PWM(duty, pc.freq)
+}
+
+// Halt implements conn.Resource. It halts the pin, which stops the PWM.
+func (pc *PWMContrast) Halt() error {
+	return pc.pin.Halt()
+}
+
+func (pc *PWMContrast) String() string {
+	return fmt.Sprintf("PWMContrast{%s, %s}", pc.pin, pc.freq)
+}
+
+// DACContrast sets the display contrast using a DAC output connected to the
+// V0 pin of the display.
+//
+// Implements display.DisplayContrast
+type DACContrast struct {
+	pin analog.PinDAC
+}
+
+// NewDACContrast returns a DACContrast that drives pin. The full range of the
+// DAC is used, so the DAC reference should be no higher than the display
+// supply voltage.
+func NewDACContrast(pin analog.PinDAC) *DACContrast {
+	return &DACContrast{pin: pin}
+}
+
+// Contrast sets the contrast. The range of the value is 0-255. The contrast
+// of the display increases as the voltage on V0 decreases, so the DAC output
+// is the inverse of contrast.
+func (dc *DACContrast) Contrast(contrast display.Contrast) error {
+	if err := checkContrast(contrast); err != nil {
+		return err
+	}
+	low, high := dc.pin.Range()
+	span := int64(high.Raw - low.Raw)
+	return dc.pin.Out(low.Raw + int32(span*int64(maxContrast-contrast)/int64(maxContrast)))
+}
+
+// Halt implements conn.Resource. It halts the pin.
+func (dc *DACContrast) Halt() error {
+	return dc.pin.Halt()
+}
+
+func (dc *DACContrast) String() string {
+	return fmt.Sprintf("DACContrast{%s}", dc.pin)
+}
+
+func checkContrast(contrast display.Contrast) error {
+	if contrast < 0 || contrast > maxContrast {
+		return fmt.Errorf("hd44780: contrast %d out of range 0-%d", contrast, maxContrast)
+	}
+	return nil
+}
+
+var _ display.DisplayContrast = &PWMContrast{}
+var _ display.DisplayContrast = &DACContrast{}
@@ -0,0 +1,309 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hd44780
+
+import (
+	"fmt"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/mcp23xxx"
+	"periph.io/x/devices/v3/pcf857x"
+)
+
+// expander is the low level port access of an 8 bit I/O expander used by an
+// expanderWriter. It's implemented using the mcp23xxx and pcf857x drivers.
+type expander interface {
+	// writeLatch writes each value of p to the output latch, in order, in a
+	// single bus transaction.
+	writeLatch(p ...byte) error
+	// readPort returns the state of the expander pins. Only the pins in mask
+	// are valid, and they must have been written high.
+	readPort(mask byte) (byte, error)
+	// setInputs configures the pins in mask as inputs, and the remaining
+	// pins used by the display as outputs.
+	setInputs(mask byte) error
+}
+
+// expanderWriter is a CommandWriter that drives the display through an 8 bit
+// I/O expander. Using a gpio.Group, every nibble requires a separate bus
+// transaction for the data, enable high, and enable low states. The
+// expanderWriter keeps a shadow copy of the expander's output latch, and
+// sends all six strobe states for a byte in one transaction.
+type expanderWriter struct {
+	name string
+	exp  expander
+	pins PinMap
+	// latch is the shadow copy of the expander output latch.
+	latch byte
+	pacer
+}
+
+// newExpanderWriter returns an expanderWriter with the latch initialized to
+// all pins low.
+func newExpanderWriter(name string, exp expander, pins PinMap) *expanderWriter {
+	return &expanderWriter{name: name, exp: exp, pins: pins, pacer: newPacer()}
+}
+
+// WriteCommand writes instruction bytes to the display.
+func (ew *expanderWriter) WriteCommand(commands ...byte) error {
+	ew.delayWrite()
+	ew.setLatch(ew.pins.RS, false)
+	for _, command := range commands {
+		if err := ew.writeByte(command); err != nil {
+			ew.touch()
+			return err
+		}
+	}
+	ew.touch()
+	return nil
+}
+
+// WriteData writes character data to the display.
+func (ew *expanderWriter) WriteData(p []byte) (n int, err error) {
+	ew.delayWrite()
+	ew.setLatch(ew.pins.RS, true)
+	for _, byteVal := range p {
+		if err = ew.writeByte(byteVal); err != nil {
+			break
+		}
+		n += 1
+		ew.delayCharacter()
+	}
+	ew.touch()
+	return
+}
+
+// Halt implements conn.Resource. The expander and display are left in their
+// current state.
+func (ew *expanderWriter) Halt() error {
+	return nil
+}
+
+func (ew *expanderWriter) String() string {
+	return ew.name
+}
+
+// InitInterface performs the 4 bit startup sequence for the controller.
+func (ew *expanderWriter) InitInterface(function byte, model Model) (byte, error) {
+	ew.setLatch(ew.pins.RS, false)
+	ew.setLatch(ew.pins.E, false)
+	if ew.pins.RW >= 0 {
+		ew.setLatch(ew.pins.RW, false)
+	}
+	q := model.quirks()
+	ew.sleep(q.powerOnDelay)
+	if q.resync {
+		// See gpioWriter.initResync()
+		for _, nibble := range []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x02} {
+			if err := ew.writeNibbles(nibble); err != nil {
+				return function, err
+			}
+		}
+		return function, ew.WriteCommand(function)
+	}
+	if err := ew.writeNibbles(0x03); err != nil {
+		return function, err
+	}
+	ew.sleep(4100 * time.Microsecond)
+	if err := ew.writeNibbles(0x03, 0x03, 0x02); err != nil {
+		return function, err
+	}
+	return function, ew.WriteCommand(function)
+}
+
+// readStatus reads the busy flag and address counter from the controller.
+// If the R/W line isn't connected to the expander, errStatusNotSupported is
+// returned.
+func (ew *expanderWriter) readStatus() (busy bool, address byte, err error) {
+	if ew.pins.RW < 0 {
+		return false, 0, errStatusNotSupported
+	}
+	// Setting the data pins high allows quasi-bidirectional expanders like
+	// the PCF8574 to read them.
+	ew.setLatch(ew.pins.RS, false)
+	ew.setLatch(ew.pins.RW, true)
+	ew.latch |= ew.nibbleBits(0x0f)
+	if err = ew.exp.writeLatch(ew.latch); err != nil {
+		return
+	}
+	if err = ew.exp.setInputs(ew.nibbleBits(0x0f)); err != nil {
+		return
+	}
+	defer func() {
+		ew.setLatch(ew.pins.RW, false)
+		if e := ew.exp.setInputs(0); err == nil {
+			err = e
+		}
+		if e := ew.exp.writeLatch(ew.latch); err == nil {
+			err = e
+		}
+	}()
+	var value byte
+	for range 2 {
+		var nibble byte
+		if nibble, err = ew.readNibble(); err != nil {
+			return
+		}
+		value = value<<4 | nibble
+	}
+	return value&0x80 != 0, value & 0x7f, nil
+}
+
+// readNibble raises enable, reads the data pins, and lowers enable.
+func (ew *expanderWriter) readNibble() (byte, error) {
+	e := byte(1) << ew.pins.E
+	if err := ew.exp.writeLatch(ew.latch | e); err != nil {
+		return 0, err
+	}
+	port, err := ew.exp.readPort(ew.nibbleBits(0x0f))
+	if e := ew.exp.writeLatch(ew.latch); err == nil {
+		err = e
+	}
+	var nibble byte
+	for ix, pin := range ew.pins.Data {
+		if port&(1<<pin) != 0 {
+			nibble |= 1 << ix
+		}
+	}
+	return nibble, err
+}
+
+// writeByte writes value as two nibbles in one bus transaction.
+func (ew *expanderWriter) writeByte(value byte) error {
+	return ew.writeNibbles(value>>4, value&0x0f)
+}
+
+// writeNibbles writes the data, enable high, and enable low states for each
+// nibble in one bus transaction. The values of register select and the
+// backlight are taken from the latch.
+func (ew *expanderWriter) writeNibbles(nibbles ...byte) error {
+	e := byte(1) << ew.pins.E
+	base := ew.latch &^ (ew.nibbleBits(0x0f) | e)
+	p := make([]byte, 0, 3*len(nibbles))
+	for _, nibble := range nibbles {
+		setup := base | ew.nibbleBits(nibble)
+		p = append(p, setup, setup|e, setup)
+	}
+	if err := ew.exp.writeLatch(p...); err != nil {
+		return err
+	}
+	ew.latch = p[len(p)-1]
+	return nil
+}
+
+// nibbleBits returns the latch bits for the data pins with the value of
+// nibble.
+func (ew *expanderWriter) nibbleBits(nibble byte) byte {
+	var bits byte
+	for ix, pin := range ew.pins.Data {
+		if nibble&(1<<ix) != 0 {
+			bits |= 1 << pin
+		}
+	}
+	return bits
+}
+
+// setLatch sets the shadow latch bit for pin. It doesn't write to the
+// expander.
+func (ew *expanderWriter) setLatch(pin int, high bool) {
+	if high {
+		ew.latch |= 1 << pin
+	} else {
+		ew.latch &^= 1 << pin
+	}
+}
+
+// backlight returns a PinOut for the backlight, or nil if the backlight isn't
+// connected to the expander.
+func (ew *expanderWriter) backlight() any {
+	if ew.pins.Backlight < 0 {
+		return nil
+	}
+	return NewBacklight(&latchPin{ew: ew, number: ew.pins.Backlight})
+}
+
+// latchPin is an expander output pin that is written using the shadow latch
+// of an expanderWriter.
+type latchPin struct {
+	ew     *expanderWriter
+	number int
+}
+
+func (lp *latchPin) String() string   { return lp.Name() }
+func (lp *latchPin) Halt() error      { return nil }
+func (lp *latchPin) Name() string     { return fmt.Sprintf("%s_GPIO%d", lp.ew.name, lp.number) }
+func (lp *latchPin) Number() int      { return lp.number }
+func (lp *latchPin) Function() string { return "Out" }
+
+func (lp *latchPin) Out(l gpio.Level) error {
+	lp.ew.setLatch(lp.number, bool(l))
+	return lp.ew.exp.writeLatch(lp.ew.latch)
+}
+
+func (lp *latchPin) PWM(gpio.Duty, physic.Frequency) error {
+	return fmt.Errorf("hd44780: PWM not supported on %s", lp)
+}
+
+// mcpExpander is an expander for port 0 of an MCP23xxx device.
+type mcpExpander struct {
+	dev mcp23xxx.Expander
+	// outputs is the set of pins used by the display.
+	outputs byte
+}
+
+// newMCPExpander clears the output latch, and sets the pins used by the
+// display as outputs. The other pins are left unchanged.
+func newMCPExpander(dev mcp23xxx.Expander, outputs byte) (*mcpExpander, error) {
+	exp := &mcpExpander{dev: dev, outputs: outputs}
+	if err := exp.writeLatch(0); err != nil {
+		return nil, err
+	}
+	return exp, exp.setInputs(0)
+}
+
+func (mcp *mcpExpander) writeLatch(p ...byte) error {
+	return mcp.dev.WritePortSequence(0, p...)
+}
+
+func (mcp *mcpExpander) readPort(mask byte) (byte, error) {
+	return mcp.dev.ReadPort(0)
+}
+
+func (mcp *mcpExpander) setInputs(mask byte) error {
+	return mcp.dev.SetDirectionMasked(uint16(mcp.outputs), uint16(mask&mcp.outputs))
+}
+
+// pcfExpander is an expander for a PCF8574.
+type pcfExpander struct {
+	dev *pcf857x.Dev
+}
+
+func (pcf *pcfExpander) writeLatch(p ...byte) error {
+	values := make([]uint16, len(p))
+	for ix, b := range p {
+		values[ix] = uint16(b)
+	}
+	return pcf.dev.WriteSequence(values...)
+}
+
+func (pcf *pcfExpander) readPort(mask byte) (byte, error) {
+	v, err := pcf.dev.ReadGPIOMasked(uint16(mask))
+	return byte(v), err
+}
+
+// setInputs is a no-op. PCF8574 pins are quasi-bidirectional, and are read by
+// setting the output high.
+func (pcf *pcfExpander) setInputs(mask byte) error {
+	return nil
+}
+
+var _ CommandWriter = &expanderWriter{}
+var _ InterfaceInitializer = &expanderWriter{}
+var _ statusReader = &expanderWriter{}
+var _ conn.Resource = &expanderWriter{}
+var _ gpio.PinOut = &latchPin{}
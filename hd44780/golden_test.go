@@ -0,0 +1,64 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hd44780
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"periph.io/x/conn/v3/display"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/devices/v3/conntrace"
+)
+
+var update = flag.Bool("update", false, "update the golden traces in testdata")
+
+// TestGolden compares the bytes written by the backpacks to the traces in
+// testdata, so that changes of the write paths are verified byte for byte.
+func TestGolden(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		open func(b i2c.Bus) (*HD44780, error)
+	}{
+		{"pcf8574", func(b i2c.Bus) (*HD44780, error) { return NewPCF857xBackpack(b, 0x27, 2, 16) }},
+		{"adafruit", func(b i2c.Bus) (*HD44780, error) { return NewAdafruitI2CBackpack(b, 0x20, 2, 16) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := conntrace.NewRecorder()
+			lcd, err := tc.open(r.Bus(&nullBus{}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			initTrace := r.Trace()
+			if err := conntrace.Golden(filepath.Join("testdata", tc.name+"_init.trace"), initTrace, *update); err != nil {
+				t.Error(err)
+			}
+			if err := lcd.Clear(); err != nil {
+				t.Fatal(err)
+			}
+			if err := lcd.MoveTo(2, 3); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := lcd.WriteString("Hi"); err != nil {
+				t.Fatal(err)
+			}
+			if err := lcd.Cursor(display.CursorBlink); err != nil {
+				t.Fatal(err)
+			}
+			if err := lcd.SetCustomChar(1, [8]byte{0x00, 0x0a, 0x00, 0x11, 0x0e}); err != nil {
+				t.Fatal(err)
+			}
+			if err := lcd.Backlight(0); err != nil {
+				t.Fatal(err)
+			}
+			ops := r.Trace()
+			ops.Txs = ops.Txs[len(initTrace.Txs):]
+			if err := conntrace.Golden(filepath.Join("testdata", tc.name+"_ops.trace"), ops, *update); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hd44780
+
+import (
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+)
+
+type writeMode bool
+
+type ifMode byte
+
+const (
+	modeCommand writeMode = false
+	modeData    writeMode = true
+
+	mode4Bit ifMode = 0x04
+	mode8Bit ifMode = 0x08
+)
+
+// gpioWriter is a CommandWriter that drives the display controller using a
+// gpio.Group for the data lines, and discrete pins for register select and
+// enable.
+type gpioWriter struct {
+	dataPins  gpio.Group
+	resetPin  gpio.PinOut
+	enablePin gpio.PinOut
+	// rwPin is the optional read/write pin set by WithRWPin(). If it's nil,
+	// the controller status can't be read.
+	rwPin gpio.PinOut
+	mode  ifMode
+	pacer
+}
+
+// newGPIOWriter returns a gpioWriter. If dataPinGroup is 8 or more pins, then
+// it's assumed the display is connected using all 8 data lines.
+func newGPIOWriter(dataPinGroup gpio.Group, resetPin, enablePin gpio.PinOut) *gpioWriter {
+	mode := mode4Bit
+	if len(dataPinGroup.Pins()) >= 8 {
+		mode = mode8Bit
+	}
+	return &gpioWriter{
+		dataPins:  dataPinGroup,
+		resetPin:  resetPin,
+		enablePin: enablePin,
+		mode:      mode,
+		pacer:     newPacer(),
+	}
+}
+
+// WriteCommand writes instruction bytes to the display.
+func (gw *gpioWriter) WriteCommand(commands ...byte) error {
+	gw.delayWrite()
+	err := gw.resetPin.Out(gpio.Level(modeCommand))
+	if err != nil {
+		return err
+	}
+	for _, command := range commands {
+		if gw.mode == mode4Bit {
+			err = gw.write4Bits(byte(command >> 4))
+			if err == nil {
+				err = gw.write4Bits(byte(command))
+			}
+		} else {
+			err = gw.write8Bits(command)
+		}
+		if err != nil {
+			break
+		}
+
+	}
+	gw.touch()
+	return err
+}
+
+// WriteData writes character data to the display.
+func (gw *gpioWriter) WriteData(p []byte) (n int, err error) {
+	gw.delayWrite()
+	err = gw.resetPin.Out(gpio.Level(modeData))
+	if err != nil {
+		return
+	}
+
+	for _, byteVal := range p {
+		gw.touch()
+		if gw.mode == mode4Bit {
+			err = gw.write4Bits(byteVal >> 4)
+			if err == nil {
+				err = gw.write4Bits(byteVal & 0x0f)
+			}
+		} else {
+			err = gw.write8Bits(byteVal)
+		}
+		if err != nil {
+			return
+		}
+		n += 1
+		gw.delayCharacter()
+	}
+	gw.touch()
+	return
+}
+
+// Halt calls Halt() for the data pins gpio.Group.
+func (gw *gpioWriter) Halt() error {
+	return gw.dataPins.Halt()
+}
+
+func (gw *gpioWriter) String() string {
+	return gw.dataPins.String()
+}
+
+// InitInterface performs the startup sequence for the Hitachi HD44780U chip
+// as documented in the Datasheet. The HD44780 has a fairly complex
+// initialization cycle with variations for 4 and 8 pin mode.
+func (gw *gpioWriter) InitInterface(function byte, model Model) (byte, error) {
+	q := model.quirks()
+	gw.sleep(q.powerOnDelay)
+	if q.resync {
+		return gw.initResync(function)
+	}
+	gw.touch()
+	if gw.rwPin != nil {
+		if err := gw.rwPin.Out(gpio.Low); err != nil {
+			return function, err
+		}
+	}
+	err := gw.resetPin.Out(gpio.Level(modeCommand))
+	if err != nil {
+		return function, err
+	}
+	err = gw.enablePin.Out(gpio.Low)
+	if err != nil {
+		return function, err
+	}
+	if gw.mode == mode4Bit {
+		err = gw.write4Bits(0x03)
+		if err != nil {
+			return function, err
+		}
+		gw.sleep(4100 * time.Microsecond)
+		for _, nibble := range []byte{0x03, 0x03, 0x02} {
+			if err = gw.write4Bits(nibble); err != nil {
+				return function, err
+			}
+		}
+		err = gw.WriteCommand(function)
+	} else {
+		// Init the display for 8 pin operation.
+		function |= 0x10               // Set the interface to 8 bits
+		err = gw.write8Bits(0x03 << 4) // Get it's attention
+		if err != nil {
+			return function, err
+		}
+		gw.sleep(4100 * time.Microsecond)
+		// Repeat the attention command, set the function, and set entry mode.
+		for _, value := range []byte{0x03 << 4, 0x03 << 4, function, 0x04} {
+			if err = gw.write8Bits(value); err != nil {
+				return function, err
+			}
+		}
+	}
+	return function, err
+}
+
+// initResync performs the startup sequence for the Winstar WS0010 OLED
+// controller. The WS0010 doesn't reset its interface when power is applied
+// to the logic but not the display, so in 4 bit mode it may be waiting for
+// the second half of a byte. Writing five zero nibbles resynchronizes the
+// interface regardless of its state. The busy flag isn't valid until
+// initialization completes, so fixed delays are used.
+func (gw *gpioWriter) initResync(function byte) (byte, error) {
+	gw.touch()
+	if gw.rwPin != nil {
+		if err := gw.rwPin.Out(gpio.Low); err != nil {
+			return function, err
+		}
+	}
+	err := gw.resetPin.Out(gpio.Level(modeCommand))
+	if err != nil {
+		return function, err
+	}
+	if err = gw.enablePin.Out(gpio.Low); err != nil {
+		return function, err
+	}
+	if gw.mode == mode4Bit {
+		for range 5 {
+			if err = gw.write4Bits(0x00); err != nil {
+				return function, err
+			}
+		}
+		if err = gw.write4Bits(0x02); err != nil {
+			return function, err
+		}
+	} else {
+		function |= 0x10
+	}
+	return function, gw.WriteCommand(function)
+}
+
+// readStatus reads the busy flag and address counter from the controller.
+// If the R/W pin isn't available, errStatusNotSupported is returned.
+func (gw *gpioWriter) readStatus() (busy bool, address byte, err error) {
+	if gw.rwPin == nil {
+		return false, 0, errStatusNotSupported
+	}
+	if err = gw.resetPin.Out(gpio.Level(modeCommand)); err != nil {
+		return
+	}
+	if err = gw.rwPin.Out(gpio.High); err != nil {
+		return
+	}
+	defer func() {
+		if e := gw.rwPin.Out(gpio.Low); err == nil {
+			err = e
+		}
+	}()
+	var value byte
+	if gw.mode == mode4Bit {
+		var high, low byte
+		if high, err = gw.readBits(0x0f); err != nil {
+			return
+		}
+		if low, err = gw.readBits(0x0f); err != nil {
+			return
+		}
+		value = high<<4 | low
+	} else if value, err = gw.readBits(0xff); err != nil {
+		return
+	}
+	return value&0x80 != 0, value & 0x7f, nil
+}
+
+// readBits raises enable, reads the data pins, and lowers enable.
+func (gw *gpioWriter) readBits(mask gpio.GPIOValue) (byte, error) {
+	err := gw.enablePin.Out(gpio.High)
+	if err != nil {
+		return 0, err
+	}
+	v, err := gw.dataPins.Read(mask)
+	if e := gw.enablePin.Out(gpio.Low); err == nil {
+		err = e
+	}
+	return byte(v), err
+}
+
+func (gw *gpioWriter) write4Bits(value byte) error {
+	return gw.writeBits(gpio.GPIOValue(value), 0x0f)
+}
+
+func (gw *gpioWriter) write8Bits(value byte) error {
+	return gw.writeBits(gpio.GPIOValue(value), 0xff)
+}
+
+func (gw *gpioWriter) writeBits(value, mask gpio.GPIOValue) error {
+	err := gw.dataPins.Out(value, mask)
+	if err != nil {
+		return err
+	}
+	err = gw.enablePin.Out(gpio.High)
+	if err == nil {
+		time.Sleep(2 * time.Microsecond)
+		err = gw.enablePin.Out(gpio.Low)
+	}
+	return err
+}
+
+var _ CommandWriter = &gpioWriter{}
+var _ InterfaceInitializer = &gpioWriter{}
+var _ statusReader = &gpioWriter{}
+var _ conn.Resource = &gpioWriter{}
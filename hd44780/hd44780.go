@@ -10,26 +10,81 @@
 package hd44780
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sync"
 	"time"
 
 	"periph.io/x/conn/v3"
 	"periph.io/x/conn/v3/display"
 	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/devices/v3/internal/wait"
 )
 
-type writeMode bool
+// CommandWriter is the transport between the HD44780 driver and the display
+// controller. It separates instructions from display data so that any byte
+// value can be written as data.
+type CommandWriter interface {
+	// WriteCommand writes one or more instruction bytes to the controller
+	// with the register select line low.
+	WriteCommand(cmds ...byte) error
+	// WriteData writes bytes to the controller's display or character
+	// generator RAM with the register select line high.
+	WriteData(p []byte) (int, error)
+}
 
-type ifMode byte
+// InterfaceInitializer is implemented by CommandWriters that must perform the
+// HD44780 interface initialization sequence before commands can be sent. For
+// example, a CommandWriter for a 4 bit interface implements it to send the
+// 8 bit function set nibbles that switch the controller to 4 bit mode. If a
+// CommandWriter doesn't implement it, the function set command for an 8 bit
+// interface is sent instead.
+type InterfaceInitializer interface {
+	// InitInterface performs the initialization sequence for the controller
+	// model using the function set value function, which has the data length
+	// bit clear, and returns the function set value written.
+	InitInterface(function byte, model Model) (byte, error)
+}
 
-const (
-	modeCommand writeMode = false
-	modeData    writeMode = true
+// statusReader is implemented by CommandWriters that can read the busy flag
+// and address counter of the controller.
+type statusReader interface {
+	// readStatus returns the busy flag and address counter. If the transport
+	// can't read the controller, errStatusNotSupported is returned.
+	readStatus() (busy bool, address byte, err error)
+}
 
-	cmdByte byte = 0xfe
+var (
+	// ErrInitFailed is returned when the display could not be initialized
+	// after retrying, or when verification of the initialization failed.
+	ErrInitFailed = errors.New("hd44780: display initialization failed")
+	// ErrDeviceNotFound is returned by the backpack constructors when the I/O
+	// expander does not respond. It wraps the error returned by the bus, so
+	// applications can detect a missing display and run without it.
+	ErrDeviceNotFound = errors.New("hd44780: device not found")
 
-	mode4Bit ifMode = 0x04
-	mode8Bit ifMode = 0x08
+	// ErrRawNotAllowed is returned by SendRaw() when a command isn't
+	// allowed.
+	ErrRawNotAllowed = errors.New("hd44780: raw command not allowed")
+
+	errStatusNotSupported = errors.New("hd44780: reading status not supported")
+)
+
+const (
+	// The number of times init is attempted.
+	initAttempts = 3
+	// The delay between init attempts. This is longer than the power on
+	// delay required by the controller.
+	initRetryDelay = 50 * time.Millisecond
+	// How long to wait for the controller busy flag to clear.
+	busyTimeout = 10 * time.Millisecond
+
+	// Winstar WS0010 cursor/display shift commands that select character
+	// mode, and turn the internal power on or off.
+	winstarPowerOn  byte = 0x17
+	winstarPowerOff byte = 0x13
 )
 
 // HD44780 is an implementation that supports writing to LCD displays using a
@@ -37,31 +92,61 @@ const (
 // and backlight pins.
 //
 // Implements periph.io/conn/x/display/TextDisplay and display.DisplayBacklight
+//
+// An HD44780 is safe for concurrent use by multiple goroutines. Each method
+// holds a lock while it communicates with the display, so the commands and
+// data of concurrent calls are never interleaved. A sequence of calls, for
+// example MoveTo() followed by WriteString(), is not atomic. Goroutines that
+// share a display and need a sequence to be uninterrupted must provide their
+// own synchronization.
 type HD44780 struct {
-	dataPins  gpio.Group
-	resetPin  gpio.PinOut
-	enablePin gpio.PinOut
-	blMono    display.DisplayBacklight
-	blRGB     display.DisplayRGBBacklight
-	mode      ifMode
-	rows      int
-	cols      int
-	on        bool
-	cursor    bool
-	blink     bool
-	lastWrite int64
+	mu     sync.Mutex
+	cw     CommandWriter
+	model  Model
+	blMono display.DisplayBacklight
+	blRGB  display.DisplayRGBBacklight
+	// contrast is the optional contrast control. See WithContrast().
+	contrast display.DisplayContrast
+	// rawAllowed checks commands passed to SendRaw(). See
+	// WithRawAllowlist().
+	rawAllowed func(cmd byte) bool
+	rows       int
+	cols       int
+	on         bool
+	cursor     bool
+	blink      bool
+	// The function set command value written during init.
+	function byte
+	// rowMap translates logical rows to physical rows when double height
+	// mode is in use. nil if double height mode is off. doubleHeight is the
+	// value passed to SetDoubleHeight().
+	rowMap       []int
+	doubleHeight []int
+	// customChars is the character generator RAM patterns set by
+	// SetCustomChar(). screen is a copy of the display RAM contents, by
+	// physical row and column. They're used to restore the display by
+	// ReInit().
+	customChars [8]*[8]byte
+	screen      [][]byte
+	// The logical cursor position, and whether writes wrap to the next row.
+	row      int
+	col      int
+	autoWrap bool
+	// split is true for 1 row displays with the right half of the columns
+	// on the second line. See WithSplitDDRAM().
+	split bool
 }
 
+var rowConstants = [][]byte{{0, 0, 64}, {0, 0, 64, 20, 84}}
+
 const (
-	delayCommand   time.Duration = 2000
-	delayCharacter time.Duration = 200
+	clearScreen       byte = 0x01
+	goHome            byte = 0x02
+	entryMode         byte = 0x06
+	setCGRAMAddress   byte = 0x40
+	setCursorPosition byte = 0x80
 )
 
-var rowConstants = [][]byte{{0, 0, 64}, {0, 0, 64, 20, 84}}
-var clearScreen = []byte{cmdByte, 0x01}
-var goHome = []byte{cmdByte, 0x02}
-var setCursorPosition = []byte{cmdByte, 0x80}
-
 // Return the row offset value
 func getRowConstant(row, maxcols int) byte {
 	var offset int
@@ -79,27 +164,56 @@ func getRowConstant(row, maxcols int) byte {
 // bit mode, D0-D7. If dataPinGroup is 8 or more pins, then it's assumed the
 // display is connected using all 8 pins.
 //
+// If the R/W line of the display is connected to a host pin, pass
+// WithRWPin() so that initialization can be verified.
+//
 // backlight should implement either display.DisplayBacklight or
 // display.DisplayRGBBacklight. See GPIOMonoBacklight.
 func NewHD44780(
 	dataPinGroup gpio.Group,
 	resetPin, enablePin gpio.PinOut,
 	backlight any,
-	rows, cols int) (*HD44780, error) {
+	rows, cols int,
+	opts ...Option) (*HD44780, error) {
 
-	mode := mode4Bit
-	if len(dataPinGroup.Pins()) >= 8 {
-		mode = mode8Bit
-	}
+	gw := newGPIOWriter(dataPinGroup, resetPin, enablePin)
+	gw.rwPin = applyOptions(opts).rw
+	return NewHD44780CommandWriter(gw, backlight, rows, cols, opts...)
+}
 
+// NewHD44780CommandWriter returns an HD44780 device that communicates with
+// the display controller using cw. It's used for transports that don't
+// expose the controller lines as a gpio.Group, for example controllers with
+// a native I2C or SPI interface. The device is returned in an initialized
+// state and ready for use, unless WithoutInit() is passed.
+//
+// backlight should implement either display.DisplayBacklight or
+// display.DisplayRGBBacklight.
+func NewHD44780CommandWriter(cw CommandWriter, backlight any, rows, cols int, opts ...Option) (*HD44780, error) {
+	o := applyOptions(opts)
+	if o.split && (rows != 1 || cols%2 != 0) {
+		return nil, fmt.Errorf("hd44780: split display RAM requires 1 row and an even number of columns, not %dx%d", rows, cols)
+	}
 	lcd := &HD44780{
-		dataPins:  dataPinGroup,
-		resetPin:  resetPin,
-		enablePin: enablePin,
-		mode:      mode,
-		rows:      rows,
-		cols:      cols,
-		on:        true,
+		cw:         cw,
+		rows:       rows,
+		cols:       cols,
+		on:         true,
+		model:      o.model,
+		split:      o.split,
+		contrast:   o.contrast,
+		rawAllowed: o.raw,
+		screen:     make([][]byte, rows),
+	}
+	for ix := range lcd.screen {
+		lcd.screen[ix] = make([]byte, cols)
+	}
+	if p, ok := cw.(paced); ok {
+		if o.pacing != nil {
+			p.setPacing(o.pacing[0], o.pacing[1])
+		} else if delay := lcd.model.quirks().characterDelay; delay > 0 {
+			p.setPacing(DefaultCommandDelay, delay)
+		}
 	}
 	switch bl := backlight.(type) {
 	case display.DisplayBacklight:
@@ -107,7 +221,21 @@ func NewHD44780(
 	case display.DisplayRGBBacklight:
 		lcd.blRGB = bl
 	}
-	return lcd, lcd.init()
+	if o.noInit {
+		if err := lcd.attach(); err != nil {
+			return nil, err
+		}
+	} else if err := lcd.init(context.Background()); err != nil {
+		return nil, err
+	}
+	if lcd.model == ModelAuto {
+		model, err := lcd.detectModel()
+		if err != nil {
+			return nil, err
+		}
+		lcd.model = model
+	}
+	return lcd, nil
 }
 
 // Not supported by this device. Returns display.ErrNotImplemented
@@ -118,7 +246,24 @@ func (lcd *HD44780) AutoScroll(enabled bool) error {
 
 // Clears the screen and moves the cursor to the first position.
 func (lcd *HD44780) Clear() error {
-	_, err := lcd.Write(clearScreen)
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	return lcd.clear()
+}
+
+func (lcd *HD44780) clear() error {
+	err := lcd.sendCommand(clearScreen)
+	if err == nil {
+		for _, row := range lcd.screen {
+			for ix := range row {
+				row[ix] = ' '
+			}
+		}
+		lcd.row, lcd.col = 1, 1
+		if delay := lcd.model.quirks().clearDelay; delay > 0 {
+			lcd.sleep(delay)
+		}
+	}
 	return err
 }
 
@@ -129,7 +274,13 @@ func (lcd *HD44780) Cols() int {
 
 // Set the cursor mode. You can pass multiple arguments.
 // Cursor(CursorOff, CursorUnderline)
-func (lcd *HD44780) Cursor(modes ...display.CursorMode) (err error) {
+func (lcd *HD44780) Cursor(modes ...display.CursorMode) error {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	return lcd.setCursor(modes...)
+}
+
+func (lcd *HD44780) setCursor(modes ...display.CursorMode) (err error) {
 	var val = byte(0x08)
 	if lcd.on {
 		val |= 0x04
@@ -158,13 +309,21 @@ func (lcd *HD44780) Cursor(modes ...display.CursorMode) (err error) {
 			return
 		}
 	}
-	_, err = lcd.Write([]byte{cmdByte, val & 0x0f})
-	return err
+	return lcd.sendCommand(val & 0x0f)
 }
 
 // Move the cursor home (MinRow(),MinCol())
-func (lcd *HD44780) Home() (err error) {
-	_, err = lcd.Write(goHome)
+func (lcd *HD44780) Home() error {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	return lcd.home()
+}
+
+func (lcd *HD44780) home() (err error) {
+	err = lcd.sendCommand(goHome)
+	if err == nil {
+		lcd.row, lcd.col = 1, 1
+	}
 	return err
 }
 
@@ -180,35 +339,76 @@ func (lcd *HD44780) MinRow() int {
 
 // Move the cursor forward or backward.
 func (lcd *HD44780) Move(dir display.CursorDirection) (err error) {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
 	var val byte = 0x10
+	delta := -1
 	switch dir {
 	case display.Backward:
 	case display.Forward:
 		val |= 0x04
+		delta = 1
 	case display.Down, display.Up:
 		fallthrough
 	default:
 		err = fmt.Errorf("hd44780: %w", display.ErrNotImplemented)
 		return
 	}
-	_, err = lcd.Write([]byte{cmdByte, val})
-	return
+	err = lcd.sendCommand(val)
+	if err == nil {
+		lcd.col = max(lcd.col+delta, 1)
+	}
+	return err
+}
+
+// Move the cursor to arbitrary position. If double height mode is enabled,
+// row is the logical row. See SetDoubleHeight().
+func (lcd *HD44780) MoveTo(row, col int) error {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	return lcd.moveTo(row, col)
 }
 
-// Move the cursor to arbitrary position.
-func (lcd *HD44780) MoveTo(row, col int) (err error) {
-	if row < lcd.MinRow() || row > lcd.rows || col < lcd.MinCol() || col > lcd.cols {
+func (lcd *HD44780) moveTo(row, col int) (err error) {
+	if row < lcd.MinRow() || row > lcd.logicalRows() || col < lcd.MinCol() || col > lcd.cols {
 		err = fmt.Errorf("HD44780.MoveTo(%d,%d) value out of range", row, col)
 		return
 	}
-	var cmd = []byte{cmdByte, setCursorPosition[1]}
-	cmd[1] |= getRowConstant(row, lcd.cols) + byte(col-1)
-	_, err = lcd.Write(cmd)
-	return
+	err = lcd.sendCommand(setCursorPosition | lcd.address(lcd.physicalRow(row), col))
+	if err == nil {
+		lcd.row, lcd.col = row, col
+	}
+	return err
+}
+
+// physicalRow returns the physical row for the logical row.
+func (lcd *HD44780) physicalRow(row int) int {
+	if lcd.rowMap != nil {
+		return lcd.rowMap[row-1]
+	}
+	return row
+}
+
+// address returns the display RAM address for the physical row and column.
+func (lcd *HD44780) address(physicalRow, col int) byte {
+	if half := lcd.cols / 2; lcd.split && col > half {
+		return getRowConstant(2, lcd.cols) + byte(col-half-1)
+	}
+	return getRowConstant(physicalRow, lcd.cols) + byte(col-1)
 }
 
-// Return the number of rows the display supports.
+// Return the number of rows the display supports. If double height mode is
+// enabled, this is the number of logical rows.
 func (lcd *HD44780) Rows() int {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	return lcd.logicalRows()
+}
+
+func (lcd *HD44780) logicalRows() int {
+	if lcd.rowMap != nil {
+		return len(lcd.rowMap)
+	}
 	return lcd.rows
 }
 
@@ -219,6 +419,12 @@ func (lcd *HD44780) String() string {
 
 // Turn the display on / off
 func (lcd *HD44780) Display(on bool) error {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	return lcd.setDisplay(on)
+}
+
+func (lcd *HD44780) setDisplay(on bool) error {
 	lcd.on = on
 	val := byte(0x08)
 	if on {
@@ -230,64 +436,181 @@ func (lcd *HD44780) Display(on bool) error {
 	if lcd.cursor {
 		val |= 0x02
 	}
-	_, err := lcd.Write([]byte{cmdByte, val})
-	return err
+	if err := lcd.sendCommand(val); err != nil || !lcd.model.quirks().powerControl {
+		return err
+	}
+	// Select character mode, and turn the internal power on or off. It's a
+	// separate write, so that the command delay elapses after the display
+	// control command.
+	power := byte(winstarPowerOff)
+	if on {
+		power = winstarPowerOn
+	}
+	return lcd.sendCommand(power)
+}
 
+// doubleHeightLayouts maps the top row of each double height block for a 4
+// row display to the UD2/UD1 bits of the double height command, and the
+// physical row for each logical row.
+var doubleHeightLayouts = []struct {
+	rows   []int
+	ud     byte
+	rowMap []int
+}{
+	{[]int{1}, 0x00, []int{1, 3, 4}},
+	{[]int{2}, 0x04, []int{1, 2, 4}},
+	{[]int{1, 3}, 0x08, []int{1, 3}},
+	{[]int{3}, 0x0c, []int{1, 2, 3}},
 }
 
-// Write a set of bytes to the display.
-func (lcd *HD44780) Write(p []byte) (n int, err error) {
+// SetDoubleHeight enables the double height font available on extended
+// HD44780 compatible controllers like the US2066 and SSD1803A. rows is the
+// list of rows that display double height characters. A double height row
+// occupies its own row and the row below it. Calling SetDoubleHeight() with
+// no rows returns the display to normal height.
+//
+// On a 2 row display, the only valid value is row 1. On a 4 row display,
+// the supported layouts are 1; 2; 3; and 1, 3.
+//
+// When double height mode is enabled, rows hidden by a double height row are
+// removed from the coordinate system. Rows() returns the number of visible
+// rows, and MoveTo() accepts the logical row number. For example, on a 4 row
+// display with SetDoubleHeight(1), Rows() returns 3, and MoveTo(2, 1) moves
+// to the start of the physical third row.
+func (lcd *HD44780) SetDoubleHeight(rows ...int) error {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	return lcd.setDoubleHeight(rows...)
+}
 
-	if len(p) == 0 {
-		return
+func (lcd *HD44780) setDoubleHeight(rows ...int) error {
+	if len(rows) == 0 {
+		lcd.rowMap = nil
+		lcd.doubleHeight = nil
+		return lcd.sendCommand(lcd.function)
 	}
-	if p[0] == cmdByte {
-		n = len(p) - 1
-		err = lcd.sendCommand(p[1:])
-		return
+	var ud byte
+	var rowMap []int
+	if lcd.rows == 2 && len(rows) == 1 && rows[0] == 1 {
+		rowMap = []int{1}
+	} else if lcd.rows == 4 {
+		for _, layout := range doubleHeightLayouts {
+			if slices.Equal(layout.rows, rows) {
+				ud = layout.ud
+				rowMap = layout.rowMap
+				break
+			}
+		}
 	}
-	lcd.delayWrite(delayCommand)
-	err = lcd.resetPin.Out(gpio.Level(modeData))
-	if err != nil {
-		return
+	if rowMap == nil {
+		return fmt.Errorf("hd44780: unsupported double height rows %v for a %d row display", rows, lcd.rows)
+	}
+	// Select the extended instruction set (RE=1), set the double height
+	// layout, and then enable double height with RE=0.
+	err := lcd.sendCommand(lcd.function|0x02, 0x10|ud, lcd.function|0x04)
+	if err == nil {
+		lcd.rowMap = rowMap
+		lcd.doubleHeight = slices.Clone(rows)
 	}
+	return err
+}
 
-	for _, byteVal := range p {
-		lcd.lastWrite = time.Now().UnixMicro()
-		if lcd.mode == mode4Bit {
-			err = lcd.write4Bits(byteVal >> 4)
-			if err == nil {
-				err = lcd.write4Bits(byteVal & 0x0f)
+// Write a set of bytes to the display. All bytes are written as character
+// data.
+//
+// If auto wrap is enabled, when a write crosses the end of a row, the cursor
+// is moved to the start of the next row. See AutoWrap(). For displays
+// configured with WithSplitDDRAM(), writes that cross the middle of the row
+// continue on the right half of the display.
+func (lcd *HD44780) Write(p []byte) (n int, err error) {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	for len(p) > 0 {
+		chunk := len(p)
+		if lcd.autoWrap {
+			if lcd.col > lcd.cols {
+				row := lcd.row + 1
+				if row > lcd.logicalRows() {
+					row = lcd.MinRow()
+				}
+				if err = lcd.moveTo(row, lcd.MinCol()); err != nil {
+					return
+				}
 			}
-		} else {
-			err = lcd.write8Bits(byteVal)
+			chunk = min(chunk, lcd.cols-lcd.col+1)
+		}
+		half := lcd.cols / 2
+		if lcd.split && lcd.col <= half {
+			chunk = min(chunk, half-lcd.col+1)
 		}
+		var written int
+		written, err = lcd.cw.WriteData(p[:chunk])
+		lcd.record(p[:written])
+		n += written
+		lcd.col += written
 		if err != nil {
 			return
 		}
-		n += 1
-		time.Sleep(delayCharacter * time.Microsecond)
+		if lcd.split && lcd.col == half+1 {
+			// Continue at the start of the second line.
+			if err = lcd.moveTo(lcd.row, lcd.col); err != nil {
+				return
+			}
+		}
+		p = p[chunk:]
 	}
-	lcd.lastWrite = time.Now().UnixMicro()
 	return
 }
 
+// record copies p, written at the cursor position, to the screen buffer.
+// Characters written past the end of the row aren't recorded.
+func (lcd *HD44780) record(p []byte) {
+	if lcd.row < 1 || lcd.row > lcd.logicalRows() || lcd.col < 1 || lcd.col > lcd.cols {
+		return
+	}
+	copy(lcd.screen[lcd.physicalRow(lcd.row)-1][lcd.col-1:], p)
+}
+
+// AutoWrap enables or disables automatic line wrapping. The HD44780 display
+// RAM is not laid out in row order. On a 4 row display, text written past the
+// end of row 1 appears on row 3. When auto wrap is enabled, the driver tracks
+// the cursor, and moves it to the start of the next row when a write crosses
+// the end of a row. After the last row, it wraps to the first row.
+func (lcd *HD44780) AutoWrap(enabled bool) {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	lcd.autoWrap = enabled
+}
+
 // Write a string output to the display.
 func (lcd *HD44780) WriteString(text string) (int, error) {
 	return lcd.Write([]byte(text))
 }
 
 // Halt clears the display, turns the backlight off, and turns the display off.
-// Halt() is called for the data pins gpio.Group.
+// If the CommandWriter implements conn.Resource, its Halt() is called. For
+// displays created with NewHD44780(), Halt() is called for the data pins
+// gpio.Group.
 func (lcd *HD44780) Halt() error {
-	_ = lcd.Clear()
-	_ = lcd.Backlight(0)
-	_ = lcd.Display(false)
-	return lcd.dataPins.Halt()
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	_ = lcd.clear()
+	_ = lcd.backlight(0)
+	_ = lcd.setDisplay(false)
+	if r, ok := lcd.cw.(conn.Resource); ok {
+		return r.Halt()
+	}
+	return nil
 }
 
 // Set the backlight intensity.
 func (lcd *HD44780) Backlight(intensity display.Intensity) error {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	return lcd.backlight(intensity)
+}
+
+func (lcd *HD44780) backlight(intensity display.Intensity) error {
 	if lcd.blMono != nil {
 		return lcd.blMono.Backlight(intensity)
 	} else if lcd.blRGB != nil {
@@ -299,6 +622,8 @@ func (lcd *HD44780) Backlight(intensity display.Intensity) error {
 // For units that have an RGB Backlight, set the backlight color/intensity.
 // The range of the values is 0-255.
 func (lcd *HD44780) RGBBacklight(red, green, blue display.Intensity) error {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
 	if lcd.blRGB != nil {
 		return lcd.blRGB.RGBBacklight(red, green, blue)
 	} else if lcd.blMono != nil {
@@ -307,134 +632,331 @@ func (lcd *HD44780) RGBBacklight(red, green, blue display.Intensity) error {
 	return display.ErrNotImplemented
 }
 
-// delayWrite looks at the time of the last LCD write and if the specified
-// microseconds period has not elapsed, it invokes time.Sleep() with the
-// difference.
+// SendRaw sends instruction bytes to the controller. It's used for
+// controller features that the driver doesn't implement, for example vendor
+// extensions. The commands are sent holding the device lock, and with the
+// normal write pacing.
 //
-// Some I/O methods, like direct GPIO on a Pi are very fast, while other methods
-// like i2c take longer. Without delays, on very fast I/O paths, the LCD will
-// display garbage. The correct way to handle this would be to read the Busy
-// flag on the LCD display. However, some backpacks don't have the capability to
-// check the Busy flag because the R/W pin isn't connected. So, we can't
-// correctly handle io delays. This handles the very fast interfaces, while not
-// penalizing the slower ones with unnecessary delays.
+// By default, only commands that don't change state tracked by the driver
+// are allowed. These are entry mode set (0x04-0x07), cursor or display shift
+// (0x10-0x1f), and function set (0x20-0x3f) with the interface data length
+// unchanged. Function set is allowed because extended controllers like the
+// US2066 use it to select their extended instruction sets. To send other
+// commands, use WithRawAllowlist(). If any command isn't allowed, nothing is
+// sent, and an error wrapping ErrRawNotAllowed is returned.
+func (lcd *HD44780) SendRaw(cmds ...byte) error {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	allowed := lcd.rawAllowed
+	if allowed == nil {
+		allowed = lcd.safeRaw
+	}
+	for _, cmd := range cmds {
+		if !allowed(cmd) {
+			return fmt.Errorf("%w: 0x%02x", ErrRawNotAllowed, cmd)
+		}
+	}
+	return lcd.sendCommand(cmds...)
+}
+
+// safeRaw returns true for commands that don't change state tracked by the
+// driver. See SendRaw().
+func (lcd *HD44780) safeRaw(cmd byte) bool {
+	switch {
+	case cmd >= 0x04 && cmd <= 0x07, cmd >= 0x10 && cmd <= 0x1f:
+		return true
+	case cmd >= 0x20 && cmd <= 0x3f:
+		return cmd&0x10 == lcd.function&0x10
+	}
+	return false
+}
+
+// Model returns the controller model. If the display was created with
+// WithModel(ModelAuto), it's the detected model.
+func (lcd *HD44780) Model() Model {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	return lcd.model
+}
+
+// Contrast sets the display contrast. The display must have been created
+// with WithContrast(), otherwise display.ErrNotImplemented is returned.
+func (lcd *HD44780) Contrast(contrast display.Contrast) error {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	if lcd.contrast == nil {
+		return display.ErrNotImplemented
+	}
+	return lcd.contrast.Contrast(contrast)
+}
+
+// Reset re-runs the display initialization sequence. It can be used to
+// recover a display that's showing garbage after a bus error. Double height
+// mode is turned off.
+func (lcd *HD44780) Reset() error {
+	return lcd.ResetContext(context.Background())
+}
+
+// ResetContext is Reset(). The initialization isn't attempted again once ctx
+// is done, and the error returned wraps ctx.Err().
+func (lcd *HD44780) ResetContext(ctx context.Context) error {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	lcd.rowMap = nil
+	lcd.doubleHeight = nil
+	return lcd.init(ctx)
+}
+
+// SetCustomChar sets the pattern of a custom character in the character
+// generator RAM. index is the character code, 0-7. Each byte of pattern is a
+// row of the character, top to bottom, with the right most pixel in bit 0.
+// Write the character code to display the custom character.
+func (lcd *HD44780) SetCustomChar(index int, pattern [8]byte) error {
+	if index < 0 || index > 7 {
+		return fmt.Errorf("hd44780: custom character index %d out of range 0-7", index)
+	}
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	if err := lcd.writeCustomChar(index, pattern); err != nil {
+		return err
+	}
+	lcd.customChars[index] = &pattern
+	// Writing the character generator RAM moves the address counter out of
+	// the display RAM, so the cursor position must be restored.
+	return lcd.restoreCursor(lcd.row, lcd.col)
+}
+
+func (lcd *HD44780) writeCustomChar(index int, pattern [8]byte) error {
+	if err := lcd.sendCommand(setCGRAMAddress | byte(index<<3)); err != nil {
+		return err
+	}
+	_, err := lcd.cw.WriteData(pattern[:])
+	return err
+}
+
+// restoreCursor moves the cursor to row and col. If col is past the end of
+// the row, the cursor is moved to the last column and col is retained as the
+// cursor position.
+func (lcd *HD44780) restoreCursor(row, col int) error {
+	if err := lcd.moveTo(row, min(col, lcd.cols)); err != nil {
+		return err
+	}
+	lcd.col = col
+	return nil
+}
+
+// ReInit re-runs the display initialization sequence and restores the
+// state of the display. It's used when the display has lost power while the
+// program is running, for example when the display is on a separately
+// switched supply. The entry mode, custom characters, double height mode,
+// display contents, cursor mode, and cursor position are restored.
 //
-// The value of lcd.lastWrite is updated to the current time by the call.
-func (lcd *HD44780) delayWrite(microseconds time.Duration) {
-	diff := microseconds - time.Duration(time.Now().UnixMicro()-lcd.lastWrite)
-	if diff > 0 {
-		time.Sleep(time.Duration(diff) * time.Microsecond)
-	}
-	lcd.lastWrite = time.Now().UnixMicro()
-}
-
-// Init the display. The HD44780 has a fairly complex initialization cycle
-// with variations for 4 and 8 pin mode.
-func (lcd *HD44780) init() error {
-	/*
-	   This is the startup sequence for the Hitachi HD44780U chip as
-	   documented in the Datasheet.
-	*/
-	lcd.lastWrite = time.Now().UnixMicro()
-	if lcd.mode == mode4Bit {
-		var lineMode byte = 0x20
-		if lcd.rows > 1 {
-			lineMode |= 0x08
+// The backlight is turned on by initialization, and isn't restored.
+func (lcd *HD44780) ReInit() error {
+	return lcd.ReInitContext(context.Background())
+}
+
+// ReInitContext is ReInit(). The initialization isn't attempted again once
+// ctx is done, and the error returned wraps ctx.Err().
+func (lcd *HD44780) ReInitContext(ctx context.Context) error {
+	lcd.mu.Lock()
+	defer lcd.mu.Unlock()
+	on, cursor, blink := lcd.on, lcd.cursor, lcd.blink
+	row, col := lcd.row, lcd.col
+	doubleHeight := lcd.doubleHeight
+	screen := make([][]byte, len(lcd.screen))
+	for ix := range lcd.screen {
+		screen[ix] = slices.Clone(lcd.screen[ix])
+	}
+	lcd.rowMap = nil
+	lcd.doubleHeight = nil
+	if err := lcd.init(ctx); err != nil {
+		return err
+	}
+	lcd.screen = screen
+	if err := lcd.sendCommand(entryMode); err != nil {
+		return err
+	}
+	for index, pattern := range lcd.customChars {
+		if pattern == nil {
+			continue
 		}
-		err := lcd.resetPin.Out(gpio.Level(modeCommand))
-		if err != nil {
+		if err := lcd.writeCustomChar(index, *pattern); err != nil {
 			return err
 		}
-		err = lcd.enablePin.Out(gpio.Low)
-		if err != nil {
+	}
+	if doubleHeight != nil {
+		if err := lcd.setDoubleHeight(doubleHeight...); err != nil {
 			return err
 		}
-		err = lcd.write4Bits(0x03)
-		if err != nil {
-			return err
+	}
+	for ix, contents := range lcd.screen {
+		segments := [][]byte{contents}
+		if lcd.split {
+			segments = [][]byte{contents[:lcd.cols/2], contents[lcd.cols/2:]}
 		}
-		time.Sleep(4100 * time.Microsecond)
-		_ = lcd.write4Bits(0x03)
-		_ = lcd.write4Bits(0x03)
-		_ = lcd.write4Bits(0x02)
-		_ = lcd.sendCommand([]byte{lineMode})
-	} else {
-		// Init the display for 8 pin operation.
-		lineMode := byte(0x30) // Set the line mode and interface to 8 bits
-		if lcd.rows > 1 {
-			lineMode |= 0x08
+		start := 1
+		for _, segment := range segments {
+			if err := lcd.sendCommand(setCursorPosition | lcd.address(ix+1, start)); err != nil {
+				return err
+			}
+			if _, err := lcd.cw.WriteData(segment); err != nil {
+				return err
+			}
+			start += len(segment)
 		}
-		err := lcd.resetPin.Out(gpio.Level(modeCommand))
+	}
+	lcd.cursor, lcd.blink = cursor, blink
+	if err := lcd.setDisplay(on); err != nil {
+		return err
+	}
+	return lcd.restoreCursor(row, col)
+}
+
+// init initializes the display. If an error occurs, initialization is
+// retried, unless ctx is done.
+func (lcd *HD44780) init(ctx context.Context) error {
+	var err error
+	for attempt := range initAttempts {
+		var delay time.Duration
+		if attempt > 0 {
+			delay = initRetryDelay
+		}
+		if ctxErr := wait.Sleep(ctx, delay); ctxErr != nil {
+			return fmt.Errorf("%w: %w", ErrInitFailed, ctxErr)
+		}
+		if err = lcd.initOnce(); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %w", ErrInitFailed, err)
+}
+
+// initOnce runs the initialization sequence. For transports that implement
+// the interface initialization sequence, it's performed first. Otherwise, the
+// function set command is sent for an 8 bit interface. If the transport can
+// read the controller status, the result is verified.
+func (lcd *HD44780) initOnce() error {
+	lcd.function = lcd.functionLines()
+	if ii, ok := lcd.cw.(InterfaceInitializer); ok {
+		function, err := ii.InitInterface(lcd.function, lcd.model)
 		if err != nil {
 			return err
 		}
-		err = lcd.enablePin.Out(gpio.Low)
-		if err != nil {
+		lcd.function = function
+	} else {
+		lcd.function |= 0x10
+		if err := lcd.sendCommand(lcd.function); err != nil {
 			return err
 		}
+	}
+	if err := lcd.setCursor(display.CursorOff); err != nil {
+		return err
+	}
+	if err := lcd.setDisplay(true); err != nil {
+		return err
+	}
+	if err := lcd.clear(); err != nil {
+		return err
+	}
+	if err := lcd.home(); err != nil {
+		return err
+	}
+	if err := lcd.verify(); err != nil {
+		return err
+	}
+	// If there's not a backlight, ignore the error.
+	_ = lcd.backlight(0xff)
+	return nil
+}
+
+// functionLines returns the function set command for the number of lines of
+// the display, without the data length bit.
+func (lcd *HD44780) functionLines() byte {
+	if lcd.rows > 1 || lcd.split {
+		return 0x28
+	}
+	return 0x20
+}
 
-		_ = lcd.write8Bits(0x03 << 4) // Get it's attention
-		time.Sleep(4100 * time.Microsecond)
-		_ = lcd.write8Bits(0x03 << 4)
-		_ = lcd.write8Bits(0x03 << 4)
-		_ = lcd.write8Bits(lineMode)
-		_ = lcd.write8Bits(0x4) // set entry mode
-	}
-	_ = lcd.Cursor(display.CursorOff)
-	_ = lcd.Display(true)
-	_ = lcd.Clear()
-	_ = lcd.Home()
+// attach sets the state of the driver for a display that was already
+// initialized. See WithoutInit().
+func (lcd *HD44780) attach() error {
+	lcd.function = lcd.functionLines()
+	gw, isGPIO := lcd.cw.(*gpioWriter)
+	if _, ok := lcd.cw.(InterfaceInitializer); !ok || isGPIO && gw.mode == mode8Bit {
+		lcd.function |= 0x10
+	}
+	if isGPIO && gw.rwPin != nil {
+		if err := gw.rwPin.Out(gpio.Low); err != nil {
+			return err
+		}
+	}
+	for _, row := range lcd.screen {
+		for ix := range row {
+			row[ix] = ' '
+		}
+	}
+	lcd.row, lcd.col = 1, 1
 	// If there's not a backlight, ignore the error.
-	_ = lcd.Backlight(0xff)
+	_ = lcd.backlight(0xff)
 	return nil
 }
 
-func (lcd *HD44780) sendCommand(commands []byte) error {
-	lcd.delayWrite(delayCommand)
-	err := lcd.resetPin.Out(gpio.Level(modeCommand))
-	if err != nil {
-		return err
+// verify checks that the controller is not busy and that the address counter
+// is 0 after the Home command. If the transport can't read the controller
+// status, verification is skipped.
+func (lcd *HD44780) verify() error {
+	sr, ok := lcd.cw.(statusReader)
+	if !ok {
+		return nil
 	}
-	for _, command := range commands {
-		if lcd.mode == mode4Bit {
-			err = lcd.write4Bits(byte(command >> 4))
-			if err == nil {
-				err = lcd.write4Bits(byte(command))
+	deadline := lcd.now().Add(busyTimeout)
+	for {
+		busy, address, err := sr.readStatus()
+		if errors.Is(err, errStatusNotSupported) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if !busy {
+			if address != 0 {
+				return fmt.Errorf("hd44780: unexpected address counter 0x%x after home", address)
 			}
-		} else {
-			err = lcd.write8Bits(command)
+			return nil
 		}
-		if err != nil {
-			break
+		if lcd.now().After(deadline) {
+			return errors.New("hd44780: timeout waiting for busy flag")
 		}
-
+		lcd.sleep(100 * time.Microsecond)
 	}
-	lcd.lastWrite = time.Now().UnixMicro()
-	return err
 }
 
-func (lcd *HD44780) write4Bits(value byte) error {
-	return lcd.writeBits(gpio.GPIOValue(value), 0x0f)
+// now returns the time of the clock of the pacer of the CommandWriter, if it
+// has one.
+func (lcd *HD44780) now() time.Time {
+	if p, ok := lcd.cw.(paced); ok {
+		return p.clock().now()
+	}
+	return time.Now()
 }
 
-func (lcd *HD44780) write8Bits(value byte) error {
-	return lcd.writeBits(gpio.GPIOValue(value), 0xff)
+// sleep sleeps for d, using the clock of the pacer of the CommandWriter if it
+// has one.
+func (lcd *HD44780) sleep(d time.Duration) {
+	if p, ok := lcd.cw.(paced); ok {
+		p.clock().sleep(d)
+		return
+	}
+	time.Sleep(d)
 }
 
-func (lcd *HD44780) writeBits(value, mask gpio.GPIOValue) error {
-	err := lcd.dataPins.Out(value, mask)
-	if err != nil {
-		return err
-	}
-	err = lcd.enablePin.Out(gpio.High)
-	if err == nil {
-		time.Sleep(2 * time.Microsecond)
-		err = lcd.enablePin.Out(gpio.Low)
-	}
-	return err
+func (lcd *HD44780) sendCommand(commands ...byte) error {
+	return lcd.cw.WriteCommand(commands...)
 }
 
 var _ display.TextDisplay = &HD44780{}
 var _ display.DisplayBacklight = &HD44780{}
 var _ display.DisplayRGBBacklight = &HD44780{}
+var _ display.DisplayContrast = &HD44780{}
 var _ conn.Resource = &HD44780{}
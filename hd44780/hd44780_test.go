@@ -5,13 +5,20 @@
 package hd44780
 
 import (
+	"context"
 	"errors"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
 	periphDisplay "periph.io/x/conn/v3/display"
 	"periph.io/x/conn/v3/display/displaytest"
+	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/mcp23xxx"
+	"periph.io/x/devices/v3/mcp23xxx/mcp23xxxtest"
 )
 
 func getLCD(t *testing.T, recordingName string) (*HD44780, error) {
@@ -116,3 +123,727 @@ func TestBacklights(t *testing.T) {
 		}
 	}
 }
+
+func TestDoubleHeight(t *testing.T) {
+	lcd, bus := newTestLCD(t, 4, 20)
+	if err := lcd.SetDoubleHeight(1); err != nil {
+		t.Fatal(err)
+	}
+	if cmds := bus.commands(t); !slices.Equal(cmds, []byte{0x2a, 0x10, 0x2c}) {
+		t.Errorf("unexpected double height commands % x", cmds)
+	}
+	if lcd.Rows() != 3 {
+		t.Errorf("expected 3 logical rows, received %d", lcd.Rows())
+	}
+	// Logical row 2 is physical row 3.
+	if err := lcd.MoveTo(2, 1); err != nil {
+		t.Error(err)
+	}
+	if cmds := bus.commands(t); !slices.Equal(cmds, []byte{0x80 | 20}) {
+		t.Errorf("unexpected MoveTo command % x", cmds)
+	}
+	if err := lcd.MoveTo(4, 1); err == nil {
+		t.Error("expected error moving to hidden row")
+	}
+	if err := lcd.SetDoubleHeight(2, 4); err == nil {
+		t.Error("expected error for unsupported layout")
+	}
+	if err := lcd.SetDoubleHeight(); err != nil {
+		t.Error(err)
+	}
+	if cmds := bus.commands(t); !slices.Equal(cmds, []byte{0x28}) {
+		t.Errorf("unexpected double height off commands % x", cmds)
+	}
+	if lcd.Rows() != 4 {
+		t.Errorf("expected 4 rows, received %d", lcd.Rows())
+	}
+}
+
+// recordingWriter is a CommandWriter that records commands and data.
+type recordingWriter struct {
+	commands []byte
+	data     []byte
+}
+
+func (rw *recordingWriter) WriteCommand(cmds ...byte) error {
+	rw.commands = append(rw.commands, cmds...)
+	return nil
+}
+
+func (rw *recordingWriter) WriteData(p []byte) (int, error) {
+	rw.data = append(rw.data, p...)
+	return len(p), nil
+}
+
+func TestCommandWriter(t *testing.T) {
+	rw := &recordingWriter{}
+	lcd, err := NewHD44780CommandWriter(rw, nil, 2, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Function set for an 8 bit interface is sent when the writer doesn't
+	// initialize the interface.
+	if len(rw.commands) == 0 || rw.commands[0] != 0x38 {
+		t.Errorf("unexpected init commands % x", rw.commands)
+	}
+	rw.commands = nil
+	// 0xfe is written as character data, not as a command prefix.
+	if _, err = lcd.Write([]byte{0xfe, 0x01}); err != nil {
+		t.Error(err)
+	}
+	if len(rw.commands) != 0 || !slices.Equal(rw.data, []byte{0xfe, 0x01}) {
+		t.Errorf("unexpected output commands % x data % x", rw.commands, rw.data)
+	}
+}
+
+// initWriter is a recordingWriter for a 4 bit interface that performs its own
+// interface initialization.
+type initWriter struct {
+	recordingWriter
+	functions []byte
+}
+
+func (iw *initWriter) InitInterface(function byte, model Model) (byte, error) {
+	iw.functions = append(iw.functions, function)
+	return function, iw.WriteCommand(function)
+}
+
+func TestInterfaceInitializer(t *testing.T) {
+	iw := &initWriter{}
+	if _, err := NewHD44780CommandWriter(iw, nil, 2, 16); err != nil {
+		t.Fatal(err)
+	}
+	// The data length bit is left clear for the writer, and the 8 bit
+	// function set isn't sent.
+	if !slices.Equal(iw.functions, []byte{0x28}) {
+		t.Errorf("unexpected function set values % x", iw.functions)
+	}
+	if len(iw.commands) == 0 || iw.commands[0] != 0x28 {
+		t.Errorf("unexpected init commands % x", iw.commands)
+	}
+}
+
+func TestWithoutInit(t *testing.T) {
+	bus := &testBus{}
+	lcd, err := NewHD44780(bus, &testPin{bus: bus, rs: true}, &testPin{bus: bus}, nil, 2, 16, WithoutInit())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bus.nibbles) != 0 {
+		t.Fatalf("expected nothing written, got %v", bus.bytes())
+	}
+	if err = lcd.MoveTo(2, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got := bus.commands(t); !slices.Equal(got, []byte{0xc0}) {
+		t.Errorf("got commands % x", got)
+	}
+	// The function set command is for a 4 bit interface.
+	if err = lcd.SetDoubleHeight(); err != nil {
+		t.Fatal(err)
+	}
+	if got := bus.commands(t); len(got) == 0 || got[0]&0xf0 != 0x20 {
+		t.Errorf("got commands % x", got)
+	}
+}
+
+func TestInitRetry(t *testing.T) {
+	lcd, bus := newTestLCD(t, 2, 16)
+	bus.failures = 2
+	if err := lcd.Reset(); err != nil {
+		t.Errorf("expected Reset() to recover after retry, received %v", err)
+	}
+	bus.failures = 1000
+	if err := lcd.Reset(); !errors.Is(err, ErrInitFailed) {
+		t.Errorf("expected ErrInitFailed, received %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := lcd.ResetContext(ctx); !errors.Is(err, ErrInitFailed) || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected ErrInitFailed wrapping context.Canceled, received %v", err)
+	}
+}
+
+// statusWriter is a recordingWriter that reports a fixed controller status.
+type statusWriter struct {
+	recordingWriter
+	address byte
+}
+
+func (sw *statusWriter) readStatus() (bool, byte, error) {
+	return false, sw.address, nil
+}
+
+func TestInitVerify(t *testing.T) {
+	if _, err := NewHD44780CommandWriter(&statusWriter{}, nil, 2, 16); err != nil {
+		t.Error(err)
+	}
+	lcd, err := NewHD44780CommandWriter(&statusWriter{address: 0x10}, nil, 2, 16)
+	if !errors.Is(err, ErrInitFailed) {
+		t.Errorf("expected verification failure, received %v", err)
+	}
+	if lcd != nil {
+		t.Error("expected nil display on error")
+	}
+}
+
+func TestRWPin(t *testing.T) {
+	bus := &testBus{}
+	rw := &testPin{bus: bus, rw: true}
+	if _, err := NewHD44780(bus, &testPin{bus: bus, rs: true}, &testPin{bus: bus}, nil, 2, 16, WithRWPin(rw)); err != nil {
+		t.Fatal(err)
+	}
+	if bus.reads != 2 || bus.rw != gpio.Low {
+		t.Errorf("expected one status read with R/W restored low, received %d nibbles R/W %s", bus.reads, bus.rw)
+	}
+	bus = &testBus{status: 0x10}
+	rw = &testPin{bus: bus, rw: true}
+	_, err := NewHD44780(bus, &testPin{bus: bus, rs: true}, &testPin{bus: bus}, nil, 2, 16, WithRWPin(rw))
+	if !errors.Is(err, ErrInitFailed) {
+		t.Errorf("expected verification failure, received %v", err)
+	}
+}
+
+func TestAdafruitInitRetry(t *testing.T) {
+	bus := &nullBus{}
+	lcd, err := NewAdafruitI2CBackpack(bus, 0x20, 2, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// R/W is tied to ground, so the controller status is never read, and a
+	// failed write is retried.
+	bus.reads = 0
+	bus.failures = 2
+	if err = lcd.Reset(); err != nil {
+		t.Errorf("expected Reset() to recover after retry, received %v", err)
+	}
+	if bus.reads != 0 {
+		t.Errorf("expected no reads during init, received %d", bus.reads)
+	}
+	bus.failures = 1000
+	if err = lcd.Reset(); !errors.Is(err, ErrInitFailed) {
+		t.Errorf("expected ErrInitFailed, received %v", err)
+	}
+}
+
+func TestDeviceNotFound(t *testing.T) {
+	// An empty playback returns an error for every transaction.
+	bus := &i2ctest.Playback{DontPanic: true}
+	if _, err := NewAdafruitI2CBackpack(bus, 0x20, 2, 16); !errors.Is(err, ErrDeviceNotFound) {
+		t.Errorf("expected ErrDeviceNotFound, received %v", err)
+	}
+	if _, err := NewPCF857xBackpack(bus, 0x27, 2, 16); !errors.Is(err, ErrDeviceNotFound) {
+		t.Errorf("expected ErrDeviceNotFound, received %v", err)
+	}
+}
+
+func TestPinMap(t *testing.T) {
+	bad := AdafruitPinMap
+	bad.E = bad.RS
+	if _, err := NewAdafruitI2CBackpack(&nullBus{}, 0x20, 2, 16, WithPinMap(bad)); err == nil {
+		t.Error("expected error for duplicate pin")
+	}
+	bad.E = 8
+	if _, err := NewAdafruitI2CBackpack(&nullBus{}, 0x20, 2, 16, WithPinMap(bad)); err == nil {
+		t.Error("expected error for out of range pin")
+	}
+	clone := PinMap{Data: [4]int{4, 5, 6, 7}, RS: 0, E: 1, Backlight: -1, RW: -1}
+	lcd, err := NewAdafruitI2CBackpack(&nullBus{}, 0x20, 2, 16, WithPinMap(clone))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = lcd.Backlight(0xff); !errors.Is(err, periphDisplay.ErrNotImplemented) {
+		t.Errorf("expected ErrNotImplemented for unconnected backlight, received %v", err)
+	}
+}
+
+func TestAutoWrap(t *testing.T) {
+	lcd, bus := newTestLCD(t, 4, 20)
+	lcd.AutoWrap(true)
+	text := "0123456789012345678901234"
+	n, err := lcd.WriteString(text)
+	if err != nil || n != len(text) {
+		t.Fatalf("WriteString() returned %d, %v", n, err)
+	}
+	written := bus.bytes()
+	if len(written) != len(text)+1 {
+		t.Fatalf("expected %d bytes, received %d", len(text)+1, len(written))
+	}
+	// After 20 characters, the cursor moves to the start of the second row,
+	// which is at DDRAM address 0x40.
+	if move := written[20]; move.data || move.value != 0x80|0x40 {
+		t.Errorf("expected move to row 2, received %s", move)
+	}
+	// Writes past the last row wrap to the first row.
+	if err = lcd.MoveTo(4, 20); err != nil {
+		t.Fatal(err)
+	}
+	_ = bus.bytes()
+	if _, err = lcd.WriteString("ab"); err != nil {
+		t.Fatal(err)
+	}
+	if move := bus.bytes()[1]; move.data || move.value != 0x80 {
+		t.Errorf("expected move to row 1, received %s", move)
+	}
+}
+
+func TestWinstarOLED(t *testing.T) {
+	bus := &testBus{}
+	lcd, err := NewHD44780(bus, &testPin{bus: bus, rs: true}, &testPin{bus: bus}, nil, 2, 16, WithModel(ModelWinstarOLED))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The interface is resynchronized with five zero nibbles, followed by the
+	// 4 bit function set nibble.
+	for ix, expected := range []byte{0, 0, 0, 0, 0, 2} {
+		if bus.nibbles[ix].value != expected {
+			t.Fatalf("init nibble %d expected 0x%x, received %s", ix, expected, bus.nibbles[ix])
+		}
+	}
+	bus.nibbles = nil
+	if err = lcd.Display(false); err != nil {
+		t.Fatal(err)
+	}
+	if cmds := bus.commands(t); !slices.Equal(cmds, []byte{0x08, winstarPowerOff}) {
+		t.Errorf("unexpected display off commands % x", cmds)
+	}
+}
+
+func TestExpanderBurst(t *testing.T) {
+	bus := &nullBus{}
+	lcd, err := NewAdafruitI2CBackpack(bus, 0x20, 2, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bus.writes = nil
+	if _, err = lcd.WriteString("A"); err != nil {
+		t.Fatal(err)
+	}
+	// Both nibbles of the character are sent to OLAT in one transaction, with
+	// enable strobed for each, and register select and the backlight held
+	// high.
+	expected := []byte{0x0a, 0xa2, 0xa6, 0xa2, 0x8a, 0x8e, 0x8a}
+	if len(bus.writes) != 1 || !slices.Equal(bus.writes[0], expected) {
+		t.Errorf("expected write % x, received % x", expected, bus.writes)
+	}
+
+	// The PCF8574 backpack has no register address, and reads the controller
+	// status during init by raising R/W.
+	bus = &nullBus{}
+	if _, err = NewPCF857xBackpack(bus, 0x27, 2, 16); err != nil {
+		t.Fatal(err)
+	}
+	// The first nibble of the init sequence is 0x3 on D4-D7, pins 4-7, with
+	// E, pin 2, strobed.
+	if w := bus.writes[0]; !slices.Equal(w, []byte{0x30, 0x34, 0x30}) {
+		t.Errorf("unexpected PCF8574 init write % x", w)
+	}
+	if !slices.ContainsFunc(bus.writes, func(w []byte) bool { return w[0]&(1<<pcf_rwPin) != 0 }) {
+		t.Error("expected status read with R/W high")
+	}
+}
+
+func TestAdafruitInitSequence(t *testing.T) {
+	// The sequence is derived from the HD44780U datasheet figure 24, and
+	// the Adafruit wiring: RS 1, E 2, D4-D7 3-6, and backlight 7. Each nibble
+	// is written as data, data with E high, and data.
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		// IODIR is read on creation, and IOCON.SEQOP is set.
+		{Addr: 0x20, W: []byte{0x00}, R: []byte{0xff}},
+		{Addr: 0x20, W: []byte{0x05}, R: []byte{0x00}},
+		{Addr: 0x20, W: []byte{0x05, 0x20}},
+		// OLAT is cleared, and pins 1-7 are outputs.
+		{Addr: 0x20, W: []byte{0x0a, 0x00}},
+		{Addr: 0x20, W: []byte{0x00, 0x01}},
+		// 0x3, wait 4.1ms, 0x3, 0x3, 0x2 selects the 4 bit interface.
+		{Addr: 0x20, W: []byte{0x0a, 0x18, 0x1c, 0x18}},
+		{Addr: 0x20, W: []byte{0x0a, 0x18, 0x1c, 0x18, 0x18, 0x1c, 0x18, 0x10, 0x14, 0x10}},
+		// Function set 0x28: 2 lines, 5x8 font.
+		{Addr: 0x20, W: []byte{0x0a, 0x10, 0x14, 0x10, 0x40, 0x44, 0x40}},
+		// Display on, cursor off 0x0c for SetCursor and Display.
+		{Addr: 0x20, W: []byte{0x0a, 0x00, 0x04, 0x00, 0x60, 0x64, 0x60}},
+		{Addr: 0x20, W: []byte{0x0a, 0x00, 0x04, 0x00, 0x60, 0x64, 0x60}},
+		// Clear 0x01, and home 0x02.
+		{Addr: 0x20, W: []byte{0x0a, 0x00, 0x04, 0x00, 0x08, 0x0c, 0x08}},
+		{Addr: 0x20, W: []byte{0x0a, 0x00, 0x04, 0x00, 0x10, 0x14, 0x10}},
+		// The backlight is turned on.
+		{Addr: 0x20, W: []byte{0x0a, 0x90}},
+	}}
+	if _, err := NewAdafruitI2CBackpack(bus, 0x20, 2, 16); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23S08Backpack(t *testing.T) {
+	if _, err := NewMCP23S08Backpack(&nullConn{}, 4, 2, 16); err == nil {
+		t.Error("expected error for invalid hardware address")
+	}
+	c := &nullConn{}
+	lcd, err := NewMCP23S08Backpack(c, 1, 2, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// HAEN is set using hardware address 0, and then the device is
+	// addressed with the opcode. IODIR is read, SEQOP is set, and the latch
+	// is cleared.
+	for ix, expected := range [][]byte{{0x40, 0x05, 0x08}, {0x43, 0x00}, {0x42, 0x05, 0x28}, {0x42, 0x0a, 0x00}} {
+		if !slices.Equal(c.writes[ix], expected) {
+			t.Errorf("expected write % x, received % x", expected, c.writes[ix])
+		}
+	}
+	c.writes = nil
+	if _, err = lcd.WriteString("A"); err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{0x42, 0x0a, 0xa2, 0xa6, 0xa2, 0x8a, 0x8e, 0x8a}
+	if len(c.writes) != 1 || !slices.Equal(c.writes[0], expected) {
+		t.Errorf("expected write % x, received % x", expected, c.writes)
+	}
+}
+
+func TestMCP23xxxBackpack(t *testing.T) {
+	c := &nullConn{}
+	dev, err := mcp23xxx.NewSPI(c, mcp23xxx.MCP23S08)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	lcd, err := NewMCP23xxxBackpack(dev, 2, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.writes = nil
+	if _, err = lcd.WriteString("A"); err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{0x40, 0x0a, 0xa2, 0xa6, 0xa2, 0x8a, 0x8e, 0x8a}
+	if len(c.writes) != 1 || !slices.Equal(c.writes[0], expected) {
+		t.Errorf("expected write % x, received % x", expected, c.writes)
+	}
+}
+
+func TestAdafruitI2CBackpackSim(t *testing.T) {
+	sim := mcp23xxxtest.NewMCP23008(0x20)
+	lcd, err := NewAdafruitI2CBackpack(sim, 0x20, 2, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if iodir := sim.Register(mcp23xxxtest.IODIR); iodir != 0x01 {
+		t.Errorf("expected IODIR 0x01, received 0x%x", iodir)
+	}
+	if !sim.Level(backlightPin) {
+		t.Error("expected the backlight to be on")
+	}
+	sim.ClearOps()
+	if _, err = lcd.WriteString("A"); err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{0x0a, 0xa2, 0xa6, 0xa2, 0x8a, 0x8e, 0x8a}
+	if ops := sim.Ops(); len(ops) != 1 || !slices.Equal(ops[0].W, expected) {
+		t.Errorf("expected write % x, received %v", expected, ops)
+	}
+}
+
+func TestConcurrentWrites(t *testing.T) {
+	lcd, bus := newTestLCD(t, 2, 16)
+	var wg sync.WaitGroup
+	for _, text := range []string{"aaaa", "bbbb"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				_ = lcd.MoveTo(2, 1)
+				_, _ = lcd.WriteString(text)
+				_ = lcd.Home()
+			}
+		}()
+	}
+	wg.Wait()
+	// If the nibbles of concurrent calls were interleaved, the bytes
+	// assembled from the bus would not be the values written.
+	for _, b := range bus.bytes() {
+		if b.data && b.value != 'a' && b.value != 'b' {
+			t.Fatalf("unexpected data byte %s", b)
+		} else if !b.data && b.value != 0x80|0x40 && b.value != goHome {
+			t.Fatalf("unexpected command byte %s", b)
+		}
+	}
+}
+
+func TestSplitDDRAM(t *testing.T) {
+	if _, err := NewHD44780CommandWriter(&recordingWriter{}, nil, 2, 16, WithSplitDDRAM()); err == nil {
+		t.Error("expected error for split 2 row display")
+	}
+	bus := &testBus{}
+	lcd, err := NewHD44780(bus, &testPin{bus: bus, rs: true}, &testPin{bus: bus}, nil, 1, 16, WithSplitDDRAM())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The display is initialized in 2 line mode.
+	if function := bus.bytes()[2]; function.value != 0x28 {
+		t.Errorf("expected 2 line function set, received %s", function)
+	}
+	if _, err = lcd.WriteString("0123456789"); err != nil {
+		t.Fatal(err)
+	}
+	written := bus.bytes()
+	if len(written) != 11 {
+		t.Fatalf("expected 11 bytes, received %d", len(written))
+	}
+	if move := written[8]; move.data || move.value != 0x80|0x40 {
+		t.Errorf("expected move to right half, received %s", move)
+	}
+	if err = lcd.MoveTo(1, 12); err != nil {
+		t.Fatal(err)
+	}
+	if cmds := bus.commands(t); !slices.Equal(cmds, []byte{0x80 | 0x43}) {
+		t.Errorf("unexpected MoveTo command % x", cmds)
+	}
+}
+
+// pwmPin is a gpio.PinOut that records the PWM duty cycle.
+type pwmPin struct {
+	testPin
+	duty gpio.Duty
+}
+
+func (pp *pwmPin) PWM(duty gpio.Duty, f physic.Frequency) error {
+	pp.duty = duty
+	return nil
+}
+
+func TestContrast(t *testing.T) {
+	lcd, _ := newTestLCD(t, 2, 16)
+	if err := lcd.Contrast(0x80); !errors.Is(err, periphDisplay.ErrNotImplemented) {
+		t.Errorf("expected ErrNotImplemented, received %v", err)
+	}
+	pin := &pwmPin{}
+	lcd, _ = newTestLCD(t, 2, 16, WithContrast(NewPWMContrast(pin, 0)))
+	// Maximum contrast is 0V on V0.
+	if err := lcd.Contrast(0xff); err != nil || pin.duty != 0 {
+		t.Errorf("expected duty 0, received %s, %v", pin.duty, err)
+	}
+	if err := lcd.Contrast(0); err != nil || pin.duty != gpio.DutyMax {
+		t.Errorf("expected duty %s, received %s, %v", gpio.DutyMax, pin.duty, err)
+	}
+	if err := lcd.Contrast(0x100); err == nil {
+		t.Error("expected error for out of range contrast")
+	}
+}
+
+func TestReInit(t *testing.T) {
+	lcd, bus := newTestLCD(t, 2, 16)
+	pattern := [8]byte{0x0e, 0x11, 0x11, 0x11, 0x11, 0x11, 0x0e, 0x00}
+	if err := lcd.SetCustomChar(8, pattern); err == nil {
+		t.Error("expected error for custom character index 8")
+	}
+	if err := lcd.SetCustomChar(1, pattern); err != nil {
+		t.Fatal(err)
+	}
+	if err := lcd.MoveTo(2, 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := lcd.WriteString("hi\x01"); err != nil {
+		t.Fatal(err)
+	}
+	if err := lcd.Cursor(periphDisplay.CursorBlink); err != nil {
+		t.Fatal(err)
+	}
+	bus.nibbles = nil
+	if err := lcd.ReInit(); err != nil {
+		t.Fatal(err)
+	}
+	var data []byte
+	var cmds []byte
+	for _, b := range bus.bytes() {
+		if b.data {
+			data = append(data, b.value)
+		} else {
+			cmds = append(cmds, b.value)
+		}
+	}
+	expected := slices.Concat(pattern[:], []byte("                "), []byte("  hi\x01           "))
+	if !slices.Equal(data, expected) {
+		t.Errorf("expected restored data %q, received %q", expected, data)
+	}
+	// The cursor mode and position are restored last.
+	if tail := cmds[len(cmds)-2:]; !slices.Equal(tail, []byte{0x0f, 0x80 | 0x40 | 5}) {
+		t.Errorf("unexpected cursor restore commands % x", tail)
+	}
+	if !slices.Contains(cmds, entryMode) || !slices.Contains(cmds, setCGRAMAddress|1<<3) {
+		t.Errorf("expected entry mode and CGRAM address commands, received % x", cmds)
+	}
+}
+
+func TestPacing(t *testing.T) {
+	for _, test := range []struct {
+		opts     []Option
+		expected []time.Duration
+	}{
+		// The command delay is requested before the write, and the
+		// character delay after each character.
+		{nil, []time.Duration{DefaultCommandDelay, DefaultCharacterDelay, DefaultCharacterDelay}},
+		{[]Option{WithPacing(time.Millisecond, 0)}, []time.Duration{time.Millisecond}},
+		{[]Option{WithPacing(0, 0)}, nil},
+	} {
+		lcd, err := NewAdafruitI2CBackpack(&nullBus{}, 0x20, 2, 16, test.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The clock is stopped, so the command delay never elapses.
+		var sleeps []time.Duration
+		ew := lcd.cw.(*expanderWriter)
+		ew.now = func() time.Time { return time.Unix(0, 0) }
+		ew.sleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+		ew.touch()
+		if _, err = lcd.WriteString("ab"); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(sleeps, test.expected) {
+			t.Errorf("%v expected delays %v, received %v", test.opts, test.expected, sleeps)
+		}
+	}
+}
+
+func TestClearDelay(t *testing.T) {
+	lcd, err := NewAdafruitI2CBackpack(&nullBus{}, 0x20, 2, 16, WithModel(ModelWinstarOLED), WithPacing(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sleeps []time.Duration
+	ew := lcd.cw.(*expanderWriter)
+	ew.sleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+	if err = lcd.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []time.Duration{ModelWinstarOLED.quirks().clearDelay}; !slices.Equal(sleeps, want) {
+		t.Errorf("expected delays %v, received %v", want, sleeps)
+	}
+}
+
+func TestWinstarOLEDPowerPacing(t *testing.T) {
+	lcd, err := NewAdafruitI2CBackpack(&nullBus{}, 0x20, 2, 16, WithModel(ModelWinstarOLED))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The clock is stopped, so each command delay is requested in full.
+	var sleeps []time.Duration
+	ew := lcd.cw.(*expanderWriter)
+	ew.now = func() time.Time { return time.Unix(0, 0) }
+	ew.sleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+	ew.touch()
+	if err = lcd.Display(false); err != nil {
+		t.Fatal(err)
+	}
+	if want := []time.Duration{DefaultCommandDelay, DefaultCommandDelay}; !slices.Equal(sleeps, want) {
+		t.Errorf("expected delays %v, received %v", want, sleeps)
+	}
+}
+
+// benchmarkWrite writes a full row to lcd, and reports the characters per
+// second and bus transactions per character.
+func benchmarkWrite(b *testing.B, lcd *HD44780, bus *nullBus) {
+	const text = "0123456789012345"
+	bus.writes = nil
+	b.ResetTimer()
+	for range b.N {
+		if err := lcd.MoveTo(1, 1); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := lcd.WriteString(text); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	chars := float64(b.N * len(text))
+	var written int
+	for _, w := range bus.writes {
+		written += len(w)
+	}
+	b.ReportMetric(chars/b.Elapsed().Seconds(), "chars/s")
+	b.ReportMetric(float64(len(bus.writes))/chars, "tx/char")
+	b.ReportMetric(float64(written)/chars, "bytes/char")
+}
+
+func BenchmarkMCP23008(b *testing.B) {
+	bus := &nullBus{}
+	lcd, err := NewAdafruitI2CBackpack(bus, 0x20, 2, 16)
+	if err != nil {
+		b.Fatal(err)
+	}
+	benchmarkWrite(b, lcd, bus)
+}
+
+func BenchmarkMCP23008NoPacing(b *testing.B) {
+	bus := &nullBus{}
+	lcd, err := NewAdafruitI2CBackpack(bus, 0x20, 2, 16, WithPacing(0, 0))
+	if err != nil {
+		b.Fatal(err)
+	}
+	benchmarkWrite(b, lcd, bus)
+}
+
+// us2066Writer is a recordingWriter that returns the US2066 part ID when the
+// extended instruction set is selected.
+type us2066Writer struct {
+	recordingWriter
+}
+
+func (uw *us2066Writer) readStatus() (bool, byte, error) {
+	if last := uw.commands[len(uw.commands)-1]; last&0xe2 == 0x22 {
+		return false, us2066PartID, nil
+	}
+	return false, 0, nil
+}
+
+func TestModelAuto(t *testing.T) {
+	lcd, err := NewHD44780CommandWriter(&us2066Writer{}, nil, 2, 16, WithModel(ModelAuto))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if model := lcd.Model(); model != ModelUS2066 {
+		t.Errorf("expected %s, received %s", ModelUS2066, model)
+	}
+	for _, cw := range []CommandWriter{&statusWriter{}, &recordingWriter{}} {
+		lcd, err = NewHD44780CommandWriter(cw, nil, 2, 16, WithModel(ModelAuto))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if model := lcd.Model(); model != ModelHD44780 {
+			t.Errorf("expected %s, received %s", ModelHD44780, model)
+		}
+	}
+	if s := Model(100).String(); s != "HD44780U" {
+		t.Errorf("expected unknown model to be HD44780U, received %s", s)
+	}
+}
+
+func TestSendRaw(t *testing.T) {
+	lcd, bus := newTestLCD(t, 2, 16)
+	if err := lcd.SendRaw(0x2a, 0x18, 0x28); err != nil {
+		t.Fatal(err)
+	}
+	if cmds := bus.commands(t); !slices.Equal(cmds, []byte{0x2a, 0x18, 0x28}) {
+		t.Errorf("unexpected raw commands % x", cmds)
+	}
+	// Clear, an 8 bit function set, and set DDRAM address change state the
+	// driver tracks.
+	for _, cmd := range []byte{clearScreen, 0x38, 0x80} {
+		if err := lcd.SendRaw(0x18, cmd); !errors.Is(err, ErrRawNotAllowed) {
+			t.Errorf("expected ErrRawNotAllowed for 0x%x, received %v", cmd, err)
+		}
+	}
+	if len(bus.nibbles) != 0 {
+		t.Error("expected nothing sent for rejected commands")
+	}
+	lcd, bus = newTestLCD(t, 2, 16, WithRawAllowlist(func(byte) bool { return true }))
+	if err := lcd.SendRaw(0x80); err != nil {
+		t.Error(err)
+	}
+	if cmds := bus.commands(t); !slices.Equal(cmds, []byte{0x80}) {
+		t.Errorf("unexpected raw commands % x", cmds)
+	}
+}
@@ -12,1885 +12,343 @@ import (
 
 var recordingData = map[string][]i2ctest.IO{
 	"TestInterface": {
-		{Addr: 0x20, W: []uint8{0x0}, R: []uint8{0x1}},
-		{Addr: 0x20, W: []uint8{0xa}, R: []uint8{0x40}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x1c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x1c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x1c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x14}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x14}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}},
-		{Addr: 0x20, W: []uint8{0xa, 0x44}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
-		{Addr: 0x20, W: []uint8{0xa, 0x64}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
-		{Addr: 0x20, W: []uint8{0xa, 0x64}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
+		{Addr: 0x20, W: []uint8{0x0}, R: []uint8{0xff}},
+		{Addr: 0x20, W: []uint8{0x5}, R: []uint8{0x0}},
+		{Addr: 0x20, W: []uint8{0x5, 0x20}},
 		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x14}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x90}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xea}},
-		{Addr: 0x20, W: []uint8{0xa, 0xee}},
-		{Addr: 0x20, W: []uint8{0xa, 0xea}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xea}},
-		{Addr: 0x20, W: []uint8{0xa, 0xee}},
-		{Addr: 0x20, W: []uint8{0xa, 0xea}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xea}},
-		{Addr: 0x20, W: []uint8{0xa, 0xee}},
-		{Addr: 0x20, W: []uint8{0xa, 0xea}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc8}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xda}},
-		{Addr: 0x20, W: []uint8{0xa, 0xde}},
-		{Addr: 0x20, W: []uint8{0xa, 0xda}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe8}},
-		{Addr: 0x20, W: []uint8{0xa, 0xec}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xda}},
-		{Addr: 0x20, W: []uint8{0xa, 0xde}},
-		{Addr: 0x20, W: []uint8{0xa, 0xda}},
-		{Addr: 0x20, W: []uint8{0xa, 0xd8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe8}},
-		{Addr: 0x20, W: []uint8{0xa, 0xec}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
+		{Addr: 0x20, W: []uint8{0x0, 0x1}},
+		{Addr: 0x20, W: []uint8{0xa, 0x18, 0x1c, 0x18}},
+		{Addr: 0x20, W: []uint8{0xa, 0x18, 0x1c, 0x18, 0x18, 0x1c, 0x18, 0x10, 0x14, 0x10}},
+		{Addr: 0x20, W: []uint8{0xa, 0x10, 0x14, 0x10, 0x40, 0x44, 0x40}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x60, 0x64, 0x60}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x60, 0x64, 0x60}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x8, 0xc, 0x8}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x10, 0x14, 0x10}},
 		{Addr: 0x20, W: []uint8{0xa, 0x90}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x98}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xfa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xf0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0xe0, 0xe4, 0xe0}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xc2, 0xc6, 0xc2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xba, 0xbe, 0xba}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xc2, 0xc6, 0xc2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0xea, 0xee, 0xea}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xaa, 0xae, 0xaa, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xba, 0xbe, 0xba}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xd2, 0xd6, 0xd2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xd2, 0xd6, 0xd2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xb2, 0xb6, 0xb2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xaa, 0xae, 0xaa, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xaa, 0xae, 0xaa, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xc0, 0xc4, 0xc0, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xba, 0xbe, 0xba}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xc2, 0xc6, 0xc2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xd2, 0xd6, 0xd2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xea, 0xee, 0xea}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xf2, 0xf6, 0xf2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xe0, 0xe4, 0xe0, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xba, 0xbe, 0xba}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xc2, 0xc6, 0xc2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xd2, 0xd6, 0xd2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xea, 0xee, 0xea}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xf2, 0xf6, 0xf2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xc2, 0xc6, 0xc2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xf2, 0xf6, 0xf2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xaa, 0xae, 0xaa, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xf2, 0xf6, 0xf2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xf2, 0xf6, 0xf2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xba, 0xbe, 0xba}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0xc0, 0xc4, 0xc0, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0xc2, 0xc6, 0xc2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0xe0, 0xe4, 0xe0, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0xc2, 0xc6, 0xc2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0xc0, 0xc4, 0xc0, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xd2, 0xd6, 0xd2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xb2, 0xb6, 0xb2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xb2, 0xb6, 0xb2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0xe0, 0xe4, 0xe0}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0xe0, 0xe4, 0xe0}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0xc0, 0xc4, 0xc0, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xd2, 0xd6, 0xd2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xaa, 0xae, 0xaa, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xf2, 0xf6, 0xf2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xf2, 0xf6, 0xf2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0xf0, 0xf4, 0xf0}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0xe0, 0xe4, 0xe0}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0xc0, 0xc4, 0xc0, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xd2, 0xd6, 0xd2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xda, 0xde, 0xda}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0xe8, 0xec, 0xe8}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0xe0, 0xe4, 0xe0}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0xc0, 0xc4, 0xc0, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xd2, 0xd6, 0xd2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xa2, 0xa6, 0xa2, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xe2, 0xe6, 0xe2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xf2, 0xf6, 0xf2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xda, 0xde, 0xda}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0xe8, 0xec, 0xe8}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0xe0, 0xe4, 0xe0}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0xaa, 0xae, 0xaa, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xf2, 0xf6, 0xf2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xba, 0xbe, 0xba}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xf2, 0xf6, 0xf2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0xa0, 0xa4, 0xa0}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0xa0, 0xa4, 0xa0}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xb2, 0xb6, 0xb2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xba, 0xbe, 0xba}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xc2, 0xc6, 0xc2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0x88, 0x8c, 0x88, 0x80, 0x84, 0x80}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0xaa, 0xae, 0xaa, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xb2, 0xb6, 0xb2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xb2, 0xb6, 0xb2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xb2, 0xb6, 0xb2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0xc0, 0xc4, 0xc0}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0xe0, 0xe4, 0xe0}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0xaa, 0xae, 0xaa, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xba, 0xbe, 0xba, 0xb2, 0xb6, 0xb2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x92, 0x96, 0x92, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xfa, 0xfe, 0xfa}},
+		{Addr: 0x20, W: []uint8{0xa, 0xb2, 0xb6, 0xb2, 0xf2, 0xf6, 0xf2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
 		{Addr: 0x20, W: []uint8{0xa, 0x8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}},
-		{Addr: 0x20, W: []uint8{0xa, 0x44}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x40, 0x44, 0x40}},
+	},
 	"TestBacklights": {
-		{Addr: 0x20, W: []uint8{0x0}, R: []uint8{0x1}},
-		{Addr: 0x20, W: []uint8{0xa}, R: []uint8{0x40}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x1c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x1c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x1c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x14}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x14}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}},
-		{Addr: 0x20, W: []uint8{0xa, 0x44}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
-		{Addr: 0x20, W: []uint8{0xa, 0x64}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
-		{Addr: 0x20, W: []uint8{0xa, 0x64}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8}},
+		{Addr: 0x20, W: []uint8{0x0}, R: []uint8{0xff}},
+		{Addr: 0x20, W: []uint8{0x5}, R: []uint8{0x0}},
+		{Addr: 0x20, W: []uint8{0x5, 0x20}},
 		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x14}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
+		{Addr: 0x20, W: []uint8{0x0, 0x1}},
+		{Addr: 0x20, W: []uint8{0xa, 0x18, 0x1c, 0x18}},
+		{Addr: 0x20, W: []uint8{0xa, 0x18, 0x1c, 0x18, 0x18, 0x1c, 0x18, 0x10, 0x14, 0x10}},
+		{Addr: 0x20, W: []uint8{0xa, 0x10, 0x14, 0x10, 0x40, 0x44, 0x40}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x60, 0x64, 0x60}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x60, 0x64, 0x60}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x8, 0xc, 0x8}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x10, 0x14, 0x10}},
 		{Addr: 0x20, W: []uint8{0xa, 0x90}},
 		{Addr: 0x20, W: []uint8{0xa, 0x10}},
 		{Addr: 0x20, W: []uint8{0xa, 0x90}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0x90}},
+		{Addr: 0x20, W: []uint8{0xa, 0x90}},
+		{Addr: 0x20, W: []uint8{0xa, 0x90}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
 		{Addr: 0x20, W: []uint8{0xa, 0x8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}},
-		{Addr: 0x20, W: []uint8{0xa, 0x44}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x40, 0x44, 0x40}},
+	},
 	"TestBasic": {
-		{Addr: 0x20, W: []uint8{0x0}, R: []uint8{0x1}},
-		{Addr: 0x20, W: []uint8{0xa}, R: []uint8{0x40}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x1c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x1c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x1c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x18}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x14}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x14}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}},
-		{Addr: 0x20, W: []uint8{0xa, 0x44}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}},
+		{Addr: 0x20, W: []uint8{0x0}, R: []uint8{0xff}},
+		{Addr: 0x20, W: []uint8{0x5}, R: []uint8{0x0}},
+		{Addr: 0x20, W: []uint8{0x5, 0x20}},
 		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
-		{Addr: 0x20, W: []uint8{0xa, 0x64}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
-		{Addr: 0x20, W: []uint8{0xa, 0x64}},
-		{Addr: 0x20, W: []uint8{0xa, 0x60}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
-		{Addr: 0x20, W: []uint8{0xa, 0x14}},
-		{Addr: 0x20, W: []uint8{0xa, 0x10}},
+		{Addr: 0x20, W: []uint8{0x0, 0x1}},
+		{Addr: 0x20, W: []uint8{0xa, 0x18, 0x1c, 0x18}},
+		{Addr: 0x20, W: []uint8{0xa, 0x18, 0x1c, 0x18, 0x18, 0x1c, 0x18, 0x10, 0x14, 0x10}},
+		{Addr: 0x20, W: []uint8{0xa, 0x10, 0x14, 0x10, 0x40, 0x44, 0x40}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x60, 0x64, 0x60}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x60, 0x64, 0x60}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x8, 0xc, 0x8}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x10, 0x14, 0x10}},
 		{Addr: 0x20, W: []uint8{0xa, 0x90}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe4}},
-		{Addr: 0x20, W: []uint8{0xa, 0xe0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x96}},
-		{Addr: 0x20, W: []uint8{0xa, 0x92}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xa2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0xae}},
-		{Addr: 0x20, W: []uint8{0xa, 0xaa}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xb2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0xbe}},
-		{Addr: 0x20, W: []uint8{0xa, 0xba}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc6}},
-		{Addr: 0x20, W: []uint8{0xa, 0xc2}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0xce}},
-		{Addr: 0x20, W: []uint8{0xa, 0xca}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x86}},
-		{Addr: 0x20, W: []uint8{0xa, 0x82}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x9a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8e}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8a}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x84}},
-		{Addr: 0x20, W: []uint8{0xa, 0x80}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
-		{Addr: 0x20, W: []uint8{0xa, 0x8c}},
-		{Addr: 0x20, W: []uint8{0xa, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xb2, 0xb6, 0xb2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xba, 0xbe, 0xba}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xc2, 0xc6, 0xc2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0xe0, 0xe4, 0xe0, 0x88, 0x8c, 0x88}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x92, 0x96, 0x92}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x9a, 0x9e, 0x9a}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xa2, 0xa6, 0xa2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xaa, 0xae, 0xaa}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xb2, 0xb6, 0xb2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xba, 0xbe, 0xba}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xc2, 0xc6, 0xc2}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0xca, 0xce, 0xca}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x82, 0x86, 0x82}},
+		{Addr: 0x20, W: []uint8{0xa, 0x9a, 0x9e, 0x9a, 0x8a, 0x8e, 0x8a}},
+		{Addr: 0x20, W: []uint8{0xa, 0x80, 0x84, 0x80, 0x88, 0x8c, 0x88}},
 		{Addr: 0x20, W: []uint8{0xa, 0x8}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x4}},
-		{Addr: 0x20, W: []uint8{0xa, 0x0}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}},
-		{Addr: 0x20, W: []uint8{0xa, 0x44}},
-		{Addr: 0x20, W: []uint8{0xa, 0x40}}},
+		{Addr: 0x20, W: []uint8{0xa, 0x0, 0x4, 0x0, 0x40, 0x44, 0x40}},
+	},
 }
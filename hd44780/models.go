@@ -0,0 +1,134 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hd44780
+
+import (
+	"errors"
+	"time"
+)
+
+// Model identifies the display controller. Many controllers are compatible
+// with the HD44780 command set, but differ in their initialization, timing,
+// power control, and character set.
+type Model int
+
+const (
+	// ModelHD44780 is the Hitachi HD44780U and directly compatible
+	// controllers. This is the default.
+	ModelHD44780 Model = iota
+	// ModelWinstarOLED is the Winstar WEH/WEO series of character OLED
+	// displays, which use the WS0010 controller. The WS0010 doesn't report
+	// busy early in initialization, requires resynchronizing the 4 bit
+	// interface, and has graphic/character mode select and internal power
+	// control.
+	ModelWinstarOLED
+	// ModelST7066U is the Sitronix ST7066U, which is used in many current
+	// production LCD modules.
+	ModelST7066U
+	// ModelKS0066 is the Samsung KS0066, and the compatible S6A0069.
+	ModelKS0066
+	// ModelSPLC780D is the Sunplus SPLC780D.
+	ModelSPLC780D
+	// ModelUS2066 is the WiseChip US2066 OLED controller. It supports the
+	// double height font. See SetDoubleHeight().
+	ModelUS2066
+	// ModelAuto initializes the display as an HD44780, and then attempts to
+	// identify the controller by reading the part ID. Reading the part ID
+	// requires the R/W line to be connected. Only the US2066 reports a part
+	// ID, so it's the only controller that can be identified. All other
+	// controllers, including the KS0066, SPLC780D and WS0010, are reported
+	// as ModelHD44780, and must be selected using WithModel() to apply their
+	// timing. See HD44780.Model().
+	ModelAuto
+)
+
+// The part ID returned by the US2066 when the status is read with the
+// extended instruction set selected.
+const us2066PartID byte = 0x21
+
+// quirks describes the differences between controller models. Differences
+// between controllers should be added here, rather than comparing the
+// model.
+type quirks struct {
+	name string
+	// powerOnDelay is the time to wait before initialization.
+	powerOnDelay time.Duration
+	// characterDelay replaces DefaultCharacterDelay if it's not 0.
+	characterDelay time.Duration
+	// clearDelay is the time to wait after the clear command, for
+	// controllers where the busy flag can't be relied on.
+	clearDelay time.Duration
+	// resync is true if the 4 bit interface must be resynchronized with
+	// zero nibbles during initialization.
+	resync bool
+	// powerControl is true if turning the display on and off also switches
+	// the controller's internal power.
+	powerControl bool
+	// charset is the character generator ROM of the common variant.
+	charset string
+}
+
+var modelQuirks = map[Model]quirks{
+	ModelHD44780: {name: "HD44780U", charset: "A00 (English/Japanese)"},
+	ModelWinstarOLED: {name: "WS0010", powerOnDelay: 500 * time.Millisecond,
+		clearDelay: 6200 * time.Microsecond, resync: true, powerControl: true,
+		charset: "English/Japanese"},
+	ModelST7066U: {name: "ST7066U", powerOnDelay: 40 * time.Millisecond,
+		characterDelay: 50 * time.Microsecond, charset: "0A (English/Japanese)"},
+	ModelKS0066: {name: "KS0066", powerOnDelay: 30 * time.Millisecond,
+		characterDelay: 50 * time.Microsecond, charset: "F00 (English/Japanese)"},
+	ModelSPLC780D: {name: "SPLC780D", powerOnDelay: 15 * time.Millisecond,
+		charset: "English/Japanese"},
+	ModelUS2066: {name: "US2066", powerOnDelay: time.Millisecond,
+		charset: "ROM A (English/Japanese)"},
+	ModelAuto: {name: "Auto", charset: "A00 (English/Japanese)"},
+}
+
+// quirks returns the quirks for the model. Unknown models are treated as
+// ModelHD44780.
+func (m Model) quirks() quirks {
+	if q, ok := modelQuirks[m]; ok {
+		return q
+	}
+	return modelQuirks[ModelHD44780]
+}
+
+// Charset returns the character generator ROM of the most common variant of
+// the controller. Variants with other ROMs, for example European character
+// sets, are available for most controllers.
+func (m Model) Charset() string {
+	return m.quirks().charset
+}
+
+func (m Model) String() string {
+	return m.quirks().name
+}
+
+// detectModel identifies the controller by selecting the extended
+// instruction set, and reading the part ID. Controllers that don't have an
+// extended instruction set ignore the RE bit of the function set command,
+// and return the address counter, which is 0 after Home().
+func (lcd *HD44780) detectModel() (Model, error) {
+	sr, ok := lcd.cw.(statusReader)
+	if !ok {
+		return ModelHD44780, nil
+	}
+	if err := lcd.sendCommand(lcd.function | 0x02); err != nil {
+		return ModelHD44780, err
+	}
+	_, id, err := sr.readStatus()
+	if e := lcd.sendCommand(lcd.function); err == nil {
+		err = e
+	}
+	if errors.Is(err, errStatusNotSupported) {
+		return ModelHD44780, nil
+	} else if err != nil {
+		return ModelHD44780, err
+	}
+	if id == us2066PartID {
+		return ModelUS2066, nil
+	}
+	return ModelHD44780, nil
+}
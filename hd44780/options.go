@@ -0,0 +1,197 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hd44780
+
+import (
+	"fmt"
+	"time"
+
+	"periph.io/x/conn/v3/display"
+	"periph.io/x/conn/v3/gpio"
+)
+
+// PinMap describes how the LCD lines are wired to the pins of an I/O
+// expander. The values are the GPIO numbers (not physical) of the expander.
+// Clone backpacks frequently wire the LCD differently than the original
+// product, and a PinMap allows the backpack constructors to drive them.
+type PinMap struct {
+	// Data is the expander pins connected to LCD lines D4-D7.
+	Data [4]int
+	// RS is the expander pin connected to the register select line.
+	RS int
+	// E is the expander pin connected to the enable line.
+	E int
+	// Backlight is the expander pin that switches the backlight. -1 if the
+	// backlight is not controlled by the expander.
+	Backlight int
+	// RW is the expander pin connected to the read/write line. -1 if R/W is
+	// tied to ground.
+	RW int
+}
+
+var (
+	// AdafruitPinMap is the wiring of the Adafruit I2C/SPI LCD Backpack.
+	AdafruitPinMap = PinMap{Data: [4]int{d4, d5, d6, d7}, RS: rsPin, E: enablePin, Backlight: backlightPin, RW: -1}
+	// PCF857xPinMap is the wiring of the common PCF8574 LCD backpacks.
+	PCF857xPinMap = PinMap{Data: [4]int{pcf_d4, pcf_d5, pcf_d6, pcf_d7}, RS: pcf_rsPin,
+		E: pcf_enablePin, Backlight: pcf_backlightPin, RW: pcf_rwPin}
+)
+
+// Option is a configuration option passed to the HD44780 and backpack
+// constructors.
+type Option func(*options)
+
+type options struct {
+	pins     *PinMap
+	model    Model
+	split    bool
+	contrast display.DisplayContrast
+	pacing   *[2]time.Duration
+	raw      func(cmd byte) bool
+	rw       gpio.PinOut
+	noInit   bool
+}
+
+// WithModel configures the display controller model. The default is
+// ModelHD44780.
+func WithModel(model Model) Option {
+	return func(o *options) {
+		o.model = model
+	}
+}
+
+// WithContrast configures the display to set contrast using c. The V0 pin of
+// HD44780 displays is normally connected to a trimpot. Connecting it to a
+// host PWM pin or DAC allows the contrast to be set by software. See
+// PWMContrast and DACContrast.
+func WithContrast(c display.DisplayContrast) Option {
+	return func(o *options) {
+		o.contrast = c
+	}
+}
+
+// WithPacing sets the delays used by the GPIO and I2C backpack drivers.
+// command is the minimum time between a command and the next write, and
+// character is the delay after each character. The defaults are
+// DefaultCommandDelay and DefaultCharacterDelay, which are safe for the
+// HD44780U. Faster controllers, or slow buses, may allow shorter delays.
+// Some clone controllers require longer delays. The delays are ignored by
+// CommandWriters supplied to NewHD44780CommandWriter().
+func WithPacing(command, character time.Duration) Option {
+	return func(o *options) {
+		o.pacing = &[2]time.Duration{command, character}
+	}
+}
+
+// WithRawAllowlist replaces the check of commands passed to SendRaw().
+// allowed is called for each command byte, and returns true if the command
+// may be sent. Commands that change state tracked by the driver, like the
+// cursor position, will cause the driver's view of the display to be
+// incorrect.
+func WithRawAllowlist(allowed func(cmd byte) bool) Option {
+	return func(o *options) {
+		o.raw = allowed
+	}
+}
+
+// WithSplitDDRAM configures a 1 row display that is electrically a 2 row
+// display with half the columns. Many 16x1 modules are wired this way, and
+// columns 9-16 are at display RAM address 0x40. The display is initialized
+// in 2 line mode, and MoveTo() and Write() translate columns in the right
+// half of the display to the second line's addresses.
+func WithSplitDDRAM() Option {
+	return func(o *options) {
+		o.split = true
+	}
+}
+
+// WithRWPin configures NewHD44780() to drive the read/write line of the
+// display with pin. The data pin group must then implement Read(), and the
+// busy flag and address counter are read to verify initialization. If the
+// option isn't supplied, R/W must be tied to ground, and initialization is
+// only retried if a write fails.
+func WithRWPin(pin gpio.PinOut) Option {
+	return func(o *options) {
+		o.rw = pin
+	}
+}
+
+// WithoutInit configures the constructor to attach to a display that was
+// already initialized, for example by a previous run of the program, without
+// running the initialization sequence, which clears it. The display keeps its
+// contents, but they aren't known to the driver, so ReInit() doesn't restore
+// them. The display is assumed to be on with the cursor off, and the cursor
+// position is unknown, so call MoveTo() before writing. The backlight is
+// turned on.
+//
+// A display that was just powered on must be initialized.
+func WithoutInit() Option {
+	return func(o *options) {
+		o.noInit = true
+	}
+}
+
+// WithPinMap configures a backpack constructor to use the specified
+// expander pin wiring instead of the product's default wiring.
+func WithPinMap(pins PinMap) Option {
+	return func(o *options) {
+		o.pins = &pins
+	}
+}
+
+// applyOptions returns the options after applying opts.
+func applyOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// pinMap returns the configured PinMap, or def if none was supplied.
+func (o *options) pinMap(def PinMap) PinMap {
+	if o.pins == nil {
+		return def
+	}
+	return *o.pins
+}
+
+// pins returns the expander pins used by the display.
+func (pm PinMap) pins() []int {
+	pins := append(pm.Data[:], pm.RS, pm.E)
+	if pm.Backlight >= 0 {
+		pins = append(pins, pm.Backlight)
+	}
+	if pm.RW >= 0 {
+		pins = append(pins, pm.RW)
+	}
+	return pins
+}
+
+// mask returns the bit mask of the expander pins used by the display.
+func (pm PinMap) mask() byte {
+	var mask byte
+	for _, pin := range pm.pins() {
+		mask |= 1 << pin
+	}
+	return mask
+}
+
+// validate verifies the pin numbers are in the range of an expander with
+// width pins, and that no pin is used twice.
+func (pm PinMap) validate(width int) error {
+	pins := pm.pins()
+	used := make(map[int]bool)
+	for _, pin := range pins {
+		if pin < 0 || pin >= width {
+			return fmt.Errorf("hd44780: pin map pin %d out of range", pin)
+		}
+		if used[pin] {
+			return fmt.Errorf("hd44780: pin map pin %d used more than once", pin)
+		}
+		used[pin] = true
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hd44780
+
+import (
+	"time"
+)
+
+const (
+	// DefaultCommandDelay is the time allowed for a command to execute
+	// before the next write. It's long enough for the clear and home
+	// commands, which take 1.52ms on the HD44780U.
+	DefaultCommandDelay = 2000 * time.Microsecond
+	// DefaultCharacterDelay is the delay after each character is written.
+	DefaultCharacterDelay = 200 * time.Microsecond
+)
+
+// pacer tracks the time of the last write to the display so that writes can
+// be delayed long enough for the controller to execute them.
+type pacer struct {
+	lastWrite      int64
+	commandDelay   time.Duration
+	characterDelay time.Duration
+	// now and sleep are time.Now and time.Sleep, replaced by tests.
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+// paced is implemented by CommandWriters that delay writes to allow the
+// controller time to execute them.
+type paced interface {
+	setPacing(command, character time.Duration)
+	// clock returns the pacer, whose now and sleep are used for the other
+	// delays of the driver.
+	clock() *pacer
+}
+
+// newPacer returns a pacer using the default delays.
+func newPacer() pacer {
+	return pacer{
+		commandDelay:   DefaultCommandDelay,
+		characterDelay: DefaultCharacterDelay,
+		now:            time.Now,
+		sleep:          time.Sleep,
+	}
+}
+
+func (pc *pacer) setPacing(command, character time.Duration) {
+	pc.commandDelay = command
+	pc.characterDelay = character
+}
+
+func (pc *pacer) clock() *pacer {
+	return pc
+}
+
+// delayWrite looks at the time of the last LCD write and if the command delay
+// has not elapsed, it sleeps for the difference.
+//
+// Some I/O methods, like direct GPIO on a Pi are very fast, while other methods
+// like i2c take longer. Without delays, on very fast I/O paths, the LCD will
+// display garbage. The correct way to handle this would be to read the Busy
+// flag on the LCD display. However, some backpacks don't have the capability to
+// check the Busy flag because the R/W pin isn't connected. So, we can't
+// correctly handle io delays. This handles the very fast interfaces, while not
+// penalizing the slower ones with unnecessary delays.
+//
+// The value of pc.lastWrite is updated to the current time by the call.
+func (pc *pacer) delayWrite() {
+	diff := pc.commandDelay - time.Duration(pc.now().UnixMicro()-pc.lastWrite)*time.Microsecond
+	if diff > 0 {
+		pc.sleep(diff)
+	}
+	pc.touch()
+}
+
+// delayCharacter sleeps for the character delay.
+func (pc *pacer) delayCharacter() {
+	if pc.characterDelay > 0 {
+		pc.sleep(pc.characterDelay)
+	}
+}
+
+// touch sets the time of the last write to now.
+func (pc *pacer) touch() {
+	pc.lastWrite = pc.now().UnixMicro()
+}
@@ -5,7 +5,8 @@
 package hd44780
 
 import (
-	"periph.io/x/conn/v3/gpio"
+	"fmt"
+
 	"periph.io/x/conn/v3/i2c"
 	"periph.io/x/devices/v3/pcf857x"
 )
@@ -31,20 +32,33 @@ const (
 //
 // This function creates a PCF8574 backpack device with the required pin
 // configuration. To use this, get an I2C bus, and call this function with the
-// bus, i2c address, number of rows, and columns.
-func NewPCF857xBackpack(bus i2c.Bus, address uint16, rows, cols int) (*HD44780, error) {
-	pcf, err := pcf857x.New(bus, address, pcf857x.PCF8574)
+// bus, i2c address, number of rows, and columns. Addresses 0x38-0x3f are
+// used by the PCF8574A. Each byte written to the display is sent in a single
+// I2C transaction using pcf857x.Dev.WriteSequence().
+//
+// If the PCF8574 does not respond at address, an error wrapping
+// ErrDeviceNotFound is returned.
+//
+// For backpacks that wire the LCD to different PCF8574 pins, pass
+// WithPinMap().
+func NewPCF857xBackpack(bus i2c.Bus, address uint16, rows, cols int, opts ...Option) (*HD44780, error) {
+	pm := applyOptions(opts).pinMap(PCF857xPinMap)
+	if err := pm.validate(8); err != nil {
+		return nil, err
+	}
+	// The PCF857x has no registers that identify it. Read the port to verify
+	// the device is present.
+	if err := bus.Tx(address, nil, make([]byte, 1)); err != nil {
+		return nil, fmt.Errorf("%w: PCF8574 at 0x%x: %w", ErrDeviceNotFound, address, err)
+	}
+	variant := pcf857x.PCF8574
+	if address&0xfff8 == 0x38 {
+		variant = pcf857x.PCF8574A
+	}
+	dev, err := pcf857x.New(bus, address, variant)
 	if err != nil {
 		return nil, err
 	}
-	// R/W is connected on this backpack. Set it to low.
-	_ = pcf.Pins[pcf_rwPin].Out(gpio.Low)
-
-	// Create our gpio.Group
-	gr, _ := pcf.Group(pcf_d4, pcf_d5, pcf_d6, pcf_d7, pcf_rsPin, pcf_enablePin, pcf_backlightPin)
-	grPins := gr.Pins()
-	reset := grPins[4].(gpio.PinOut)
-	enable := grPins[5].(gpio.PinOut)
-	bl := grPins[6].(gpio.PinOut)
-	return NewHD44780(gr, reset, enable, NewBacklight(bl), rows, cols)
+	ew := newExpanderWriter(fmt.Sprintf("PCF8574_%x", address), &pcfExpander{dev: dev}, pm)
+	return NewHD44780CommandWriter(ew, ew.backlight(), rows, cols, opts...)
 }
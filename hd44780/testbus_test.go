@@ -0,0 +1,203 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hd44780
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/pin"
+	"periph.io/x/conn/v3/spi"
+)
+
+// busByte is a byte decoded from the bus along with the state of the
+// register select line.
+type busByte struct {
+	data  bool
+	value byte
+}
+
+// testBus is a gpio.Group that records the nibbles latched by the enable pin
+// so that tests can verify the command and data stream sent to the display.
+type testBus struct {
+	value   gpio.GPIOValue
+	rs      gpio.Level
+	enable  gpio.Level
+	nibbles []busByte
+	// failures is the number of subsequent writes that will return an error.
+	failures int
+	// rw is the level of the read/write pin, and status is the busy flag and
+	// address counter returned by Read() when it's high.
+	rw     gpio.Level
+	status byte
+	reads  int
+}
+
+// newTestLCD returns a 4 bit HD44780 connected to a testBus. The bus is reset
+// after the display is initialized.
+func newTestLCD(t *testing.T, rows, cols int, opts ...Option) (*HD44780, *testBus) {
+	bus := &testBus{}
+	lcd, err := NewHD44780(bus, &testPin{bus: bus, rs: true}, &testPin{bus: bus}, nil, rows, cols, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bus.nibbles = nil
+	return lcd, bus
+}
+
+// bytes returns the recorded nibbles assembled into bytes.
+func (tb *testBus) bytes() []busByte {
+	var result []busByte
+	for ix := 0; ix+1 < len(tb.nibbles); ix += 2 {
+		result = append(result, busByte{data: tb.nibbles[ix].data,
+			value: tb.nibbles[ix].value<<4 | tb.nibbles[ix+1].value})
+	}
+	tb.nibbles = nil
+	return result
+}
+
+// commands returns the recorded command bytes, and verifies no data was
+// written.
+func (tb *testBus) commands(t *testing.T) []byte {
+	var result []byte
+	for _, b := range tb.bytes() {
+		if b.data {
+			t.Errorf("unexpected data byte 0x%x", b.value)
+		}
+		result = append(result, b.value)
+	}
+	return result
+}
+
+func (tb *testBus) Pins() []pin.Pin             { return nil }
+func (tb *testBus) ByOffset(offset int) pin.Pin { return nil }
+func (tb *testBus) ByName(name string) pin.Pin  { return nil }
+func (tb *testBus) ByNumber(number int) pin.Pin { return nil }
+func (tb *testBus) Halt() error                 { return nil }
+func (tb *testBus) String() string              { return "testBus" }
+
+// Read returns the high nibble of status, and then the low nibble.
+func (tb *testBus) Read(gpio.GPIOValue) (gpio.GPIOValue, error) {
+	if tb.rw != gpio.High {
+		return 0, errors.New("testBus: read with R/W low")
+	}
+	tb.reads++
+	if tb.reads%2 == 1 {
+		return gpio.GPIOValue(tb.status >> 4), nil
+	}
+	return gpio.GPIOValue(tb.status & 0x0f), nil
+}
+func (tb *testBus) WaitForEdge(time.Duration) (int, gpio.Edge, error) {
+	return 0, gpio.NoEdge, gpio.ErrGroupFeatureNotImplemented
+}
+
+func (tb *testBus) Out(value, mask gpio.GPIOValue) error {
+	if tb.failures > 0 {
+		tb.failures--
+		return errors.New("testBus: injected failure")
+	}
+	tb.value = (tb.value &^ mask) | (value & mask)
+	return nil
+}
+
+// testPin is the register select, read/write, or enable pin of a testBus.
+type testPin struct {
+	bus *testBus
+	rs  bool
+	rw  bool
+}
+
+func (tp *testPin) String() string   { return tp.Name() }
+func (tp *testPin) Halt() error      { return nil }
+func (tp *testPin) Number() int      { return 0 }
+func (tp *testPin) Function() string { return "OUT" }
+func (tp *testPin) PWM(gpio.Duty, physic.Frequency) error {
+	return errors.New("not supported")
+}
+
+func (tp *testPin) Name() string {
+	if tp.rs {
+		return "RS"
+	} else if tp.rw {
+		return "RW"
+	}
+	return "E"
+}
+
+func (tp *testPin) Out(l gpio.Level) error {
+	if tp.rw {
+		tp.bus.rw = l
+		return nil
+	}
+	if tp.rs {
+		tp.bus.rs = l
+		return nil
+	}
+	if tp.bus.enable == gpio.High && l == gpio.Low {
+		tp.bus.nibbles = append(tp.bus.nibbles, busByte{data: bool(tp.bus.rs), value: byte(tp.bus.value & 0x0f)})
+	}
+	tp.bus.enable = l
+	return nil
+}
+
+func (bb busByte) String() string {
+	return fmt.Sprintf("{data: %t, 0x%x}", bb.data, bb.value)
+}
+
+var _ gpio.Group = &testBus{}
+var _ gpio.PinOut = &testPin{}
+
+// nullBus is an i2c.Bus that records transactions and answers reads with
+// zeros.
+type nullBus struct {
+	writes [][]byte
+	reads  int
+	// failures is the number of subsequent transactions that will return an
+	// error.
+	failures int
+}
+
+func (nb *nullBus) String() string { return "nullBus" }
+
+func (nb *nullBus) SetSpeed(f physic.Frequency) error { return nil }
+
+func (nb *nullBus) Tx(addr uint16, w, r []byte) error {
+	if nb.failures > 0 {
+		nb.failures--
+		return errors.New("nullBus: injected failure")
+	}
+	if len(r) > 0 {
+		nb.reads++
+	}
+	if len(w) > 0 {
+		nb.writes = append(nb.writes, slices.Clone(w))
+	}
+	clear(r)
+	return nil
+}
+
+// nullConn is a spi.Conn that records transactions and answers reads with
+// zeros.
+type nullConn struct {
+	writes [][]byte
+}
+
+func (nc *nullConn) String() string                 { return "nullConn" }
+func (nc *nullConn) Duplex() conn.Duplex            { return conn.Half }
+func (nc *nullConn) TxPackets(p []spi.Packet) error { return nil }
+
+func (nc *nullConn) Tx(w, r []byte) error {
+	if len(w) > 0 {
+		nc.writes = append(nc.writes, slices.Clone(w))
+	}
+	clear(r)
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package hil is a harness to test the drivers on real hardware, to
+// validate a release on the boards of the maintainers.
+//
+// The devices wired to the board are described by a manifest, a JSON file
+// such as:
+//
+//	{
+//	  "board": "raspberrypi-4",
+//	  "lcds": [{"name": "lcd", "type": "pcf8574", "address": "0x27", "rows": 2, "cols": 16}],
+//	  "expanders": [{"name": "exp", "type": "mcp23008", "address": "0x20", "out": 0, "in": 1}],
+//	  "encoders": [{"name": "knob", "a": "GPIO17", "b": "GPIO27", "sw": "GPIO22"}]
+//	}
+//
+// The out and in pins of an expander must be wired together, for a
+// loopback test.
+//
+// The smoke tests are built with the hil tag, and run when HIL_MANIFEST is
+// the path of the manifest:
+//
+//	HIL_MANIFEST=board.json go test -tags hil ./hil
+//
+// The tests that need an operator, to look at a display or turn a knob,
+// prompt on the terminal, and are skipped when HIL_UNATTENDED is set. A
+// summary of the results is printed at the end, and written to the file
+// named by HIL_REPORT, if set.
+package hil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// Manifest describes the devices wired to the board under test.
+type Manifest struct {
+	// Board is the name of the board, printed in the report.
+	Board     string     `json:"board"`
+	LCDs      []LCD      `json:"lcds"`
+	Expanders []Expander `json:"expanders"`
+	Encoders  []Encoder  `json:"encoders"`
+}
+
+// LCD is a character display on an I²C bus.
+type LCD struct {
+	Name string `json:"name"`
+	// Type is the kind of backpack, "pcf8574" or "adafruit".
+	Type string `json:"type"`
+	// Bus is the name of the I²C bus, the default bus if empty.
+	Bus     string `json:"bus"`
+	Address string `json:"address"`
+	Rows    int    `json:"rows"`
+	Cols    int    `json:"cols"`
+}
+
+// Expander is a GPIO expander on an I²C bus, with two of its pins wired
+// together.
+type Expander struct {
+	Name string `json:"name"`
+	// Type is the variant, such as "mcp23008", "mcp23017", "pcf8574" or
+	// "pcf8575".
+	Type    string `json:"type"`
+	Bus     string `json:"bus"`
+	Address string `json:"address"`
+	// Out is driven, and In is read.
+	Out int `json:"out"`
+	In  int `json:"in"`
+}
+
+// Encoder is a rotary encoder connected to host pins.
+type Encoder struct {
+	Name string `json:"name"`
+	A    string `json:"a"`
+	B    string `json:"b"`
+	// SW is the pin of the switch, if any.
+	SW string `json:"sw"`
+}
+
+// ReadManifest reads the manifest file name.
+func ReadManifest(name string) (*Manifest, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("hil: %w", err)
+	}
+	defer f.Close()
+	return ParseManifest(f)
+}
+
+// ParseManifest parses a manifest, rejecting unknown fields, and checks it.
+func ParseManifest(r io.Reader) (*Manifest, error) {
+	m := &Manifest{}
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(m); err != nil {
+		return nil, fmt.Errorf("hil: invalid manifest: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Validate checks that the devices are fully described, and that their
+// names are unique.
+func (m *Manifest) Validate() error {
+	names := map[string]bool{}
+	check := func(name string) error {
+		if name == "" {
+			return errors.New("hil: device without a name")
+		}
+		if names[name] {
+			return fmt.Errorf("hil: duplicate device %q", name)
+		}
+		names[name] = true
+		return nil
+	}
+	for _, l := range m.LCDs {
+		if err := check(l.Name); err != nil {
+			return err
+		}
+		if _, err := ParseAddress(l.Address); err != nil {
+			return fmt.Errorf("hil: %s: %w", l.Name, err)
+		}
+		if l.Rows <= 0 || l.Cols <= 0 {
+			return fmt.Errorf("hil: %s: invalid size %dx%d", l.Name, l.Rows, l.Cols)
+		}
+	}
+	for _, e := range m.Expanders {
+		if err := check(e.Name); err != nil {
+			return err
+		}
+		if _, err := ParseAddress(e.Address); err != nil {
+			return fmt.Errorf("hil: %s: %w", e.Name, err)
+		}
+		if e.Out == e.In || e.Out < 0 || e.In < 0 {
+			return fmt.Errorf("hil: %s: invalid loopback pins %d and %d", e.Name, e.Out, e.In)
+		}
+	}
+	for _, e := range m.Encoders {
+		if err := check(e.Name); err != nil {
+			return err
+		}
+		if e.A == "" || e.B == "" {
+			return fmt.Errorf("hil: %s: pins a and b are required", e.Name)
+		}
+	}
+	return nil
+}
+
+// ParseAddress parses an I²C address, such as "0x27".
+func ParseAddress(s string) (uint16, error) {
+	v, err := strconv.ParseUint(s, 0, 16)
+	if err != nil || v > 0x7f {
+		return 0, fmt.Errorf("invalid I²C address %q", s)
+	}
+	return uint16(v), nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hil
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseManifest(t *testing.T) {
+	m, err := ParseManifest(strings.NewReader(`{
+		"board": "pi",
+		"lcds": [{"name": "lcd", "type": "pcf8574", "address": "0x27", "rows": 2, "cols": 16}],
+		"expanders": [{"name": "exp", "type": "mcp23008", "address": "0x20", "out": 0, "in": 1}],
+		"encoders": [{"name": "knob", "a": "GPIO17", "b": "GPIO27"}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Board != "pi" || len(m.LCDs) != 1 || m.Expanders[0].In != 1 || m.Encoders[0].B != "GPIO27" {
+		t.Errorf("unexpected manifest %+v", m)
+	}
+	for _, bad := range []string{
+		`{"lcd": []}`,
+		`{"lcds": [{"name": "lcd", "address": "0x80", "rows": 2, "cols": 16}]}`,
+		`{"lcds": [{"name": "lcd", "address": "0x27"}]}`,
+		`{"expanders": [{"name": "exp", "address": "0x20", "out": 1, "in": 1}]}`,
+		`{"encoders": [{"name": "knob", "a": "GPIO17"}]}`,
+		`{"encoders": [{"name": "knob", "a": "1", "b": "2"}, {"name": "knob", "a": "3", "b": "4"}]}`,
+	} {
+		if _, err := ParseManifest(strings.NewReader(bad)); err == nil {
+			t.Errorf("%s: expected error", bad)
+		}
+	}
+}
+
+func TestReport(t *testing.T) {
+	r := &Report{Board: "pi"}
+	r.Add(Result{Test: "lcd-pattern", Device: "lcd", Duration: 1500 * time.Millisecond})
+	r.Add(Result{Test: "expander-loopback", Device: "exp", Status: Fail, Detail: "GPIO1 read Low"})
+	r.Add(Result{Test: "encoder-turn", Device: "knob", Status: Skip})
+	if !r.Failed() {
+		t.Error("expected a failure")
+	}
+	var b bytes.Buffer
+	if _, err := r.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"HIL report for pi", "PASS    lcd-pattern", "FAIL    expander-loopback  exp     0s    GPIO1 read Low", "1 passed, 1 failed, 1 skipped"} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("report without %q:\n%s", want, b.String())
+		}
+	}
+}
+
+func TestOperator(t *testing.T) {
+	var out bytes.Buffer
+	o := NewOperator(strings.NewReader("maybe\nY\nn\n"), &out)
+	if ok, err := o.Confirm("Is %s lit?", "lcd"); err != nil || !ok {
+		t.Fatal(ok, err)
+	}
+	if ok, err := o.Confirm("Again?"); err != nil || ok {
+		t.Fatal(ok, err)
+	}
+	if _, err := o.Confirm("Once more?"); err == nil {
+		t.Fatal("expected an error at the end of the input")
+	}
+	if !strings.HasPrefix(out.String(), ">>> Is lcd lit? [y/n] >>> Is lcd lit? [y/n] ") {
+		t.Errorf("unexpected prompts %q", out.String())
+	}
+	o = NewOperator(nil, &out)
+	if o.Attended() {
+		t.Error("expected no operator")
+	}
+	if err := o.Instruct("Turn the knob"); !errors.Is(err, ErrUnattended) {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package hil
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Status is the outcome of a test.
+type Status int
+
+const (
+	Pass Status = iota
+	Fail
+	Skip
+)
+
+func (s Status) String() string {
+	switch s {
+	case Pass:
+		return "PASS"
+	case Fail:
+		return "FAIL"
+	case Skip:
+		return "SKIP"
+	default:
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+}
+
+// Result is the outcome of a test of a device.
+type Result struct {
+	Test     string
+	Device   string
+	Status   Status
+	Detail   string
+	Duration time.Duration
+}
+
+// Report collects the results of the tests. It's safe for concurrent use.
+type Report struct {
+	Board string
+
+	mu      sync.Mutex
+	results []Result
+}
+
+// Add adds a result.
+func (r *Report) Add(res Result) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, res)
+}
+
+// Results returns the results added.
+func (r *Report) Results() []Result {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Result(nil), r.results...)
+}
+
+// Failed returns true if a test failed.
+func (r *Report) Failed() bool {
+	for _, res := range r.Results() {
+		if res.Status == Fail {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteTo implements io.WriterTo. It writes a table of the results, and the
+// count of each status.
+func (r *Report) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "HIL report for %s\n\n", r.Board)
+	tw := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "STATUS\tTEST\tDEVICE\tTIME\tDETAIL")
+	var counts [3]int
+	for _, res := range r.Results() {
+		if int(res.Status) < len(counts) {
+			counts[res.Status]++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", res.Status, res.Test, res.Device, res.Duration.Round(time.Millisecond), res.Detail)
+	}
+	_ = tw.Flush()
+	fmt.Fprintf(&b, "\n%d passed, %d failed, %d skipped\n", counts[Pass], counts[Fail], counts[Skip])
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// ErrUnattended is returned by the Operator methods when no operator is
+// present.
+var ErrUnattended = errors.New("hil: no operator")
+
+// Operator asks the person running the tests to act, or to check the
+// result of a test.
+type Operator struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// NewOperator returns an Operator reading answers from in and writing
+// prompts to out. If in is nil, there's no operator, and the methods return
+// ErrUnattended.
+func NewOperator(in io.Reader, out io.Writer) *Operator {
+	o := &Operator{out: out}
+	if in != nil {
+		o.in = bufio.NewReader(in)
+	}
+	return o
+}
+
+// Attended returns true if there's an operator.
+func (o *Operator) Attended() bool {
+	return o.in != nil
+}
+
+// Instruct asks the operator to act, such as to turn a knob, and returns
+// without waiting.
+func (o *Operator) Instruct(format string, args ...any) error {
+	if o.in == nil {
+		return ErrUnattended
+	}
+	_, err := fmt.Fprintf(o.out, ">>> "+format+"\n", args...)
+	return err
+}
+
+// Confirm asks the operator a yes or no question, and returns the answer.
+func (o *Operator) Confirm(format string, args ...any) (bool, error) {
+	if o.in == nil {
+		return false, ErrUnattended
+	}
+	for {
+		if _, err := fmt.Fprintf(o.out, ">>> "+format+" [y/n] ", args...); err != nil {
+			return false, err
+		}
+		l, err := o.in.ReadString('\n')
+		switch strings.ToLower(strings.TrimSpace(l)) {
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("hil: %w", err)
+		}
+	}
+}
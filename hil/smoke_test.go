@@ -0,0 +1,286 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+//go:build hil
+
+package hil
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/hd44780"
+	"periph.io/x/devices/v3/mcp23xxx"
+	"periph.io/x/devices/v3/pcf857x"
+	"periph.io/x/devices/v3/rotary"
+	"periph.io/x/host/v3"
+)
+
+// promptTimeout is how long the operator has to act.
+const promptTimeout = 15 * time.Second
+
+var (
+	manifest *Manifest
+	report   = &Report{}
+	operator *Operator
+)
+
+func TestMain(m *testing.M) {
+	name := os.Getenv("HIL_MANIFEST")
+	if name == "" {
+		fmt.Println("hil: HIL_MANIFEST isn't set, skipping the hardware tests")
+		os.Exit(0)
+	}
+	var err error
+	if manifest, err = ReadManifest(name); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if _, err := host.Init(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	report.Board = manifest.Board
+	var in io.Reader = os.Stdin
+	if os.Getenv("HIL_UNATTENDED") != "" {
+		in = nil
+	}
+	operator = NewOperator(in, os.Stdout)
+	code := m.Run()
+	_, _ = report.WriteTo(os.Stdout)
+	if path := os.Getenv("HIL_REPORT"); path != "" {
+		f, err := os.Create(path)
+		if err == nil {
+			_, err = report.WriteTo(f)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			code = 1
+		}
+	}
+	os.Exit(code)
+}
+
+// check runs f as a subtest for device, and adds its result to the report.
+// f sets res.Detail before failing, to explain the failure in the report.
+func check(t *testing.T, test, device string, f func(t *testing.T, res *Result)) {
+	t.Run(device, func(t *testing.T) {
+		res := Result{Test: test, Device: device}
+		start := time.Now()
+		defer func() {
+			res.Duration = time.Since(start)
+			switch {
+			case t.Skipped():
+				res.Status = Skip
+			case t.Failed():
+				res.Status = Fail
+			}
+			report.Add(res)
+		}()
+		f(t, &res)
+	})
+}
+
+// openBus opens the I²C bus name, closed at the end of the test.
+func openBus(t *testing.T, res *Result, name string) i2c.Bus {
+	b, err := i2creg.Open(name)
+	if err != nil {
+		res.Detail = err.Error()
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = b.Close() })
+	return b
+}
+
+// TestLCD writes a pattern with the number of each row, and asks the
+// operator to check it.
+func TestLCD(t *testing.T) {
+	if len(manifest.LCDs) == 0 {
+		t.Skip("no LCD in the manifest")
+	}
+	for _, l := range manifest.LCDs {
+		check(t, "lcd-pattern", l.Name, func(t *testing.T, res *Result) {
+			b := openBus(t, res, l.Bus)
+			addr, _ := ParseAddress(l.Address)
+			var lcd *hd44780.HD44780
+			var err error
+			switch l.Type {
+			case "pcf8574":
+				lcd, err = hd44780.NewPCF857xBackpack(b, addr, l.Rows, l.Cols)
+			case "adafruit":
+				lcd, err = hd44780.NewAdafruitI2CBackpack(b, addr, l.Rows, l.Cols)
+			default:
+				res.Detail = "unknown type " + l.Type
+				t.Skip(res.Detail)
+			}
+			if err != nil {
+				res.Detail = err.Error()
+				t.Fatal(err)
+			}
+			for row := 1; row <= l.Rows; row++ {
+				text := fmt.Sprintf("Row %d ", row)
+				for ix := 0; len(text) < l.Cols; ix++ {
+					text += string(rune('A' + ix%26))
+				}
+				if err := lcd.MoveTo(row, 1); err != nil {
+					res.Detail = err.Error()
+					t.Fatal(err)
+				}
+				if _, err := lcd.WriteString(text[:l.Cols]); err != nil {
+					res.Detail = err.Error()
+					t.Fatal(err)
+				}
+			}
+			ok, err := operator.Confirm("Does %s show rows 1 to %d, filled with letters?", l.Name, l.Rows)
+			if err == ErrUnattended {
+				res.Detail = "written, not checked"
+				return
+			}
+			if err != nil || !ok {
+				res.Detail = "pattern not seen"
+				t.Fatal(res.Detail, err)
+			}
+			_ = lcd.Clear()
+		})
+	}
+}
+
+// openExpander returns the pins of the expander e.
+func openExpander(b i2c.Bus, e Expander) ([]gpio.PinIO, error) {
+	addr, _ := ParseAddress(e.Address)
+	variant := strings.ToUpper(e.Type)
+	if strings.HasPrefix(variant, "PCF") {
+		dev, err := pcf857x.New(b, addr, pcf857x.Variant(variant))
+		if err != nil {
+			return nil, err
+		}
+		return dev.Pins, nil
+	}
+	dev, err := mcp23xxx.NewI2C(b, mcp23xxx.Variant(variant), addr)
+	if err != nil {
+		return nil, err
+	}
+	var pins []gpio.PinIO
+	for _, port := range dev.Pins {
+		for _, p := range port {
+			pins = append(pins, p)
+		}
+	}
+	return pins, nil
+}
+
+// TestExpanderLoopback drives the out pin of each expander, and reads the
+// level on its in pin.
+func TestExpanderLoopback(t *testing.T) {
+	if len(manifest.Expanders) == 0 {
+		t.Skip("no expander in the manifest")
+	}
+	for _, e := range manifest.Expanders {
+		check(t, "expander-loopback", e.Name, func(t *testing.T, res *Result) {
+			pins, err := openExpander(openBus(t, res, e.Bus), e)
+			if err != nil {
+				res.Detail = err.Error()
+				t.Fatal(err)
+			}
+			if e.Out >= len(pins) || e.In >= len(pins) {
+				res.Detail = fmt.Sprintf("the device has %d pins", len(pins))
+				t.Fatal(res.Detail)
+			}
+			out, in := pins[e.Out], pins[e.In]
+			if err := in.In(gpio.Float, gpio.NoEdge); err != nil {
+				res.Detail = err.Error()
+				t.Fatal(err)
+			}
+			for _, l := range []gpio.Level{gpio.High, gpio.Low, gpio.High} {
+				if err := out.Out(l); err != nil {
+					res.Detail = err.Error()
+					t.Fatal(err)
+				}
+				if got := in.Read(); got != l {
+					res.Detail = fmt.Sprintf("%s read %s after %s was set %s", in, got, out, l)
+					t.Fatal(res.Detail)
+				}
+			}
+		})
+	}
+}
+
+// waitEvent waits for an event of kind from events.
+func waitEvent(events <-chan rotary.Event, kind rotary.Kind) (rotary.Event, bool) {
+	timeout := time.After(promptTimeout)
+	for {
+		select {
+		case ev := <-events:
+			if ev.Kind == kind {
+				return ev, true
+			}
+		case <-timeout:
+			return rotary.Event{}, false
+		}
+	}
+}
+
+// TestEncoder asks the operator to turn each encoder in both directions,
+// and to press its switch.
+func TestEncoder(t *testing.T) {
+	if len(manifest.Encoders) == 0 {
+		t.Skip("no encoder in the manifest")
+	}
+	for _, e := range manifest.Encoders {
+		check(t, "encoder-turn", e.Name, func(t *testing.T, res *Result) {
+			if !operator.Attended() {
+				res.Detail = "needs an operator"
+				t.Skip(res.Detail)
+			}
+			var sw gpio.PinIn
+			if e.SW != "" {
+				sw = gpioreg.ByName(e.SW)
+			}
+			enc, err := rotary.New(gpioreg.ByName(e.A), gpioreg.ByName(e.B), sw)
+			if err != nil {
+				res.Detail = err.Error()
+				t.Fatal(err)
+			}
+			events, err := enc.Start()
+			if err != nil {
+				res.Detail = err.Error()
+				t.Fatal(err)
+			}
+			defer enc.Stop()
+			for _, dir := range []struct {
+				name string
+				sign int
+			}{{"clockwise", 1}, {"counter-clockwise", -1}} {
+				_ = operator.Instruct("Turn %s one detent %s", e.Name, dir.name)
+				ev, ok := waitEvent(events, rotary.Turn)
+				if !ok {
+					res.Detail = "no turn " + dir.name
+					t.Fatal(res.Detail)
+				}
+				if ev.Steps*dir.sign < 0 {
+					res.Detail = fmt.Sprintf("turning %s decoded %+d steps: swap A and B", dir.name, ev.Steps)
+					t.Fatal(res.Detail)
+				}
+			}
+			if sw != nil {
+				_ = operator.Instruct("Press %s", e.Name)
+				if _, ok := waitEvent(events, rotary.Press); !ok {
+					res.Detail = "no press"
+					t.Fatal(res.Detail)
+				}
+			}
+		})
+	}
+}
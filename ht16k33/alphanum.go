@@ -5,6 +5,8 @@
 package ht16k33
 
 import (
+	"fmt"
+
 	"periph.io/x/conn/v3/i2c"
 )
 
@@ -161,7 +163,21 @@ func (d *Display) WriteString(s string) (int, error) {
 	return pos, nil
 }
 
+// SetBlink Blink display at specified frequency.
+func (d *Display) SetBlink(freq BlinkFrequency) error {
+	return d.dev.SetBlink(freq)
+}
+
+// SetBrightness of entire display to specified value, from 0 to 15.
+func (d *Display) SetBrightness(brightness int) error {
+	return d.dev.SetBrightness(brightness)
+}
+
 // Halt clear all the display.
 func (d *Display) Halt() error {
 	return d.dev.Halt()
 }
+
+func (d *Display) String() string {
+	return fmt.Sprintf("AlphaNumericDisplay{%s}", d.dev)
+}
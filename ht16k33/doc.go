@@ -6,6 +6,9 @@
 //
 // # More Details
 //
+// Display drives the Adafruit 4-digit 14-segment alphanumeric backpack, and
+// SevenSegmentDisplay the Adafruit 4-digit 7-segment backpack with a colon.
+//
 // # Datasheets
 //
 // http://www.holtek.com/documents/10179/116711/HT16K33v120.pdf
@@ -61,3 +61,38 @@ func Example() {
 	}
 	time.Sleep(1 * time.Second)
 }
+
+func Example_sevenSegment() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer bus.Close()
+
+	display, err := ht16k33.NewSevenSegmentDisplay(bus, ht16k33.I2CAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer display.Halt()
+
+	if err := display.SetBrightness(8); err != nil {
+		log.Fatal(err)
+	}
+	// Show the time, with the colon blinking every second.
+	for range 10 {
+		now := time.Now()
+		if _, err := display.WriteString(now.Format("15:04")); err != nil {
+			log.Fatal(err)
+		}
+		time.Sleep(500 * time.Millisecond)
+		if err := display.SetColon(false); err != nil {
+			log.Fatal(err)
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
@@ -6,6 +6,7 @@ package ht16k33
 
 import (
 	"errors"
+	"fmt"
 
 	"periph.io/x/conn/v3/i2c"
 )
@@ -97,6 +98,10 @@ func (d *Dev) WriteColumn(column int, data uint16) error {
 	return err
 }
 
+func (d *Dev) String() string {
+	return fmt.Sprintf("HT16K33{%s}", &d.dev)
+}
+
 // Halt clear the contents of display buffer.
 func (d *Dev) Halt() error {
 	for i := 0; i < 4; i++ {
@@ -0,0 +1,185 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ht16k33
+
+import (
+	"fmt"
+	"unicode"
+
+	"periph.io/x/conn/v3/i2c"
+)
+
+// segmentValues maps characters to the segments a (bit 0) to g (bit 6) of a
+// 7-segment digit. Lower case letters that have no distinct shape use the
+// upper case one.
+var segmentValues = map[rune]byte{
+	' ':  0x00,
+	'-':  0x40,
+	'_':  0x08,
+	'=':  0x48,
+	'"':  0x22,
+	'\'': 0x02,
+	'°':  0x63,
+	'0':  0x3f,
+	'1':  0x06,
+	'2':  0x5b,
+	'3':  0x4f,
+	'4':  0x66,
+	'5':  0x6d,
+	'6':  0x7d,
+	'7':  0x07,
+	'8':  0x7f,
+	'9':  0x6f,
+	'A':  0x77,
+	'B':  0x7c,
+	'C':  0x39,
+	'D':  0x5e,
+	'E':  0x79,
+	'F':  0x71,
+	'G':  0x3d,
+	'H':  0x76,
+	'I':  0x30,
+	'J':  0x1e,
+	'L':  0x38,
+	'N':  0x54,
+	'O':  0x3f,
+	'P':  0x73,
+	'R':  0x50,
+	'S':  0x6d,
+	'T':  0x78,
+	'U':  0x3e,
+	'Y':  0x6e,
+	'c':  0x58,
+	'h':  0x74,
+	'o':  0x5c,
+	'u':  0x1c,
+}
+
+const (
+	// segmentDP is the decimal point of a 7-segment digit.
+	segmentDP = 0x80
+	// colonPosition is the display RAM position of the colon of the
+	// Adafruit 7-segment backpack, between digits 1 and 2.
+	colonPosition = 2
+	// colonOn lights the center colon.
+	colonOn = 0x02
+	// sevenSegDigits is the number of digits of the backpack.
+	sevenSegDigits = 4
+)
+
+// SevenSegmentDisplay is a handler to control the Adafruit 4-digit 7-segment
+// backpack based on ht16k33.
+type SevenSegmentDisplay struct {
+	dev *Dev
+}
+
+// NewSevenSegmentDisplay returns a SevenSegmentDisplay object that
+// communicates over I2C to ht16k33.
+//
+// To use on the default address, ht16k33.I2CAddr must be passed as argument.
+func NewSevenSegmentDisplay(bus i2c.Bus, address uint16) (*SevenSegmentDisplay, error) {
+	dev, err := NewI2C(bus, address)
+	if err != nil {
+		return nil, err
+	}
+	return &SevenSegmentDisplay{dev: dev}, nil
+}
+
+// SetDigit at position, 0 to 3 from the left, to provided value. Characters
+// that can't be shown on 7 segments are blank.
+func (d *SevenSegmentDisplay) SetDigit(pos int, digit rune, decimal bool) error {
+	if pos < 0 || pos >= sevenSegDigits {
+		return fmt.Errorf("ht16k33: invalid digit position %d", pos)
+	}
+	val, ok := segmentValues[digit]
+	if !ok {
+		val = segmentValues[unicode.ToUpper(digit)]
+	}
+	if decimal {
+		val |= segmentDP
+	}
+	// The colon is between digits 1 and 2.
+	if pos >= colonPosition {
+		pos++
+	}
+	return d.dev.WriteColumn(pos, uint16(val))
+}
+
+// SetColon turns the colon on or off.
+func (d *SevenSegmentDisplay) SetColon(on bool) error {
+	var val uint16
+	if on {
+		val = colonOn
+	}
+	return d.dev.WriteColumn(colonPosition, val)
+}
+
+// WriteString print string of values to the display, right aligned. A '.'
+// lights the decimal point of the previous digit, and a ':' turns the colon
+// on; otherwise the colon is turned off.
+//
+// Characters that can't be shown on 7 segments are blank.
+func (d *SevenSegmentDisplay) WriteString(s string) (int, error) {
+	var digits []rune
+	var points []bool
+	colon := false
+	for _, ch := range s {
+		switch {
+		case ch == ':':
+			colon = true
+		case ch == '.' && len(digits) > 0 && !points[len(points)-1]:
+			points[len(points)-1] = true
+		default:
+			digits = append(digits, ch)
+			points = append(points, ch == '.')
+			if ch == '.' {
+				digits[len(digits)-1] = ' '
+			}
+		}
+	}
+	if len(digits) > sevenSegDigits {
+		digits = digits[:sevenSegDigits]
+		points = points[:sevenSegDigits]
+	}
+	pos := sevenSegDigits - len(digits)
+	for i := range pos {
+		if err := d.SetDigit(i, ' ', false); err != nil {
+			return 0, err
+		}
+	}
+	for i, ch := range digits {
+		if err := d.SetDigit(pos+i, ch, points[i]); err != nil {
+			return i, err
+		}
+	}
+	if err := d.SetColon(colon); err != nil {
+		return len(digits), err
+	}
+	return len(digits), nil
+}
+
+// SetBlink Blink display at specified frequency.
+func (d *SevenSegmentDisplay) SetBlink(freq BlinkFrequency) error {
+	return d.dev.SetBlink(freq)
+}
+
+// SetBrightness of entire display to specified value, from 0 to 15.
+func (d *SevenSegmentDisplay) SetBrightness(brightness int) error {
+	return d.dev.SetBrightness(brightness)
+}
+
+func (d *SevenSegmentDisplay) String() string {
+	return fmt.Sprintf("SevenSegmentDisplay{%s}", d.dev)
+}
+
+// Halt clear all the display, including the colon.
+func (d *SevenSegmentDisplay) Halt() error {
+	for i := 0; i <= sevenSegDigits; i++ {
+		if err := d.dev.WriteColumn(i, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
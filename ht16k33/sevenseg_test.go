@@ -0,0 +1,42 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ht16k33
+
+import (
+	"bytes"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestSevenSegmentWriteString(t *testing.T) {
+	bus := &i2ctest.Record{}
+	d, err := NewSevenSegmentDisplay(bus, I2CAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bus.Ops = nil
+	if _, err = d.WriteString("1:2.5"); err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]byte{
+		{0, 0x00, 0},
+		{2, 0x06, 0},
+		{6, 0xdb, 0},
+		{8, 0x6d, 0},
+		{4, colonOn, 0},
+	}
+	if len(bus.Ops) != len(expected) {
+		t.Fatalf("got %d writes, expected %d", len(bus.Ops), len(expected))
+	}
+	for ix, op := range bus.Ops {
+		if !bytes.Equal(op.W, expected[ix]) {
+			t.Errorf("write %d: got % x, expected % x", ix, op.W, expected[ix])
+		}
+	}
+	if err = d.SetDigit(4, '1', false); err == nil {
+		t.Error("SetDigit(4) succeeded")
+	}
+}
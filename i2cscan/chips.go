@@ -0,0 +1,182 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package i2cscan
+
+// chip is a chip, the addresses it can use, and its signature.
+type chip struct {
+	Chip
+	// first and last are the range of addresses of the chip, and more its
+	// other addresses.
+	first, last uint16
+	more        []uint16
+	// registerless is true for chips without registers, whose outputs are
+	// set by any byte written.
+	registerless bool
+	// commands is true for chips that take the first byte written as a
+	// command, rather than as a register address. Only the bytes in safe,
+	// which don't change their state, are written to their addresses.
+	commands bool
+	safe     []byte
+	// match returns true if the device has the signature of the chip. It's
+	// nil for chips without a signature. For chips without registers, it
+	// only looks at prober.raw.
+	match func(p *prober) bool
+	// exact is true if match checks an identification register, rather than
+	// values that are only likely.
+	exact bool
+}
+
+func (c *chip) hasAddr(addr uint16) bool {
+	if addr >= c.first && addr <= c.last {
+		return true
+	}
+	for _, a := range c.more {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// chips is the chips supported by this repository that have an I²C
+// interface, in the order they're listed as candidates.
+var chips = []chip{
+	{Chip: Chip{"ADS1x15", "ads1x15"}, first: 0x48, last: 0x4b},
+	{Chip: Chip{"ADXL345", "adxl345"}, first: 0x53, last: 0x53, more: []uint16{0x1d},
+		match: func(p *prober) bool { return p.is8(0x00, 0xe5) }, exact: true},
+	{Chip: Chip{"AHT20", "aht20"}, first: 0x38, last: 0x38},
+	{Chip: Chip{"AM2320", "am2320"}, first: 0x5c, last: 0x5c},
+	{Chip: Chip{"APDS-9960", "apds9960"}, first: 0x39, last: 0x39,
+		match: func(p *prober) bool { return p.is8(0x92, 0xab) }, exact: true},
+	{Chip: Chip{"AS5600", "as5600"}, first: 0x36, last: 0x36},
+	{Chip: Chip{"AT24C", "at24c"}, first: 0x50, last: 0x57},
+	{Chip: Chip{"BH1750", "bh1750"}, first: 0x23, last: 0x23, more: []uint16{0x5c}, commands: true},
+	{Chip: Chip{"BMP180", "bmxx80"}, first: 0x77, last: 0x77,
+		match: func(p *prober) bool { return p.is8(0xd0, 0x55) }, exact: true},
+	{Chip: Chip{"BMP280", "bmxx80"}, first: 0x76, last: 0x77,
+		match: func(p *prober) bool { return p.is8(0xd0, 0x56, 0x57, 0x58) }, exact: true},
+	{Chip: Chip{"BME280", "bmxx80"}, first: 0x76, last: 0x77,
+		match: func(p *prober) bool { return p.is8(0xd0, 0x60) }, exact: true},
+	{Chip: Chip{"CAP1xxx", "cap1xxx"}, first: 0x28, last: 0x2d,
+		match: func(p *prober) bool { return p.is8(0xfe, 0x5d) }, exact: true},
+	{Chip: Chip{"CCS811", "ccs811"}, first: 0x5a, last: 0x5b,
+		match: func(p *prober) bool { return p.is8(0x20, 0x81) }, exact: true},
+	{Chip: Chip{"DS248x", "ds248x"}, first: 0x18, last: 0x1b},
+	{Chip: Chip{"DS3231", "ds3231"}, first: 0x68, last: 0x68, match: matchDS3231},
+	{Chip: Chip{"HDC302x", "hdc302x"}, first: 0x44, last: 0x47},
+	// 0x40 reads the key data. 0xd0, the chip ID register of the BMx280, isn't
+	// an HT16K33 command.
+	{Chip: Chip{"HT16K33", "ht16k33"}, first: 0x70, last: 0x77, match: matchHT16K33,
+		commands: true, safe: []byte{0x40, 0xd0}},
+	{Chip: Chip{"MCP23008", "mcp23xxx"}, first: 0x20, last: 0x27, match: matchMCP23008},
+	{Chip: Chip{"MCP23017", "mcp23xxx"}, first: 0x20, last: 0x27, match: matchMCP23017},
+	{Chip: Chip{"MCP4725", "mcp4725"}, first: 0x60, last: 0x67},
+	{Chip: Chip{"MCP9808", "mcp9808"}, first: 0x18, last: 0x1f, match: matchMCP9808, exact: true},
+	{Chip: Chip{"MPU-9250", "mpu9250"}, first: 0x68, last: 0x69,
+		match: func(p *prober) bool { return p.is8(0x75, 0x71, 0x73) }, exact: true},
+	{Chip: Chip{"PCA9548", "pca9548"}, first: 0x70, last: 0x77, registerless: true},
+	{Chip: Chip{"PCA9685", "pca9685"}, first: 0x40, last: 0x6f, more: []uint16{0x71, 0x72, 0x73, 0x74, 0x75, 0x76, 0x77},
+		match: matchPCA9685},
+	{Chip: Chip{"PCF8574", "pcf857x"}, first: 0x20, last: 0x27, registerless: true, match: matchPCF8574},
+	{Chip: Chip{"PCF8574A", "pcf857x"}, first: 0x38, last: 0x3f, registerless: true, match: matchPCF8574},
+	{Chip: Chip{"PCF8575", "pcf857x"}, first: 0x20, last: 0x27, registerless: true, match: matchPCF8575},
+	// Each byte written is a control byte, which can enable the analog output.
+	{Chip: Chip{"PCF8591", "pcf8591"}, first: 0x48, last: 0x4f, commands: true},
+	{Chip: Chip{"SCD4x", "scd4x"}, first: 0x62, last: 0x62},
+	{Chip: Chip{"SGP30", "sgp30"}, first: 0x58, last: 0x58},
+	{Chip: Chip{"SHT3x/SHT4x", "shtxx"}, first: 0x44, last: 0x45},
+	{Chip: Chip{"SSD1306", "ssd1306"}, first: 0x3c, last: 0x3d},
+	{Chip: Chip{"SX1509", "sx1509"}, first: 0x3e, last: 0x3f, more: []uint16{0x70, 0x71}},
+	{Chip: Chip{"TCA8418", "tca8418"}, first: 0x34, last: 0x34},
+	{Chip: Chip{"Tic", "tic"}, first: 0x0e, last: 0x0e},
+	{Chip: Chip{"TLV493D", "tlv493d"}, first: 0x5e, last: 0x5e, more: []uint16{0x1f}},
+	{Chip: Chip{"TMP102", "tmp102"}, first: 0x48, last: 0x4b},
+}
+
+// matchDS3231 checks that the time registers are BCD, and that the bits of
+// the status and temperature registers that always read 0 are.
+func matchDS3231(p *prober) bool {
+	r, ok := p.read(0x00, 0x13)
+	if !ok {
+		return false
+	}
+	return isBCD(r[0]&0x7f) && r[0]&0x7f < 0x60 && isBCD(r[1]) && r[1] < 0x60 &&
+		r[0x0f]&0x70 == 0 && r[0x12]&0x3f == 0
+}
+
+func isBCD(v byte) bool {
+	return v&0x0f < 10 && v>>4 < 10
+}
+
+// matchHT16K33 reads the key data, whose 3 top bits of each row are 0.
+func matchHT16K33(p *prober) bool {
+	r, ok := p.read(0x40, 6)
+	if !ok {
+		return false
+	}
+	return r[1]&0xe0 == 0 && r[3]&0xe0 == 0 && r[5]&0xe0 == 0
+}
+
+// isMCP23017 returns true if IOCON is at both 0x0a and 0x0b, where the
+// MCP23017 maps it when IOCON.BANK is clear, and at 0x05 and 0x15 when it's
+// set. The unimplemented bit 0 of IOCON reads 0.
+func isMCP23017(p *prober) bool {
+	a, okA := p.read8(0x0a)
+	b, okB := p.read8(0x0b)
+	if okA && okB && a == b && a&0x81 == 0 {
+		return true
+	}
+	a, okA = p.read8(0x05)
+	b, okB = p.read8(0x15)
+	return okA && okB && a == b && a&0x81 == 0x80
+}
+
+// matchMCP23008 checks the unimplemented bits of IOCON read 0.
+func matchMCP23008(p *prober) bool {
+	iocon, ok := p.read8(0x05)
+	return ok && iocon&0xc1 == 0 && !isMCP23017(p)
+}
+
+func matchMCP23017(p *prober) bool {
+	return isMCP23017(p)
+}
+
+// matchMCP9808 reads the manufacturer and device IDs.
+func matchMCP9808(p *prober) bool {
+	m, ok := p.read(0x06, 2)
+	if !ok || m[0] != 0x00 || m[1] != 0x54 {
+		return false
+	}
+	d, ok := p.read(0x07, 2)
+	return ok && d[0] == 0x04
+}
+
+// matchPCA9685 checks the reserved bits of MODE2 read 0, and that the
+// prescaler is at least its minimum of 3.
+func matchPCA9685(p *prober) bool {
+	mode2, ok := p.read8(0x01)
+	if !ok || mode2&0xe0 != 0 {
+		return false
+	}
+	prescale, ok := p.read8(0xfe)
+	return ok && prescale >= 3
+}
+
+// matchPCF8574 checks that the port reads the same each time. A PCF8575
+// whose two ports read the same can't be told apart, and matches too.
+func matchPCF8574(p *prober) bool {
+	for _, v := range p.raw {
+		if v != p.raw[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// matchPCF8575 checks that the two ports read the same each time, and
+// differ from each other.
+func matchPCF8575(p *prober) bool {
+	return singleRegister(p.raw) && p.raw[0] != p.raw[1]
+}
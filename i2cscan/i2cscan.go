@@ -0,0 +1,226 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package i2cscan finds the devices that respond on an I²C bus, and
+// identifies the chips supported by this repository by their signature
+// registers, to discover what's wired to a bus.
+//
+// # Probing
+//
+// An address is probed by reading a byte from it, since the Linux I²C
+// interface doesn't support the quick write used by i2cdetect. Chips that
+// don't acknowledge a read without a pending command, like some humidity
+// sensors, aren't found.
+//
+// A device is identified by reading registers: the register address is
+// written, then the register is read, which doesn't change the state of the
+// chips supported. Chips without registers, like the PCF8574, set their
+// outputs to any byte written instead. When such a chip can be at an
+// address, a device whose reads all return the same value, as if it had a
+// single register, is not probed further. Chips that take the first byte
+// written as a command, like the PCF8591, are only sent the commands that
+// don't change their state, so that the devices at their addresses are
+// often identified only by a plain read, or not at all.
+//
+// Reading a register can clear a flag, like an interrupt status, so
+// scanning a bus in use can disturb the drivers of its devices.
+package i2cscan
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"periph.io/x/conn/v3/i2c"
+)
+
+const (
+	// FirstAddr and LastAddr are the addresses scanned by Scan(). The
+	// addresses outside are reserved by the I²C specification.
+	FirstAddr uint16 = 0x08
+	LastAddr  uint16 = 0x77
+)
+
+// rawReadSize is the number of bytes read without writing a register
+// address, to detect chips without registers.
+const rawReadSize = 16
+
+// Chip is a chip supported by this repository.
+type Chip struct {
+	Name string
+	// Package is the package of this repository that drives the chip.
+	Package string
+}
+
+func (c Chip) String() string {
+	return c.Name + " (" + c.Package + ")"
+}
+
+// Device is a device that responded on a bus.
+type Device struct {
+	Addr uint16
+	// Chip is the chip identified, nil if it wasn't.
+	Chip *Chip
+	// Candidates are the chips that the device can be, if it wasn't
+	// identified: the chips whose signature matched, or all the chips
+	// supported that can use Addr.
+	Candidates []Chip
+}
+
+func (d *Device) String() string {
+	switch {
+	case d.Chip != nil:
+		return fmt.Sprintf("0x%02x %s", d.Addr, d.Chip)
+	case len(d.Candidates) != 0:
+		names := make([]string, len(d.Candidates))
+		for ix, c := range d.Candidates {
+			names[ix] = c.String()
+		}
+		return fmt.Sprintf("0x%02x unidentified, maybe %s", d.Addr, strings.Join(names, ", "))
+	default:
+		return fmt.Sprintf("0x%02x unknown", d.Addr)
+	}
+}
+
+// Probe returns true if a device responds at addr.
+func Probe(b i2c.Bus, addr uint16) bool {
+	var r [1]byte
+	return b.Tx(addr, nil, r[:]) == nil
+}
+
+// Scan returns the addresses from FirstAddr to LastAddr where a device
+// responds.
+func Scan(b i2c.Bus) []uint16 {
+	var found []uint16
+	for addr := FirstAddr; addr <= LastAddr; addr++ {
+		if Probe(b, addr) {
+			found = append(found, addr)
+		}
+	}
+	return found
+}
+
+// Identify identifies the device at addr by the signatures of the chips
+// that can use it. An identification register that matches takes precedence
+// over a signature that's only likely.
+func Identify(b i2c.Bus, addr uint16) Device {
+	d := Device{Addr: addr}
+	p := &prober{b: b, addr: addr}
+	raw := make([]byte, rawReadSize)
+	if b.Tx(addr, nil, raw) == nil {
+		p.raw = raw
+	}
+	var all, exact, likely []Chip
+	registerless := false
+	for ix := range chips {
+		if c := &chips[ix]; c.hasAddr(addr) {
+			all = append(all, c.Chip)
+			registerless = registerless || c.registerless
+			if c.commands {
+				p.commands = append(p.commands, c)
+			}
+		}
+	}
+	// Registers aren't read from a device that may be a chip without
+	// registers.
+	readRegisters := !registerless || (p.raw != nil && !singleRegister(p.raw))
+	for ix := range chips {
+		c := &chips[ix]
+		if !c.hasAddr(addr) || c.match == nil {
+			continue
+		}
+		if c.registerless && p.raw == nil || !c.registerless && !readRegisters {
+			continue
+		}
+		if c.match(p) {
+			if c.exact {
+				exact = append(exact, c.Chip)
+			} else {
+				likely = append(likely, c.Chip)
+			}
+		}
+	}
+	matched := exact
+	if len(matched) == 0 {
+		matched = likely
+	}
+	switch len(matched) {
+	case 0:
+		d.Candidates = all
+	case 1:
+		d.Chip = &matched[0]
+	default:
+		d.Candidates = matched
+	}
+	return d
+}
+
+// ScanAndIdentify scans b, and identifies each device found.
+func ScanAndIdentify(b i2c.Bus) []Device {
+	var devs []Device
+	for _, addr := range Scan(b) {
+		devs = append(devs, Identify(b, addr))
+	}
+	return devs
+}
+
+// singleRegister returns true if raw repeats the same 8 or 16 bits, as the
+// reads of a chip without registers, or of a single register, do.
+func singleRegister(raw []byte) bool {
+	for ix := range raw {
+		if raw[ix] != raw[ix%2] {
+			return false
+		}
+	}
+	return true
+}
+
+// prober reads the registers of the device at addr.
+type prober struct {
+	b    i2c.Bus
+	addr uint16
+	// raw is the bytes read without writing a register address, nil if the
+	// read failed.
+	raw []byte
+	// commands is the chips that can be at addr and take commands.
+	commands []*chip
+}
+
+// read reads n bytes from reg. It fails without writing reg if reg is a
+// command that may change the state of a chip at the address.
+func (p *prober) read(reg byte, n int) ([]byte, bool) {
+	for _, c := range p.commands {
+		if !slices.Contains(c.safe, reg) {
+			return nil, false
+		}
+	}
+	r := make([]byte, n)
+	if err := p.b.Tx(p.addr, []byte{reg}, r); err != nil {
+		return nil, false
+	}
+	return r, true
+}
+
+// read8 reads the 8 bit register reg.
+func (p *prober) read8(reg byte) (byte, bool) {
+	r, ok := p.read(reg, 1)
+	if !ok {
+		return 0, false
+	}
+	return r[0], true
+}
+
+// is8 returns true if the 8 bit register reg is one of values.
+func (p *prober) is8(reg byte, values ...byte) bool {
+	v, ok := p.read8(reg)
+	if !ok {
+		return false
+	}
+	for _, want := range values {
+		if v == want {
+			return true
+		}
+	}
+	return false
+}
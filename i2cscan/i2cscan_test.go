@@ -0,0 +1,153 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package i2cscan
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/devices/v3/sim"
+)
+
+// regDevice is a chip with registers. Reading without writing a register
+// address continues from the last register read.
+type regDevice struct {
+	regs   []byte
+	ptr    int
+	writes int
+}
+
+func newRegDevice(n int, values map[int]byte) *regDevice {
+	d := &regDevice{regs: make([]byte, n)}
+	for reg, v := range values {
+		d.regs[reg] = v
+	}
+	return d
+}
+
+func (d *regDevice) Tx(w, r []byte) error {
+	if len(w) != 0 {
+		if int(w[0]) >= len(d.regs) {
+			return errors.New("invalid register")
+		}
+		d.ptr = int(w[0])
+		d.writes++
+	}
+	for ix := range r {
+		r[ix] = d.regs[d.ptr]
+		d.ptr = (d.ptr + 1) % len(d.regs)
+	}
+	return nil
+}
+
+// newBMx280 returns a BMP280 or BME280 with the chip ID id, after its
+// calibration data was read.
+func newBMx280(id byte) *regDevice {
+	d := newRegDevice(0x100, map[int]byte{0xd0: id})
+	for ix := range 24 {
+		d.regs[0x88+ix] = byte(0x70 + ix)
+	}
+	d.ptr = 0x88
+	return d
+}
+
+func TestScanAndIdentify(t *testing.T) {
+	bus := sim.NewBus("I2C1")
+	pcf := sim.NewPCF8574()
+	bus.Attach(0x27, pcf)
+	// MCP23008 after reset, and MCP23017 with IOCON.BANK clear.
+	bus.Attach(0x20, newRegDevice(0x0b, map[int]byte{0x00: 0xff}))
+	bus.Attach(0x21, newRegDevice(0x16, map[int]byte{0x00: 0xff, 0x01: 0xff, 0x0a: 0x20, 0x0b: 0x20}))
+	bus.Attach(0x68, newRegDevice(0x80, map[int]byte{0x6b: 0x01, 0x75: 0x71}))
+	bus.Attach(0x76, newBMx280(0x60))
+	// A blank HT16K33 reads like a PCA9548, and isn't probed.
+	blank := newRegDevice(0x100, nil)
+	bus.Attach(0x70, blank)
+	bus.Attach(0x11, newRegDevice(0x10, map[int]byte{0x00: 0x01}))
+
+	devs := ScanAndIdentify(bus)
+	var got []string
+	for _, d := range devs {
+		got = append(got, d.String())
+	}
+	want := []string{
+		"0x11 unknown",
+		"0x20 MCP23008 (mcp23xxx)",
+		"0x21 MCP23017 (mcp23xxx)",
+		"0x27 PCF8574 (pcf857x)",
+		"0x68 MPU-9250 (mpu9250)",
+		"0x70 unidentified, maybe HT16K33 (ht16k33), PCA9548 (pca9548), SX1509 (sx1509)",
+		"0x76 BME280 (bmxx80)",
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("got %q\nwant %q", got, want)
+	}
+	if blank.writes != 0 {
+		t.Errorf("got %d register writes to a chip without registers", blank.writes)
+	}
+	for pin := range 8 {
+		if pcf.Level(pin) != gpio.High {
+			t.Errorf("PCF8574 pin %d was written", pin)
+		}
+	}
+}
+
+func TestIdentify_ambiguous(t *testing.T) {
+	bus := sim.NewBus("I2C1")
+	// A PCF8575 whose ports differ.
+	bus.Attach(0x22, &pcf8575{ports: [2]byte{0xff, 0x0f}})
+	if d := Identify(bus, 0x22); d.Chip == nil || d.Chip.Name != "PCF8575" {
+		t.Errorf("got %s", &d)
+	}
+	bus.Attach(0x40, newRegDevice(0x100, map[int]byte{0x01: 0x04, 0xfe: 0x1e}))
+	if d := Identify(bus, 0x40); d.Chip == nil || d.Chip.Name != "PCA9685" {
+		t.Errorf("got %s", &d)
+	}
+	bus.Attach(0x77, newBMx280(0x58))
+	if d := Identify(bus, 0x77); d.Chip == nil || d.Chip.Name != "BMP280" {
+		t.Errorf("expected the ID register to take precedence, got %s", &d)
+	}
+	bus.Attach(0x68, newRegDevice(0x13, map[int]byte{0x00: 0x42, 0x01: 0x17, 0x0f: 0x88}))
+	if d := Identify(bus, 0x68); d.Chip == nil || d.Chip.Name != "DS3231" {
+		t.Errorf("got %s", &d)
+	}
+}
+
+func TestIdentify_commands(t *testing.T) {
+	// A PCF8591 takes each byte written as a control byte, so its addresses
+	// are only read.
+	for addr := uint16(0x48); addr <= 0x4f; addr++ {
+		bus := i2ctest.Playback{
+			Ops: []i2ctest.IO{
+				{Addr: addr, R: []byte{0x80}},
+				{Addr: addr, R: []byte{0x80, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x80, 0x7f, 0x80}},
+			},
+		}
+		if !Probe(&bus, addr) {
+			t.Fatalf("0x%02x not found", addr)
+		}
+		if d := Identify(&bus, addr); d.Chip != nil {
+			t.Errorf("got %s", &d)
+		}
+		if err := bus.Close(); err != nil {
+			t.Errorf("0x%02x: %v", addr, err)
+		}
+	}
+}
+
+// pcf8575 is a PCF8575, whose two ports are read in turn.
+type pcf8575 struct {
+	ports [2]byte
+}
+
+func (p *pcf8575) Tx(w, r []byte) error {
+	for ix := range r {
+		r[ix] = p.ports[ix%2]
+	}
+	return nil
+}
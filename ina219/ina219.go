@@ -73,7 +73,7 @@ func New(bus i2c.Bus, opts *Opts) (*Dev, error) {
 		return nil, err
 	}
 
-	if err := dev.m.WriteUint16(configRegister, 0x1FFF); err != nil {
+	if err := dev.m.WriteUint16(configRegister, configContinuous); err != nil {
 		return nil, errWritingToConfigRegister
 	}
 
@@ -87,6 +87,8 @@ type Dev struct {
 	mu         sync.Mutex
 	currentLSB physic.ElectricCurrent
 	powerLSB   physic.Power
+	// halted is true when the device was powered down by Halt().
+	halted bool
 }
 
 const (
@@ -96,6 +98,12 @@ const (
 	powerRegister        = 0x03
 	currentRegister      = 0x04
 	calibrationRegister  = 0x05
+
+	// configContinuous measures the shunt and bus voltages continuously,
+	// at 12 bits with 128 samples averaged, over a 32V range.
+	configContinuous = 0x1FFF
+	// configPowerDown is configContinuous in the power-down mode.
+	configPowerDown = 0x1FF8
 )
 
 // Sense reads the power values from the ina219 sensor.
@@ -103,6 +111,14 @@ func (d *Dev) Sense() (pm PowerMonitor, err error) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
+	if d.halted {
+		if err = d.m.WriteUint16(configRegister, configContinuous); err != nil {
+			err = errWritingToConfigRegister
+			return
+		}
+		d.halted = false
+	}
+
 	shunt, err := d.m.ReadUint16(shuntVoltageRegister)
 	if err != nil {
 		err = errReadShunt
@@ -143,6 +159,23 @@ func (d *Dev) Sense() (pm PowerMonitor, err error) {
 	return
 }
 
+// Halt implements conn.Resource. It powers the sensor down. The next call
+// to Sense() powers it up, and may return the values measured before Halt()
+// until a new measurement completes.
+func (d *Dev) Halt() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.m.WriteUint16(configRegister, configPowerDown); err != nil {
+		return errWritingToConfigRegister
+	}
+	d.halted = true
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("INA219{%s}", d.m.Conn)
+}
+
 // Since physic electrical is in nano units we need to scale taking care to not
 // overflow int64 or loose resolution.
 const calibratescale int64 = ((int64(physic.Ampere) * int64(physic.Ohm)) / 100000) << 12
@@ -385,3 +385,32 @@ func TestPowerStringer(t *testing.T) {
 		t.Errorf("wanted %s\n, but got: %s", want, got)
 	}
 }
+
+func TestHalt(t *testing.T) {
+	bus := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			{Addr: 0x40, W: []byte{calibrationRegister, 0x10, 0x62}, R: []byte{}},
+			{Addr: 0x40, W: []byte{configRegister, 0x1f, 0xff}, R: []byte{}},
+			{Addr: 0x40, W: []byte{configRegister, 0x1f, 0xf8}, R: []byte{}},
+			// Sense() powers the sensor up again.
+			{Addr: 0x40, W: []byte{configRegister, 0x1f, 0xff}, R: []byte{}},
+			{Addr: 0x40, W: []byte{shuntVoltageRegister}, R: []byte{0x00, 0x00}},
+			{Addr: 0x40, W: []byte{busVoltageRegister}, R: []byte{0x00, 0x00}},
+			{Addr: 0x40, W: []byte{currentRegister}, R: []byte{0x00, 0x00}},
+			{Addr: 0x40, W: []byte{powerRegister}, R: []byte{0x00, 0x00}},
+		},
+	}
+	ina, err := New(bus, &Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ina.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ina.Sense(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
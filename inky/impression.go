@@ -10,7 +10,7 @@ import (
 	"image"
 	"image/color"
 	"image/draw"
-	"log"
+	"log/slog"
 	"math"
 	"time"
 
@@ -19,8 +19,18 @@ import (
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/physic"
 	"periph.io/x/conn/v3/spi"
+	"periph.io/x/devices/v3/internal/logging"
 )
 
+// logger receives the errors of the wait for the busy pin.
+var logger logging.Logger
+
+// SetLogger sets the logger of the package. Until it's called, the records
+// are discarded.
+func SetLogger(l *slog.Logger) {
+	logger.Set(l)
+}
+
 var _ display.Drawer = &DevImpression{}
 var _ conn.Resource = &DevImpression{}
 var _ draw.Image = &DevImpression{}
@@ -555,7 +565,7 @@ func (d *DevImpression) updateAC(pix []uint8) error {
 func (d *DevImpression) wait(dur time.Duration) {
 	// Set it as input, with a pull down and enable rising edge triggering.
 	if err := d.busy.In(gpio.PullDown, gpio.RisingEdge); err != nil {
-		log.Printf("Err: %s", err)
+		logger.Get().Error("inky: failed to wait for the busy pin", "err", err)
 		return
 	}
 	// Wait for rising edges (Low -> High) or the timeout.
@@ -0,0 +1,43 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package logging holds the loggers of the diagnostics of the drivers, which
+// discard them unless the application sets a logger, so that a library never
+// writes to the default logger of the process.
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+)
+
+// Logger is a logger that can be replaced concurrently with its use. The
+// zero value discards the records.
+type Logger struct {
+	p atomic.Pointer[slog.Logger]
+}
+
+// Set sets the logger. nil restores the default, which discards the records.
+func (l *Logger) Set(s *slog.Logger) {
+	l.p.Store(s)
+}
+
+// Get returns the logger.
+func (l *Logger) Get() *slog.Logger {
+	if s := l.p.Load(); s != nil {
+		return s
+	}
+	return discard
+}
+
+var discard = slog.New(discardHandler{})
+
+// discardHandler is slog.DiscardHandler, which requires go 1.24.
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (d discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return d }
+func (d discardHandler) WithGroup(string) slog.Handler           { return d }
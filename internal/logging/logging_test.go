@@ -0,0 +1,31 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogger(t *testing.T) {
+	var l Logger
+	if l.Get().Enabled(context.Background(), slog.LevelError) {
+		t.Fatal("the default logger must discard")
+	}
+	var buf bytes.Buffer
+	l.Set(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	l.Get().Debug("trace", "reg", 1)
+	if !strings.Contains(buf.String(), "msg=trace reg=1") {
+		t.Fatal(buf.String())
+	}
+	l.Set(nil)
+	l.Get().Error("lost")
+	if strings.Contains(buf.String(), "lost") {
+		t.Fatal(buf.String())
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package wait bounds the blocking waits of the drivers with a context, so
+// that callers can cancel them and time them out uniformly.
+package wait
+
+import (
+	"context"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// slice is the longest a pin is waited on between checks of the context,
+// since gpio.PinIn.WaitForEdge() can't be interrupted otherwise.
+const slice = 100 * time.Millisecond
+
+// Edge waits up to timeout for an edge on p, or until ctx is done. A
+// negative timeout waits forever. It returns false if the timeout expired,
+// ctx is done, or the wait was interrupted by halting p.
+//
+// If ctx can't be canceled, p is waited on directly.
+func Edge(ctx context.Context, p gpio.PinIn, timeout time.Duration) bool {
+	if ctx.Done() == nil {
+		return p.WaitForEdge(timeout)
+	}
+	var deadline time.Time
+	if timeout >= 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	for {
+		if ctx.Err() != nil {
+			return false
+		}
+		w := slice
+		if timeout >= 0 {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return false
+			}
+			w = min(w, remaining)
+		}
+		start := time.Now()
+		if p.WaitForEdge(w) {
+			return true
+		}
+		// Returning before the slice elapsed means that the wait was
+		// interrupted by Halt().
+		if time.Since(start) < w {
+			return false
+		}
+	}
+}
+
+// Sleep waits for d, or until ctx is done, in which case it returns
+// ctx.Err().
+func Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package wait
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+)
+
+func TestEdge(t *testing.T) {
+	p := &gpiotest.Pin{N: "INT", EdgesChan: make(chan gpio.Level, 1)}
+	p.EdgesChan <- gpio.High
+	if !Edge(context.Background(), p, time.Second) {
+		t.Fatal("expected an edge")
+	}
+	if Edge(context.Background(), p, time.Millisecond) {
+		t.Fatal("expected a timeout")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.EdgesChan <- gpio.Low
+	if !Edge(ctx, p, -1) {
+		t.Fatal("expected an edge")
+	}
+	if Edge(ctx, p, 10*time.Millisecond) {
+		t.Fatal("expected a timeout")
+	}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	start := time.Now()
+	if Edge(ctx, p, -1) {
+		t.Fatal("expected the wait to be canceled")
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Fatalf("cancellation took %s", d)
+	}
+}
+
+func TestSleep(t *testing.T) {
+	if err := Sleep(context.Background(), time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := Sleep(ctx, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, expected context.Canceled", err)
+	}
+	if err := Sleep(ctx, 0); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, expected context.Canceled", err)
+	}
+}
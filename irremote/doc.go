@@ -0,0 +1,31 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package irremote receives and sends the commands of IR remote controls,
+// with the NEC and RC5 protocols.
+//
+// Receiver decodes the output of an IR receiver module connected to a GPIO,
+// and sends the frames as events on a channel. Decode() decodes a pulse train
+// captured otherwise, for example with Receiver.Capture().
+//
+// Transmitter sends frames, or raw pulse trains of other protocols, with an
+// IR LED. Learn() captures the pulse train of a button of a remote, so that
+// it can be sent back.
+//
+// # Wiring
+//
+// Connect the output of the receiver module to a GPIO. Most modules work at
+// 3.3V; add a 100Ω resistor and a 4.7µF capacitor on their power supply if
+// the reception is unreliable.
+//
+// Drive the IR LED with an NPN transistor or a MOSFET from a GPIO that
+// supports PWM, with a resistor limiting the current of the LED to its
+// rating, typically 100mA for a 940nm LED.
+//
+// # Protocols
+//
+// https://www.sbprojects.net/knowledge/ir/nec.php
+//
+// https://www.sbprojects.net/knowledge/ir/rc5.php
+package irremote
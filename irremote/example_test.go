@@ -0,0 +1,75 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package irremote_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/devices/v3/irremote"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	pin := gpioreg.ByName("GPIO17")
+	if pin == nil {
+		log.Fatal("failed to find GPIO17")
+	}
+	r, err := irremote.NewReceiver(pin)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Halt()
+	events, err := r.Start()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for ev := range events {
+		fmt.Printf("%s: address %#x command %#x\n", ev.Protocol, ev.Address, ev.Command)
+	}
+	if err := r.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func ExampleLearn() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	r, err := irremote.NewReceiver(gpioreg.ByName("GPIO17"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	tx, err := irremote.NewTransmitter(gpioreg.ByName("GPIO18"), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer tx.Halt()
+
+	fmt.Println("Press a button of the remote")
+	f, pulses, err := irremote.Learn(r, 10*time.Second)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if f.Protocol != 0 {
+		fmt.Printf("Learned %s\n", f)
+		err = tx.Send(f)
+	} else {
+		fmt.Printf("Learned %d pulses of an unknown protocol\n", len(pulses))
+		err = tx.SendRaw(pulses)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,295 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package irremote
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+)
+
+// necPulses returns the pulse train of an NEC frame.
+func necPulses(addr, cmd uint8) []time.Duration {
+	p := []time.Duration{necLeaderMark, necLeaderSpace}
+	v := uint32(addr) | uint32(^addr)<<8 | uint32(cmd)<<16 | uint32(^cmd)<<24
+	for i := 0; i < 32; i++ {
+		space := necUnit
+		if v&(1<<i) != 0 {
+			space = necOneSpace
+		}
+		p = append(p, necUnit, space)
+	}
+	return append(p, necUnit)
+}
+
+// rc5Pulses returns the pulse train of an RC5 frame.
+func rc5Pulses(toggle bool, addr, cmd uint8) []time.Duration {
+	v := uint16(3)<<12 | uint16(addr&0x1f)<<6 | uint16(cmd&0x3f)
+	if toggle {
+		v |= 1 << 11
+	}
+	var halves []bool
+	for i := rc5Bits - 1; i >= 0; i-- {
+		one := v&(1<<i) != 0
+		halves = append(halves, !one, one)
+	}
+	// Skip the leading space, and merge the half bits.
+	var p []time.Duration
+	level := true
+	for _, h := range halves[1:] {
+		if h == level && len(p) != 0 {
+			p[len(p)-1] += rc5HalfBit
+			continue
+		}
+		if h != level {
+			level = h
+		}
+		p = append(p, rc5HalfBit)
+	}
+	if !level {
+		// Drop the trailing space.
+		p = p[:len(p)-1]
+	}
+	return p
+}
+
+// scale scales the pulses by f, to emulate timing errors.
+func scale(p []time.Duration, f float64) []time.Duration {
+	out := make([]time.Duration, len(p))
+	for i, d := range p {
+		out[i] = time.Duration(float64(d) * f)
+	}
+	return out
+}
+
+func TestDecode(t *testing.T) {
+	data := []struct {
+		name   string
+		pulses []time.Duration
+		want   Frame
+	}{
+		{"NEC", necPulses(0x04, 0x08), Frame{Protocol: NEC, Address: 0x04, Command: 0x08}},
+		{"NEC slow", scale(necPulses(0xff, 0x00), 1.15), Frame{Protocol: NEC, Address: 0xff}},
+		{"NEC repeat", []time.Duration{necLeaderMark, necRepeatSpace, necUnit}, Frame{Protocol: NEC, Repeat: true}},
+		{"RC5", rc5Pulses(false, 0x05, 0x35), Frame{Protocol: RC5, Address: 0x05, Command: 0x35}},
+		{"RC5 toggle", scale(rc5Pulses(true, 0x1f, 0x00), 0.85), Frame{Protocol: RC5, Address: 0x1f, Toggle: true}},
+		{"RC5 odd", rc5Pulses(true, 0x00, 0x01), Frame{Protocol: RC5, Command: 0x01, Toggle: true}},
+	}
+	for _, line := range data {
+		t.Run(line.name, func(t *testing.T) {
+			got, err := Decode(line.pulses)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != line.want {
+				t.Errorf("got %s, want %s", got, line.want)
+			}
+		})
+	}
+	// Extended NEC, with a 16 bit address.
+	p := necPulses(0x00, 0x12)
+	p[2+2*8+1] = necUnit
+	if f, err := Decode(p); err != nil || f.Address != 0xfe00 {
+		t.Errorf("extended NEC: %s, %v", f, err)
+	}
+	// Corrupted command.
+	p = necPulses(0x00, 0x12)
+	p[2+2*16+1] = necOneSpace + necUnit
+	if _, err := Decode(p); err != ErrUnknownProtocol {
+		t.Errorf("corrupted NEC: %v", err)
+	}
+	if _, err := Decode([]time.Duration{time.Millisecond}); err != ErrUnknownProtocol {
+		t.Errorf("noise: %v", err)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	for _, f := range []Frame{
+		{Protocol: NEC, Address: 0x04, Command: 0x08},
+		{Protocol: NEC, Address: 0x1234, Command: 0xff},
+		{Protocol: NEC, Repeat: true},
+		{Protocol: RC5, Address: 0x05, Command: 0x35},
+		{Protocol: RC5, Address: 0x1f, Command: 0x7f, Toggle: true},
+		{Protocol: RC5, Command: 0x40},
+	} {
+		p, err := Encode(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := Decode(p); err != nil || got != f {
+			t.Errorf("Decode(Encode(%s)) = %s, %v", f, got, err)
+		}
+	}
+	if p, _ := Encode(Frame{Protocol: RC5, Address: 0x05, Command: 0x35}); !equal(p, rc5Pulses(false, 0x05, 0x35)) {
+		t.Errorf("RC5 pulses %v", p)
+	}
+	if p, _ := Encode(Frame{Protocol: NEC, Address: 0x04, Command: 0x08}); !equal(p, necPulses(0x04, 0x08)) {
+		t.Errorf("NEC pulses %v", p)
+	}
+	if _, err := Encode(Frame{Protocol: RC5, Address: 0x20}); err == nil {
+		t.Error("expected an error for an out of range address")
+	}
+	if _, err := Encode(Frame{}); err != ErrUnknownProtocol {
+		t.Errorf("unknown protocol: %v", err)
+	}
+}
+
+func equal(a, b []time.Duration) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFrame_String(t *testing.T) {
+	if s := (Frame{Protocol: RC5, Address: 5, Command: 0x35, Toggle: true}).String(); s != "RC5{0x05, 0x35, T:true}" {
+		t.Error(s)
+	}
+	if s := (Frame{Protocol: NEC, Address: 4, Command: 8, Repeat: true}).String(); s != "NEC{0x04, 0x08, repeat}" {
+		t.Error(s)
+	}
+}
+
+// irPin plays back pulse trains as edges, with a fake clock.
+type irPin struct {
+	gpiotest.Pin
+	mu     sync.Mutex
+	clock  time.Time
+	edges  []time.Duration
+	level  gpio.Level
+	halted chan struct{}
+}
+
+func newIRPin(trains ...[]time.Duration) *irPin {
+	p := &irPin{Pin: gpiotest.Pin{N: "IR"}, clock: time.Unix(1000, 0), level: gpio.High, halted: make(chan struct{})}
+	for _, t := range trains {
+		// The first edge of a train comes after an idle gap.
+		p.edges = append(p.edges, 50*time.Millisecond)
+		p.edges = append(p.edges, t...)
+	}
+	return p
+}
+
+func (p *irPin) now() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.clock
+}
+
+func (p *irPin) In(pull gpio.Pull, edge gpio.Edge) error {
+	return nil
+}
+
+func (p *irPin) Read() gpio.Level {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.level
+}
+
+func (p *irPin) WaitForEdge(timeout time.Duration) bool {
+	p.mu.Lock()
+	if len(p.edges) == 0 {
+		p.mu.Unlock()
+		// Let Stop() interrupt.
+		time.Sleep(time.Millisecond)
+		return false
+	}
+	defer p.mu.Unlock()
+	if d := p.edges[0]; timeout < 0 || d <= timeout {
+		p.clock = p.clock.Add(d)
+		p.edges = p.edges[1:]
+		p.level = !p.level
+		return true
+	}
+	p.clock = p.clock.Add(timeout)
+	p.edges[0] -= timeout
+	return false
+}
+
+func TestReceiver(t *testing.T) {
+	repeat := []time.Duration{necLeaderMark, necRepeatSpace, necUnit}
+	pin := newIRPin(repeat, necPulses(0x04, 0x08), repeat, rc5Pulses(true, 0x05, 0x35))
+	r, err := NewReceiver(pin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.now = pin.now
+	events, err := r.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Halt()
+	want := []Frame{
+		{Protocol: NEC, Address: 0x04, Command: 0x08},
+		{Protocol: NEC, Address: 0x04, Command: 0x08, Repeat: true},
+		{Protocol: RC5, Address: 0x05, Command: 0x35, Toggle: true},
+	}
+	for i := range want {
+		select {
+		case ev := <-events:
+			if ev.Frame != want[i] {
+				t.Errorf("event %d = %s, want %s", i, ev.Frame, want[i])
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no event %d", i)
+		}
+	}
+	if _, err := r.Start(); err == nil {
+		t.Error("expected an error when started twice")
+	}
+	r.Stop()
+	if _, ok := <-events; ok {
+		t.Error("channel not closed")
+	}
+}
+
+func TestReceiver_Capture(t *testing.T) {
+	want := necPulses(0x01, 0x02)
+	pin := newIRPin(want)
+	r, err := NewReceiver(pin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.now = pin.now
+	got, err := r.Capture(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("%d pulses, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("pulse %d = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
+
+func TestReceiver_CaptureContext(t *testing.T) {
+	pin := newIRPin()
+	r, err := NewReceiver(pin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.now = pin.now
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := r.CaptureContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want DeadlineExceeded", err)
+	}
+	if _, err := r.Capture(10 * time.Millisecond); err == nil || errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want a timeout", err)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package irremote
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Protocol is an IR remote control protocol.
+type Protocol int
+
+const (
+	// NEC is the protocol of most cheap remotes, with an 8 bit address, or
+	// 16 bits for the extended variant, and an 8 bit command.
+	NEC Protocol = iota + 1
+	// RC5 is the Philips protocol, with a 5 bit address and a 7 bit command.
+	RC5
+)
+
+func (p Protocol) String() string {
+	switch p {
+	case NEC:
+		return "NEC"
+	case RC5:
+		return "RC5"
+	default:
+		return fmt.Sprintf("Protocol(%d)", int(p))
+	}
+}
+
+// Frame is a decoded command.
+type Frame struct {
+	Protocol Protocol
+	Address  uint16
+	Command  uint8
+	// Repeat is true for the repeat codes sent while a button is held.
+	Repeat bool
+	// Toggle is the toggle bit of RC5, which changes at each button press.
+	Toggle bool
+}
+
+func (f Frame) String() string {
+	s := fmt.Sprintf("%s{0x%02x, 0x%02x", f.Protocol, f.Address, f.Command)
+	if f.Protocol == RC5 {
+		s += fmt.Sprintf(", T:%t", f.Toggle)
+	}
+	if f.Repeat {
+		s += ", repeat"
+	}
+	return s + "}"
+}
+
+// ErrUnknownProtocol is returned when a pulse train can't be decoded.
+var ErrUnknownProtocol = errors.New("irremote: unknown protocol")
+
+// Decode decodes a pulse train, which alternates marks, when the carrier is
+// on, and spaces, starting with a mark.
+func Decode(pulses []time.Duration) (Frame, error) {
+	if f, ok := decodeNEC(pulses); ok {
+		return f, nil
+	}
+	if f, ok := decodeRC5(pulses); ok {
+		return f, nil
+	}
+	return Frame{}, ErrUnknownProtocol
+}
+
+// NEC timings.
+const (
+	necUnit        = 562500 * time.Nanosecond
+	necLeaderMark  = 16 * necUnit
+	necLeaderSpace = 8 * necUnit
+	necRepeatSpace = 4 * necUnit
+	necOneSpace    = 3 * necUnit
+)
+
+// RC5 timings.
+const (
+	rc5HalfBit = 889 * time.Microsecond
+	rc5Bits    = 14
+)
+
+// near returns true if d is within 30% of want.
+func near(d, want time.Duration) bool {
+	return d > want*7/10 && d < want*13/10
+}
+
+func decodeNEC(p []time.Duration) (Frame, bool) {
+	if len(p) < 3 || !near(p[0], necLeaderMark) {
+		return Frame{}, false
+	}
+	if near(p[1], necRepeatSpace) && near(p[2], necUnit) {
+		return Frame{Protocol: NEC, Repeat: true}, true
+	}
+	// Leader, 32 bits and a stop mark.
+	if len(p) < 2+2*32+1 || !near(p[1], necLeaderSpace) {
+		return Frame{}, false
+	}
+	var v uint32
+	for i := 0; i < 32; i++ {
+		mark, space := p[2+2*i], p[3+2*i]
+		if !near(mark, necUnit) {
+			return Frame{}, false
+		}
+		switch {
+		case near(space, necOneSpace):
+			v |= 1 << i
+		case near(space, necUnit):
+		default:
+			return Frame{}, false
+		}
+	}
+	addr, naddr, cmd, ncmd := uint8(v), uint8(v>>8), uint8(v>>16), uint8(v>>24)
+	if cmd != ^ncmd {
+		return Frame{}, false
+	}
+	f := Frame{Protocol: NEC, Address: uint16(addr), Command: cmd}
+	if addr != ^naddr {
+		// Extended NEC.
+		f.Address = uint16(v)
+	}
+	return f, true
+}
+
+func decodeRC5(p []time.Duration) (Frame, bool) {
+	// Expand the pulses in half bits. The first half of the first bit is a
+	// space, which isn't part of the pulse train.
+	halves := []bool{false}
+	for i, d := range p {
+		mark := i%2 == 0
+		switch {
+		case near(d, rc5HalfBit):
+			halves = append(halves, mark)
+		case near(d, 2*rc5HalfBit):
+			halves = append(halves, mark, mark)
+		case !mark && i == len(p)-1:
+			// A long trailing space.
+			halves = append(halves, false)
+		default:
+			return Frame{}, false
+		}
+	}
+	// The last half bit is a space if the last bit is a 0.
+	if len(halves) == 2*rc5Bits-1 {
+		halves = append(halves, false)
+	}
+	if len(halves) != 2*rc5Bits {
+		return Frame{}, false
+	}
+	var v uint16
+	for i := 0; i < rc5Bits; i++ {
+		first, second := halves[2*i], halves[2*i+1]
+		if first == second {
+			return Frame{}, false
+		}
+		// A 1 is a space then a mark.
+		v <<= 1
+		if second {
+			v |= 1
+		}
+	}
+	if v>>13 != 1 {
+		return Frame{}, false
+	}
+	f := Frame{
+		Protocol: RC5,
+		Address:  (v >> 6) & 0x1f,
+		Command:  uint8(v & 0x3f),
+		Toggle:   v&(1<<11) != 0,
+	}
+	// The second start bit is the inverted 7th bit of the command in RC5X.
+	if v&(1<<12) == 0 {
+		f.Command |= 0x40
+	}
+	return f, true
+}
+
+// Encode returns the pulse train of f, as marks and spaces alternating,
+// starting with a mark, without the space that ends the frame.
+//
+// For NEC, an Address above 0xff is sent as an extended address, and a
+// Repeat frame is the repeat code. For RC5, the Address is 5 bits, and the
+// Command is 7 bits, as in RC5X.
+func Encode(f Frame) ([]time.Duration, error) {
+	switch f.Protocol {
+	case NEC:
+		return encodeNEC(f), nil
+	case RC5:
+		if f.Address > 0x1f || f.Command > 0x7f {
+			return nil, fmt.Errorf("irremote: %s out of range", f)
+		}
+		return encodeRC5(f), nil
+	default:
+		return nil, ErrUnknownProtocol
+	}
+}
+
+func encodeNEC(f Frame) []time.Duration {
+	if f.Repeat {
+		return []time.Duration{necLeaderMark, necRepeatSpace, necUnit}
+	}
+	v := uint32(f.Address)
+	if f.Address <= 0xff {
+		v |= uint32(^uint8(f.Address)) << 8
+	}
+	v |= uint32(f.Command)<<16 | uint32(^f.Command)<<24
+	p := make([]time.Duration, 0, 2+2*32+1)
+	p = append(p, necLeaderMark, necLeaderSpace)
+	for i := 0; i < 32; i++ {
+		space := necUnit
+		if v&(1<<i) != 0 {
+			space = necOneSpace
+		}
+		p = append(p, necUnit, space)
+	}
+	return append(p, necUnit)
+}
+
+func encodeRC5(f Frame) []time.Duration {
+	v := uint16(1)<<13 | f.Address<<6 | uint16(f.Command&0x3f)
+	if f.Command&0x40 == 0 {
+		v |= 1 << 12
+	}
+	if f.Toggle {
+		v |= 1 << 11
+	}
+	// Merge the half bits in pulses. The first half of the first bit is a
+	// space, which isn't part of the pulse train.
+	var p []time.Duration
+	mark := false
+	for i := 2*rc5Bits - 2; i >= 0; i-- {
+		bit := v&(1<<(i/2)) != 0
+		// A 1 is a space then a mark.
+		half := bit == (i%2 == 0)
+		if half == mark && len(p) != 0 {
+			p[len(p)-1] += rc5HalfBit
+			continue
+		}
+		mark = half
+		p = append(p, rc5HalfBit)
+	}
+	if !mark {
+		p = p[:len(p)-1]
+	}
+	return p
+}
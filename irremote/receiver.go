@@ -0,0 +1,213 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package irremote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+const (
+	// frameGap is the longest time without an edge within a frame, the 9ms
+	// mark of the NEC leader with some margin. A longer space ends the frame.
+	frameGap = 15 * time.Millisecond
+	// idleTimeout is how long the receiving goroutine waits for an edge
+	// between checks of Stop().
+	idleTimeout = 250 * time.Millisecond
+	// maxPulses bounds the length of a captured pulse train.
+	maxPulses = 256
+	// eventBufferSize is the size of the buffer of the events channel.
+	eventBufferSize = 16
+)
+
+// Event is a frame received from a remote.
+type Event struct {
+	Frame
+	// Time is when the end of the frame was detected.
+	Time time.Time
+}
+
+// Receiver decodes the output of an IR receiver module, such as the TSOP38238
+// or the VS1838B, which demodulates the carrier and holds its output low
+// during marks.
+//
+// The edges are timestamped by the host, so decoding depends on its latency;
+// NEC and RC5 tolerate errors of about 30% of their shortest pulse, around
+// 170µs.
+type Receiver struct {
+	pin gpio.PinIn
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+	err  error
+	// last is the last NEC frame, which a repeat code repeats.
+	last Frame
+
+	// now is replaced by tests.
+	now func() time.Time
+}
+
+// NewReceiver returns a Receiver for a receiver module connected to pin.
+func NewReceiver(pin gpio.PinIn) (*Receiver, error) {
+	if pin == nil {
+		return nil, errors.New("irremote: pin is required")
+	}
+	if err := pin.In(gpio.PullUp, gpio.BothEdges); err != nil {
+		return nil, fmt.Errorf("irremote: %w", err)
+	}
+	return &Receiver{pin: pin, now: time.Now}, nil
+}
+
+// Capture waits up to timeout for a pulse train, and returns it undecoded,
+// as marks and spaces alternating, starting with a mark. It can't be used
+// while the goroutine started by Start() runs.
+func (r *Receiver) Capture(timeout time.Duration) ([]time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	p, err := r.CaptureContext(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, errors.New("irremote: timeout waiting for a pulse train")
+	}
+	return p, err
+}
+
+// CaptureContext waits for a pulse train until ctx is done, as Capture(). If
+// ctx is done first, the error returned wraps ctx.Err().
+func (r *Receiver) CaptureContext(ctx context.Context) ([]time.Duration, error) {
+	r.mu.Lock()
+	running := r.stop != nil
+	r.mu.Unlock()
+	if running {
+		return nil, errors.New("irremote: receiver already started")
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("irremote: %w", err)
+		}
+		wait := idleTimeout
+		if deadline, ok := ctx.Deadline(); ok {
+			if wait = min(wait, time.Until(deadline)); wait <= 0 {
+				return nil, fmt.Errorf("irremote: %w", context.DeadlineExceeded)
+			}
+		}
+		if p := r.capture(wait); len(p) != 0 {
+			return p, nil
+		}
+	}
+}
+
+// Start starts a goroutine that decodes the frames received, and sends them
+// to the returned channel. Repeat codes of NEC, sent while a button is held,
+// are sent as the last frame with Repeat set. Frames that can't be decoded
+// are ignored. The channel is closed when Stop() is called.
+func (r *Receiver) Start() (<-chan Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stop != nil {
+		return nil, errors.New("irremote: receiver already started")
+	}
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	r.err = nil
+	events := make(chan Event, eventBufferSize)
+	go r.run(events, r.stop, r.done)
+	return events, nil
+}
+
+// Stop stops the goroutine started by Start(), and waits for it to exit.
+func (r *Receiver) Stop() {
+	r.mu.Lock()
+	stop, done := r.stop, r.done
+	r.stop = nil
+	r.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Err returns the error that stopped the receiving goroutine, if any.
+func (r *Receiver) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// Halt implements conn.Resource. It stops the goroutine started by Start().
+func (r *Receiver) Halt() error {
+	r.Stop()
+	return nil
+}
+
+func (r *Receiver) String() string {
+	return fmt.Sprintf("irremote.Receiver{%s}", r.pin)
+}
+
+func (r *Receiver) run(events chan<- Event, stop, done chan struct{}) {
+	defer close(done)
+	defer close(events)
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		p := r.capture(idleTimeout)
+		if len(p) == 0 {
+			continue
+		}
+		f, err := Decode(p)
+		if err != nil {
+			continue
+		}
+		if f.Protocol == NEC {
+			if f.Repeat {
+				if r.last.Protocol == 0 {
+					continue
+				}
+				f = r.last
+				f.Repeat = true
+			} else {
+				r.last = f
+			}
+		}
+		select {
+		case events <- Event{Frame: f, Time: r.now()}:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// capture waits up to wait for the first edge, and returns the pulses until
+// the space that ends the frame.
+func (r *Receiver) capture(wait time.Duration) []time.Duration {
+	if !r.pin.WaitForEdge(wait) {
+		return nil
+	}
+	if r.pin.Read() == gpio.High {
+		// The end of a frame that was missed.
+		return nil
+	}
+	var p []time.Duration
+	last := r.now()
+	for len(p) < maxPulses && r.pin.WaitForEdge(frameGap) {
+		t := r.now()
+		p = append(p, t.Sub(last))
+		last = t
+	}
+	// A frame ends with a mark.
+	if len(p)%2 == 0 {
+		return nil
+	}
+	return p
+}
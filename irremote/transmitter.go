@@ -0,0 +1,152 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package irremote
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// TxOpts holds the configuration options of a Transmitter.
+type TxOpts struct {
+	// Carrier is the frequency of the carrier, generated with the PWM of the
+	// pin during marks. If 0, the pin is driven high during marks, for an
+	// LED driver that modulates the carrier itself.
+	Carrier physic.Frequency
+	// Duty is the duty cycle of the carrier.
+	Duty gpio.Duty
+}
+
+// DefaultTxOpts is the 38kHz carrier used by NEC and most remotes, with a
+// duty cycle of 1/3. RC5 uses 36kHz, which most receivers accept.
+var DefaultTxOpts = TxOpts{
+	Carrier: 38 * physic.KiloHertz,
+	Duty:    gpio.DutyMax / 3,
+}
+
+// Transmitter sends pulse trains with an IR LED driven by a GPIO, usually
+// through a transistor, since an LED driven for the range of a remote draws
+// more current than a GPIO can supply.
+//
+// The pulses are timed by the host, so their accuracy depends on its
+// scheduling. The protocols tolerate errors of about 20%.
+type Transmitter struct {
+	pin  gpio.PinOut
+	opts TxOpts
+
+	mu sync.Mutex
+
+	// now and sleep are replaced by tests.
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+// NewTransmitter returns a Transmitter for an IR LED connected to pin. If
+// opts is nil, DefaultTxOpts is used.
+func NewTransmitter(pin gpio.PinOut, opts *TxOpts) (*Transmitter, error) {
+	if pin == nil {
+		return nil, errors.New("irremote: pin is required")
+	}
+	if opts == nil {
+		opts = &DefaultTxOpts
+	}
+	if opts.Carrier < 0 || (opts.Carrier > 0 && (opts.Duty <= 0 || opts.Duty > gpio.DutyMax)) {
+		return nil, fmt.Errorf("irremote: invalid carrier %s, duty %s", opts.Carrier, opts.Duty)
+	}
+	t := &Transmitter{pin: pin, opts: *opts, now: time.Now, sleep: time.Sleep}
+	if err := pin.Out(gpio.Low); err != nil {
+		return nil, fmt.Errorf("irremote: %w", err)
+	}
+	return t, nil
+}
+
+// Send sends f. To emulate a held button, send the NEC repeat code, a Frame
+// with Repeat set, every 108ms after the frame, or repeat an RC5 frame with
+// the same Toggle.
+func (t *Transmitter) Send(f Frame) error {
+	p, err := Encode(f)
+	if err != nil {
+		return err
+	}
+	return t.SendRaw(p)
+}
+
+// SendRaw sends a pulse train, as marks and spaces alternating, starting
+// with a mark, such as one returned by Learn().
+func (t *Transmitter) SendRaw(pulses []time.Duration) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// Each pulse ends at a deadline from the start, so that the latency of
+	// switching the pin doesn't accumulate.
+	at := t.now()
+	for i, d := range pulses {
+		if d <= 0 {
+			return t.abort(fmt.Errorf("irremote: invalid pulse %d: %s", i, d))
+		}
+		var err error
+		if i%2 == 0 {
+			err = t.mark()
+		} else {
+			err = t.pin.Out(gpio.Low)
+		}
+		if err != nil {
+			return t.abort(fmt.Errorf("irremote: %w", err))
+		}
+		at = at.Add(d)
+		if w := at.Sub(t.now()); w > 0 {
+			t.sleep(w)
+		}
+	}
+	if err := t.pin.Out(gpio.Low); err != nil {
+		return fmt.Errorf("irremote: %w", err)
+	}
+	return nil
+}
+
+// Halt implements conn.Resource. It turns the LED off.
+func (t *Transmitter) Halt() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.pin.Out(gpio.Low)
+}
+
+func (t *Transmitter) String() string {
+	return fmt.Sprintf("irremote.Transmitter{%s}", t.pin)
+}
+
+func (t *Transmitter) mark() error {
+	if t.opts.Carrier == 0 {
+		return t.pin.Out(gpio.High)
+	}
+	return t.pin.PWM(t.opts.Duty, t.opts.Carrier)
+}
+
+// abort turns the LED off, so that it isn't left on after an error, and
+// returns err.
+func (t *Transmitter) abort(err error) error {
+	_ = t.pin.Out(gpio.Low)
+	return err
+}
+
+// Learn waits up to timeout for a button of a remote to be pressed in front
+// of r, and returns the pulse train received, for SendRaw(). If the pulse
+// train is of a known protocol, it's also returned decoded, and can be sent
+// with Send(); otherwise the Frame is zero.
+func Learn(r *Receiver, timeout time.Duration) (Frame, []time.Duration, error) {
+	p, err := r.Capture(timeout)
+	if err != nil {
+		return Frame{}, nil, err
+	}
+	f, err := Decode(p)
+	if err != nil {
+		return Frame{}, p, nil
+	}
+	return f, p, nil
+}
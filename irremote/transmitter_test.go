@@ -0,0 +1,148 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package irremote
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// ledPin records the changes of the pin, with the time of a fake clock.
+type ledPin struct {
+	gpiotest.Pin
+	clock   time.Time
+	changes []ledChange
+	err     error
+}
+
+type ledChange struct {
+	at   time.Duration
+	duty gpio.Duty
+	f    physic.Frequency
+}
+
+func (p *ledPin) Out(l gpio.Level) error {
+	d := gpio.Duty(0)
+	if l {
+		d = gpio.DutyMax
+	}
+	return p.PWM(d, 0)
+}
+
+func (p *ledPin) PWM(d gpio.Duty, f physic.Frequency) error {
+	if p.err != nil && d != 0 {
+		return p.err
+	}
+	p.changes = append(p.changes, ledChange{at: p.clock.Sub(time.Unix(0, 0)), duty: d, f: f})
+	return nil
+}
+
+func newTestTransmitter(t *testing.T, opts *TxOpts) (*Transmitter, *ledPin) {
+	p := &ledPin{Pin: gpiotest.Pin{N: "LED"}, clock: time.Unix(0, 0)}
+	tx, err := NewTransmitter(p, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.changes = nil
+	tx.now = func() time.Time { return p.clock }
+	// Switching the pin takes 10µs, which isn't accumulated.
+	tx.sleep = func(d time.Duration) { p.clock = p.clock.Add(d + 10*time.Microsecond) }
+	return tx, p
+}
+
+func TestTransmitter_SendRaw(t *testing.T) {
+	tx, p := newTestTransmitter(t, nil)
+	if err := tx.SendRaw([]time.Duration{9 * time.Millisecond, 4500 * time.Microsecond, 560 * time.Microsecond}); err != nil {
+		t.Fatal(err)
+	}
+	on := DefaultTxOpts.Duty
+	want := []ledChange{
+		{0, on, 38 * physic.KiloHertz},
+		{9010 * time.Microsecond, 0, 0},
+		{13510 * time.Microsecond, on, 38 * physic.KiloHertz},
+		{14070 * time.Microsecond, 0, 0},
+	}
+	if len(p.changes) != len(want) {
+		t.Fatalf("%v", p.changes)
+	}
+	for i := range want {
+		if p.changes[i] != want[i] {
+			t.Errorf("change %d = %v, want %v", i, p.changes[i], want[i])
+		}
+	}
+}
+
+func TestTransmitter_Send(t *testing.T) {
+	// Without a carrier, the pin is driven high during marks.
+	tx, p := newTestTransmitter(t, &TxOpts{})
+	f := Frame{Protocol: RC5, Address: 0x05, Command: 0x35}
+	if err := tx.Send(f); err != nil {
+		t.Fatal(err)
+	}
+	// Rebuild the pulse train from the changes.
+	var pulses []time.Duration
+	for i := 1; i < len(p.changes); i++ {
+		if i%2 == 1 && p.changes[i-1].duty != gpio.DutyMax {
+			t.Fatalf("change %d isn't a mark: %v", i-1, p.changes[i-1])
+		}
+		// Remove the switching latency.
+		pulses = append(pulses, (p.changes[i].at-p.changes[i-1].at)/time.Microsecond*time.Microsecond-10*time.Microsecond)
+	}
+	if got, err := Decode(pulses); err != nil || got != f {
+		t.Errorf("sent %s, %v", got, err)
+	}
+	if err := tx.Send(Frame{}); err != ErrUnknownProtocol {
+		t.Errorf("unknown protocol: %v", err)
+	}
+}
+
+func TestTransmitter_errors(t *testing.T) {
+	if _, err := NewTransmitter(&ledPin{}, &TxOpts{Carrier: 38 * physic.KiloHertz}); err == nil {
+		t.Error("expected an error for a 0 duty cycle")
+	}
+	tx, p := newTestTransmitter(t, nil)
+	if err := tx.SendRaw([]time.Duration{time.Millisecond, 0, time.Millisecond}); err == nil {
+		t.Error("expected an error for an invalid pulse")
+	}
+	p.err = errors.New("no PWM")
+	if err := tx.SendRaw([]time.Duration{time.Millisecond}); !errors.Is(err, p.err) {
+		t.Errorf("got %v", err)
+	}
+	if last := p.changes[len(p.changes)-1]; last.duty != 0 {
+		t.Errorf("LED left on: %v", last)
+	}
+	if s := tx.String(); s != "irremote.Transmitter{LED(0)}" {
+		t.Error(s)
+	}
+}
+
+func TestLearn(t *testing.T) {
+	raw := []time.Duration{time.Millisecond, 2 * time.Millisecond, time.Millisecond}
+	pin := newIRPin(necPulses(0x10, 0x20), raw)
+	r, err := NewReceiver(pin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.now = pin.now
+	f, p, err := Learn(r, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Frame{Protocol: NEC, Address: 0x10, Command: 0x20}); f != want || len(p) != 67 {
+		t.Errorf("got %s, %d pulses", f, len(p))
+	}
+	f, p, err = Learn(r, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f != (Frame{}) || !equal(p, raw) {
+		t.Errorf("got %s, %v", f, p)
+	}
+}
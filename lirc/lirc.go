@@ -7,7 +7,7 @@ package lirc
 import (
 	"bufio"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
 	"strconv"
 	"strings"
@@ -15,8 +15,18 @@ import (
 
 	"periph.io/x/conn/v3"
 	"periph.io/x/conn/v3/ir"
+	"periph.io/x/devices/v3/internal/logging"
 )
 
+// logger receives the lines of lircd that can't be parsed.
+var logger logging.Logger
+
+// SetLogger sets the logger of the package, which discards the records until
+// it's called.
+func SetLogger(l *slog.Logger) {
+	logger.Set(l)
+}
+
 // New returns a IR receiver / emitter handle.
 func New() (*Conn, error) {
 	w, err := net.Dial("unix", "/var/run/lirc/lircd")
@@ -97,10 +107,10 @@ func (c *Conn) loop(r *bufio.Reader) {
 			// Format is: <code> <repeat count> <button name> <remote control name>
 			// http://www.lirc.org/html/lircd.html#lbAG
 			if parts := strings.SplitN(line, " ", 5); len(parts) != 4 {
-				log.Printf("ir: corrupted line: %v", line)
+				logger.Get().Warn("lirc: corrupted line", "line", line)
 			} else {
 				if i, err2 := strconv.Atoi(parts[1]); err2 != nil {
-					log.Printf("ir: corrupted line: %v", line)
+					logger.Get().Warn("lirc: corrupted line", "line", line)
 				} else if len(parts[2]) != 0 && len(parts[3]) != 0 {
 					c.c <- ir.Message{Key: ir.Key(parts[2]), RemoteType: parts[3], Repeat: i != 0}
 				}
@@ -139,14 +149,14 @@ func (c *Conn) readData(r *bufio.Reader) error {
 			return err
 		}
 		if line != "SUCCESS" {
-			log.Printf("ir: unexpected line: %v, expected SUCCESS", line)
+			logger.Get().Warn("lirc: unexpected line", "line", line, "expected", "SUCCESS")
 			return nil
 		}
 		if line, err = read(r); err != nil {
 			return err
 		}
 		if line != "DATA" {
-			log.Printf("ir: unexpected line: %v, expected DATA", line)
+			logger.Get().Warn("lirc: unexpected line", "line", line, "expected", "DATA")
 			return nil
 		}
 		if line, err = read(r); err != nil {
@@ -168,7 +178,7 @@ func (c *Conn) readData(r *bufio.Reader) error {
 			c.pendingList = map[string][]string{}
 			for _, l := range list {
 				if _, ok := c.pendingList[l]; ok {
-					log.Printf("ir: unexpected %s", cmd)
+					logger.Get().Warn("lirc: unexpected command", "cmd", cmd)
 				} else {
 					c.pendingList[l] = []string{}
 					if _, err = fmt.Fprintf(c.w, "LIST %s\n", l); err != nil {
@@ -178,7 +188,7 @@ func (c *Conn) readData(r *bufio.Reader) error {
 			}
 		case strings.HasPrefix(line, "LIST "):
 			if c.pendingList == nil {
-				log.Printf("ir: unexpected %s", cmd)
+				logger.Get().Warn("lirc: unexpected command", "cmd", cmd)
 			} else {
 				remote := cmd[5:]
 				c.pendingList[remote] = list
@@ -204,7 +214,7 @@ func (c *Conn) readData(r *bufio.Reader) error {
 		return err
 	}
 	if line != "END" {
-		log.Printf("ir: unexpected line: %v, expected END", line)
+		logger.Get().Warn("lirc: unexpected line", "line", line, "expected", "END")
 	}
 	return nil
 }
@@ -7,7 +7,9 @@ package matrixorbital_test
 import (
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"os"
 	"time"
 
 	"periph.io/x/conn/v3/display"
@@ -48,3 +50,25 @@ func Example() {
 	}
 
 }
+
+func ExampleNewReconnecting() {
+	// The tty of the backpack must be in raw mode. It's set by the udev rule
+	// that creates the /dev/lcd link, as the device is reset when it's
+	// plugged back.
+	open := func() (io.ReadWriter, error) {
+		return os.OpenFile("/dev/lcd", os.O_RDWR, 0)
+	}
+	dev, err := matrixorbital.NewReconnecting(open, matrixorbital.ModelAdafruitUSBBackpack, 2, 16)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer dev.Halt()
+	go func() {
+		for e := range dev.Events() {
+			log.Printf("display %s", e)
+		}
+	}()
+	if _, err := dev.WriteString("Hello"); err != nil {
+		log.Print(err)
+	}
+}
@@ -2,8 +2,14 @@
 // Use of this source code is governed under the Apache License, Version 2.0
 // that can be found in the LICENSE file.
 
-// This package provides an interface to MatrixOrbital Character LCD displays.
-// The LK2047T display is compatible with the Adafruit USB-LCD Backpack.
+// This package provides an interface to MatrixOrbital Character LCD displays,
+// and displays that emulate the MatrixOrbital command set, like the Adafruit
+// USB-LCD Backpack. The features supported by each display are described by
+// a Model. Commands for features a model doesn't support return
+// display.ErrNotImplemented.
+//
+// A USB display that's unplugged and plugged back can be reopened and
+// restored automatically, see NewReconnecting().
 package matrixorbital
 
 import (
@@ -11,6 +17,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"periph.io/x/conn/v3"
 	"periph.io/x/conn/v3/display"
@@ -28,24 +35,38 @@ const (
 	Yellow
 )
 
-// The LK2047T is a basic MatrixOrbital LCD display. It's a 20x4 LCD with a
-// keypad and LEDs.
+// Dev is a MatrixOrbital compatible LCD display.
 //
 // Implements periph.io/x/conn/v3/display.TextDisplay, Backlight, and
-// DisplayContrast.
-type LK2047T struct {
+// DisplayContrast. For displays with an RGB backlight, see RGBDev.
+type Dev struct {
 	// Pins represents the set of gpio.PinOut pins exposed by the device. For
 	// units with LEDS, the pins are used to manipulate them. For the Adafruit
 	// USB/LCD backpack, 4 pins are exposed.
-	Pins []gpio.PinOut
-	rows int
-	cols int
+	Pins  []gpio.PinOut
+	rows  int
+	cols  int
+	model Model
 
 	mu         sync.Mutex
 	d          conn.Conn
 	writer     io.Writer
 	chKeyboard chan byte
 	shutdown   chan struct{}
+	// input receives the bytes read by the reader goroutine, which runs
+	// until readerStop is closed. See startReader().
+	input      chan byte
+	readerStop chan struct{}
+	// pacing is the minimum time between bytes written to the display, and
+	// lastWrite the time of the last write.
+	pacing    time.Duration
+	lastWrite time.Time
+	// now and sleep are time.Now and time.Sleep, replaced by tests.
+	now   func() time.Time
+	sleep func(time.Duration)
+	// rc is the reconnection state of a display created by
+	// NewReconnecting(), nil otherwise.
+	rc *reconnector
 }
 
 type GPOEnabledDisplay interface {
@@ -65,6 +86,7 @@ var cursorBack = []byte{cmdByte, 0x4c}
 var cursorBlinkOff = []byte{cmdByte, 0x54}
 var cursorBlinkOn = []byte{cmdByte, 0x53}
 var cursorForward = []byte{cmdByte, 0x4d}
+var defineCustomChar = []byte{cmdByte, 0x4e}
 var displayOff = []byte{cmdByte, 0x46}
 var displayOn = []byte{cmdByte, 0x42}
 var goHome = []byte{cmdByte, 0x48}
@@ -76,36 +98,77 @@ var setGPOOn = []byte{cmdByte, 0x57}
 var setGPOOff = []byte{cmdByte, 0x56}
 var underlineCursorOff = []byte{cmdByte, 0x4b}
 var underlineCursorOn = []byte{cmdByte, 0x4a}
+var setVFDBrightness = []byte{cmdByte, 0x59}
+var setRGBBacklight = []byte{cmdByte, 0xd0}
+var setSize = []byte{cmdByte, 0xd1}
 
 func wrapErr(err error) error {
 	if err == nil {
 		return nil
 	}
-	return fmt.Errorf("lk2047t: %w", err)
+	return fmt.Errorf("matrixorbital: %w", err)
+}
+
+// The LK2047T is a basic MatrixOrbital LCD display. It's a 20x4 LCD with a
+// keypad and LEDs.
+type LK2047T = Dev
+
+// Create a new LCD device of the specified model using a
+// periph.io/conn/Conn. If rows and cols are 0, they're set by Probe() from the
+// detected model. See OpenConn().
+func NewConn(conn conn.Conn, model Model, rows, cols int) *Dev {
+	return newDev(conn, nil, model, rows, cols)
+}
+
+// Create a new LCD device of the specified model using an io.Writer. If your
+// display is connected using a hardware interface that periph.io doesn't
+// support (e.g. UART), you can still use this package as long as the hardware
+// interface provides the io.Writer interface. If rows and cols are 0, they're
+// set by Probe() from the detected model. See OpenWriter().
+func NewWriter(writer io.Writer, model Model, rows, cols int) *Dev {
+	return newDev(nil, writer, model, rows, cols)
 }
 
-// Create a new LCD device using a periph.io/conn/Conn
+// Create a new LK204-7T LCD device using a periph.io/conn/Conn
 func NewConnLK2047T(conn conn.Conn, rows, cols int) *LK2047T {
-	dev := &LK2047T{d: conn, rows: rows, cols: cols, Pins: make([]gpio.PinOut, 6)}
-	a := GPOEnabledDisplay(dev)
-	makePins(&a, dev.Pins)
-	return dev
+	return NewConn(conn, ModelLK2047T, rows, cols)
 }
 
-// Create a new LCD device using an io.Writer. If your display is connected
-// using a hardware interface that periph.io doesn't support (e.g. UART),
-// you can still use this package as long as the hardware interface provides
-// the io.Writer interface. rows is the number of lines the display supports,
-// and cols is the character width of the device.
+// Create a new LK204-7T LCD device using an io.Writer. rows is the number of
+// lines the display supports, and cols is the character width of the device.
 func NewWriterLK2047T(writer io.Writer, rows, cols int) *LK2047T {
-	dev := &LK2047T{writer: writer, rows: rows, cols: cols, Pins: make([]gpio.PinOut, 6)}
+	return NewWriter(writer, ModelLK2047T, rows, cols)
+}
+
+func newDev(conn conn.Conn, writer io.Writer, model Model, rows, cols int) *Dev {
+	dev := &Dev{d: conn, writer: writer, rows: rows, cols: cols, model: model, now: time.Now, sleep: time.Sleep}
+	dev.makePins()
+	return dev
+}
+
+// makePins creates the GPO pins of the display model.
+func (dev *Dev) makePins() {
+	dev.Pins = make([]gpio.PinOut, dev.model.GPOs)
 	a := GPOEnabledDisplay(dev)
 	makePins(&a, dev.Pins)
-	return dev
+}
+
+// Model returns the model description of the display.
+func (dev *Dev) Model() Model {
+	return dev.model
+}
+
+// notImplemented returns display.ErrNotImplemented if the display model
+// doesn't support the capability.
+func (dev *Dev) notImplemented(c Capability) error {
+	if dev.model.Caps&c == c {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", dev.model.Name, display.ErrNotImplemented)
 }
 
 // Enable or disable AutoScroll.
-func (dev *LK2047T) AutoScroll(enabled bool) (err error) {
+func (dev *Dev) AutoScroll(enabled bool) (err error) {
 	if enabled {
 		_, err = dev.Write(autoScrollOn)
 	} else {
@@ -115,7 +178,7 @@ func (dev *LK2047T) AutoScroll(enabled bool) (err error) {
 }
 
 // Clears the screen, and moves the cursor to the home position.
-func (dev *LK2047T) Clear() (err error) {
+func (dev *Dev) Clear() (err error) {
 	_, err = dev.Write(clearScreen)
 	if err == nil {
 		err = dev.Home()
@@ -124,12 +187,12 @@ func (dev *LK2047T) Clear() (err error) {
 }
 
 // Return the number of columns supported by the device.
-func (dev *LK2047T) Cols() int {
+func (dev *Dev) Cols() int {
 	return dev.cols
 }
 
 // Set the cursor mode. E.G. underline, block, etc.
-func (dev *LK2047T) Cursor(modes ...display.CursorMode) (err error) {
+func (dev *Dev) Cursor(modes ...display.CursorMode) (err error) {
 	for _, mode := range modes {
 		switch mode {
 		case display.CursorOff:
@@ -147,7 +210,7 @@ func (dev *LK2047T) Cursor(modes ...display.CursorMode) (err error) {
 		case display.CursorBlink:
 			_, err = dev.Write(cursorBlinkOn)
 		default:
-			err = fmt.Errorf("lk2047t: invalid cursor mode %d", mode)
+			err = fmt.Errorf("matrixorbital: invalid cursor mode %d", mode)
 		}
 		if err != nil {
 			break
@@ -158,17 +221,26 @@ func (dev *LK2047T) Cursor(modes ...display.CursorMode) (err error) {
 
 // Halt shuts down the display, and closes the output device if it implements
 // io.Closer. If a keypad read operation is running, closing the device will
-// terminate it.
-func (dev *LK2047T) Halt() (err error) {
+// terminate it. For a display created by NewReconnecting(), reopening the
+// device is stopped.
+func (dev *Dev) Halt() (err error) {
 	err = dev.Display(false)
 	_ = dev.KeypadBacklight(false)
-	if err != nil {
+	if err != nil && !errors.Is(err, ErrDisconnected) {
 		return err
 	}
+	if dev.rc != nil {
+		dev.stopReconnect()
+	}
 	dev.mu.Lock()
 	defer dev.mu.Unlock()
 	if dev.shutdown != nil {
-		dev.shutdown <- struct{}{}
+		close(dev.shutdown)
+		dev.shutdown = nil
+	}
+	dev.stopReader()
+	if dev.rc != nil && !dev.rc.connected {
+		return nil
 	}
 	var cl io.Closer
 	var ok bool
@@ -188,25 +260,25 @@ func (dev *LK2047T) Halt() (err error) {
 }
 
 // Home resets the cursor to the default position.
-func (dev *LK2047T) Home() (err error) {
+func (dev *Dev) Home() (err error) {
 	_, err = dev.Write(goHome)
 	return
 }
 
 // MinCol returns the numbering scheme of the device's minimum column number.
 // Generally, it will be 0 or 1
-func (dev *LK2047T) MinCol() int {
+func (dev *Dev) MinCol() int {
 	return 1
 }
 
 // MinRow returns the numbering scheme of the device's minimum row (line)
 // number. Generally, it will be 0 or 1.
-func (dev *LK2047T) MinRow() int {
+func (dev *Dev) MinRow() int {
 	return 1
 }
 
 // Move the cursor forward or backwards.
-func (dev *LK2047T) Move(direction display.CursorDirection) (err error) {
+func (dev *Dev) Move(direction display.CursorDirection) (err error) {
 	switch direction {
 	case display.Forward:
 		_, err = dev.Write(cursorForward)
@@ -215,15 +287,15 @@ func (dev *LK2047T) Move(direction display.CursorDirection) (err error) {
 	case display.Up:
 	case display.Down:
 	default:
-		err = errors.New("lk2047t: invalid move direction")
+		err = errors.New("matrixorbital: invalid move direction")
 	}
 	return
 }
 
 // Move the cursor to an arbitrary row/column on the device.
-func (dev *LK2047T) MoveTo(row, col int) (err error) {
+func (dev *Dev) MoveTo(row, col int) (err error) {
 	if row < 1 || row > dev.rows || col < 1 || col > dev.cols {
-		return fmt.Errorf("lk2047t: MoveTo(%d, %d) value out of range", row, col)
+		return fmt.Errorf("matrixorbital: MoveTo(%d, %d) value out of range", row, col)
 	}
 	_, err = dev.Write([]byte{setCursorPosition[0], setCursorPosition[1], byte(col), byte(row)})
 	return err
@@ -231,45 +303,43 @@ func (dev *LK2047T) MoveTo(row, col int) (err error) {
 
 // ReadKeypad reads from the displays built-in keypad. The io device used by the
 // display must implement io.Reader. If it does not, then an error is returned.
-func (dev *LK2047T) ReadKeypad() (<-chan byte, error) {
+func (dev *Dev) ReadKeypad() (<-chan byte, error) {
+	if err := dev.notImplemented(CapKeypad); err != nil {
+		return nil, err
+	}
 	dev.mu.Lock()
 	defer dev.mu.Unlock()
 	if dev.chKeyboard != nil {
 		return dev.chKeyboard, nil
 	}
-	var rdr io.Reader
-	var ok bool
-	if dev.writer == nil {
-		rdr, ok = dev.d.(io.Reader)
-	} else {
-		rdr, ok = dev.writer.(io.Reader)
-	}
-	if !ok {
-		return nil, errors.New("lk2047t: output device does not implement io.Reader")
+	rdr, err := dev.reader()
+	if err != nil {
+		return nil, err
 	}
-
-	dev.chKeyboard = make(chan byte, 8)
-	dev.shutdown = make(chan struct{})
+	input := dev.startReader(rdr)
+	ch := make(chan byte, 8)
+	shutdown := make(chan struct{})
+	dev.chKeyboard = ch
+	dev.shutdown = shutdown
 	go func() {
 		defer func() {
 			dev.mu.Lock()
-			close(dev.chKeyboard)
+			close(ch)
 			dev.chKeyboard = nil
 			dev.mu.Unlock()
 		}()
-		buf := make([]byte, 4)
-		var err error
-		var n int
-		for err == nil {
+		for {
 			select {
-			case <-dev.shutdown:
+			case <-shutdown:
 				return
-			default:
-				n, err = rdr.Read(buf)
-				if n > 0 {
-					for ix := range n {
-						dev.chKeyboard <- buf[ix]
-					}
+			case b, ok := <-input:
+				if !ok {
+					return
+				}
+				select {
+				case ch <- b:
+				case <-shutdown:
+					return
 				}
 			}
 		}
@@ -277,27 +347,135 @@ func (dev *LK2047T) ReadKeypad() (<-chan byte, error) {
 	return dev.chKeyboard, nil
 }
 
+// reader returns the io device as an io.Reader.
+func (dev *Dev) reader() (io.Reader, error) {
+	var rdr io.Reader
+	var ok bool
+	if dev.writer == nil {
+		rdr, ok = dev.d.(io.Reader)
+	} else {
+		rdr, ok = dev.writer.(io.Reader)
+	}
+	if !ok {
+		return nil, errors.New("matrixorbital: output device does not implement io.Reader")
+	}
+	return rdr, nil
+}
+
+// startReader starts the goroutine that reads from the io device, if it's
+// not running, and returns the channel that receives the bytes read. A single
+// goroutine reads until Halt() is called, so a query that times out doesn't
+// leave a read pending that consumes a later response or key press. The
+// caller must hold dev.mu.
+func (dev *Dev) startReader(rdr io.Reader) chan byte {
+	if dev.input == nil {
+		dev.input = make(chan byte, 16)
+		dev.readerStop = make(chan struct{})
+		go readInput(rdr, dev.input, dev.readerStop)
+	}
+	return dev.input
+}
+
+// stopReader stops the reader goroutine. A Read() of the io device that's in
+// progress returns when the device is closed. The caller must hold dev.mu.
+func (dev *Dev) stopReader() {
+	if dev.readerStop != nil {
+		close(dev.readerStop)
+		dev.readerStop = nil
+		dev.input = nil
+	}
+}
+
+// readInput sends the bytes read from rdr to input, until a read returns an
+// error or stop is closed.
+func readInput(rdr io.Reader, input chan<- byte, stop <-chan struct{}) {
+	defer close(input)
+	buf := make([]byte, 4)
+	for {
+		n, err := rdr.Read(buf)
+		for ix := range n {
+			select {
+			case input <- buf[ix]:
+			case <-stop:
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+		select {
+		case <-stop:
+			return
+		default:
+		}
+	}
+}
+
 // Return the number of rows supported by the device.
-func (dev *LK2047T) Rows() int {
+func (dev *Dev) Rows() int {
 	return dev.rows
 }
 
 // Set the intensity of the backlight. Refer to the docs in the lcd package
 // for warnings on this function. Provides periph.io/x/conn/v3/display.Backlight
-func (dev *LK2047T) Backlight(intensity display.Intensity) error {
-	_, err := dev.Write([]byte{setBrightness[0], setBrightness[1], byte(intensity)})
+func (dev *Dev) Backlight(intensity display.Intensity) error {
+	if dev.model.Caps&CapVFD == CapVFD {
+		// VFD displays have 4 brightness levels, 0 being the brightest.
+		_, err := dev.Write([]byte{setVFDBrightness[0], setVFDBrightness[1], 3 - intensityByte(intensity)>>6})
+		return err
+	}
+	if err := dev.notImplemented(CapBacklight); err != nil {
+		return err
+	}
+	_, err := dev.Write([]byte{setBrightness[0], setBrightness[1], intensityByte(intensity)})
+	return err
+}
+
+// intensityByte returns intensity clamped to the range 0-255.
+func intensityByte(intensity display.Intensity) byte {
+	return byte(min(max(intensity, 0), 0xff))
+}
+
+// SetSize stores the dimensions of the attached LCD in the display
+// controller. Only supported by the Adafruit USB-LCD Backpack, which
+// persists the value.
+func (dev *Dev) SetSize(rows, cols int) error {
+	if err := dev.notImplemented(CapSetSize); err != nil {
+		return err
+	}
+	_, err := dev.Write([]byte{setSize[0], setSize[1], byte(cols), byte(rows)})
+	if err == nil {
+		dev.mu.Lock()
+		dev.rows = rows
+		dev.cols = cols
+		dev.mu.Unlock()
+	}
+	return err
+}
+
+// SetCustomChar defines the pattern of the custom character c, from 0 to 7.
+// Each byte of pattern is a row of the character, from the top, with the
+// leftmost pixel in bit 4.
+func (dev *Dev) SetCustomChar(c byte, pattern [8]byte) error {
+	if c > 7 {
+		return fmt.Errorf("matrixorbital: invalid custom character %d", c)
+	}
+	_, err := dev.Write(append([]byte{defineCustomChar[0], defineCustomChar[1], c}, pattern[:]...))
 	return err
 }
 
 // Set the constrast of the display.  Refer to the docs in the lcd package
 // for warnings on this function. Provides periph.io/x/conn/v3/display.DisplayContrast
-func (dev *LK2047T) Contrast(contrast display.Contrast) error {
+func (dev *Dev) Contrast(contrast display.Contrast) error {
+	if err := dev.notImplemented(CapContrast); err != nil {
+		return err
+	}
 	_, err := dev.Write([]byte{setContrast[0], setContrast[1], byte(contrast)})
 	return err
 }
 
 // Set the display on or off.
-func (dev *LK2047T) Display(on bool) (err error) {
+func (dev *Dev) Display(on bool) (err error) {
 	if on {
 		_, err = dev.Write([]byte{displayOn[0], displayOn[1], 0})
 	} else {
@@ -306,7 +484,10 @@ func (dev *LK2047T) Display(on bool) (err error) {
 	return
 }
 
-func (dev *LK2047T) KeypadBacklight(on bool) error {
+func (dev *Dev) KeypadBacklight(on bool) error {
+	if err := dev.notImplemented(CapKeypad); err != nil {
+		return err
+	}
 	if on {
 		return dev.Display(on)
 	}
@@ -315,7 +496,10 @@ func (dev *LK2047T) KeypadBacklight(on bool) error {
 }
 
 // Set the specified output pin state.
-func (dev *LK2047T) GPO(pin int, on gpio.Level) (err error) {
+func (dev *Dev) GPO(pin int, on gpio.Level) (err error) {
+	if pin < 1 || pin > dev.model.GPOs {
+		return fmt.Errorf("%s: invalid GPO pin %d", dev.model.Name, pin)
+	}
 
 	if on {
 		_, err = dev.Write([]byte{setGPOOn[0], setGPOOn[1], byte(pin)})
@@ -327,9 +511,15 @@ func (dev *LK2047T) GPO(pin int, on gpio.Level) (err error) {
 }
 
 // Set an led to a supported color. number is 0 based.
-func (dev *LK2047T) LED(number int, color LEDColor) error {
+func (dev *Dev) LED(number int, color LEDColor) error {
+	if err := dev.notImplemented(CapLEDs); err != nil {
+		return err
+	}
 	if color < Off || color > Yellow {
-		return fmt.Errorf("lk2047t: invalid color: %d", color)
+		return fmt.Errorf("matrixorbital: invalid color: %d", color)
+	}
+	if number < 0 || number*2+1 >= len(dev.Pins) {
+		return fmt.Errorf("matrixorbital: invalid LED number: %d", number)
 	}
 	err := dev.Pins[number*2].Out(gpio.Level(color&Red == Red))
 	if err != nil {
@@ -338,20 +528,74 @@ func (dev *LK2047T) LED(number int, color LEDColor) error {
 	return dev.Pins[number*2+1].Out(gpio.Level(color&Green == Green))
 }
 
-func (dev *LK2047T) String() string {
+func (dev *Dev) String() string {
 	var ioType any
 	if dev.d != nil {
 		ioType = dev.d
 	} else {
 		ioType = dev.writer
 	}
-	return fmt.Sprintf("MatrixOrbital LK204-7T LCD Display: Rows: %d Cols: %d Connection: %T", dev.rows, dev.cols, ioType)
+	return fmt.Sprintf("MatrixOrbital %s LCD Display: Rows: %d Cols: %d Connection: %T", dev.model.Name, dev.rows, dev.cols, ioType)
 }
 
 // Write commands or data to the display
-func (dev *LK2047T) Write(p []byte) (n int, err error) {
+func (dev *Dev) Write(p []byte) (n int, err error) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	return dev.write(p)
+}
+
+// SetPacing sets the minimum time between bytes written to the display. The
+// default is 0, and bytes are written as fast as the io device accepts them.
+// Some clone displays and serial adapters without flow control overflow their
+// receive buffer when written at full speed. If pacing is non-zero, bytes are
+// written to the io device one at a time.
+func (dev *Dev) SetPacing(pacing time.Duration) {
 	dev.mu.Lock()
 	defer dev.mu.Unlock()
+	dev.pacing = pacing
+}
+
+// write sends p to the display. For a display created by NewReconnecting(),
+// p is recorded to restore the display, and if the device disappeared, it's
+// reopened. The caller must hold dev.mu.
+func (dev *Dev) write(p []byte) (n int, err error) {
+	if dev.rc == nil {
+		return dev.writePaced(p)
+	}
+	dev.rc.screen.write(p)
+	if !dev.rc.connected {
+		return 0, ErrDisconnected
+	}
+	if n, err = dev.writePaced(p); err != nil && isDisconnect(err) {
+		dev.disconnected(err)
+		err = fmt.Errorf("%w: %w", ErrDisconnected, err)
+	}
+	return
+}
+
+// writePaced sends p to the display, with the pacing set by SetPacing(). The
+// caller must hold dev.mu.
+func (dev *Dev) writePaced(p []byte) (n int, err error) {
+	if dev.pacing > 0 {
+		for ix := range p {
+			if delay := dev.pacing - dev.now().Sub(dev.lastWrite); delay > 0 {
+				dev.sleep(delay)
+			}
+			var written int
+			written, err = dev.writeUnpaced(p[ix : ix+1])
+			dev.lastWrite = dev.now()
+			n += written
+			if err != nil {
+				break
+			}
+		}
+		return
+	}
+	return dev.writeUnpaced(p)
+}
+
+func (dev *Dev) writeUnpaced(p []byte) (n int, err error) {
 	if dev.writer == nil {
 		err = dev.d.Tx(p, nil)
 		n = len(p)
@@ -363,7 +607,7 @@ func (dev *LK2047T) Write(p []byte) (n int, err error) {
 }
 
 // WriteString sends a text string to the display.
-func (dev *LK2047T) WriteString(text string) (int, error) {
+func (dev *Dev) WriteString(text string) (int, error) {
 	n, err := dev.Write([]byte(text))
 	return n, err
 }
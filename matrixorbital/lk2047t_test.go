@@ -5,12 +5,18 @@
 package matrixorbital
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"hash"
 	"hash/crc32"
 	"io"
 	"math/rand"
+	"os"
+	"slices"
+	"sync"
 	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
@@ -19,7 +25,7 @@ import (
 )
 
 type mockReadWriterCloser struct {
-	closed       bool
+	closed       atomic.Bool
 	bytesWritten int
 	bytesRead    int
 	readChars    string
@@ -28,7 +34,7 @@ type mockReadWriterCloser struct {
 }
 
 func (mr *mockReadWriterCloser) Read(p []byte) (n int, err error) {
-	if mr.closed {
+	if mr.closed.Load() {
 		err = io.EOF
 	}
 	cPos := rand.Intn(len(mr.readChars))
@@ -39,7 +45,7 @@ func (mr *mockReadWriterCloser) Read(p []byte) (n int, err error) {
 }
 
 func (mr *mockReadWriterCloser) Write(p []byte) (n int, err error) {
-	if mr.closed {
+	if mr.closed.Load() {
 		err = io.EOF
 		return
 	}
@@ -50,7 +56,7 @@ func (mr *mockReadWriterCloser) Write(p []byte) (n int, err error) {
 }
 
 func (mr *mockReadWriterCloser) Close() error {
-	mr.closed = true
+	mr.closed.Store(true)
 	return nil
 }
 
@@ -189,3 +195,404 @@ func TestKeypad(t *testing.T) {
 var _ io.Reader = &mockReadWriterCloser{}
 var _ io.Writer = &mockReadWriterCloser{}
 var _ io.Closer = &mockReadWriterCloser{}
+
+// queryResponder is a mock display that answers the read version and read
+// module type commands.
+type queryResponder struct {
+	version    byte
+	moduleType byte
+	pending    chan byte
+	closed     chan struct{}
+}
+
+func newQueryResponder(version, moduleType byte) *queryResponder {
+	return &queryResponder{version: version, moduleType: moduleType,
+		pending: make(chan byte, 1), closed: make(chan struct{})}
+}
+
+func (qr *queryResponder) Write(p []byte) (int, error) {
+	if len(p) == 2 && p[0] == cmdByte {
+		switch p[1] {
+		case readVersion[1]:
+			qr.pending <- qr.version
+		case readModuleType[1]:
+			qr.pending <- qr.moduleType
+		}
+	}
+	return len(p), nil
+}
+
+func (qr *queryResponder) Read(p []byte) (int, error) {
+	select {
+	case p[0] = <-qr.pending:
+		return 1, nil
+	case <-qr.closed:
+		return 0, io.EOF
+	}
+}
+
+func (qr *queryResponder) Close() error {
+	close(qr.closed)
+	return nil
+}
+
+// silentDisplay is a mock display that accepts commands but never answers.
+// Read returns io.EOF when it's closed.
+type silentDisplay struct {
+	closed chan struct{}
+}
+
+func (sd *silentDisplay) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func (sd *silentDisplay) Read(p []byte) (int, error) {
+	<-sd.closed
+	return 0, io.EOF
+}
+
+func (sd *silentDisplay) Close() error {
+	close(sd.closed)
+	return nil
+}
+
+func TestProbe(t *testing.T) {
+	qr := newQueryResponder(0x1a, 0x09)
+	defer qr.Close()
+	dev := NewWriterLK2047T(qr, 0, 0)
+	info, err := dev.Probe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Firmware != 0x1a || info.Name != "LK204-25" {
+		t.Errorf("unexpected module info %#v", info)
+	}
+	if dev.Rows() != 4 || dev.Cols() != 20 {
+		t.Errorf("expected size to be populated, got %dx%d", dev.Rows(), dev.Cols())
+	}
+
+	qr = newQueryResponder(0x1a, 0x09)
+	defer qr.Close()
+	dev = NewWriterLK2047T(qr, 2, 16)
+	_, err = dev.Probe()
+	if !errors.Is(err, ErrSizeMismatch) {
+		t.Errorf("expected ErrSizeMismatch, received %v", err)
+	}
+	if dev.Rows() != 2 || dev.Cols() != 16 {
+		t.Errorf("constructor size was not retained, got %dx%d", dev.Rows(), dev.Cols())
+	}
+
+	// A display constructed for another model, with the size detected.
+	qr = newQueryResponder(0x1a, 0x05)
+	defer qr.Close()
+	dev = NewWriterLK2047T(qr, 0, 0)
+	if info, err = dev.Probe(); err != nil {
+		t.Fatal(err)
+	}
+	if info.Name != "LCD2041" || dev.Model() != ModelLCD2041 || len(dev.Pins) != 1 {
+		t.Errorf("expected LCD2041 model, got %#v with %d pins", dev.Model(), len(dev.Pins))
+	}
+
+	// A display that never answers.
+	sd := &silentDisplay{closed: make(chan struct{})}
+	dev = NewWriterLK2047T(sd, 2, 16)
+	for range 2 {
+		// The second query doesn't start another read.
+		if _, err = dev.Probe(); !errors.Is(err, ErrNoResponse) {
+			t.Errorf("expected ErrNoResponse, received %v", err)
+		}
+	}
+	if err = dev.Halt(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	qr := newQueryResponder(0x1a, 0x0a)
+	dev, err := OpenWriter(qr, ModelLK2047T, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dev.Rows() != 4 || dev.Cols() != 40 || dev.Model() != ModelLK40455 {
+		t.Errorf("expected size to be detected, got %dx%d", dev.Rows(), dev.Cols())
+	}
+	if err = dev.Halt(); err != nil {
+		t.Error(err)
+	}
+
+	// A display that doesn't answer uses the model dimensions.
+	sd := &silentDisplay{closed: make(chan struct{})}
+	if dev, err = OpenWriter(sd, ModelLK2047T, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if dev.Rows() != 4 || dev.Cols() != 20 {
+		t.Errorf("expected model size, got %dx%d", dev.Rows(), dev.Cols())
+	}
+	if err = dev.Halt(); err != nil {
+		t.Error(err)
+	}
+
+	// The Adafruit backpack can't be queried, and has no fixed size.
+	wr := &mockReadWriterCloser{readChars: "A", hash: crc32.NewIEEE()}
+	if _, err = OpenWriter(wr, ModelAdafruitUSBBackpack, 0, 0); err == nil {
+		t.Error("expected error without dimensions")
+	}
+	if dev, err = OpenWriter(wr, ModelAdafruitUSBBackpack, 2, 16); err != nil {
+		t.Fatal(err)
+	}
+	if dev.Rows() != 2 || dev.Cols() != 16 {
+		t.Errorf("unexpected size %dx%d", dev.Rows(), dev.Cols())
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	wr := &mockReadWriterCloser{readChars: "A", hash: crc32.NewIEEE()}
+	if _, err := NewWriterRGB(wr, ModelLK2047T, 4, 20); !errors.Is(err, display.ErrNotImplemented) {
+		t.Errorf("NewWriterRGB() expected ErrNotImplemented, received %v", err)
+	}
+	dev, err := NewWriterRGB(wr, ModelAdafruitUSBBackpack, 2, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dev.Pins) != 4 {
+		t.Errorf("expected 4 GPO pins, received %d", len(dev.Pins))
+	}
+	if err := dev.RGBBacklight(0xff, 0, 0x80); err != nil {
+		t.Error(err)
+	}
+	if err := dev.SetSize(4, 20); err != nil {
+		t.Error(err)
+	}
+	if dev.Rows() != 4 || dev.Cols() != 20 {
+		t.Errorf("SetSize() didn't update dimensions, got %dx%d", dev.Rows(), dev.Cols())
+	}
+	if err := dev.LED(0, Red); !errors.Is(err, display.ErrNotImplemented) {
+		t.Errorf("LED() expected ErrNotImplemented, received %v", err)
+	}
+	if _, err := dev.ReadKeypad(); !errors.Is(err, display.ErrNotImplemented) {
+		t.Errorf("ReadKeypad() expected ErrNotImplemented, received %v", err)
+	}
+	if err := dev.GPO(5, true); err == nil {
+		t.Error("GPO() expected error for invalid pin")
+	}
+
+	if _, ok := any(NewWriterLK2047T(wr, 4, 20)).(display.DisplayRGBBacklight); ok {
+		t.Error("monochrome display implements DisplayRGBBacklight")
+	}
+
+	rec := &recordingWriter{}
+	vfd := NewWriter(rec, ModelVK20425, 4, 20)
+	if err := vfd.Contrast(10); !errors.Is(err, display.ErrNotImplemented) {
+		t.Errorf("Contrast() expected ErrNotImplemented, received %v", err)
+	}
+	// Intensities out of range are clamped.
+	for _, intensity := range []display.Intensity{0xff, 0x100, -1} {
+		if err := vfd.Backlight(intensity); err != nil {
+			t.Error(err)
+		}
+	}
+	want := []byte{cmdByte, 0x59, 0, cmdByte, 0x59, 0, cmdByte, 0x59, 3}
+	if string(rec.written) != string(want) {
+		t.Errorf("Backlight() wrote %#v, expected %#v", rec.written, want)
+	}
+}
+
+// recordingWriter is an io.Writer that records the bytes written.
+type recordingWriter struct {
+	written []byte
+}
+
+func (rw *recordingWriter) Write(p []byte) (int, error) {
+	rw.written = append(rw.written, p...)
+	return len(p), nil
+}
+
+// countingWriter is an io.Writer that counts writes and bytes written.
+type countingWriter struct {
+	writes int
+	bytes  int
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	cw.writes++
+	cw.bytes += len(p)
+	return len(p), nil
+}
+
+func TestPacing(t *testing.T) {
+	wr := &countingWriter{}
+	dev := NewWriterLK2047T(wr, 4, 20)
+	// The clock only advances while sleeping. The last write was 400us ago,
+	// so the first delay is the remainder of the pacing interval.
+	clock := time.Unix(0, 0)
+	var sleeps []time.Duration
+	dev.now = func() time.Time { return clock }
+	dev.sleep = func(d time.Duration) {
+		sleeps = append(sleeps, d)
+		clock = clock.Add(d)
+	}
+	dev.lastWrite = clock.Add(-400 * time.Microsecond)
+	dev.SetPacing(time.Millisecond)
+	if n, err := dev.WriteString("abc"); n != 3 || err != nil {
+		t.Fatalf("WriteString() returned %d, %v", n, err)
+	}
+	if wr.writes != 3 {
+		t.Errorf("expected 3 writes, received %d", wr.writes)
+	}
+	expected := []time.Duration{600 * time.Microsecond, time.Millisecond, time.Millisecond}
+	if !slices.Equal(sleeps, expected) {
+		t.Errorf("expected delays %v, received %v", expected, sleeps)
+	}
+	sleeps = nil
+	dev.SetPacing(0)
+	if _, err := dev.WriteString("abc"); err != nil {
+		t.Fatal(err)
+	}
+	if len(sleeps) != 0 || wr.writes != 4 {
+		t.Errorf("expected one write without delays, received %d writes %v", wr.writes, sleeps)
+	}
+}
+
+// benchmarkWrite writes a full row to dev, and reports the characters per
+// second and writes per character.
+func benchmarkWrite(b *testing.B, dev *Dev, wr *countingWriter) {
+	const text = "01234567890123456789"
+	b.ResetTimer()
+	for range b.N {
+		if err := dev.MoveTo(1, 1); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := dev.WriteString(text); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	chars := float64(b.N * len(text))
+	b.ReportMetric(chars/b.Elapsed().Seconds(), "chars/s")
+	b.ReportMetric(float64(wr.writes)/chars, "writes/char")
+	b.ReportMetric(float64(wr.bytes)/chars, "bytes/char")
+}
+
+func BenchmarkWrite(b *testing.B) {
+	wr := &countingWriter{}
+	benchmarkWrite(b, NewWriterLK2047T(wr, 4, 20), wr)
+}
+
+func BenchmarkWritePaced(b *testing.B) {
+	wr := &countingWriter{}
+	dev := NewWriterLK2047T(wr, 4, 20)
+	// 1 byte time at 9600 baud.
+	dev.SetPacing(1042 * time.Microsecond)
+	benchmarkWrite(b, dev, wr)
+}
+
+// usbDevice is the tty of a USB backpack that can be unplugged.
+type usbDevice struct {
+	mu      sync.Mutex
+	written []byte
+	gone    bool
+	closed  bool
+}
+
+func (u *usbDevice) Read(p []byte) (int, error) {
+	return 0, io.EOF
+}
+
+func (u *usbDevice) Write(p []byte) (int, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.gone {
+		return 0, &os.PathError{Op: "write", Path: "/dev/ttyACM0", Err: syscall.EIO}
+	}
+	u.written = append(u.written, p...)
+	return len(p), nil
+}
+
+func (u *usbDevice) Close() error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.closed = true
+	return nil
+}
+
+func TestReconnect(t *testing.T) {
+	var plugged atomic.Pointer[usbDevice]
+	first := &usbDevice{}
+	plugged.Store(first)
+	open := func() (io.ReadWriter, error) {
+		if u := plugged.Swap(nil); u != nil {
+			return u, nil
+		}
+		return nil, &os.PathError{Op: "open", Path: "/dev/ttyACM0", Err: syscall.ENOENT}
+	}
+	dev, err := NewReconnecting(open, ModelAdafruitUSBBackpack, 2, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev.SetReconnectInterval(time.Millisecond)
+	nextEvent := func() ConnEvent {
+		select {
+		case e := <-dev.Events():
+			return e
+		case <-time.After(time.Second):
+			t.Fatal("no event")
+			return ConnEvent{}
+		}
+	}
+	if err := dev.Contrast(200); err != nil {
+		t.Fatal(err)
+	}
+	if err := dev.SetCustomChar(1, [8]byte{0x1f, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x1f}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dev.MoveTo(2, 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dev.WriteString("hi"); err != nil {
+		t.Fatal(err)
+	}
+
+	first.mu.Lock()
+	first.gone = true
+	first.mu.Unlock()
+	if _, err := dev.WriteString("!"); !errors.Is(err, ErrDisconnected) || !errors.Is(err, syscall.EIO) {
+		t.Fatalf("expected ErrDisconnected, got %v", err)
+	}
+	if e := nextEvent(); e.State != Disconnected || !errors.Is(e.Err, syscall.EIO) {
+		t.Fatalf("got %s", e)
+	}
+	if !first.closed {
+		t.Error("expected the device to be closed")
+	}
+	// Changes while disconnected are restored.
+	if _, err := dev.WriteString("?"); !errors.Is(err, ErrDisconnected) {
+		t.Fatalf("expected ErrDisconnected, got %v", err)
+	}
+
+	second := &usbDevice{}
+	plugged.Store(second)
+	if e := nextEvent(); e.State != Connected {
+		t.Fatalf("got %s", e)
+	}
+	want := []byte{cmdByte, 0x50, 200,
+		cmdByte, 0x4e, 1, 0x1f, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x1f,
+		cmdByte, 0x52, cmdByte, 0x58,
+		cmdByte, 0x47, 1, 1}
+	want = append(want, "                "...)
+	want = append(want, cmdByte, 0x47, 1, 2)
+	want = append(want, "  hi!?          "...)
+	want = append(want, cmdByte, 0x47, 7, 2)
+	second.mu.Lock()
+	got := second.written
+	second.mu.Unlock()
+	if !bytes.Equal(got, want) {
+		t.Fatalf("restored % x\nexpected % x", got, want)
+	}
+
+	if err := dev.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if !second.closed {
+		t.Error("expected Halt() to close the device")
+	}
+}
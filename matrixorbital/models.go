@@ -0,0 +1,106 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package matrixorbital
+
+// Capability is a bit mask of the optional features supported by a display
+// model.
+type Capability uint16
+
+const (
+	// CapKeypad indicates the display has a keypad interface.
+	CapKeypad Capability = 1 << iota
+	// CapLEDs indicates the GPO pins drive bi-color LEDs.
+	CapLEDs
+	// CapBacklight indicates the display supports setting the backlight
+	// brightness.
+	CapBacklight
+	// CapContrast indicates the display supports setting the contrast.
+	CapContrast
+	// CapRGBBacklight indicates the display supports an RGB backlight.
+	CapRGBBacklight
+	// CapSetSize indicates the display accepts the set LCD size command.
+	CapSetSize
+	// CapQuery indicates the display answers the read version and read module
+	// type commands.
+	CapQuery
+	// CapVFD indicates the display is a vacuum fluorescent display. VFD
+	// displays use a different brightness command, and have no contrast.
+	CapVFD
+)
+
+// Model describes a MatrixOrbital compatible display.
+type Model struct {
+	// Name is the model name.
+	Name string
+	// TypeCode is the value returned by the read module type command. 0 if
+	// the display doesn't support the command.
+	TypeCode byte
+	// Rows and Cols are the dimensions of the display. They're 0 for
+	// controllers that can be attached to different sized displays.
+	Rows int
+	Cols int
+	// GPOs is the number of general purpose outputs.
+	GPOs int
+	// Caps is the set of optional features the display supports.
+	Caps Capability
+}
+
+var (
+	// ModelLK2047T is the MatrixOrbital LK204-7T-1U. It's a 20x4 LCD with a
+	// keypad and three bi-color LEDs.
+	ModelLK2047T = Model{Name: "LK204-7T", TypeCode: 0x2b, Rows: 4, Cols: 20, GPOs: 6,
+		Caps: CapKeypad | CapLEDs | CapBacklight | CapContrast | CapQuery}
+	// ModelLK2047TUSB is the USB variant of the LK204-7T-1U.
+	ModelLK2047TUSB = Model{Name: "LK204-7T-USB", TypeCode: 0x2c, Rows: 4, Cols: 20, GPOs: 6,
+		Caps: CapKeypad | CapLEDs | CapBacklight | CapContrast | CapQuery}
+	// ModelLK20225 is the MatrixOrbital LK202-25 20x2 LCD.
+	ModelLK20225 = Model{Name: "LK202-25", TypeCode: 0x08, Rows: 2, Cols: 20, GPOs: 1,
+		Caps: CapKeypad | CapBacklight | CapContrast | CapQuery}
+	// ModelLK20425 is the MatrixOrbital LK204-25 20x4 LCD.
+	ModelLK20425 = Model{Name: "LK204-25", TypeCode: 0x09, Rows: 4, Cols: 20, GPOs: 1,
+		Caps: CapKeypad | CapBacklight | CapContrast | CapQuery}
+	// ModelLK40455 is the MatrixOrbital LK404-55 40x4 LCD.
+	ModelLK40455 = Model{Name: "LK404-55", TypeCode: 0x0a, Rows: 4, Cols: 40, GPOs: 1,
+		Caps: CapKeypad | CapBacklight | CapContrast | CapQuery}
+	// ModelLCD2041 is the MatrixOrbital LCD2041 20x4 LCD.
+	ModelLCD2041 = Model{Name: "LCD2041", TypeCode: 0x05, Rows: 4, Cols: 20, GPOs: 1,
+		Caps: CapKeypad | CapBacklight | CapContrast | CapQuery}
+	// ModelVK20225 is the MatrixOrbital VK202-25 20x2 VFD.
+	ModelVK20225 = Model{Name: "VK202-25", TypeCode: 0x0e, Rows: 2, Cols: 20, GPOs: 1,
+		Caps: CapKeypad | CapQuery | CapVFD}
+	// ModelVK20425 is the MatrixOrbital VK204-25 20x4 VFD.
+	ModelVK20425 = Model{Name: "VK204-25", TypeCode: 0x0f, Rows: 4, Cols: 20, GPOs: 1,
+		Caps: CapKeypad | CapQuery | CapVFD}
+	// ModelAdafruitUSBBackpack is the Adafruit USB/Serial LCD Backpack. It
+	// emulates a subset of the MatrixOrbital command set, and can drive
+	// displays of various sizes. It has 4 bare GPO pins.
+	//
+	// https://www.adafruit.com/product/782
+	ModelAdafruitUSBBackpack = Model{Name: "Adafruit USB/Serial Backpack", GPOs: 4,
+		Caps: CapBacklight | CapContrast | CapRGBBacklight | CapSetSize}
+)
+
+// knownModels is the set of models that can be identified by their module
+// type code.
+var knownModels = []*Model{
+	&ModelLK2047T,
+	&ModelLK2047TUSB,
+	&ModelLK20225,
+	&ModelLK20425,
+	&ModelLK40455,
+	&ModelLCD2041,
+	&ModelVK20225,
+	&ModelVK20425,
+}
+
+// modelByTypeCode returns the model with the specified module type code.
+func modelByTypeCode(code byte) (Model, bool) {
+	for _, m := range knownModels {
+		if m.TypeCode == code {
+			return *m, true
+		}
+	}
+	return Model{}, false
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package matrixorbital
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"periph.io/x/conn/v3"
+)
+
+// ModuleInfo is the information returned by Probe().
+type ModuleInfo struct {
+	// Firmware is the firmware revision reported by the display.
+	Firmware byte
+	// ModuleType is the raw module type code reported by the display.
+	ModuleType byte
+	// Name is the model name corresponding to ModuleType. If the code is not
+	// known, Name is "unknown".
+	Name string
+	// Rows and Cols are the dimensions of the detected model. They're zero if
+	// the model is not known.
+	Rows int
+	Cols int
+}
+
+var (
+	// ErrNoResponse is returned by Probe() when the display doesn't answer a
+	// query. Models that don't support queries, like the Adafruit USB/Serial
+	// backpack, return display.ErrNotImplemented instead.
+	ErrNoResponse = errors.New("matrixorbital: no response from display")
+	// ErrSizeMismatch is returned by Probe() when the dimensions passed to the
+	// constructor don't match the dimensions of the detected model. The
+	// constructor values are retained.
+	ErrSizeMismatch = errors.New("matrixorbital: display size mismatch")
+)
+
+var readVersion = []byte{cmdByte, 0x36}
+var readModuleType = []byte{cmdByte, 0x37}
+
+// How long to wait for a response to a query command.
+const probeTimeout = 250 * time.Millisecond
+
+// OpenConn creates a display of the specified model using conn, and probes
+// it. See OpenWriter().
+func OpenConn(conn conn.Conn, model Model, rows, cols int) (*Dev, error) {
+	return open(newDev(conn, nil, model, rows, cols))
+}
+
+// OpenWriter creates a display of the specified model using rw, and probes
+// it for its module type and size as described for Probe(). If rows and cols
+// are 0, they're set from the detected model. If the model doesn't support
+// queries, or the display doesn't answer, the model passed is used, and rows
+// and cols are set from it if they're 0.
+//
+// If rows and cols don't match the detected model, the display is returned
+// along with an error wrapping ErrSizeMismatch. The display is usable, with
+// the dimensions passed.
+func OpenWriter(rw io.ReadWriter, model Model, rows, cols int) (*Dev, error) {
+	return open(newDev(nil, rw, model, rows, cols))
+}
+
+func open(dev *Dev) (*Dev, error) {
+	if dev.notImplemented(CapQuery) == nil {
+		_, err := dev.Probe()
+		if errors.Is(err, ErrSizeMismatch) {
+			return dev, err
+		}
+		if err != nil && !errors.Is(err, ErrNoResponse) {
+			dev.mu.Lock()
+			dev.stopReader()
+			dev.mu.Unlock()
+			return nil, err
+		}
+	}
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if dev.rows == 0 || dev.cols == 0 {
+		if dev.model.Rows == 0 || dev.model.Cols == 0 {
+			dev.stopReader()
+			return nil, fmt.Errorf("%s: rows and cols are required", dev.model.Name)
+		}
+		dev.rows = dev.model.Rows
+		dev.cols = dev.model.Cols
+	}
+	return dev, nil
+}
+
+// Probe queries the display for its firmware revision and module type. If
+// the module type is known, the model of the display is set to the detected
+// model, and if the display was constructed with rows and cols set to 0, the
+// dimensions of the display are populated from it. If the constructor
+// dimensions don't match the detected model, the ModuleInfo is returned along
+// with an error wrapping ErrSizeMismatch. Probe must not be called
+// concurrently with other methods of the display.
+//
+// The io device used by the display must implement io.Reader, and Probe()
+// must be called before ReadKeypad(). If the display does not answer within
+// a short timeout, ErrNoResponse is returned.
+//
+// NewConn() and NewWriter() don't probe the display, since they can't return
+// an error, and a display that doesn't answer delays them by the timeout. Use
+// OpenConn() or OpenWriter() to probe the display when it's created.
+func (dev *Dev) Probe() (ModuleInfo, error) {
+	info := ModuleInfo{Name: "unknown"}
+	if err := dev.notImplemented(CapQuery); err != nil {
+		return info, err
+	}
+	var err error
+	if info.Firmware, err = dev.query(readVersion); err != nil {
+		return info, err
+	}
+	if info.ModuleType, err = dev.query(readModuleType); err != nil {
+		return info, err
+	}
+	model, ok := modelByTypeCode(info.ModuleType)
+	if !ok {
+		return info, nil
+	}
+	info.Name = model.Name
+	info.Rows = model.Rows
+	info.Cols = model.Cols
+
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if dev.model != model {
+		dev.model = model
+		dev.makePins()
+	}
+	if dev.rows == 0 && dev.cols == 0 {
+		dev.rows = model.Rows
+		dev.cols = model.Cols
+	} else if dev.rows != model.Rows || dev.cols != model.Cols {
+		err = fmt.Errorf("%w: configured %dx%d, detected %s %dx%d",
+			ErrSizeMismatch, dev.rows, dev.cols, model.Name, model.Rows, model.Cols)
+	}
+	return info, err
+}
+
+// query sends a command to the display and returns the single byte response.
+func (dev *Dev) query(cmd []byte) (byte, error) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if dev.chKeyboard != nil {
+		return 0, errors.New("matrixorbital: can't query display while reading keypad")
+	}
+	if dev.rc != nil && !dev.rc.connected {
+		return 0, ErrDisconnected
+	}
+	r := make([]byte, 1)
+	if dev.writer == nil {
+		if err := dev.d.Tx(cmd, r); err != nil {
+			return 0, wrapErr(err)
+		}
+		return r[0], nil
+	}
+	rdr, err := dev.reader()
+	if err != nil {
+		return 0, err
+	}
+	input := dev.startReader(rdr)
+	// Discard bytes received since the last query, like a response that
+	// arrived after the timeout.
+	for drained := false; !drained; {
+		select {
+		case _, ok := <-input:
+			if !ok {
+				return 0, wrapErr(io.EOF)
+			}
+		default:
+			drained = true
+		}
+	}
+	if _, err := dev.write(cmd); err != nil {
+		return 0, err
+	}
+	select {
+	case b, ok := <-input:
+		if !ok {
+			return 0, wrapErr(io.EOF)
+		}
+		return b, nil
+	case <-time.After(probeTimeout):
+		return 0, ErrNoResponse
+	}
+}
@@ -0,0 +1,392 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package matrixorbital
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"time"
+)
+
+// ErrDisconnected is returned by the methods of a display created by
+// NewReconnecting() while its device is disconnected.
+var ErrDisconnected = errors.New("matrixorbital: display disconnected")
+
+// DefaultReconnectInterval is how often the device of a disconnected display
+// is reopened. See SetReconnectInterval().
+const DefaultReconnectInterval = time.Second
+
+const eventBufferSize = 16
+
+// Opener opens the io device of a display, for example the tty of a USB
+// serial backpack. It must configure the port, such as setting it to raw
+// mode, since a device that reappears has the default settings.
+type Opener func() (io.ReadWriter, error)
+
+// ConnState is the state of the connection to a display.
+type ConnState int
+
+const (
+	// Disconnected is the state after the device disappeared.
+	Disconnected ConnState = iota
+	// Connected is the state after the device was reopened, and the display
+	// restored.
+	Connected
+)
+
+func (s ConnState) String() string {
+	switch s {
+	case Disconnected:
+		return "Disconnected"
+	case Connected:
+		return "Connected"
+	default:
+		return fmt.Sprintf("ConnState(%d)", int(s))
+	}
+}
+
+// ConnEvent is a change of the state of the connection to a display.
+type ConnEvent struct {
+	State ConnState
+	// Err is the error that caused the disconnection.
+	Err error
+}
+
+func (e ConnEvent) String() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s(%v)", e.State, e.Err)
+	}
+	return e.State.String()
+}
+
+// reconnector is the state of a display created by NewReconnecting().
+type reconnector struct {
+	open      Opener
+	interval  time.Duration
+	events    chan ConnEvent
+	connected bool
+	// screen is a copy of what's displayed, to restore the display once the
+	// device is reopened.
+	screen *shadow
+	// stop and done are the channels of the goroutine that reopens the
+	// device. stop is nil when it's not running.
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewReconnecting creates a display of the specified model using the io
+// device returned by open. If the device disappears, for example when a USB
+// backpack is unplugged, open is called every reconnect interval until it
+// succeeds. The display is then restored: its size, contrast, backlight,
+// custom characters and GPOs are set again, and the text displayed is
+// written at the same position.
+//
+// While the device is disconnected, methods that write to the display
+// return an error wrapping ErrDisconnected, and their changes are restored
+// once it's reconnected. The changes of the connection are sent to Events().
+// If open fails at creation, the display starts disconnected. A channel
+// returned by ReadKeypad() is closed when the device disappears; call
+// ReadKeypad() again after reconnection.
+//
+// rows and cols are required if the model has no fixed size, since the
+// display isn't probed.
+func NewReconnecting(open Opener, model Model, rows, cols int) (*Dev, error) {
+	if rows == 0 || cols == 0 {
+		if model.Rows == 0 || model.Cols == 0 {
+			return nil, fmt.Errorf("%s: rows and cols are required", model.Name)
+		}
+		rows, cols = model.Rows, model.Cols
+	}
+	dev := newDev(nil, nil, model, rows, cols)
+	dev.rc = &reconnector{
+		open:     open,
+		interval: DefaultReconnectInterval,
+		events:   make(chan ConnEvent, eventBufferSize),
+		screen:   newShadow(rows, cols),
+	}
+	rw, err := open()
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if err != nil {
+		dev.disconnected(err)
+	} else {
+		dev.writer = rw
+		dev.rc.connected = true
+	}
+	return dev, nil
+}
+
+// Events returns the channel of the changes of the connection of a display
+// created by NewReconnecting(), or nil for other displays. Events are dropped
+// when the channel's buffer is full. The channel is never closed.
+func (dev *Dev) Events() <-chan ConnEvent {
+	if dev.rc == nil {
+		return nil
+	}
+	return dev.rc.events
+}
+
+// SetReconnectInterval sets how often the device of a display created by
+// NewReconnecting() is reopened after it disappeared. The default is
+// DefaultReconnectInterval.
+func (dev *Dev) SetReconnectInterval(interval time.Duration) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if dev.rc != nil {
+		dev.rc.interval = interval
+	}
+}
+
+// isDisconnect returns true if err is returned by a device that disappeared.
+func isDisconnect(err error) bool {
+	return errors.Is(err, syscall.EIO) || errors.Is(err, syscall.ENODEV) || errors.Is(err, syscall.ENXIO)
+}
+
+// disconnected closes the device, and starts the goroutine that reopens it.
+// The caller must hold dev.mu.
+func (dev *Dev) disconnected(err error) {
+	rc := dev.rc
+	rc.connected = false
+	dev.stopReader()
+	if cl, ok := dev.writer.(io.Closer); ok {
+		_ = cl.Close()
+	}
+	dev.writer = nil
+	rc.send(ConnEvent{State: Disconnected, Err: err})
+	rc.stop = make(chan struct{})
+	rc.done = make(chan struct{})
+	go dev.reconnect(rc.interval, rc.stop, rc.done)
+}
+
+// reconnect reopens the device and restores the display, until it succeeds
+// or stop is closed.
+func (dev *Dev) reconnect(interval time.Duration, stop, done chan struct{}) {
+	defer close(done)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(interval):
+		}
+		rw, err := dev.rc.open()
+		if err != nil {
+			continue
+		}
+		dev.mu.Lock()
+		select {
+		case <-stop:
+			dev.mu.Unlock()
+			if cl, ok := rw.(io.Closer); ok {
+				_ = cl.Close()
+			}
+			return
+		default:
+		}
+		dev.writer = rw
+		if err = dev.restore(); err != nil {
+			if cl, ok := rw.(io.Closer); ok {
+				_ = cl.Close()
+			}
+			dev.writer = nil
+			dev.mu.Unlock()
+			continue
+		}
+		dev.rc.connected = true
+		dev.rc.stop = nil
+		dev.rc.send(ConnEvent{State: Connected})
+		dev.mu.Unlock()
+		return
+	}
+}
+
+// stopReconnect stops the goroutine that reopens the device, if it's
+// running. The caller must not hold dev.mu.
+func (dev *Dev) stopReconnect() {
+	dev.mu.Lock()
+	stop, done := dev.rc.stop, dev.rc.done
+	dev.rc.stop = nil
+	dev.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+// restore writes the settings and the contents of the display to the
+// reopened device. The caller must hold dev.mu.
+func (dev *Dev) restore() error {
+	_, err := dev.writePaced(dev.rc.screen.restore())
+	return err
+}
+
+func (rc *reconnector) send(e ConnEvent) {
+	select {
+	case rc.events <- e:
+	default:
+	}
+}
+
+// commandArgs is the number of argument bytes of the commands that have
+// arguments.
+var commandArgs = map[byte]int{
+	0x42: 1, // display on
+	0x47: 2, // set cursor position
+	0x4e: 9, // define custom character
+	0x50: 1, // set contrast
+	0x56: 1, // GPO off
+	0x57: 1, // GPO on
+	0x59: 1, // set VFD brightness
+	0x99: 1, // set brightness
+	0xd0: 3, // set RGB backlight
+	0xd1: 2, // set size
+}
+
+// shadow follows the bytes written to a display, to keep a copy of the
+// text displayed and of the last command of each setting.
+type shadow struct {
+	rows, cols int
+	screen     [][]byte
+	row, col   int
+	// settings is the last command written for each setting, by key, and
+	// keys the settings in the order they were first written.
+	settings map[string][]byte
+	keys     []string
+	// cmd is a command whose arguments haven't all been written.
+	cmd []byte
+}
+
+func newShadow(rows, cols int) *shadow {
+	s := &shadow{settings: map[string][]byte{}}
+	s.resize(rows, cols)
+	return s
+}
+
+func (s *shadow) resize(rows, cols int) {
+	s.rows, s.cols = rows, cols
+	s.screen = make([][]byte, rows)
+	for ix := range s.screen {
+		s.screen[ix] = make([]byte, cols)
+	}
+	s.clear()
+}
+
+func (s *shadow) clear() {
+	for _, l := range s.screen {
+		for ix := range l {
+			l[ix] = ' '
+		}
+	}
+	s.row, s.col = 0, 0
+}
+
+// write follows p, written to the display.
+func (s *shadow) write(p []byte) {
+	for _, b := range p {
+		switch {
+		case len(s.cmd) == 0 && b != cmdByte:
+			s.char(b)
+		case len(s.cmd) == 0:
+			s.cmd = append(s.cmd, b)
+		default:
+			s.cmd = append(s.cmd, b)
+			if len(s.cmd) == 2+commandArgs[s.cmd[1]] {
+				s.command(s.cmd)
+				s.cmd = nil
+			}
+		}
+	}
+}
+
+// char follows a character written at the cursor position. The cursor wraps
+// to the next row, and from the last row to the first.
+func (s *shadow) char(b byte) {
+	s.screen[s.row][s.col] = b
+	s.forward()
+}
+
+func (s *shadow) forward() {
+	if s.col++; s.col == s.cols {
+		s.col = 0
+		s.row = (s.row + 1) % s.rows
+	}
+}
+
+// command follows a command.
+func (s *shadow) command(cmd []byte) {
+	switch cmd[1] {
+	case clearScreen[1]:
+		s.clear()
+	case goHome[1]:
+		s.row, s.col = 0, 0
+	case setCursorPosition[1]:
+		s.col = min(max(int(cmd[2]), 1), s.cols) - 1
+		s.row = min(max(int(cmd[3]), 1), s.rows) - 1
+	case cursorForward[1]:
+		s.forward()
+	case cursorBack[1]:
+		if s.col > 0 {
+			s.col--
+		}
+	case setSize[1]:
+		if cmd[2] != 0 && cmd[3] != 0 {
+			s.resize(int(cmd[3]), int(cmd[2]))
+		}
+		s.set("size", cmd)
+	case autoScrollOn[1], autoScrollOff[1]:
+		s.set("autoscroll", cmd)
+	case displayOn[1], displayOff[1]:
+		s.set("display", cmd)
+	case blockCursorOn[1], blockCursorOff[1]:
+		s.set("block", cmd)
+	case underlineCursorOn[1], underlineCursorOff[1]:
+		s.set("underline", cmd)
+	case setContrast[1]:
+		s.set("contrast", cmd)
+	case setBrightness[1], setVFDBrightness[1]:
+		s.set("brightness", cmd)
+	case setRGBBacklight[1]:
+		s.set("rgb", cmd)
+	case keypadBacklightOff[1]:
+		s.set("keypad", cmd)
+	case setGPOOn[1], setGPOOff[1]:
+		s.set(fmt.Sprintf("gpo%d", cmd[2]), cmd)
+	case defineCustomChar[1]:
+		s.set(fmt.Sprintf("char%d", cmd[2]), cmd)
+	}
+}
+
+func (s *shadow) set(key string, cmd []byte) {
+	if _, ok := s.settings[key]; !ok {
+		s.keys = append(s.keys, key)
+	}
+	s.settings[key] = append([]byte(nil), cmd...)
+}
+
+// restore returns the bytes to write to restore the display. The size is
+// set first, and the settings that affect the text written are set last.
+func (s *shadow) restore() []byte {
+	var b []byte
+	b = append(b, s.settings["size"]...)
+	for _, key := range s.keys {
+		switch key {
+		case "size", "autoscroll", "display":
+		default:
+			b = append(b, s.settings[key]...)
+		}
+	}
+	// The text is written without scrolling.
+	b = append(b, autoScrollOff...)
+	b = append(b, clearScreen...)
+	for ix, l := range s.screen {
+		b = append(b, setCursorPosition[0], setCursorPosition[1], 1, byte(ix+1))
+		b = append(b, l...)
+	}
+	b = append(b, setCursorPosition[0], setCursorPosition[1], byte(s.col+1), byte(s.row+1))
+	b = append(b, s.settings["autoscroll"]...)
+	b = append(b, s.settings["display"]...)
+	return b
+}
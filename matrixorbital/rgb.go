@@ -0,0 +1,51 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package matrixorbital
+
+import (
+	"fmt"
+	"io"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/display"
+)
+
+// RGBDev is a display with an RGB backlight, like the Adafruit USB/Serial
+// backpack.
+//
+// Implements periph.io/x/conn/v3/display.DisplayRGBBacklight, in addition to
+// the interfaces implemented by Dev.
+type RGBDev struct {
+	*Dev
+}
+
+// NewConnRGB creates a display with an RGB backlight using a
+// periph.io/conn/Conn. The model must support CapRGBBacklight.
+func NewConnRGB(conn conn.Conn, model Model, rows, cols int) (*RGBDev, error) {
+	return newRGBDev(newDev(conn, nil, model, rows, cols))
+}
+
+// NewWriterRGB creates a display with an RGB backlight using an io.Writer.
+// The model must support CapRGBBacklight.
+func NewWriterRGB(writer io.Writer, model Model, rows, cols int) (*RGBDev, error) {
+	return newRGBDev(newDev(nil, writer, model, rows, cols))
+}
+
+func newRGBDev(dev *Dev) (*RGBDev, error) {
+	if err := dev.notImplemented(CapRGBBacklight); err != nil {
+		return nil, fmt.Errorf("%w: no RGB backlight", err)
+	}
+	return &RGBDev{Dev: dev}, nil
+}
+
+// RGBBacklight sets the backlight color. Provides
+// periph.io/x/conn/v3/display.DisplayRGBBacklight
+func (dev *RGBDev) RGBBacklight(red, green, blue display.Intensity) error {
+	_, err := dev.Write([]byte{setRGBBacklight[0], setRGBBacklight[1],
+		intensityByte(red), intensityByte(green), intensityByte(blue)})
+	return err
+}
+
+var _ display.DisplayRGBBacklight = &RGBDev{}
@@ -0,0 +1,40 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31855_test
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/spi/spireg"
+	"periph.io/x/devices/v3/max31855"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+	p, err := spireg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer p.Close()
+	d, err := max31855.NewSPI(p, max31855.MAX31855)
+	if err != nil {
+		log.Fatal(err)
+	}
+	r, err := d.Read()
+	var f max31855.Fault
+	if errors.As(err, &f) {
+		fmt.Printf("fault: %v, junction %s\n", f, r.Junction)
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("probe %s, junction %s\n", r.Probe, r.Junction)
+}
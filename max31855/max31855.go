@@ -0,0 +1,257 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package max31855 controls the MAX31855 and MAX6675 thermocouple to
+// digital converters, for type K thermocouples, with the SPI interface.
+//
+// The MAX31855 measures from -270°C to 1800°C with a resolution of 0.25°C,
+// and the temperature of its cold junction, the temperature of the chip.
+// It detects an open thermocouple, and one shorted to ground or to VCC. Its
+// conversion assumes a linear thermocouple, so the error increases away from
+// 0°C to a few degrees.
+//
+// The MAX6675 measures from 0°C to 1024°C with a resolution of 0.25°C, and
+// only detects an open thermocouple.
+//
+// Both convert continuously, every 100ms for the MAX31855 and 220ms for the
+// MAX6675. Reading them during a conversion aborts it, so reading faster
+// returns the same temperature.
+//
+// # Wiring
+//
+// The chips only output data: connect SO to MISO, SCK to SCLK and CS to a
+// chip select. The thermocouple must be floating, not grounded, for the
+// short detection of the MAX31855.
+//
+// # Datasheets
+//
+// https://www.analog.com/media/en/technical-documentation/data-sheets/MAX31855.pdf
+//
+// https://www.analog.com/media/en/technical-documentation/data-sheets/MAX6675.pdf
+package max31855
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// Variant is a model of converter.
+type Variant int
+
+const (
+	MAX31855 Variant = iota
+	MAX6675
+)
+
+func (v Variant) String() string {
+	switch v {
+	case MAX31855:
+		return "MAX31855"
+	case MAX6675:
+		return "MAX6675"
+	default:
+		return fmt.Sprintf("Variant(%d)", int(v))
+	}
+}
+
+// Fault is a set of faults of the thermocouple. It's returned as the error
+// of Read() and Sense() when not zero.
+type Fault uint8
+
+const (
+	// Open is an open thermocouple, or one not connected.
+	Open Fault = 1 << iota
+	// ShortGND is a thermocouple shorted to ground. MAX31855 only.
+	ShortGND
+	// ShortVCC is a thermocouple shorted to VCC. MAX31855 only.
+	ShortVCC
+)
+
+func (f Fault) Error() string {
+	var s []string
+	if f&Open != 0 {
+		s = append(s, "open thermocouple")
+	}
+	if f&ShortGND != 0 {
+		s = append(s, "thermocouple shorted to GND")
+	}
+	if f&ShortVCC != 0 {
+		s = append(s, "thermocouple shorted to VCC")
+	}
+	return "max31855: " + strings.Join(s, ", ")
+}
+
+// Reading is the result of a conversion.
+type Reading struct {
+	// Probe is the temperature of the thermocouple.
+	Probe physic.Temperature
+	// Junction is the temperature of the cold junction, the chip. It's
+	// always 0 for the MAX6675.
+	Junction physic.Temperature
+}
+
+// Dev is a handle to a converter.
+type Dev struct {
+	c       spi.Conn
+	variant Variant
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewSPI returns a handle to a converter connected to p.
+func NewSPI(p spi.Port, v Variant) (*Dev, error) {
+	if v != MAX31855 && v != MAX6675 {
+		return nil, fmt.Errorf("max31855: invalid variant %d", v)
+	}
+	// The MAX6675 supports up to 4.3MHz, the MAX31855 up to 5MHz.
+	c, err := p.Connect(4*physic.MegaHertz, spi.Mode0, 8)
+	if err != nil {
+		return nil, fmt.Errorf("max31855: %w", err)
+	}
+	return &Dev{c: c, variant: v}, nil
+}
+
+// Read returns the temperatures of the last conversion. If the thermocouple
+// is faulty, the error is a Fault, and the junction temperature of a
+// MAX31855 is still valid.
+func (d *Dev) Read() (Reading, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.variant == MAX6675 {
+		var r [2]byte
+		if err := d.c.Tx(make([]byte, len(r)), r[:]); err != nil {
+			return Reading{}, fmt.Errorf("max31855: %w", err)
+		}
+		return decode6675(uint16(r[0])<<8 | uint16(r[1]))
+	}
+	var r [4]byte
+	if err := d.c.Tx(make([]byte, len(r)), r[:]); err != nil {
+		return Reading{}, fmt.Errorf("max31855: %w", err)
+	}
+	return decode31855(uint32(r[0])<<24 | uint32(r[1])<<16 | uint32(r[2])<<8 | uint32(r[3]))
+}
+
+// Sense implements physic.SenseEnv. It returns the probe temperature.
+func (d *Dev) Sense(e *physic.Env) error {
+	r, err := d.Read()
+	if err != nil {
+		return err
+	}
+	e.Temperature = r.Probe
+	e.Pressure = 0
+	e.Humidity = 0
+	return nil
+}
+
+// SenseContinuous implements physic.SenseEnv. The readings with a fault are
+// skipped.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if interval < d.conversion() {
+		return nil, fmt.Errorf("max31855: interval %s shorter than the conversion time %s", interval, d.conversion())
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return nil, errors.New("max31855: SenseContinuous already running")
+	}
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
+	ch := make(chan physic.Env, 16)
+	go d.run(interval, ch, d.stop, d.done)
+	return ch, nil
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(e *physic.Env) {
+	e.Temperature = 250 * physic.MilliKelvin
+	e.Pressure = 0
+	e.Humidity = 0
+}
+
+// Halt implements conn.Resource. It stops SenseContinuous().
+func (d *Dev) Halt() error {
+	d.mu.Lock()
+	stop, done := d.stop, d.done
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("%s{%s}", d.variant, d.c)
+}
+
+func (d *Dev) conversion() time.Duration {
+	if d.variant == MAX6675 {
+		return 220 * time.Millisecond
+	}
+	return 100 * time.Millisecond
+}
+
+func (d *Dev) run(interval time.Duration, ch chan<- physic.Env, stop, done chan struct{}) {
+	defer close(done)
+	defer close(ch)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+			var e physic.Env
+			if err := d.Sense(&e); err != nil {
+				continue
+			}
+			select {
+			case ch <- e:
+			case <-stop:
+				return
+			}
+		}
+	}
+}
+
+// decode31855 decodes the 32 bits read from a MAX31855.
+func decode31855(v uint32) (Reading, error) {
+	// The probe temperature is 14 bits signed in 0.25°C, the junction
+	// temperature 12 bits signed in 0.0625°C.
+	probe := int64(int32(v) >> 18)
+	junction := int64(int32(v<<16) >> 20)
+	r := Reading{
+		Probe:    physic.ZeroCelsius + physic.Temperature(probe*250)*physic.MilliKelvin,
+		Junction: physic.ZeroCelsius + physic.Temperature(junction*62500)*physic.MicroKelvin,
+	}
+	if v&0x10000 != 0 {
+		f := Fault(v & 7)
+		if f == 0 {
+			f = Open
+		}
+		return Reading{Junction: r.Junction}, f
+	}
+	return r, nil
+}
+
+// decode6675 decodes the 16 bits read from a MAX6675.
+func decode6675(v uint16) (Reading, error) {
+	if v&4 != 0 {
+		return Reading{}, Open
+	}
+	return Reading{Probe: physic.ZeroCelsius + physic.Temperature(int64(v>>3)*250)*physic.MilliKelvin}, nil
+}
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
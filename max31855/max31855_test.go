@@ -0,0 +1,124 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package max31855
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func celsius(milli int64) physic.Temperature {
+	return physic.ZeroCelsius + physic.Temperature(milli)*physic.MilliKelvin
+}
+
+func TestDecode31855(t *testing.T) {
+	data := []struct {
+		v    uint32
+		want Reading
+		err  error
+	}{
+		{0x64001900, Reading{Probe: celsius(1600000), Junction: celsius(25000)}, nil},
+		{0xF060C900, Reading{Probe: celsius(-250000), Junction: celsius(-55000)}, nil},
+		{0x0004FFF0, Reading{Probe: celsius(250), Junction: physic.ZeroCelsius - 62500*physic.MicroKelvin}, nil},
+		{0x00011901, Reading{Junction: celsius(25000)}, Open},
+		{0x00011906, Reading{Junction: celsius(25000)}, ShortGND | ShortVCC},
+	}
+	for _, line := range data {
+		got, err := decode31855(line.v)
+		if got != line.want || err != line.err {
+			t.Errorf("%#08x: got %v, %v; want %v, %v", line.v, got, err, line.want, line.err)
+		}
+	}
+}
+
+func TestDecode6675(t *testing.T) {
+	if r, err := decode6675(0x0C80); err != nil || r.Probe != celsius(100000) {
+		t.Fatal(r, err)
+	}
+	if _, err := decode6675(0x0C84); err != Open {
+		t.Fatal(err)
+	}
+}
+
+func TestFault_Error(t *testing.T) {
+	if s := (Open | ShortVCC).Error(); s != "max31855: open thermocouple, thermocouple shorted to VCC" {
+		t.Fatal(s)
+	}
+}
+
+func TestRead(t *testing.T) {
+	s := spitest.Playback{
+		Playback: conntest.Playback{
+			Ops: []conntest.IO{
+				{W: []byte{0, 0, 0, 0}, R: []byte{0x64, 0x00, 0x19, 0x00}},
+				{W: []byte{0, 0, 0, 0}, R: []byte{0x00, 0x01, 0x19, 0x02}},
+			},
+		},
+	}
+	d, err := NewSPI(&s, MAX31855)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e physic.Env
+	if err := d.Sense(&e); err != nil || e.Temperature != celsius(1600000) {
+		t.Fatal(e, err)
+	}
+	r, err := d.Read()
+	var f Fault
+	if !errors.As(err, &f) || f != ShortGND || r.Junction != celsius(25000) {
+		t.Fatal(r, err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSenseContinuous(t *testing.T) {
+	s := spitest.Playback{
+		Playback: conntest.Playback{
+			Ops: []conntest.IO{
+				{W: []byte{0, 0}, R: []byte{0x0C, 0x80}},
+				{W: []byte{0, 0}, R: []byte{0x0C, 0x84}},
+				{W: []byte{0, 0}, R: []byte{0x0C, 0x88}},
+			},
+			DontPanic: true,
+		},
+	}
+	d, err := NewSPI(&s, MAX6675)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(100 * time.Millisecond); err == nil {
+		t.Fatal("expected error")
+	}
+	ch, err := d.SenseContinuous(220 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := <-ch; e.Temperature != celsius(100000) {
+		t.Fatal(e)
+	}
+	// The open thermocouple is skipped.
+	if e := <-ch; e.Temperature != celsius(100250) {
+		t.Fatal(e)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-ch; ok {
+		t.Fatal("channel not closed")
+	}
+}
+
+func TestNewSPI_Invalid(t *testing.T) {
+	if _, err := NewSPI(&spitest.Playback{}, Variant(2)); err == nil {
+		t.Fatal("expected error")
+	}
+}
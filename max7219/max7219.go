@@ -11,13 +11,24 @@ package max7219
 import (
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"time"
 
 	"periph.io/x/conn/v3/physic"
 	"periph.io/x/conn/v3/spi"
+	"periph.io/x/devices/v3/internal/logging"
 )
 
+// logger receives the errors of the initialization of the display, which
+// NewSPI() doesn't return.
+var logger logging.Logger
+
+// SetLogger sets the logger of the package. The records are discarded by
+// default.
+func SetLogger(l *slog.Logger) {
+	logger.Set(l)
+}
+
 // DecodeMode is the mode for handling data. Refer to the datasheet for
 // more information.
 type DecodeMode byte
@@ -89,7 +100,7 @@ func (d *Dev) init() {
 	for _, cmd := range initCommands {
 		err := d.sendCommand(cmd[0], cmd[1])
 		if err != nil {
-			log.Println(err)
+			logger.Get().Error("max7219: initialization failed", "err", err)
 			break
 		}
 	}
@@ -136,6 +147,20 @@ func NewSPI(p spi.Port, units, numDigits int) (*Dev, error) {
 	return d, nil
 }
 
+// Halt implements conn.Resource. It clears the display, and puts the units
+// in the shutdown mode. The display stays off until NewSPI() is called
+// again.
+func (d *Dev) Halt() error {
+	if err := d.Clear(); err != nil {
+		return err
+	}
+	return d.sendCommand(_REGISTER_SHUTDOWN, 0x00)
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("MAX7219{%s, %d units}", d.conn, d.units)
+}
+
 // Clear erases the content of all display segments or matrix LEDs.
 func (d *Dev) Clear() error {
 	empty := d.emptyBytes()
@@ -194,7 +219,7 @@ func (d *Dev) ScrollChars(data []byte, scrollCount int, updateInterval time.Dura
 		bytes := make([][]byte, len(data))
 		for ix, val := range data {
 			newVals := make([]byte, 8)
-			copy(newVals, d.glyphs[val])
+			copy(newVals, d.glyphSet()[val])
 			bytes[ix] = newVals
 		}
 		_ = d.WriteCascadedUnits(bytes)
@@ -246,6 +271,15 @@ func (d *Dev) SetGlyphs(glyphs [][]byte, reverse bool) {
 	}
 }
 
+// glyphSet returns the glyphs supplied to SetGlyphs(). If none were
+// supplied, CP437Glyphs is used, reversed for the common 8x8 matrix modules.
+func (d *Dev) glyphSet() [][]byte {
+	if d.glyphs == nil {
+		d.glyphs = reverseGlyphs(CP437Glyphs)
+	}
+	return d.glyphs
+}
+
 // SetDecode tells the Max7219 whether values should be decoded for a 7 segment
 // display, or if they should be interpreted literally. Refer to the datasheet
 // for more detailed information.
@@ -278,12 +312,12 @@ func (d *Dev) writeChars(bytes []byte) error {
 	w := make([][]byte, d.units)
 	for ix := range d.units {
 		x := make([]byte, 8)
-		copy(x, d.glyphs[0x20])
+		copy(x, d.glyphSet()[0x20])
 		w[ix] = x
 	}
 	charPos := len(bytes) - 1
 	for ix := d.units - 1; ix >= 0 && charPos >= 0; ix-- {
-		w[ix] = d.glyphs[bytes[charPos]]
+		w[ix] = d.glyphSet()[bytes[charPos]]
 		charPos = charPos - 1
 	}
 	return d.WriteCascadedUnits(w)
@@ -381,6 +415,19 @@ func (d *Dev) Write(bytes []byte) error {
 	return nil
 }
 
+// WriteString displays s, right aligned. On a matrix, each character is a
+// glyph, using the CP437 font if SetGlyphs() wasn't called. On a numeric
+// display, the characters are converted as for Write().
+func (d *Dev) WriteString(s string) error {
+	return d.Write([]byte(s))
+}
+
+// ScrollString scrolls s from right to left scrollCount times, as for
+// ScrollChars().
+func (d *Dev) ScrollString(s string, scrollCount int, updateInterval time.Duration) {
+	d.ScrollChars([]byte(s), scrollCount, updateInterval)
+}
+
 // WriteInt provide a convenience method that displays the specified integer
 // value on the display. It will work for either matrixes (with a glyph set)
 // or numeric displays.
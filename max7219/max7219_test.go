@@ -232,3 +232,39 @@ func TestCommand(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestWriteStringMatrix(t *testing.T) {
+	record := &spitest.Record{}
+	dev, err := NewSPI(record, 2, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record.Ops = make([]conntest.IO, 0)
+	// No glyphs were set, so the CP437 font is used.
+	if err = dev.WriteString("H"); err != nil {
+		t.Fatal(err)
+	}
+	glyph := reverseGlyphs(CP437Glyphs)['H']
+	expected := make([]conntest.IO, 8)
+	for line := range 8 {
+		expected[line] = conntest.IO{W: []uint8{byte(line + 1), glyph[7-line], byte(line + 1), 0}}
+	}
+	if err = verifyOperations(record.Ops, expected); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReverseGlyphsAll(t *testing.T) {
+	for v := range 256 {
+		r := reverseGlyphs([][]byte{{byte(v)}})[0][0]
+		var want byte
+		for bit := range 8 {
+			if v&(1<<bit) != 0 {
+				want |= 0x80 >> bit
+			}
+		}
+		if r != want {
+			t.Errorf("reverse of 0x%02x is 0x%02x, expected 0x%02x", v, r, want)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// Pattern is the sequence of durations that a status LED is on and off for,
+// starting with on. It must have an even number of positive durations, and
+// repeats until the Blinker is stopped.
+type Pattern []time.Duration
+
+// Heartbeat is a double flash followed by a pause, repeated every second.
+var Heartbeat = Pattern{100 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond, 700 * time.Millisecond}
+
+// Blink returns a Pattern that is on for on, and off for off.
+func Blink(on, off time.Duration) Pattern {
+	return Pattern{on, off}
+}
+
+// ErrorCode returns a Pattern of n short flashes followed by a pause, which
+// is used to show an error code that can be counted.
+func ErrorCode(n int) Pattern {
+	p := make(Pattern, 0, 2*n)
+	for range n {
+		p = append(p, 200*time.Millisecond, 300*time.Millisecond)
+	}
+	if n > 0 {
+		p[len(p)-1] = 1500 * time.Millisecond
+	}
+	return p
+}
+
+// validate returns an error if the pattern can't be run.
+func (p Pattern) validate() error {
+	if len(p) == 0 || len(p)%2 != 0 {
+		return errors.New("MCP23xxx: pattern must have an even number of durations")
+	}
+	for _, d := range p {
+		if d <= 0 {
+			return errors.New("MCP23xxx: pattern durations must be positive")
+		}
+	}
+	return nil
+}
+
+// Blinker runs a Pattern on an output pin in a goroutine, for status LEDs
+// that share an expander with other devices, such as a character display.
+// The pin can be any gpio.PinOut, not only a pin of a Dev.
+type Blinker struct {
+	pin       gpio.PinOut
+	activeLow bool
+
+	// mu serializes Start() and Stop(), and is held while the goroutine
+	// exits, so that only one pattern runs at a time.
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+	// errMu guards err, which is set by the goroutine.
+	errMu sync.Mutex
+	err   error
+}
+
+// NewBlinker returns a Blinker for the LED connected to pin. If activeLow is
+// true, the LED is on when the pin is low, which is the case for an LED
+// connected between the supply and an open-drain output. The LED isn't
+// changed until Start() is called.
+func NewBlinker(pin gpio.PinOut, activeLow bool) *Blinker {
+	return &Blinker{pin: pin, activeLow: activeLow}
+}
+
+// Start runs p on the LED until Stop() is called. If a pattern is already
+// running, it's replaced.
+func (b *Blinker) Start(p Pattern) error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.stopLocked()
+	b.errMu.Lock()
+	b.err = nil
+	b.errMu.Unlock()
+	b.stop = make(chan struct{})
+	b.done = make(chan struct{})
+	go b.run(append(Pattern(nil), p...), b.stop, b.done)
+	return nil
+}
+
+// Stop stops the running pattern, waits for the goroutine to exit, and turns
+// the LED off.
+func (b *Blinker) Stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.stopLocked()
+}
+
+// stopLocked stops the running pattern. b.mu must be held.
+func (b *Blinker) stopLocked() {
+	if b.stop == nil {
+		return
+	}
+	close(b.stop)
+	<-b.done
+	b.stop, b.done = nil, nil
+}
+
+// Halt implements conn.Resource. It stops the running pattern, which turns
+// the LED off.
+func (b *Blinker) Halt() error {
+	b.Stop()
+	return nil
+}
+
+func (b *Blinker) String() string {
+	return fmt.Sprintf("Blinker{%s}", b.pin)
+}
+
+// Err returns the last error returned by the pin while the pattern ran, if
+// any. Errors don't stop the pattern.
+func (b *Blinker) Err() error {
+	b.errMu.Lock()
+	defer b.errMu.Unlock()
+	return b.err
+}
+
+func (b *Blinker) run(p Pattern, stop, done chan struct{}) {
+	defer close(done)
+	defer b.set(false)
+	for ix := 0; ; ix = (ix + 1) % len(p) {
+		b.set(ix%2 == 0)
+		select {
+		case <-stop:
+			return
+		case <-time.After(p[ix]):
+		}
+	}
+}
+
+// set turns the LED on or off.
+func (b *Blinker) set(on bool) {
+	if err := b.pin.Out(gpio.Level(on != b.activeLow)); err != nil {
+		b.errMu.Lock()
+		b.err = err
+		b.errMu.Unlock()
+	}
+}
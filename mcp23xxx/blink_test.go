@@ -0,0 +1,87 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+)
+
+// ledPin records the levels written to it.
+type ledPin struct {
+	gpiotest.Pin
+	mu     sync.Mutex
+	levels []gpio.Level
+}
+
+func (lp *ledPin) Out(l gpio.Level) error {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	lp.levels = append(lp.levels, l)
+	return nil
+}
+
+func (lp *ledPin) written() []gpio.Level {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	return append([]gpio.Level(nil), lp.levels...)
+}
+
+func TestBlinker(t *testing.T) {
+	for _, p := range []Pattern{nil, {time.Millisecond}, {time.Millisecond, 0}, ErrorCode(0)} {
+		if err := NewBlinker(&ledPin{}, false).Start(p); err == nil {
+			t.Errorf("expected error for pattern %v", p)
+		}
+	}
+	if p := ErrorCode(2); len(p) != 4 || p[3] <= p[1] {
+		t.Errorf("unexpected error code pattern %v", p)
+	}
+
+	pin := &ledPin{}
+	b := NewBlinker(pin, true)
+	if err := b.Start(Blink(time.Millisecond, time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+	for len(pin.written()) < 4 {
+		time.Sleep(time.Millisecond)
+	}
+	b.Stop()
+	levels := pin.written()
+	// The LED is active low, so it starts low, and is left high.
+	if levels[0] != gpio.Low || levels[1] != gpio.High || levels[len(levels)-1] != gpio.High {
+		t.Errorf("unexpected levels %v", levels)
+	}
+	if err := b.Err(); err != nil {
+		t.Error(err)
+	}
+	b.Stop()
+}
+
+func TestBlinker_concurrentStart(t *testing.T) {
+	pin := &ledPin{}
+	b := NewBlinker(pin, false)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := b.Start(Blink(time.Millisecond, time.Millisecond)); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	b.Stop()
+	// No goroutine is left running a pattern.
+	n := len(pin.written())
+	time.Sleep(5 * time.Millisecond)
+	if levels := pin.written(); len(levels) != n || levels[n-1] != gpio.Low {
+		t.Errorf("unexpected levels after Stop %v", levels[n-1:])
+	}
+}
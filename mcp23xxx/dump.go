@@ -0,0 +1,150 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"fmt"
+)
+
+// ChipRegister is the datasheet name of a register of the device, for
+// example "IODIR" for the MCP23008, "IODIRA" for the MCP23017, or "IODIR0"
+// for the MCP23016.
+type ChipRegister string
+
+// DumpRegisters reads the configuration and output latch registers of the
+// device. The GPIO, interrupt flag and interrupt capture registers aren't
+// read, since reading them clears a pending interrupt. IOCON is shared by
+// the ports of the 16 bit variants, and is returned once as "IOCONA".
+//
+// The driver's cached copies of the registers are updated, as for Refresh().
+func (dev *Dev) DumpRegisters() (map[ChipRegister]byte, error) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	result := make(map[ChipRegister]byte)
+	for ix := range dev.ports {
+		for _, rc := range dev.dumpRegisters(ix) {
+			v, err := rc.readValue(false)
+			if err != nil {
+				return nil, err
+			}
+			result[ChipRegister(rc.name)] = v
+		}
+	}
+	return result, nil
+}
+
+// RestoreRegisters writes the registers in regs, which is typically the
+// result of DumpRegisters(). Registers not in regs are left unchanged.
+//
+// For each port, IOCON is written first, and the output latch is written
+// before the direction, so that pins that become outputs drive the restored
+// level. Interrupt on change is enabled last. IOCON.BANK is not changed, use
+// SetBank() instead.
+//
+// For the 8 bit variants, if regs contains all the registers from IODIR to
+// GPPU, which have consecutive addresses, they are written in one
+// transaction after OLAT, so that the configuration is applied atomically.
+// IOCON.SEQOP is cleared first if needed. In this case GPINTEN is written
+// before DEFVAL and INTCON, which may flag an interrupt if the comparison
+// changes. Write verification disables this.
+func (dev *Dev) RestoreRegisters(regs map[ChipRegister]byte) error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	return dev.restoreRegisters(regs)
+}
+
+// restoreRegisters implements RestoreRegisters(). dev.mu must be held.
+func (dev *Dev) restoreRegisters(regs map[ChipRegister]byte) error {
+	known := make(map[ChipRegister]bool)
+	for ix := range dev.ports {
+		for _, rc := range dev.dumpRegisters(ix) {
+			known[ChipRegister(rc.name)] = true
+		}
+	}
+	for reg := range regs {
+		if !known[reg] {
+			return fmt.Errorf("%s: unknown register %q", dev, reg)
+		}
+	}
+	if done, err := dev.restoreConfig(regs); done || err != nil {
+		return err
+	}
+	for ix := range dev.ports {
+		p := &dev.ports[ix]
+		order := []*registerCache{&p.iocon, &p.olat, &p.ipol, &p.gppu, &p.defval, &p.intcon, &p.iodir, &p.gpinten}
+		for _, rc := range order {
+			v, ok := regs[ChipRegister(rc.name)]
+			if !ok {
+				continue
+			}
+			if rc == &p.iocon {
+				v = v&^(1<<ioconBANK) | boolBits(dev.bank1, 1<<ioconBANK)
+			}
+			if err := rc.writeValue(v, false); err != nil {
+				return err
+			}
+			if rc == &dev.ports[0].iocon {
+				for i := 1; i < len(dev.ports); i++ {
+					dev.ports[i].iocon.setCache(v)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// restoreConfig writes the registers of an 8 bit variant in regs, using one
+// transaction for IODIR to GPPU, and returns true. It returns false without
+// writing anything if regs doesn't contain all of them. dev.mu must be held.
+func (dev *Dev) restoreConfig(regs map[ChipRegister]byte) (bool, error) {
+	if len(dev.ports) != 1 || dev.verify {
+		return false, nil
+	}
+	p := &dev.ports[0]
+	if !p.supportIOCON || !p.supportInterrupt || !p.supportPullup {
+		return false, nil
+	}
+	seq := []*registerCache{&p.iodir, &p.ipol, &p.gpinten, &p.defval, &p.intcon, &p.iocon, &p.gppu}
+	values := make([]uint8, len(seq))
+	for ix, rc := range seq {
+		v, ok := regs[ChipRegister(rc.name)]
+		if !ok || rc.address != p.iodir.address+uint8(ix) {
+			return false, nil
+		}
+		values[ix] = v
+	}
+	values[5] = values[5]&^(1<<ioconBANK) | boolBits(dev.bank1, 1<<ioconBANK)
+	if v, ok := regs[ChipRegister(p.olat.name)]; ok {
+		if err := p.olat.writeValue(v, false); err != nil {
+			return true, err
+		}
+	}
+	// The address is only incremented with IOCON.SEQOP clear.
+	if err := dev.setIOCONBits(1<<ioconSEQOP, 0); err != nil {
+		return true, err
+	}
+	if err := p.iodir.writeRegisters(p.iodir.address, values...); err != nil {
+		return true, p.iodir.wrap("write", err)
+	}
+	for ix, rc := range seq {
+		rc.setCache(values[ix])
+	}
+	return true, nil
+}
+
+// dumpRegisters returns the registers of port returned by DumpRegisters().
+// dev.mu must be held.
+func (dev *Dev) dumpRegisters(port int) []*registerCache {
+	p := &dev.ports[port]
+	var regs []*registerCache
+	for _, rc := range p.cachedRegisters() {
+		// IOCON is shared by the ports.
+		if rc == &p.iocon && port > 0 {
+			continue
+		}
+		regs = append(regs, rc)
+	}
+	return regs
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestMCP23008_dumpRestore(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			// the configuration registers are dumped
+			{Addr: address, W: []byte{0x00}, R: []byte{0xF0}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0x01}},
+			{Addr: address, W: []byte{0x06}, R: []byte{0x30}},
+			{Addr: address, W: []byte{0x02}, R: []byte{0x10}},
+			{Addr: address, W: []byte{0x03}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x04}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x05}, R: []byte{0x04}},
+			{Addr: address, W: []byte{0x0A}, R: []byte{0x05}},
+			// after a reset, they're restored with the latch before the
+			// direction, and IODIR to GPPU in one transaction
+			{Addr: address, W: []byte{0x0A, 0x05}, R: nil},
+			{Addr: address, W: []byte{0x00, 0xF0, 0x01, 0x10, 0x00, 0x00, 0x04, 0x30}, R: nil},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23008, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	regs, err := dev.DumpRegisters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regs) != 8 || regs["IODIR"] != 0xF0 || regs["OLAT"] != 0x05 {
+		t.Errorf("unexpected registers %v", regs)
+	}
+	if err = dev.RestoreRegisters(map[ChipRegister]byte{"IODIRA": 0}); err == nil {
+		t.Error("expected error for unknown register")
+	}
+	if err = dev.RestoreRegisters(regs); err != nil {
+		t.Fatal(err)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23008_restoreSequential(t *testing.T) {
+	bus := &registerBus{}
+	bus.regs[regIODIR] = 0xff
+	// IOCON.SEQOP is set, so it's cleared before the sequential write.
+	bus.regs[regIOCON] = 1 << ioconSEQOP
+	dev, err := NewI2C(bus, MCP23008, 0x20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	regs, err := dev.DumpRegisters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	regs["IODIR"] = 0x0f
+	regs["GPPU"] = 0xf0
+	regs["OLAT"] = 0x03
+	if err = dev.RestoreRegisters(regs); err != nil {
+		t.Fatal(err)
+	}
+	if bus.regs[regIODIR] != 0x0f || bus.regs[regGPPU] != 0xf0 || bus.regs[regOLAT] != 0x03 || bus.regs[regIOCON] != 1<<ioconSEQOP {
+		t.Errorf("unexpected registers % x", bus.regs)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"fmt"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// Expander is the interface of an MCP23xxx device, independent of the
+// variant and of the bus it's connected to. The I2C and SPI variants are
+// created by NewI2C(), NewSPI() and NewSPIAddress(), which differ only in how
+// registers are accessed. Code that drives displays, keypads or switches
+// through an expander should accept an Expander, so that it works with
+// either bus.
+type Expander interface {
+	// Pin returns the pin number n, or nil if it's out of range.
+	Pin(n int) Pin
+	// Group returns a gpio.Group made up of pins of port.
+	Group(port int, pins []int) *gpio.Group
+	// Port returns a gpio.Group made up of all the pins of port.
+	Port(port int) *gpio.Group
+	// ReadPort returns the levels of the pins of port.
+	ReadPort(port int) (uint8, error)
+	// WritePort writes the output latch of port.
+	WritePort(port int, value uint8) error
+	// WritePortSequence writes each of values to the output latch of port,
+	// in one bus transaction where the variant supports it.
+	WritePortSequence(port int, values ...uint8) error
+	// SetDirectionMasked sets the direction of the pins selected by mask. A
+	// set bit in inputs configures the pin as an input.
+	SetDirectionMasked(mask, inputs uint16) error
+	// SetEdgePin supplies the host pin connected to the INT pin.
+	SetEdgePin(pin *gpio.PinIn)
+	// EnableInterrupt enables interrupt on change events for pin.
+	EnableInterrupt(pin int, mode ChangeMode) error
+	// DisableInterrupt disables interrupt on change for pin.
+	DisableInterrupt(pin int) error
+	// Events returns the channel of interrupt on change events.
+	Events() <-chan Event
+	// Close releases the device.
+	Close() error
+	fmt.Stringer
+}
+
+var _ Expander = &Dev{}
@@ -5,14 +5,19 @@
 package mcp23xxx
 
 import (
+	"errors"
 	"fmt"
+	"math/bits"
 	"time"
 
-	"periph.io/x/conn/v3"
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/pin"
 )
 
+// ErrPinNotInGroup is returned by the WaitForEdge() function of a group when
+// the pin that changed is not a member of the group.
+var ErrPinNotInGroup = errors.New("MCP23xxx: pin that changed is not in group")
+
 // The internal structure for a group of pins.
 type pinGroup struct {
 	dev         *Dev
@@ -21,10 +26,17 @@ type pinGroup struct {
 	defaultMask gpio.GPIOValue
 }
 
-// Group returns a gpio.Group that is made up of the specified pins.
+// Group returns a gpio.Group that is made up of the specified pins. If port or
+// any of the pins is out of range, nil is returned.
 func (dev *Dev) Group(port int, pins []int) *gpio.Group {
+	if port < 0 || port >= len(dev.Pins) || len(pins) == 0 {
+		return nil
+	}
 	grouppins := make([]*portpin, len(pins))
 	for ix, number := range pins {
+		if number < 0 || number >= len(dev.Pins[port]) {
+			return nil
+		}
 		pp, ok := dev.Pins[port][number].(*portpin)
 		if !ok {
 			return nil
@@ -40,6 +52,14 @@ func (dev *Dev) Group(port int, pins []int) *gpio.Group {
 	return nil
 }
 
+// Port returns a gpio.Group made up of all 8 pins of port, in order. The
+// value written by Out() is the value of the port, and is written to the
+// output latch in a single bus transaction. This makes it suitable for
+// driving parallel data buses. If port is out of range, nil is returned.
+func (dev *Dev) Port(port int) *gpio.Group {
+	return dev.Group(port, []int{0, 1, 2, 3, 4, 5, 6, 7})
+}
+
 // Pins returns the set of pin.Pin that make up that group.
 func (pg *pinGroup) Pins() []pin.Pin {
 	pins := make([]pin.Pin, len(pg.pins))
@@ -79,6 +99,8 @@ func (pg *pinGroup) ByNumber(number int) pin.Pin {
 // Out writes value to the specified pins of the device/port. If mask is 0,
 // the default mask of all pins in the group is used.
 func (pg *pinGroup) Out(value, mask gpio.GPIOValue) error {
+	pg.dev.mu.Lock()
+	defer pg.dev.mu.Unlock()
 	if mask == 0 {
 		mask = pg.defaultMask
 	} else {
@@ -130,6 +152,8 @@ func (pg *pinGroup) Out(value, mask gpio.GPIOValue) error {
 // pins in the group. If a pin specified by mask is not configured for
 // input, it is transparently re-configured.
 func (pg *pinGroup) Read(mask gpio.GPIOValue) (result gpio.GPIOValue, err error) {
+	pg.dev.mu.Lock()
+	defer pg.dev.mu.Unlock()
 	if mask == 0 {
 		mask = pg.defaultMask
 	} else {
@@ -187,20 +211,60 @@ func (pg *pinGroup) Read(mask gpio.GPIOValue) (result gpio.GPIOValue, err error)
 //
 // In the event that the changed pin is NOT part of the io group, the
 // triggering pin number will be returned, along with the error
-// ErrPinNotInGroup
+// ErrPinNotInGroup. If the timeout expires, -1 is returned with no error.
+// If no edge pin was supplied, gpio.ErrGroupFeatureNotImplemented is
+// returned. If the edge pin is used by Dev.EnableInterrupt() or
+// Keypad.Start(), an error wrapping ErrEdgePinBusy is returned.
 func (pg *pinGroup) WaitForEdge(timeout time.Duration) (number int, edge gpio.Edge, err error) {
-	return -1, gpio.NoEdge, gpio.ErrGroupFeatureNotImplemented
+	if !pg.pins[0].port.supportInterrupt {
+		return -1, gpio.NoEdge, gpio.ErrGroupFeatureNotImplemented
+	}
+	edgePin, err := pg.dev.acquireEdgePin(edgeUserWaitForEdge)
+	if err != nil {
+		return -1, gpio.NoEdge, err
+	}
+	if edgePin == nil {
+		return -1, gpio.NoEdge, gpio.ErrGroupFeatureNotImplemented
+	}
+	defer pg.dev.releaseEdgePin()
+	port := pg.pins[0].port
+	var deadline time.Time
+	if timeout >= 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	for {
+		var intf uint8
+		pg.dev.mu.Lock()
+		intf, _, err = port.pendingInterrupt()
+		pg.dev.mu.Unlock()
+		if err != nil {
+			return -1, gpio.NoEdge, err
+		}
+		if intf != 0 {
+			number = bits.TrailingZeros8(intf)
+			if pg.ByNumber(number) == nil {
+				err = ErrPinNotInGroup
+			}
+			return number, gpio.NoEdge, err
+		}
+		remaining := time.Duration(-1)
+		if timeout >= 0 {
+			if remaining = time.Until(deadline); remaining <= 0 {
+				return -1, gpio.NoEdge, nil
+			}
+		}
+		if !edgePin.WaitForEdge(remaining) {
+			return -1, gpio.NoEdge, nil
+		}
+	}
 }
 
-// Halt() interrupts a pending WaitForEdge() call if one is in process.
+// Halt() interrupts a pending WaitForEdge() call if one is in process, by
+// halting the host pin supplied to Dev.SetEdgePin().
 func (pg *pinGroup) Halt() error {
-	if pg.dev.edgePin != nil {
-		var ifpin interface{} = pg.dev.edgePin
-		if r, ok := ifpin.(conn.Resource); ok {
-			return r.Halt()
-		}
+	if edgePin := pg.dev.hostEdgePin(); edgePin != nil {
+		return edgePin.Halt()
 	}
-	// TODO: I think we want to call Dev.Halt()
 	return nil
 }
 
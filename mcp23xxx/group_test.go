@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
 	"periph.io/x/conn/v3/i2c/i2ctest"
 )
 
@@ -119,3 +120,79 @@ func TestReadWrite(t *testing.T) {
 		}
 	}
 }
+
+func TestPort(t *testing.T) {
+	const address uint16 = 0x20
+	bus := i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			// the port is set to output, and written in one transaction
+			{Addr: address, W: []byte{0x00, 0x00}, R: nil},
+			{Addr: address, W: []byte{0x0a}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x0a, 0xa5}, R: nil},
+			// pin 6 changed
+			{Addr: address, W: []byte{0x07}, R: []byte{0x40}},
+			{Addr: address, W: []byte{0x08}, R: []byte{0x40}},
+		},
+	}
+	extender, err := NewI2C(&bus, MCP23008, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if extender.Port(1) != nil {
+		t.Error("expected nil group for invalid port")
+	}
+	g := *extender.Port(0)
+	if len(g.Pins()) != 8 {
+		t.Errorf("expected 8 pins, got %d", len(g.Pins()))
+	}
+	if _, _, err := g.WaitForEdge(0); err != gpio.ErrGroupFeatureNotImplemented {
+		t.Errorf("expected ErrGroupFeatureNotImplemented, got %v", err)
+	}
+	if err := g.Out(0xa5, 0); err != nil {
+		t.Fatal(err)
+	}
+	var intPin gpio.PinIn = &gpiotest.Pin{N: "INT", EdgesChan: make(chan gpio.Level)}
+	extender.SetEdgePin(&intPin)
+	number, _, err := g.WaitForEdge(time.Second)
+	if err != nil || number != 6 {
+		t.Errorf("expected pin 6, got %d, %v", number, err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGroupHalt(t *testing.T) {
+	bus := &registerBus{}
+	bus.regs[regIODIR] = 0xff
+	dev, err := NewI2C(bus, MCP23008, 0x20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	ip := newIntPin()
+	var edgePin gpio.PinIn = ip
+	dev.SetEdgePin(&edgePin)
+	gr := *dev.Group(0, []int{0, 1})
+	result := make(chan int)
+	go func() {
+		number, _, err := gr.WaitForEdge(-1)
+		if err != nil {
+			t.Error(err)
+		}
+		result <- number
+	}()
+	if err = gr.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case number := <-result:
+		if number != -1 {
+			t.Errorf("expected -1 from halted WaitForEdge, received %d", number)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Halt() didn't interrupt WaitForEdge(-1)")
+	}
+}
@@ -0,0 +1,441 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// ChangeMode selects the changes of an input pin that are reported as an
+// Event.
+type ChangeMode int
+
+const (
+	// ChangeAny reports every change of the pin.
+	ChangeAny ChangeMode = iota
+	// ChangeRising reports changes from low to high.
+	ChangeRising
+	// ChangeFalling reports changes from high to low.
+	ChangeFalling
+)
+
+// Event is a change of an input pin reported by the device.
+type Event struct {
+	// Pin is the number of the pin that changed, as used by Dev.Pin().
+	Pin int
+	// Level is the level of the pin captured when the interrupt occurred.
+	Level gpio.Level
+	// Time is when the interrupt was read from the device.
+	Time time.Time
+}
+
+// interruptPollInterval is the longest time the interrupt goroutine waits for
+// an edge on the host pin before checking the interrupt flags. It recovers
+// from edges missed by the host.
+const interruptPollInterval = 100 * time.Millisecond
+
+// eventBufferSize is the capacity of the channel returned by Dev.Events().
+const eventBufferSize = 16
+
+// ErrEdgePinBusy is returned when the host pin supplied to Dev.SetEdgePin()
+// is already used by another of EnableInterrupt(), Keypad.Start(), or the
+// WaitForEdge() of a pin or group.
+var ErrEdgePinBusy = errors.New("MCP23xxx: edge pin in use")
+
+// edgeUser identifies the code that reads the interrupt registers when the
+// host edge pin signals an interrupt.
+type edgeUser string
+
+const (
+	edgeUserInterrupts  edgeUser = "EnableInterrupt()"
+	edgeUserKeypad      edgeUser = "Keypad"
+	edgeUserWaitForEdge edgeUser = "WaitForEdge()"
+	edgeUserPending     edgeUser = "PendingInterrupts()"
+)
+
+// claimEdgePin records that user waits on the host edge pin. Reading the
+// interrupt flags and capture registers clears the interrupt, so an
+// interrupt read by one user would be lost by the others. Users of the same
+// kind share the pin, and each claim must be released with
+// unclaimEdgePin(). intMu must be held.
+func (dev *Dev) claimEdgePin(user edgeUser) error {
+	if dev.edgeClaims > 0 && dev.edgeUser != user {
+		return fmt.Errorf("%s: %w by %s", dev, ErrEdgePinBusy, dev.edgeUser)
+	}
+	dev.edgeUser = user
+	dev.edgeClaims++
+	return nil
+}
+
+// unclaimEdgePin releases a claim made by claimEdgePin(). intMu must be
+// held.
+func (dev *Dev) unclaimEdgePin() {
+	dev.edgeClaims--
+}
+
+// acquireEdgePin claims the pin supplied to SetEdgePin() for user, and
+// returns it. If no pin was supplied, nil is returned. The pin must be
+// released with releaseEdgePin().
+func (dev *Dev) acquireEdgePin(user edgeUser) (gpio.PinIn, error) {
+	dev.intMu.Lock()
+	defer dev.intMu.Unlock()
+	if dev.edgePin == nil || *dev.edgePin == nil {
+		return nil, nil
+	}
+	if err := dev.claimEdgePin(user); err != nil {
+		return nil, err
+	}
+	return *dev.edgePin, nil
+}
+
+// releaseEdgePin releases the pin returned by acquireEdgePin().
+func (dev *Dev) releaseEdgePin() {
+	dev.intMu.Lock()
+	defer dev.intMu.Unlock()
+	dev.unclaimEdgePin()
+}
+
+// interrupts is the state of the goroutine that reads interrupts from the
+// device.
+type interrupts struct {
+	modes map[int]ChangeMode
+
+	// poll is the interval set by SetInterruptPolling().
+	poll time.Duration
+	// service is the InterruptService the device was added to, if any. It
+	// reads the interrupts instead of a goroutine of the device.
+	service *InterruptService
+	// dispatchMu is held by the InterruptService while it sends events, so
+	// that stopInterrupts() doesn't close events during a send.
+	dispatchMu sync.Mutex
+
+	events chan Event
+	stop   chan struct{}
+	done   chan struct{}
+	// closed is set by Close(), after which the goroutine can't be
+	// restarted, since events is closed.
+	closed bool
+}
+
+// EnableInterrupt configures pin as an input, and enables interrupt on change
+// for it. Changes selected by mode are sent to the channel returned by
+// Events().
+//
+// The INT pin of the device must be connected to a host GPIO pin configured
+// for falling edge detection, and supplied to SetEdgePin() before calling
+// EnableInterrupt(). The 16 bit variants have an INT pin for each port. Either
+// both must be connected to the edge pin using a wired-or, or SetMirror(true)
+// must be called.
+//
+// The first call starts a goroutine that waits for the edge pin, and reads
+// the interrupt flag and capture registers. Reading the capture register
+// clears the interrupt, so while the goroutine runs, the WaitForEdge() of
+// pins and groups, and Keypad.Start() fail, and if either is in use,
+// EnableInterrupt() returns an error wrapping ErrEdgePinBusy.
+//
+// If the INT pin isn't connected to the host, call SetInterruptPolling()
+// instead of SetEdgePin(). If the device was added to an InterruptService,
+// neither is needed, and no goroutine is started; the service reads the
+// interrupts while it runs.
+func (dev *Dev) EnableInterrupt(pin int, mode ChangeMode) error {
+	pp, err := dev.interruptPin(pin)
+	if err != nil {
+		return err
+	}
+	if mode < ChangeAny || mode > ChangeFalling {
+		return fmt.Errorf("%s: invalid change mode %d", dev, mode)
+	}
+	edgePin := dev.hostEdgePin()
+	dev.intMu.Lock()
+	poll := dev.interrupts.poll
+	serviced := dev.interrupts.service != nil
+	closed := dev.interrupts.closed
+	busy := dev.edgeClaims > 0 && dev.edgeUser != edgeUserInterrupts
+	user := dev.edgeUser
+	dev.intMu.Unlock()
+	if edgePin == nil && poll == 0 && !serviced {
+		return fmt.Errorf("%s: SetEdgePin() or SetInterruptPolling() must be called before EnableInterrupt()", dev)
+	}
+	if closed {
+		return fmt.Errorf("%s: EnableInterrupt() called after Close()", dev)
+	}
+	if busy {
+		return fmt.Errorf("%s: %w by %s", dev, ErrEdgePinBusy, user)
+	}
+	if err = dev.enableInterrupt(pp); err != nil {
+		return err
+	}
+	dev.intMu.Lock()
+	defer dev.intMu.Unlock()
+	if dev.interrupts.closed {
+		return fmt.Errorf("%s: EnableInterrupt() called after Close()", dev)
+	}
+	if dev.interrupts.service != nil {
+		dev.interrupts.modes[pin] = mode
+		return nil
+	}
+	if dev.interrupts.stop == nil {
+		if err = dev.claimEdgePin(edgeUserInterrupts); err != nil {
+			return err
+		}
+	}
+	dev.interrupts.modes[pin] = mode
+	if dev.interrupts.stop == nil {
+		dev.interrupts.stop = make(chan struct{})
+		dev.interrupts.done = make(chan struct{})
+		go dev.watchInterrupts(edgePin, poll, dev.interrupts.stop, dev.interrupts.done)
+	}
+	return nil
+}
+
+// SetInterruptPolling makes the goroutine started by EnableInterrupt() read
+// the interrupt flags every interval, rather than waiting for an edge on the
+// host pin, for hosts where the INT pin isn't connected. The device still
+// flags and captures changes between reads, so the Events are the same as
+// with an edge pin, but are delayed by up to interval. If several changes of
+// a pin occur between reads, only the first is reported.
+//
+// It has no effect if SetEdgePin() was called, and must be called before
+// EnableInterrupt(). An interval of 0 disables polling.
+func (dev *Dev) SetInterruptPolling(interval time.Duration) error {
+	if interval < 0 {
+		return fmt.Errorf("%s: invalid polling interval %s", dev, interval)
+	}
+	if !dev.ports[0].supportInterrupt {
+		return errors.New("MCP23xxx: interrupts are not supported by this device")
+	}
+	dev.intMu.Lock()
+	defer dev.intMu.Unlock()
+	if dev.interrupts.stop != nil {
+		return fmt.Errorf("%s: SetInterruptPolling() called after EnableInterrupt()", dev)
+	}
+	dev.interrupts.poll = interval
+	return nil
+}
+
+// enableInterrupt configures the registers for interrupt on change of pp.
+func (dev *Dev) enableInterrupt(pp *portpin) error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if err := pp.port.iodir.getAndSetBit(pp.pinbit, true, true); err != nil {
+		return err
+	}
+	// Compare against the previous value of the pin.
+	if err := pp.port.intcon.getAndSetBit(pp.pinbit, false, true); err != nil {
+		return err
+	}
+	return pp.port.gpinten.getAndSetBit(pp.pinbit, true, true)
+}
+
+// SetInterruptOnChange enables interrupt on change for pin, comparing the
+// pin against its previous value. The pin must be configured as an input.
+func (dev *Dev) SetInterruptOnChange(pin int) error {
+	return dev.configureInterrupt(pin, false, gpio.Low)
+}
+
+// SetInterruptCompare enables interrupt on change for pin, comparing the pin
+// against def. The interrupt is asserted while the pin differs from def, and
+// is asserted again after being cleared until the pin returns to def. The pin
+// must be configured as an input.
+//
+// Call SetInterruptCompare after EnableInterrupt() to receive events for a
+// pin using a default value, since EnableInterrupt() selects comparison
+// against the previous value.
+func (dev *Dev) SetInterruptCompare(pin int, def gpio.Level) error {
+	return dev.configureInterrupt(pin, true, def)
+}
+
+// configureInterrupt sets the DEFVAL, INTCON and GPINTEN bits for pin.
+func (dev *Dev) configureInterrupt(pin int, compare bool, def gpio.Level) error {
+	pp, err := dev.interruptPin(pin)
+	if err != nil {
+		return err
+	}
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	input, err := pp.port.iodir.getBit(pp.pinbit, true)
+	if err != nil {
+		return err
+	}
+	if !input {
+		return fmt.Errorf("%s: pin %d is not an input", dev, pin)
+	}
+	if compare {
+		if err = pp.port.defval.getAndSetBit(pp.pinbit, bool(def), true); err != nil {
+			return err
+		}
+	}
+	if err = pp.port.intcon.getAndSetBit(pp.pinbit, compare, true); err != nil {
+		return err
+	}
+	return pp.port.gpinten.getAndSetBit(pp.pinbit, true, true)
+}
+
+// DisableInterrupt disables interrupt on change for pin.
+func (dev *Dev) DisableInterrupt(pin int) error {
+	pp, err := dev.interruptPin(pin)
+	if err != nil {
+		return err
+	}
+	dev.intMu.Lock()
+	delete(dev.interrupts.modes, pin)
+	dev.intMu.Unlock()
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	return pp.port.gpinten.getAndSetBit(pp.pinbit, false, true)
+}
+
+// Events returns the channel that receives the changes of pins enabled by
+// EnableInterrupt(). The channel is buffered. If it fills, the interrupt
+// goroutine waits, and further changes of the same pin are lost. The channel
+// is closed by Close().
+func (dev *Dev) Events() <-chan Event {
+	return dev.interrupts.events
+}
+
+// interruptPin returns the pin number n, if it supports interrupts.
+func (dev *Dev) interruptPin(n int) (*portpin, error) {
+	pp, err := dev.portPin(n)
+	if err != nil {
+		return nil, err
+	}
+	if !pp.port.supportInterrupt {
+		return nil, errors.New("MCP23xxx: interrupts are not supported by this device")
+	}
+	return pp, nil
+}
+
+// watchInterrupts reads and dispatches interrupts each time an edge is seen
+// on edgePin, until stop is closed. If edgePin is nil, the interrupts are
+// read every poll.
+func (dev *Dev) watchInterrupts(edgePin gpio.PinIn, poll time.Duration, stop, done chan struct{}) {
+	defer close(done)
+	for {
+		// Interrupts that occurred before the goroutine started keep INT
+		// asserted, so the flags are checked before waiting.
+		dev.dispatchInterrupts(stop)
+		if edgePin == nil {
+			select {
+			case <-stop:
+				return
+			case <-time.After(poll):
+			}
+			continue
+		}
+		edgePin.WaitForEdge(interruptPollInterval)
+		select {
+		case <-stop:
+			return
+		default:
+		}
+	}
+}
+
+// PendingInterrupts reads the interrupt flag and capture registers of each
+// port, and returns an Event for each pin that caused an interrupt, with the
+// level of the pin captured when it occurred. Reading the capture register
+// clears the interrupt, so the flags are read first, and a port's capture
+// register is only read if a flag is set. Events are returned for all the
+// flagged pins, whatever the ChangeMode passed to EnableInterrupt().
+//
+// This is for code that services the INT pin itself. If a read fails, the
+// events of the ports already read are returned with the error, since their
+// interrupts are cleared. While the goroutine started by EnableInterrupt(),
+// a Keypad, or a WaitForEdge() reads the registers, an error wrapping
+// ErrEdgePinBusy is returned.
+func (dev *Dev) PendingInterrupts() ([]Event, error) {
+	dev.intMu.Lock()
+	err := dev.claimEdgePin(edgeUserPending)
+	dev.intMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	defer dev.releaseEdgePin()
+	return dev.readInterrupts()
+}
+
+// readInterrupts reads the interrupt flags of each port, and returns an Event
+// for each flagged pin. A port whose registers can't be read is skipped, and
+// the first error is returned.
+func (dev *Dev) readInterrupts() ([]Event, error) {
+	var events []Event
+	var firstErr error
+	for ix := range dev.ports {
+		p := &dev.ports[ix]
+		if !p.supportInterrupt {
+			continue
+		}
+		dev.mu.Lock()
+		intf, captured, err := p.pendingInterrupt()
+		dev.mu.Unlock()
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		now := time.Now()
+		for bit := range 8 {
+			if intf&(1<<bit) != 0 {
+				events = append(events, Event{Pin: ix*8 + bit, Level: gpio.Level(captured&(1<<bit) != 0), Time: now})
+			}
+		}
+	}
+	return events, firstErr
+}
+
+// dispatchInterrupts reads the interrupt flags of each port, and sends an
+// Event for each flagged pin that matches its ChangeMode.
+func (dev *Dev) dispatchInterrupts(stop chan struct{}) {
+	// Bus errors are ignored, the flags are read again at the next edge or
+	// poll.
+	events, _ := dev.readInterrupts()
+	for _, ev := range events {
+		dev.intMu.Lock()
+		mode, ok := dev.interrupts.modes[ev.Pin]
+		dev.intMu.Unlock()
+		high := ev.Level == gpio.High
+		if !ok || (mode == ChangeRising && !high) || (mode == ChangeFalling && high) {
+			continue
+		}
+		select {
+		case dev.interrupts.events <- ev:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// stopInterrupts stops the interrupt goroutine if it's running, and closes
+// the events channel. The edge pin belongs to the caller, and isn't halted,
+// so this waits up to interruptPollInterval for the goroutine to see stop.
+// If the device was added to an InterruptService, this waits for the service
+// to finish sending events to the device.
+func (dev *Dev) stopInterrupts() {
+	dev.intMu.Lock()
+	if dev.interrupts.closed {
+		dev.intMu.Unlock()
+		return
+	}
+	dev.interrupts.closed = true
+	stop, done := dev.interrupts.stop, dev.interrupts.done
+	dev.interrupts.stop = nil
+	dev.intMu.Unlock()
+	if stop != nil {
+		close(stop)
+		if done != nil {
+			<-done
+		}
+		dev.releaseEdgePin()
+	}
+	dev.interrupts.dispatchMu.Lock()
+	defer dev.interrupts.dispatchMu.Unlock()
+	close(dev.interrupts.events)
+}
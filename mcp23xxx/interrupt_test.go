@@ -0,0 +1,221 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+// intPin is a host pin connected to the INT pin of the device. WaitForEdge
+// returns when an edge is sent, the timeout expires, or the pin is halted.
+type intPin struct {
+	gpiotest.Pin
+	edges  chan struct{}
+	halt   chan struct{}
+	halted bool
+}
+
+func newIntPin() *intPin {
+	return &intPin{Pin: gpiotest.Pin{N: "INT"}, edges: make(chan struct{}), halt: make(chan struct{})}
+}
+
+func (ip *intPin) WaitForEdge(timeout time.Duration) bool {
+	var expired <-chan time.Time
+	if timeout >= 0 {
+		expired = time.After(timeout)
+	}
+	select {
+	case <-ip.edges:
+		return true
+	case <-expired:
+		return false
+	case <-ip.halt:
+		return false
+	}
+}
+
+func (ip *intPin) Halt() error {
+	ip.halted = true
+	close(ip.halt)
+	return nil
+}
+
+func TestMCP23008_interrupt(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		// The interrupt flags are also read when WaitForEdge times out, and
+		// reads past the end of Ops fail.
+		DontPanic: true,
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			// intcon is read, compare against previous value
+			{Addr: address, W: []byte{0x04}, R: []byte{0x00}},
+			// gpinten is read, and interrupt on change enabled for pin 2
+			{Addr: address, W: []byte{0x02}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x02, 0x04}, R: nil},
+			// pending falling edge on pin 2
+			{Addr: address, W: []byte{0x07}, R: []byte{0x04}},
+			{Addr: address, W: []byte{0x08}, R: []byte{0x00}},
+			// rising edge on pin 2 is filtered
+			{Addr: address, W: []byte{0x07}, R: []byte{0x04}},
+			{Addr: address, W: []byte{0x08}, R: []byte{0x04}},
+			// falling edge on pin 2
+			{Addr: address, W: []byte{0x07}, R: []byte{0x04}},
+			{Addr: address, W: []byte{0x08}, R: []byte{0x00}},
+		},
+	}
+
+	dev, err := NewI2C(scenario, MCP23008, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.EnableInterrupt(2, ChangeFalling); err == nil {
+		t.Error("expected error without an edge pin")
+	}
+	ip := newIntPin()
+	var edgePin gpio.PinIn = ip
+	dev.SetEdgePin(&edgePin)
+	if err = dev.EnableInterrupt(8, ChangeAny); err == nil {
+		t.Error("expected error for invalid pin")
+	}
+	if err = dev.EnableInterrupt(2, ChangeFalling); err != nil {
+		t.Fatal(err)
+	}
+	// The interrupt goroutine reads the interrupt registers.
+	if _, _, err = (*dev.Port(0)).WaitForEdge(0); !errors.Is(err, ErrEdgePinBusy) {
+		t.Errorf("expected ErrEdgePinBusy, got %v", err)
+	}
+	for ix := range 2 {
+		if ix > 0 {
+			// The first edge is the filtered rising edge.
+			ip.edges <- struct{}{}
+			ip.edges <- struct{}{}
+		}
+		ev := <-dev.Events()
+		if ev.Pin != 2 || ev.Level != gpio.Low {
+			t.Errorf("unexpected event %#v", ev)
+		}
+	}
+	if err = dev.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-dev.Events(); ok {
+		t.Error("expected events channel to be closed")
+	}
+	if err = dev.EnableInterrupt(2, ChangeFalling); err == nil {
+		t.Error("expected error after Close()")
+	}
+	if ip.halted {
+		t.Error("Close() halted the edge pin")
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23008_interruptConfig(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation, pin 0 is an output
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFE}},
+			// pin 1 compares against a high default value
+			{Addr: address, W: []byte{0x03}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x03, 0x02}, R: nil},
+			{Addr: address, W: []byte{0x04}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x04, 0x02}, R: nil},
+			{Addr: address, W: []byte{0x02}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x02, 0x02}, R: nil},
+			// pin 1 compares against the previous value
+			{Addr: address, W: []byte{0x04, 0x00}, R: nil},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23008, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if err = dev.SetInterruptOnChange(0); err == nil {
+		t.Error("expected error for output pin")
+	}
+	if err = dev.SetInterruptCompare(1, gpio.High); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetInterruptOnChange(1); err != nil {
+		t.Fatal(err)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23017_pendingInterrupts(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0xFF}},
+			// pins 0 and 2 are flagged, and intcapa is read
+			{Addr: address, W: []byte{0x0e}, R: []byte{0x05}},
+			{Addr: address, W: []byte{0x10}, R: []byte{0x04}},
+			// no pin of port B is flagged, so intcapb isn't read
+			{Addr: address, W: []byte{0x0f}, R: []byte{0x00}},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23017, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	events, err := dev.PendingInterrupts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].Pin != 0 || events[0].Level != gpio.Low || events[1].Pin != 2 || events[1].Level != gpio.High {
+		t.Errorf("unexpected events %v", events)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23008_interruptPolling(t *testing.T) {
+	bus := &registerBus{}
+	bus.regs[regIODIR] = 0xff
+	dev, err := NewI2C(bus, MCP23008, 0x20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	if err = dev.SetInterruptPolling(-time.Millisecond); err == nil {
+		t.Error("expected error for invalid interval")
+	}
+	if err = dev.SetInterruptPolling(time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.EnableInterrupt(3, ChangeRising); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetInterruptPolling(0); err == nil {
+		t.Error("expected error after EnableInterrupt()")
+	}
+	// Pin 3 rises without an edge pin.
+	bus.mu.Lock()
+	bus.regs[regINTF] = 0x08
+	bus.regs[regINTCAP] = 0x08
+	bus.mu.Unlock()
+	if ev := <-dev.Events(); ev.Pin != 3 || ev.Level != gpio.High {
+		t.Errorf("unexpected event %#v", ev)
+	}
+}
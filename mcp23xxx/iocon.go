@@ -0,0 +1,306 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MCP23x08 register addresses. For the MCP23x17/MCP23x18, these are the
+// register offsets within a port. See x17Address().
+const (
+	regIODIR uint8 = iota
+	regIPOL
+	regGPINTEN
+	regDEFVAL
+	regINTCON
+	regIOCON
+	regGPPU
+	regINTF
+	regINTCAP
+	regGPIO
+	regOLAT
+)
+
+// IOCON register bits.
+const (
+	ioconBANK   uint8 = 7
+	ioconMIRROR uint8 = 6
+	ioconSEQOP  uint8 = 5
+	ioconDISSLW uint8 = 4
+	ioconHAEN   uint8 = 3
+	ioconODR    uint8 = 2
+	ioconINTPOL uint8 = 1
+)
+
+// x17Address returns the address of the register reg for port of an
+// MCP23x17/MCP23x18. With IOCON.BANK clear, which is the power on default,
+// the port A and port B registers are interleaved. With IOCON.BANK set, the
+// port B registers start at 0x10.
+func x17Address(reg uint8, port int, bank1 bool) uint8 {
+	if bank1 {
+		return uint8(port)<<4 | reg
+	}
+	return reg<<1 | uint8(port)
+}
+
+// is16Bit returns true for variants with two 8 bit ports that have the
+// IOCON register.
+func (dev *Dev) is16Bit() bool {
+	return len(dev.ports) == 2 && dev.ports[0].supportIOCON
+}
+
+// SetBank sets the IOCON.BANK bit of the MCP23x17/MCP23x18. If separate is
+// true, the registers for each port are grouped together, with the port B
+// registers starting at 0x10. Otherwise, which is the power on default, the
+// port A and port B registers are interleaved. The driver tracks the
+// register addresses, so this is only needed when other software accesses
+// the device.
+//
+// The driver assumes the device is in the power on default state when it's
+// created.
+func (dev *Dev) SetBank(separate bool) error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if !dev.is16Bit() {
+		return fmt.Errorf("%s: IOCON.BANK is only supported by 16 bit variants", dev)
+	}
+	if separate == dev.bank1 {
+		return nil
+	}
+	// The address of IOCON changes when it's written, so it can't be
+	// verified.
+	iocon := &dev.ports[0].iocon
+	verify := iocon.verify
+	iocon.verify = false
+	err := iocon.getAndSetBit(ioconBANK, separate, true)
+	iocon.verify = verify
+	if err != nil {
+		return err
+	}
+	dev.bank1 = separate
+	for ix := range dev.ports {
+		for reg, rc := range dev.ports[ix].registers() {
+			rc.address = x17Address(uint8(reg), ix, separate)
+		}
+	}
+	// IOCON is shared by the ports.
+	dev.ports[1].iocon.cache = dev.ports[0].iocon.cache
+	dev.ports[1].iocon.got = dev.ports[0].iocon.got
+	return nil
+}
+
+// SetMirror sets the IOCON.MIRROR bit of the MCP23x17/MCP23x18. If mirror is
+// true, the INTA and INTB pins are internally connected, and either port
+// asserts both. This allows a single host GPIO pin to handle interrupts from
+// both ports.
+func (dev *Dev) SetMirror(mirror bool) error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if !dev.is16Bit() {
+		return fmt.Errorf("%s: IOCON.MIRROR is only supported by 16 bit variants", dev)
+	}
+	return dev.setIOCONBits(1<<ioconMIRROR, boolBits(mirror, 1<<ioconMIRROR))
+}
+
+// SetInterruptOutput configures the INT pin. If openDrain is true, the pin is
+// an open-drain output, so the INT pins of several devices can be wired
+// together, and activeHigh is ignored. Otherwise, the pin is driven, and is
+// high when an interrupt is pending if activeHigh is true. The power on
+// default is driven and active low.
+//
+// The host pin passed to SetEdgePin() must detect the edge that asserts the
+// interrupt, which is the rising edge when activeHigh is true.
+func (dev *Dev) SetInterruptOutput(openDrain, activeHigh bool) error {
+	if !dev.ports[0].supportIOCON || !dev.ports[0].supportInterrupt {
+		return fmt.Errorf("%s: interrupt output configuration is not supported", dev)
+	}
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	mask := uint8(1<<ioconODR | 1<<ioconINTPOL)
+	return dev.setIOCONBits(mask, boolBits(openDrain, 1<<ioconODR)|boolBits(activeHigh && !openDrain, 1<<ioconINTPOL))
+}
+
+// SetSlewRateControl sets the IOCON.DISSLW bit of the MCP23008 and MCP23017,
+// which controls the slew rate of the SDA output. Slew rate control is
+// enabled at power on, and reduces ringing on long bus lines. Disabling it
+// may be needed for fast mode I2C with a heavily loaded bus.
+func (dev *Dev) SetSlewRateControl(enabled bool) error {
+	if dev.variant != MCP23008 && dev.variant != MCP23017 {
+		return fmt.Errorf("%s: slew rate control is not supported by this variant", dev)
+	}
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	return dev.setIOCONBits(1<<ioconDISSLW, boolBits(!enabled, 1<<ioconDISSLW))
+}
+
+// SetSequentialOperation sets the IOCON.SEQOP bit. If enabled, which is the
+// power on default, the register address is incremented after each byte of
+// a transaction. Otherwise, the same register is read or written repeatedly,
+// which allows a register to be polled with a single long read. See
+// ReadPortSequence().
+//
+// With IOCON.BANK clear, the register address of the 16 bit variants toggles
+// between the port A and port B registers when sequential operation is
+// disabled. WritePortSequence() and ReadPortSequence() disable sequential
+// operation, and StreamGPIO() disables it while it runs.
+func (dev *Dev) SetSequentialOperation(enabled bool) error {
+	if !dev.ports[0].supportIOCON {
+		return fmt.Errorf("%s: IOCON.SEQOP is not supported by this variant", dev)
+	}
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	return dev.setIOCONBits(1<<ioconSEQOP, boolBits(!enabled, 1<<ioconSEQOP))
+}
+
+// setIOCONBits sets the IOCON bits selected by mask to value. IOCON is
+// shared by the ports of 16 bit variants, so the cached value of each port
+// is updated. dev.mu must be held.
+func (dev *Dev) setIOCONBits(mask, value uint8) error {
+	iocon := &dev.ports[0].iocon
+	current, err := iocon.readValue(true)
+	if err != nil {
+		return err
+	}
+	if err = iocon.writeValue(current&^mask|value, true); err != nil {
+		return err
+	}
+	for ix := 1; ix < len(dev.ports); ix++ {
+		dev.ports[ix].iocon.setCache(iocon.cache)
+	}
+	return nil
+}
+
+// boolBits returns bits if b is true, otherwise 0.
+func boolBits(b bool, bits uint8) uint8 {
+	if b {
+		return bits
+	}
+	return 0
+}
+
+// ReadPort returns the value of the GPIO register of port. port is 0 for
+// 8 bit variants, and 0 (port A) or 1 (port B) for 16 bit variants.
+func (dev *Dev) ReadPort(port int) (uint8, error) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if port < 0 || port >= len(dev.ports) {
+		return 0, fmt.Errorf("%s: %w %d", dev, ErrInvalidPort, port)
+	}
+	return dev.ports[port].gpio.readValue(false)
+}
+
+// WritePort writes value to the output latch of port. Pins configured as
+// inputs are not affected.
+func (dev *Dev) WritePort(port int, value uint8) error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if port < 0 || port >= len(dev.ports) {
+		return fmt.Errorf("%s: %w %d", dev, ErrInvalidPort, port)
+	}
+	return dev.ports[port].olat.writeValue(value, true)
+}
+
+// WritePortSequence writes each of values to the output latch of port, in
+// order, in one bus transaction. It generates a waveform on the outputs, like
+// the strobe of a character display's enable line, without the overhead of a
+// transaction per value. IOCON.SEQOP is set, so that the register address
+// isn't incremented.
+//
+// The 16 bit variants with IOCON.BANK clear toggle the register address
+// between the port A and port B latches, so the cached latch value of the
+// other port is interleaved with values. The MCP23016 doesn't support
+// sequential writes, and each value is written in a separate transaction.
+func (dev *Dev) WritePortSequence(port int, values ...uint8) error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if port < 0 || port >= len(dev.ports) {
+		return fmt.Errorf("%s: %w %d", dev, ErrInvalidPort, port)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	olat := &dev.ports[port].olat
+	if !dev.ports[port].supportIOCON {
+		for _, value := range values {
+			if err := olat.writeValue(value, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := dev.setIOCONBits(1<<ioconSEQOP, 1<<ioconSEQOP); err != nil {
+		return err
+	}
+	w := values
+	if dev.is16Bit() && !dev.bank1 {
+		other, err := dev.ports[1-port].olat.readValue(true)
+		if err != nil {
+			return err
+		}
+		w = make([]uint8, 0, 2*len(values))
+		for _, value := range values {
+			w = append(w, value, other)
+		}
+		w = w[:len(w)-1]
+	}
+	if err := olat.writeRegisters(olat.address, w...); err != nil {
+		return olat.wrap("write", err)
+	}
+	olat.setCache(values[len(values)-1])
+	return nil
+}
+
+// ReadGPIO16 reads both ports of a 16 bit variant. Port A is the low byte,
+// and port B is the high byte. With IOCON.BANK clear, both registers are read
+// in one transaction.
+func (dev *Dev) ReadGPIO16() (uint16, error) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if !dev.is16Bit() {
+		return 0, errors.New("MCP23xxx: ReadGPIO16 requires a 16 bit variant")
+	}
+	a, b := &dev.ports[0].gpio, &dev.ports[1].gpio
+	if dev.bank1 {
+		va, err := a.readValue(false)
+		if err != nil {
+			return 0, err
+		}
+		vb, err := b.readValue(false)
+		return uint16(vb)<<8 | uint16(va), err
+	}
+	r := make([]uint8, 2)
+	if err := a.readRegisters(a.address, r); err != nil {
+		return 0, a.wrap("read", err)
+	}
+	a.setCache(r[0])
+	b.setCache(r[1])
+	return uint16(r[1])<<8 | uint16(r[0]), nil
+}
+
+// WriteGPIO16 writes value to the output latches of both ports of a 16 bit
+// variant. Port A is the low byte, and port B is the high byte. With
+// IOCON.BANK clear, both registers are written in one transaction.
+func (dev *Dev) WriteGPIO16(value uint16) error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if !dev.is16Bit() {
+		return errors.New("MCP23xxx: WriteGPIO16 requires a 16 bit variant")
+	}
+	a, b := &dev.ports[0].olat, &dev.ports[1].olat
+	if dev.bank1 || dev.verify {
+		if err := a.writeValue(uint8(value), true); err != nil {
+			return err
+		}
+		return b.writeValue(uint8(value>>8), true)
+	}
+	if err := a.writeRegisters(a.address, uint8(value), uint8(value>>8)); err != nil {
+		return a.wrap("write", err)
+	}
+	a.setCache(uint8(value))
+	b.setCache(uint8(value >> 8))
+	return nil
+}
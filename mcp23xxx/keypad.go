@@ -0,0 +1,290 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// KeyEvent is a change of a key of a Keypad.
+type KeyEvent struct {
+	// Row and Col are the indexes of the key's row and column pins passed to
+	// NewKeypad().
+	Row, Col int
+	// Pressed is true if the key was pressed, and false if it was released.
+	Pressed bool
+	// Time is when the change was detected.
+	Time time.Time
+}
+
+// keypadIdleTimeout is the longest time the scanning goroutine waits for an
+// interrupt while no keys are pressed.
+const keypadIdleTimeout = 250 * time.Millisecond
+
+// Keypad scans a matrix keypad connected to the pins of an expander. The row
+// pins are driven low one at a time, and the column pins are inputs with the
+// pull-ups enabled. A pressed key pulls its column low while its row is
+// driven. Rows that aren't being scanned are high impedance, so pressing
+// several keys can't short two outputs together.
+//
+// Between scans, all rows are driven low, so that pressing any key pulls a
+// column low. If the INT pin of the device is connected to a host pin
+// supplied to Dev.SetEdgePin(), interrupt on change is enabled for the
+// columns, and the scanning goroutine waits for an interrupt rather than
+// polling while no keys are pressed. The column pins must not be passed to
+// Dev.EnableInterrupt().
+type Keypad struct {
+	dev     *Dev
+	rows    []int
+	cols    []int
+	rowMask uint16
+	colMask uint16
+	wake    bool
+
+	mu      sync.Mutex
+	pressed [][]bool
+	stop    chan struct{}
+	done    chan struct{}
+	err     error
+}
+
+// NewKeypad returns a Keypad with the row and column pins of dev. Pins are
+// numbered as for Dev.Pin().
+func NewKeypad(dev *Dev, rows, cols []int) (*Keypad, error) {
+	if len(rows) == 0 || len(cols) == 0 {
+		return nil, errors.New("MCP23xxx: keypad requires rows and columns")
+	}
+	k := &Keypad{dev: dev, rows: rows, cols: cols, pressed: make([][]bool, len(rows))}
+	for ix := range k.pressed {
+		k.pressed[ix] = make([]bool, len(cols))
+	}
+	for _, pins := range []struct {
+		numbers []int
+		mask    *uint16
+	}{{rows, &k.rowMask}, {cols, &k.colMask}} {
+		for _, pin := range pins.numbers {
+			if dev.Pin(pin) == nil {
+				return nil, fmt.Errorf("%s: %w %d", dev, ErrInvalidPin, pin)
+			}
+			if (k.rowMask|k.colMask)&(1<<pin) != 0 {
+				return nil, fmt.Errorf("%s: keypad pin %d used more than once", dev, pin)
+			}
+			*pins.mask |= 1 << pin
+		}
+	}
+	if err := dev.SetDirectionMasked(k.colMask, k.colMask); err != nil {
+		return nil, err
+	}
+	if err := dev.SetPullupMasked(k.colMask, k.colMask); err != nil {
+		return nil, err
+	}
+	if err := dev.WriteGPIOMasked(k.rowMask, 0); err != nil {
+		return nil, err
+	}
+	if err := dev.SetDirectionMasked(k.rowMask, 0); err != nil {
+		return nil, err
+	}
+	if dev.hostEdgePin() != nil && dev.ports[0].supportInterrupt {
+		for _, col := range cols {
+			if err := dev.SetInterruptOnChange(col); err != nil {
+				return nil, err
+			}
+		}
+		k.wake = true
+	}
+	return k, nil
+}
+
+// Scan scans the keypad once, and returns the keys that changed since the
+// previous scan.
+func (k *Keypad) Scan() ([]KeyEvent, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	var events []KeyEvent
+	for r, row := range k.rows {
+		// Only the scanned row is an output.
+		if err := k.dev.SetDirectionMasked(k.rowMask, k.rowMask&^(1<<row)); err != nil {
+			return events, err
+		}
+		levels, err := k.readColumns()
+		if err != nil {
+			return events, err
+		}
+		now := time.Now()
+		for c, col := range k.cols {
+			pressed := levels&(1<<col) == 0
+			if pressed != k.pressed[r][c] {
+				k.pressed[r][c] = pressed
+				events = append(events, KeyEvent{Row: r, Col: c, Pressed: pressed, Time: now})
+			}
+		}
+	}
+	// Drive all rows low while idle, so that a key press changes a column.
+	return events, k.dev.SetDirectionMasked(k.rowMask, 0)
+}
+
+// Pressed returns true if the key at row and col was pressed at the last
+// scan. It returns false if row or col is out of range.
+func (k *Keypad) Pressed(row, col int) bool {
+	if row < 0 || row >= len(k.rows) || col < 0 || col >= len(k.cols) {
+		return false
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.pressed[row][col]
+}
+
+// Start starts a goroutine that scans the keypad every interval, and sends
+// the changes to the returned channel. The channel is closed when Stop() is
+// called, or a scan returns an error. See Err().
+//
+// If the keypad waits for interrupts, the edge pin can't be used by
+// Dev.EnableInterrupt() or the WaitForEdge() of pins and groups until the
+// goroutine exits, and if it's already in use, an error wrapping
+// ErrEdgePinBusy is returned. The edge pin belongs to the caller, and isn't
+// halted, so after Stop() it's released once a pending wait on it times out,
+// up to keypadIdleTimeout later.
+func (k *Keypad) Start(interval time.Duration) (<-chan KeyEvent, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.stop != nil {
+		return nil, errors.New("MCP23xxx: keypad already started")
+	}
+	var edgePin gpio.PinIn
+	if k.wake {
+		var err error
+		if edgePin, err = k.dev.acquireEdgePin(edgeUserKeypad); err != nil {
+			return nil, err
+		}
+	}
+	k.stop = make(chan struct{})
+	k.done = make(chan struct{})
+	k.err = nil
+	events := make(chan KeyEvent, eventBufferSize)
+	go k.run(interval, edgePin, events, k.stop, k.done)
+	return events, nil
+}
+
+// Stop stops the goroutine started by Start(), and waits for it to exit.
+func (k *Keypad) Stop() {
+	k.mu.Lock()
+	stop, done := k.stop, k.done
+	k.stop = nil
+	k.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Halt implements conn.Resource. It stops the goroutine started by Start().
+func (k *Keypad) Halt() error {
+	k.Stop()
+	return nil
+}
+
+func (k *Keypad) String() string {
+	return fmt.Sprintf("Keypad{%s, %dx%d}", k.dev, len(k.rows), len(k.cols))
+}
+
+// Err returns the error that stopped the scanning goroutine, if any.
+func (k *Keypad) Err() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.err
+}
+
+// run scans the keypad until stop is closed. If edgePin isn't nil, it has
+// been acquired for the keypad, and is waited on while no keys are pressed.
+func (k *Keypad) run(interval time.Duration, edgePin gpio.PinIn, events chan<- KeyEvent, stop, done chan struct{}) {
+	defer close(done)
+	defer close(events)
+	var wait, woke chan struct{}
+	if edgePin != nil {
+		wait = make(chan struct{})
+		woke = make(chan struct{}, 1)
+		go k.waitForEdges(edgePin, wait, woke)
+		defer close(wait)
+	}
+	for {
+		changes, err := k.Scan()
+		if err != nil {
+			k.mu.Lock()
+			k.err = err
+			k.mu.Unlock()
+			return
+		}
+		for _, ev := range changes {
+			select {
+			case events <- ev:
+			case <-stop:
+				return
+			}
+		}
+		if edgePin != nil && !k.anyPressed() {
+			// A key press asserts INT. Scanning reads the GPIO register,
+			// which clears the interrupt.
+			wait <- struct{}{}
+			select {
+			case <-stop:
+				return
+			case <-woke:
+			}
+			continue
+		}
+		select {
+		case <-stop:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// waitForEdges waits on edgePin each time run() sends to wait, and sends to
+// woke when the wait ends. It runs apart from run(), so that Stop() doesn't
+// wait for the timeout, and releases edgePin once wait is closed.
+func (k *Keypad) waitForEdges(edgePin gpio.PinIn, wait <-chan struct{}, woke chan<- struct{}) {
+	defer k.dev.releaseEdgePin()
+	for range wait {
+		edgePin.WaitForEdge(keypadIdleTimeout)
+		woke <- struct{}{}
+	}
+}
+
+func (k *Keypad) anyPressed() bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	for _, row := range k.pressed {
+		for _, pressed := range row {
+			if pressed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readColumns returns the levels of the ports that have column pins, as a
+// value with bit n for pin n.
+func (k *Keypad) readColumns() (uint16, error) {
+	var levels uint16
+	for port := range k.dev.ports {
+		if uint8(k.colMask>>(8*port)) == 0 {
+			continue
+		}
+		v, err := k.dev.ReadPort(port)
+		if err != nil {
+			return 0, err
+		}
+		levels |= uint16(v) << (8 * port)
+	}
+	return levels, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// keypadBus is a registerBus with a keypad connected. A pressed key pulls its
+// column low while its row is driven low.
+type keypadBus struct {
+	registerBus
+	// pressed is the set of pressed keys as [row pin, column pin].
+	pressed map[[2]int]bool
+}
+
+func (kb *keypadBus) Tx(addr uint16, w, r []byte) error {
+	kb.mu.Lock()
+	gpio := byte(0xff)
+	for key := range kb.pressed {
+		row := byte(1) << key[0]
+		if kb.regs[regIODIR]&row == 0 && kb.regs[regOLAT]&row == 0 {
+			gpio &^= 1 << key[1]
+		}
+	}
+	kb.regs[regGPIO] = gpio
+	kb.mu.Unlock()
+	return kb.registerBus.Tx(addr, w, r)
+}
+
+func TestKeypad(t *testing.T) {
+	bus := &keypadBus{pressed: map[[2]int]bool{{1, 6}: true}}
+	bus.regs[regIODIR] = 0xff
+	dev, err := NewI2C(bus, MCP23008, 0x20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	if _, err = NewKeypad(dev, []int{0, 1}, []int{1, 2}); err == nil {
+		t.Error("expected error for pin used twice")
+	}
+	k, err := NewKeypad(dev, []int{0, 1, 2, 3}, []int{4, 5, 6, 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bus.regs[regGPPU] != 0xf0 || bus.regs[regIODIR] != 0xf0 {
+		t.Errorf("unexpected gppu 0x%x iodir 0x%x", bus.regs[regGPPU], bus.regs[regIODIR])
+	}
+	events, err := k.Scan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Row != 1 || events[0].Col != 2 || !events[0].Pressed {
+		t.Errorf("unexpected events %v", events)
+	}
+	if !k.Pressed(1, 2) {
+		t.Error("expected key 1,2 pressed")
+	}
+	if k.Pressed(4, 0) || k.Pressed(0, -1) {
+		t.Error("expected keys out of range not pressed")
+	}
+
+	ch, err := k.Start(time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bus.mu.Lock()
+	bus.pressed = map[[2]int]bool{{3, 4}: true}
+	bus.mu.Unlock()
+	received := map[KeyEvent]bool{}
+	for range 2 {
+		ev := <-ch
+		ev.Time = time.Time{}
+		received[ev] = true
+	}
+	if !received[KeyEvent{Row: 1, Col: 2}] || !received[KeyEvent{Row: 3, Col: 0, Pressed: true}] {
+		t.Errorf("unexpected events %v", received)
+	}
+	k.Stop()
+	if _, ok := <-ch; ok {
+		t.Error("expected channel to be closed")
+	}
+	if k.Err() != nil {
+		t.Error(k.Err())
+	}
+}
+
+func TestKeypad_stopWaiting(t *testing.T) {
+	bus := &keypadBus{}
+	bus.regs[regIODIR] = 0xff
+	dev, err := NewI2C(bus, MCP23008, 0x20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	ip := newIntPin()
+	var edgePin gpio.PinIn = ip
+	dev.SetEdgePin(&edgePin)
+	k, err := NewKeypad(dev, []int{0, 1, 2, 3}, []int{4, 5, 6, 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, err := k.Start(time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// With no keys pressed, the keypad waits for an edge, which wakes it to
+	// scan again.
+	ip.edges <- struct{}{}
+	time.Sleep(10 * time.Millisecond)
+	start := time.Now()
+	k.Stop()
+	if d := time.Since(start); d >= keypadIdleTimeout/2 {
+		t.Errorf("Stop() took %s waiting for the edge pin", d)
+	}
+	if _, ok := <-ch; ok {
+		t.Error("expected channel to be closed")
+	}
+}
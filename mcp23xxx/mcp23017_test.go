@@ -5,12 +5,18 @@
 package mcp23xxx
 
 import (
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"periph.io/x/conn/v3/conntest"
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/gpio/gpiotest"
 	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/pin"
 	"periph.io/x/conn/v3/spi"
 	"periph.io/x/conn/v3/spi/spitest"
 )
@@ -173,3 +179,674 @@ func TestMCP23017_inPullUp(t *testing.T) {
 		t.Errorf("Input should be High")
 	}
 }
+
+func TestMCP23017_16bit(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0xFF}},
+			// both gpio registers are read in one transaction
+			{Addr: address, W: []byte{0x12}, R: []byte{0x34, 0x12}},
+			// both olat registers are written in one transaction
+			{Addr: address, W: []byte{0x14, 0xcd, 0xab}},
+			// iocon is read, and bank is set
+			{Addr: address, W: []byte{0x0a}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x0a, 0x80}},
+			// with bank set, port b registers start at 0x10
+			{Addr: address, W: []byte{0x09}, R: []byte{0x78}},
+			{Addr: address, W: []byte{0x19}, R: []byte{0x56}},
+			{Addr: address, W: []byte{0x1a, 0x01}},
+			// mirror is set using the bank 1 address of iocon
+			{Addr: address, W: []byte{0x05, 0xc0}},
+		},
+	}
+
+	dev, err := NewI2C(scenario, MCP23017, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if v, err := dev.ReadGPIO16(); err != nil || v != 0x1234 {
+		t.Errorf("ReadGPIO16() returned 0x%x, %v", v, err)
+	}
+	if err = dev.WriteGPIO16(0xabcd); err != nil {
+		t.Error(err)
+	}
+	if err = dev.SetBank(true); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := dev.ReadGPIO16(); err != nil || v != 0x5678 {
+		t.Errorf("ReadGPIO16() returned 0x%x, %v", v, err)
+	}
+	if err = dev.WritePort(1, 0x01); err != nil {
+		t.Error(err)
+	}
+	if err = dev.SetMirror(true); err != nil {
+		t.Error(err)
+	}
+	if err = dev.WritePort(2, 0x01); err == nil {
+		t.Error("expected error for invalid port")
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23S17_address(t *testing.T) {
+	scenario := &spitest.Playback{
+		Playback: conntest.Playback{
+			Ops: []conntest.IO{
+				// iocon.haen is set using address 0
+				{W: []byte{0x40, 0x0a, 0x08}},
+				// registers are accessed with address 5 in the opcode
+				{W: []byte{0x4b, 0x00}, R: []byte{0xFF}},
+				{W: []byte{0x4b, 0x01}, R: []byte{0xFF}},
+				{W: []byte{0x4a, 0x00, 0xFE}},
+				{W: []byte{0x4b, 0x14}, R: []byte{0x00}},
+				{W: []byte{0x4a, 0x14, 0x01}},
+			},
+		},
+	}
+	conn, err := scenario.Connect(1, spi.Mode0, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewSPIAddress(conn, MCP23S17, 8); err == nil {
+		t.Error("expected error for address 8")
+	}
+	if _, err = NewSPIAddress(conn, MCP23S18, 0); err == nil {
+		t.Error("expected error for MCP23S18")
+	}
+	dev, err := NewSPIAddress(conn, MCP23S17, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if err = dev.Pins[0][0].Out(gpio.High); err != nil {
+		t.Error(err)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23008_pinEdge(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			// gppu is read
+			{Addr: address, W: []byte{0x06}, R: []byte{0x00}},
+			// intcon is read, compare against previous value
+			{Addr: address, W: []byte{0x04}, R: []byte{0x00}},
+			// gpinten is read, and interrupt on change enabled for pin 2
+			{Addr: address, W: []byte{0x02}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x02, 0x04}, R: nil},
+			// no interrupt pending
+			{Addr: address, W: []byte{0x07}, R: []byte{0x00}},
+			// after the INT edge, a falling edge on pin 2 is captured
+			{Addr: address, W: []byte{0x07}, R: []byte{0x04}},
+			{Addr: address, W: []byte{0x08}, R: []byte{0x00}},
+			// pin 2 rises
+			{Addr: address, W: []byte{0x07}, R: []byte{0x04}},
+			{Addr: address, W: []byte{0x08}, R: []byte{0x04}},
+		},
+	}
+
+	dev, err := NewI2C(scenario, MCP23008, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if dev.Pin(8) != nil {
+		t.Error("expected nil for out of range pin")
+	}
+	p := dev.Pin(2)
+	if p.Name() != "MCP23008_20_2" {
+		t.Errorf("unexpected pin %s", p)
+	}
+	if p.WaitForEdge(0) {
+		t.Error("expected no edge without an edge pin")
+	}
+	edges := make(chan gpio.Level, 2)
+	var intPin gpio.PinIn = &gpiotest.Pin{N: "INT", EdgesChan: edges}
+	dev.SetEdgePin(&intPin)
+	if err := p.In(gpio.Float, gpio.RisingEdge); err != nil {
+		t.Fatal(err)
+	}
+	edges <- gpio.Low
+	edges <- gpio.Low
+	if !p.WaitForEdge(time.Second) {
+		t.Error("expected rising edge")
+	}
+}
+
+func TestMCP23008_refresh(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			// iodir, ipol, gppu, gpinten, defval, intcon, iocon, olat
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFE}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x06}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x02}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x03}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x04}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x05}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x0A}, R: []byte{0x00}},
+			// pin 0 is already an output, and olat is cached
+			{Addr: address, W: []byte{0x0A, 0x01}, R: nil},
+		},
+	}
+
+	dev, err := NewI2C(scenario, MCP23008, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	if err = dev.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.Pin(0).Out(gpio.High); err != nil {
+		t.Fatal(err)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+// registerBus is an i2c.Bus that simulates the registers of an MCP23008.
+type registerBus struct {
+	mu   sync.Mutex
+	regs [11]byte
+}
+
+func (rb *registerBus) String() string                    { return "registerBus" }
+func (rb *registerBus) SetSpeed(f physic.Frequency) error { return nil }
+
+func (rb *registerBus) Tx(addr uint16, w, r []byte) error {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	copy(rb.regs[w[0]:], w[1:])
+	copy(r, rb.regs[w[0]:])
+	return nil
+}
+
+func TestMCP23008_concurrent(t *testing.T) {
+	bus := &registerBus{}
+	bus.regs[regIODIR] = 0xff
+	dev, err := NewI2C(bus, MCP23008, 0x20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	var wg sync.WaitGroup
+	for n := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p := dev.Pin(n)
+			for ix := range 100 {
+				if err := p.Out(gpio.Level(ix%2 == 0)); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if bus.regs[regOLAT] != 0 || bus.regs[regIODIR] != 0 {
+		t.Errorf("unexpected olat 0x%x iodir 0x%x", bus.regs[regOLAT], bus.regs[regIODIR])
+	}
+}
+
+func TestMCP23017_errors(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0xFF}},
+		},
+		DontPanic: true,
+	}
+	dev, err := NewI2C(scenario, MCP23017, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if dev.Group(0, []int{8}) != nil {
+		t.Error("expected nil group for invalid pin")
+	}
+	if _, err = dev.ReadPort(2); !errors.Is(err, ErrInvalidPort) {
+		t.Errorf("expected ErrInvalidPort, got %v", err)
+	}
+	if err = dev.EnableInterrupt(16, ChangeAny); !errors.Is(err, ErrInvalidPin) {
+		t.Errorf("expected ErrInvalidPin, got %v", err)
+	}
+	// The playback has no more operations, so the bus returns an error.
+	_, err = dev.ReadPort(1)
+	var regErr *RegisterError
+	if !errors.As(err, &regErr) || regErr.Op != "read" || regErr.Register != "GPIOB" {
+		t.Errorf("expected read GPIOB RegisterError, got %v", err)
+	}
+}
+
+func TestMCP23017_gpioPin(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0xFF}},
+			// gpiob is read
+			{Addr: address, W: []byte{0x13}, R: []byte{0x04}},
+			// iodirb is set to output, and olatb written
+			{Addr: address, W: []byte{0x01, 0xFB}, R: nil},
+			{Addr: address, W: []byte{0x15}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x15, 0x04}, R: nil},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23017, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if _, err = dev.ReadGPIOPin(16); !errors.Is(err, ErrInvalidPin) {
+		t.Errorf("expected ErrInvalidPin, got %v", err)
+	}
+	high, err := dev.ReadGPIOPin(10)
+	if err != nil || !high {
+		t.Errorf("expected pin 10 high, got %t %v", high, err)
+	}
+	if err = dev.WriteGPIOPin(10, true); err != nil {
+		t.Fatal(err)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23017_masked(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0xFF}},
+			// pins 0-3 and 8 set to output, using the cached iodir
+			{Addr: address, W: []byte{0x00, 0xF0}, R: nil},
+			{Addr: address, W: []byte{0x01, 0xFE}, R: nil},
+			// pull-up enabled for pin 12
+			{Addr: address, W: []byte{0x0D}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x0D, 0x10}, R: nil},
+			// olata is read once, and the latch bits updated
+			{Addr: address, W: []byte{0x14}, R: []byte{0x80}},
+			{Addr: address, W: []byte{0x14, 0x85}, R: nil},
+			{Addr: address, W: []byte{0x14, 0x8A}, R: nil},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23017, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if err = dev.SetDirectionMasked(0x010f, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetPullupMasked(0x1000, 0xffff); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.WriteGPIOMasked(0x000f, 0xff05); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.WriteGPIOMasked(0x000f, 0x000a); err != nil {
+		t.Fatal(err)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23008_inputPolarity(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			// ipol is read, and pin 1 inverted
+			{Addr: address, W: []byte{0x01}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x01, 0x02}, R: nil},
+			// pins 4-7 inverted using the cached value
+			{Addr: address, W: []byte{0x01, 0xF2}, R: nil},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23008, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if err = dev.SetInputPolarity(8, true); !errors.Is(err, ErrInvalidPin) {
+		t.Errorf("expected ErrInvalidPin, got %v", err)
+	}
+	if err = dev.SetInputPolarity(1, true); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetInputPolarityMasked(0xf0, 0xff); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetInputPolarityMasked(0x100, 0); !errors.Is(err, ErrInvalidPin) {
+		t.Errorf("expected ErrInvalidPin, got %v", err)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23017_interruptOutput(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0xFF}},
+			// iocon is read, and mirror set
+			{Addr: address, W: []byte{0x0A}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x0A, 0x40}, R: nil},
+			// INT is driven active high
+			{Addr: address, W: []byte{0x0A, 0x42}, R: nil},
+			// INT is open-drain
+			{Addr: address, W: []byte{0x0A, 0x44}, R: nil},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23017, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if err = dev.SetMirror(true); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetInterruptOutput(false, true); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetInterruptOutput(true, true); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := dev.ports[1].iocon.readValue(true); v != 0x44 {
+		t.Errorf("expected port B iocon cache 0x44, got 0x%x", v)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23017_halt(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0x0F}},
+			// interrupts are disabled, then the pins are inputs without
+			// pull-ups
+			{Addr: address, W: []byte{0x04, 0x00}, R: nil},
+			{Addr: address, W: []byte{0x00, 0xFF}, R: nil},
+			{Addr: address, W: []byte{0x0C, 0x00}, R: nil},
+			{Addr: address, W: []byte{0x05, 0x00}, R: nil},
+			{Addr: address, W: []byte{0x01, 0xFF}, R: nil},
+			{Addr: address, W: []byte{0x0D, 0x00}, R: nil},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23017, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if s := dev.String(); s != "MCP23017{playback(32)}" {
+		t.Errorf("unexpected String() %q", s)
+	}
+	if err = dev.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if p := dev.Pin(3); p.Function() != "IN/Float" || p.Pull() != gpio.Float {
+		t.Errorf("expected pin 3 to be a floating input, got %s %s", p.Function(), p.Pull())
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23017_writePortSequence(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0xFF}},
+			// iocon is read, and seqop is set
+			{Addr: address, W: []byte{0x0a}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x0a, 0x20}},
+			// the address toggles between olata and olatb, so the olatb
+			// value is read and interleaved
+			{Addr: address, W: []byte{0x15}, R: []byte{0x80}},
+			{Addr: address, W: []byte{0x14, 0x01, 0x80, 0x03, 0x80, 0x01}},
+			// with bank set, the address doesn't change
+			{Addr: address, W: []byte{0x0a, 0xa0}},
+			{Addr: address, W: []byte{0x1a, 0x02, 0x06, 0x02}},
+		},
+	}
+
+	dev, err := NewI2C(scenario, MCP23017, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if err = dev.WritePortSequence(0, 0x01, 0x03, 0x01); err != nil {
+		t.Error(err)
+	}
+	if err = dev.SetBank(true); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.WritePortSequence(1, 0x02, 0x06, 0x02); err != nil {
+		t.Error(err)
+	}
+	if err = dev.WritePortSequence(2, 0x01); err == nil {
+		t.Error("expected error for invalid port")
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23017_notFound(t *testing.T) {
+	if _, err := NewI2C(&i2ctest.Record{}, MCP23017, 0x28); err == nil || errors.Is(err, ErrDeviceNotFound) {
+		t.Errorf("expected address error, got %v", err)
+	}
+	// The device stops responding after IODIRA is read.
+	scenario := &i2ctest.Playback{
+		Ops:       []i2ctest.IO{{Addr: 0x21, W: []byte{0x00}, R: []byte{0xff}}},
+		DontPanic: true,
+	}
+	_, err := NewI2C(scenario, MCP23017, 0x21)
+	var re *RegisterError
+	if !errors.Is(err, ErrDeviceNotFound) || !errors.As(err, &re) || re.Register != "IODIRB" {
+		t.Errorf("expected ErrDeviceNotFound reading IODIRB, got %v", err)
+	}
+	if p := gpioreg.ByName("MCP23017_21_PORTA_0"); p != nil {
+		t.Errorf("expected pins not registered, found %s", p)
+	}
+}
+
+func TestMCP23009_openDrain(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xff}},
+			// pin 0 is pulled up
+			{Addr: address, W: []byte{0x06}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x06, 0x01}, R: nil},
+			// pin 0 is an output, released high, and keeps its pull-up
+			{Addr: address, W: []byte{0x00, 0xfe}, R: nil},
+			{Addr: address, W: []byte{0x0a}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x0a, 0x01}, R: nil},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23009, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dev.OpenDrain() {
+		t.Error("expected open-drain outputs")
+	}
+	p := dev.Pin(0)
+	if err = p.In(gpio.PullUp, gpio.NoEdge); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.Out(gpio.High); err != nil {
+		t.Fatal(err)
+	}
+	pf := p.(pin.PinFunc)
+	if f := pf.Func(); f != gpio.OUT_OC {
+		t.Errorf("expected %s, got %s", gpio.OUT_OC, f)
+	}
+	if p.Pull() != gpio.PullUp {
+		t.Error("expected pull-up enabled on output")
+	}
+	if err = pf.SetFunc(gpio.OUT); err == nil {
+		t.Error("expected error for push-pull output")
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23017_setDirections(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0xFF}},
+			// both ports are written in one transaction, without reads
+			{Addr: address, W: []byte{0x00, 0x00, 0xff}},
+			{Addr: address, W: []byte{0x0c, 0x00, 0xf0}},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23017, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	if err = dev.SetDirections(0x00ff); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetPullups(0xf000); err != nil {
+		t.Fatal(err)
+	}
+	if f := dev.Pin(8).(pin.PinFunc).Func(); f != gpio.IN {
+		t.Errorf("expected pin 8 input, got %s", f)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+
+	bus := &registerBus{}
+	dev8, err := NewI2C(bus, MCP23008, 0x21)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev8.Close()
+	if err = dev8.SetDirections(0x0f); err != nil {
+		t.Fatal(err)
+	}
+	if bus.regs[regIODIR] != 0xf0 {
+		t.Errorf("unexpected iodir 0x%x", bus.regs[regIODIR])
+	}
+}
+
+func TestMCP23008_writeVerification(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xff}},
+			// the write reads back
+			{Addr: address, W: []byte{0x00, 0xfe}},
+			{Addr: address, W: []byte{0x00}, R: []byte{0xfe}},
+			// the latch is corrupted once, and written again
+			{Addr: address, W: []byte{0x0a}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x0a, 0x01}},
+			{Addr: address, W: []byte{0x0a}, R: []byte{0x03}},
+			{Addr: address, W: []byte{0x0a, 0x01}},
+			{Addr: address, W: []byte{0x0a}, R: []byte{0x01}},
+			// the retry fails too
+			{Addr: address, W: []byte{0x0a, 0x00}},
+			{Addr: address, W: []byte{0x0a}, R: []byte{0x80}},
+			{Addr: address, W: []byte{0x0a, 0x00}},
+			{Addr: address, W: []byte{0x0a}, R: []byte{0x80}},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23008, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	dev.SetWriteVerification(true)
+	p := dev.Pin(0)
+	if err = p.Out(gpio.High); err != nil {
+		t.Fatal(err)
+	}
+	err = p.Out(gpio.Low)
+	var re *RegisterError
+	if !errors.Is(err, ErrVerifyFailed) || !errors.As(err, &re) || re.Register != "OLAT" {
+		t.Errorf("expected ErrVerifyFailed for OLAT, got %v", err)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23008_pull(t *testing.T) {
+	bus := &registerBus{}
+	bus.regs[regIODIR] = 0xff
+	dev, err := NewI2C(bus, MCP23008, 0x20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	p := dev.Pin(2)
+	if err = p.In(gpio.PullUp, gpio.NoEdge); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.In(gpio.PullNoChange, gpio.NoEdge); err != nil {
+		t.Fatal(err)
+	}
+	if f := p.Function(); f != "IN/PullUp" || bus.regs[regGPPU] != 0x04 {
+		t.Errorf("expected pull-up input, got %s gppu 0x%x", f, bus.regs[regGPPU])
+	}
+	if err = p.Out(gpio.Low); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.In(gpio.PullDown, gpio.NoEdge); err == nil {
+		t.Error("expected error for PullDown")
+	}
+	if f := p.Function(); f != "OUT" {
+		t.Errorf("expected output unchanged by failed In(), got %s", f)
+	}
+}
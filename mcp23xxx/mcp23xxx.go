@@ -5,24 +5,48 @@
 package mcp23xxx
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+	"sync"
 
+	"periph.io/x/conn/v3"
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/gpio/gpioreg"
 	"periph.io/x/conn/v3/i2c"
 	"periph.io/x/conn/v3/spi"
 )
 
-// Dev is a handle for a configured MCP23xxx device.
+// Dev is a handle for a configured MCP23xxx device. Dev and its pins are
+// safe for concurrent use.
 type Dev struct {
 	// For all variants, Pins exposes a two dimensional slice of pins. For a
 	// MCP23X08/X09, [][]Pins would have one row with 8 pins, while the 16
 	// pin variants would have two rows with 8 pins each.
 	Pins [][]Pin
 
-	edgePin *gpio.PinIn
 	variant Variant
+	ra      registerAccess
+	ports   []port
+	// bank1 is true if IOCON.BANK is set, and the registers of each port of
+	// a 16 bit variant are grouped together.
+	bank1 bool
+	// verify is true if writes are read back. See SetWriteVerification().
+	verify bool
+
+	// mu serializes access to the registers, so that read-modify-write
+	// sequences of different pins don't interleave.
+	mu sync.Mutex
+	// intMu protects the edge pin, and the state shared with the interrupt
+	// and watchdog goroutines.
+	intMu   sync.Mutex
+	edgePin *gpio.PinIn
+	// edgeUser and edgeClaims track the code waiting on edgePin. See
+	// claimEdgePin().
+	edgeUser   edgeUser
+	edgeClaims int
+	interrupts interrupts
+	watchdog   watchdog
 }
 
 // Variant is the type denoting a specific variant of the family.
@@ -57,8 +81,21 @@ const (
 	MCP23S18 Variant = "MCP23S18"
 )
 
-// NewI2C initializes an IO extender through I2C connection.
-func NewI2C(b i2c.Bus, variant Variant, addr uint16) (*Dev, error) {
+// openDrain returns true for the variants with open-drain outputs.
+func (v Variant) openDrain() bool {
+	switch v {
+	case MCP23009, MCP23S09, MCP23018, MCP23S18:
+		return true
+	}
+	return false
+}
+
+// NewI2C initializes an IO extender through I2C connection. The address
+// must be in the range 0x20-0x27. The direction registers are read to check
+// that the device responds, and if it doesn't, an error wrapping
+// ErrDeviceNotFound is returned, rather than the first register access
+// failing later.
+func NewI2C(b i2c.Bus, variant Variant, addr uint16, opts ...Option) (*Dev, error) {
 	if addr&0xFFF8 != 0x20 {
 		return nil, fmt.Errorf("%s: Supported address range is 0x20 - 0x27", variant)
 	}
@@ -66,20 +103,77 @@ func NewI2C(b i2c.Bus, variant Variant, addr uint16) (*Dev, error) {
 	ra := &i2cRegisterAccess{
 		Dev: &i2c.Dev{Bus: b, Addr: addr},
 	}
-	return makeDev(ra, variant, devicename)
+	dev, err := makeDev(ra, variant, devicename)
+	var re *RegisterError
+	if errors.As(err, &re) {
+		return nil, fmt.Errorf("%w: %s at 0x%x: %w", ErrDeviceNotFound, variant, addr, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return configure(dev, opts)
 }
 
-// NewSPI initializes an IO extender through I2C connection.
-func NewSPI(b spi.Conn, variant Variant) (*Dev, error) {
+// NewSPI initializes an IO extender through SPI connection. To share a chip
+// select between multiple devices, use NewSPIAddress().
+func NewSPI(b spi.Conn, variant Variant, opts ...Option) (*Dev, error) {
 	devicename := string(variant)
 	ra := &spiRegisterAccess{
 		Conn: b,
 	}
-	return makeDev(ra, variant, devicename)
+	dev, err := makeDev(ra, variant, devicename)
+	if err != nil {
+		return nil, err
+	}
+	return configure(dev, opts)
 }
 
-// Close removes any registration to the device.
+// NewSPIAddress initializes an IO extender that shares a chip select with
+// other MCP23S08/MCP23S17 devices. The devices are distinguished by the
+// hardware address set by their address pins, which is 0-3 for the MCP23S08
+// and 0-7 for the MCP23S17. The address is sent in the SPI opcode.
+//
+// Hardware addressing is enabled by setting IOCON.HAEN. Until it's set, every
+// device on the chip select responds to address 0, so IOCON is written for
+// all of them. The devices must be in their power on state. The MCP23S09 and
+// MCP23S18 don't have address pins, and can't share a chip select.
+func NewSPIAddress(b spi.Conn, variant Variant, address uint8, opts ...Option) (*Dev, error) {
+	var ioconAddress uint8
+	switch {
+	case variant == MCP23S08 && address < 4:
+		ioconAddress = regIOCON
+	case variant == MCP23S17 && address < 8:
+		ioconAddress = x17Address(regIOCON, 0, false)
+	default:
+		return nil, fmt.Errorf("%s: hardware address %d not supported", variant, address)
+	}
+	// The opcode is sent with hardware address 0, so every device on the
+	// chip select has HAEN set.
+	if err := b.Tx([]byte{spiOpcodeWrite, ioconAddress, 1 << ioconHAEN}, nil); err != nil {
+		return nil, err
+	}
+	devicename := string(variant) + "_" + strconv.Itoa(int(address))
+	ra := &spiRegisterAccess{
+		Conn:      b,
+		hwAddress: address << 1,
+	}
+	dev, err := makeDev(ra, variant, devicename)
+	if err != nil {
+		return nil, err
+	}
+	for ix := range dev.ports {
+		dev.ports[ix].iocon.setCache(1 << ioconHAEN)
+	}
+	return configure(dev, opts)
+}
+
+// Close stops the interrupt and watchdog goroutines, and removes any
+// registration to the device. The pin supplied to SetEdgePin() isn't halted,
+// so Close may wait for a pending WaitForEdge() on it to time out.
+// EnableInterrupt() fails after Close.
 func (d *Dev) Close() error {
+	d.stopInterrupts()
+	d.StopWatchdog()
 	for _, port := range d.Pins {
 		for _, pin := range port {
 			err := gpioreg.Unregister(pin.Name())
@@ -106,74 +200,319 @@ func makeDev(ra registerAccess, variant Variant, devicename string) (*Dev, error
 
 	pins := make([][]Pin, len(ports))
 	for i := range ports {
+		ports[i].openDrain = variant.openDrain()
+		switch {
+		case len(ports) == 1:
+			ports[i].nameRegisters("")
+		case variant == MCP23016:
+			ports[i].nameRegisters(strconv.Itoa(i))
+		default:
+			ports[i].nameRegisters(string(rune('A' + i)))
+		}
 		// pre-cache iodir
 		_, err := ports[i].iodir.readValue(false)
 		if err != nil {
 			return nil, err
 		}
 		pins[i] = ports[i].pins()
+	}
+	// The pins are registered once the device is known to respond.
+	for i := range pins {
 		for _, pin := range pins[i] {
 			// Ignore registration failure.
 			_ = gpioreg.Register(pin)
 		}
 	}
-	return &Dev{
+	dev := &Dev{
 		Pins:    pins,
 		variant: variant,
-	}, nil
+		ra:      ra,
+		ports:   ports,
+		interrupts: interrupts{
+			modes:  make(map[int]ChangeMode),
+			events: make(chan Event, eventBufferSize),
+		},
+	}
+	for i := range ports {
+		ports[i].dev = dev
+	}
+	return dev, nil
+}
+
+// OpenDrain returns true if the outputs of the device are open-drain, which
+// is the case for the MCP23009, MCP23S09, MCP23018 and MCP23S18. An output of
+// these variants pulls the pin low, or releases it when set high, so it's
+// only high if a pull-up is enabled with In(gpio.PullUp, ...) before Out(),
+// or fitted externally. The internal pull-ups apply to outputs as well as
+// inputs. Their function is gpio.OUT_OC rather than gpio.OUT.
+//
+// An LED or relay must be connected between the supply and the pin, so that
+// it's turned on by gpio.Low.
+func (dev *Dev) OpenDrain() bool {
+	return dev.variant.openDrain()
 }
 
 // SetEdgePin supplies a configured GPIO pin
 func (dev *Dev) SetEdgePin(pin *gpio.PinIn) {
+	dev.intMu.Lock()
+	defer dev.intMu.Unlock()
 	dev.edgePin = pin
 }
 
-func (dev *Dev) String() string {
-	return string(dev.variant)
+// hostEdgePin returns the pin supplied to SetEdgePin(), or nil if none was
+// supplied.
+func (dev *Dev) hostEdgePin() gpio.PinIn {
+	dev.intMu.Lock()
+	defer dev.intMu.Unlock()
+	if dev.edgePin == nil {
+		return nil
+	}
+	return *dev.edgePin
 }
 
-func mcp23x178ports(devicename string, ra registerAccess) []port {
-	return []port{{
-		name: devicename + "_PORTA",
-		// GPIO basic registers
-		iodir: ra.define(0x00),
-		gpio:  ra.define(0x12),
-		olat:  ra.define(0x14),
+// SetWriteVerification enables or disables the verification of register
+// writes. When enabled, each configuration and output latch register that's
+// written is read back, and if the value doesn't match, it's written and
+// read once more. If it still doesn't match, a RegisterError wrapping
+// ErrVerifyFailed is returned. This catches corruption on long or noisy bus
+// lines when the write happens, rather than as wrong outputs later, at the
+// cost of a read per write.
+//
+// Registers written together in one transaction are written one at a time
+// instead, so that each is verified, except for WritePortSequence(), whose
+// intermediate values can't be read back, and IOCON when SetBank() changes
+// its address.
+func (dev *Dev) SetWriteVerification(enabled bool) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	dev.verify = enabled
+	for ix := range dev.ports {
+		for _, rc := range dev.ports[ix].cachedRegisters() {
+			rc.verify = enabled
+		}
+	}
+}
 
-		// polarity setting
-		ipol: ra.define(0x02),
+// Refresh reads the configuration and output latch registers of the device,
+// and replaces the driver's cached copies. The driver caches the registers it
+// writes, so that changing a single pin requires one bus transaction. If
+// the device is reset, or written by other software, Refresh must be called
+// to resynchronize the cache. Calling it after the device is created avoids
+// the read of each register on its first use.
+func (dev *Dev) Refresh() error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	for ix := range dev.ports {
+		for _, rc := range dev.ports[ix].cachedRegisters() {
+			if _, err := rc.readValue(false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
 
-		// pull-up control register
-		gppu:          ra.define(0x0C),
-		supportPullup: true,
+// Pin returns the pin number n of the device, or nil if n is out of range.
+// Pins are numbered 0-7 for port A (or the only port), and 8-15 for port B.
+// The returned pin implements gpio.PinIO. Edge detection requires the INT
+// pin of the device to be connected to a host GPIO pin. See SetEdgePin() and
+// WaitForEdge().
+func (dev *Dev) Pin(n int) Pin {
+	if n < 0 || n >= 8*len(dev.Pins) {
+		return nil
+	}
+	return dev.Pins[n/8][n%8]
+}
 
-		// interrupt handling registers
-		gpinten:          ra.define(0x04),
-		intcon:           ra.define(0x08),
-		intf:             ra.define(0x0E),
-		intcap:           ra.define(0x10),
-		supportInterrupt: true,
-	}, {
-		name: devicename + "_PORTB",
-		// GPIO basic registers
-		iodir: ra.define(0x01),
-		gpio:  ra.define(0x13),
-		olat:  ra.define(0x15),
+// ReadGPIOPin returns the level of pin, where true is high. The pin direction
+// is not changed. Pins are numbered as for Pin().
+func (dev *Dev) ReadGPIOPin(pin int) (bool, error) {
+	pp, err := dev.portPin(pin)
+	if err != nil {
+		return false, err
+	}
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	return pp.port.gpio.getBit(pp.pinbit, false)
+}
 
-		// polarity setting
-		ipol:          ra.define(0x03),
-		supportPullup: true,
+// WriteGPIOPin configures pin as an output, and sets its level to high if
+// value is true. Pins are numbered as for Pin().
+func (dev *Dev) WriteGPIOPin(pin int, value bool) error {
+	pp, err := dev.portPin(pin)
+	if err != nil {
+		return err
+	}
+	return pp.Out(gpio.Level(value))
+}
 
-		// pull-up control register
-		gppu: ra.define(0x0D),
+// WriteGPIOMasked sets the output latch bits selected by mask to the bits of
+// value. Bit n is the pin number n, as used by Pin(). Each port with bits in
+// mask is written in one bus transaction, using the cached latch value. The
+// pin directions are not changed.
+func (dev *Dev) WriteGPIOMasked(mask, value uint16) error {
+	return dev.writeMasked(func(p *port) *registerCache { return &p.olat }, mask, value)
+}
 
-		// interrupt handling registers
-		gpinten:          ra.define(0x05),
-		intcon:           ra.define(0x09),
-		intf:             ra.define(0x0F),
-		intcap:           ra.define(0x11),
-		supportInterrupt: true,
-	}}
+// SetDirectionMasked sets the direction of the pins selected by mask. A set
+// bit in inputs configures the pin as an input, and a clear bit as an output.
+func (dev *Dev) SetDirectionMasked(mask, inputs uint16) error {
+	return dev.writeMasked(func(p *port) *registerCache { return &p.iodir }, mask, inputs)
+}
+
+// SetPullupMasked enables the pull-up resistors of the pins selected by mask
+// that are set in value, and disables the others.
+func (dev *Dev) SetPullupMasked(mask, value uint16) error {
+	for ix := range dev.ports {
+		if !dev.ports[ix].supportPullup && uint8(mask>>(8*ix)) != 0 {
+			return errors.New("MCP23xxx: PullUp is not supported by this device")
+		}
+	}
+	return dev.writeMasked(func(p *port) *registerCache { return &p.gppu }, mask, value)
+}
+
+// SetDirections sets the direction of all the pins of the device. Pins with a
+// set bit in outputs are configured as outputs, and the others as inputs.
+// Unlike SetDirectionMasked(), the current directions aren't needed, so no
+// register is read, and the ports of the 16 bit variants are written in one
+// bus transaction. This replaces configuring pins one at a time when the
+// device is brought up.
+func (dev *Dev) SetDirections(outputs uint16) error {
+	return dev.writePorts(func(p *port) *registerCache { return &p.iodir }, ^outputs)
+}
+
+// SetPullups enables the pull-up resistors of the pins set in mask, and
+// disables the others, as SetDirections() does for the directions.
+func (dev *Dev) SetPullups(mask uint16) error {
+	if !dev.ports[0].supportPullup {
+		return errors.New("MCP23xxx: PullUp is not supported by this device")
+	}
+	return dev.writePorts(func(p *port) *registerCache { return &p.gppu }, mask)
+}
+
+// SetInputPolarity sets the input polarity of pin. If inverted is true, the
+// GPIO and interrupt capture registers report the inverted level of the pin,
+// so an active low button reads as high. Interrupt change modes then refer to
+// the inverted level.
+func (dev *Dev) SetInputPolarity(pin int, inverted bool) error {
+	pp, err := dev.portPin(pin)
+	if err != nil {
+		return err
+	}
+	return pp.SetPolarityInverted(inverted)
+}
+
+// SetInputPolarityMasked sets the input polarity of the pins selected by
+// mask. Pins with a set bit in inverted are inverted. See SetInputPolarity().
+func (dev *Dev) SetInputPolarityMasked(mask, inverted uint16) error {
+	return dev.writeMasked(func(p *port) *registerCache { return &p.ipol }, mask, inverted)
+}
+
+// writeMasked updates the bits selected by mask of the register returned by
+// reg for each port.
+func (dev *Dev) writeMasked(reg func(*port) *registerCache, mask, value uint16) error {
+	if mask>>(8*len(dev.ports)) != 0 {
+		return fmt.Errorf("%s: %w in mask 0x%x", dev, ErrInvalidPin, mask)
+	}
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	for ix := range dev.ports {
+		portMask, portValue := uint8(mask>>(8*ix)), uint8(value>>(8*ix))
+		if portMask == 0 {
+			continue
+		}
+		rc := reg(&dev.ports[ix])
+		current, err := rc.readValue(true)
+		if err != nil {
+			return err
+		}
+		if err = rc.writeValue(current&^portMask|portValue&portMask, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writePorts writes value to the register returned by reg for each port,
+// with bit n for pin n. With IOCON.BANK clear, the registers of both ports of
+// a 16 bit variant are adjacent, and are written in one transaction. Bits
+// above the pins of the device are ignored.
+func (dev *Dev) writePorts(reg func(*port) *registerCache, value uint16) error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if dev.is16Bit() && !dev.bank1 && !dev.verify {
+		a, b := reg(&dev.ports[0]), reg(&dev.ports[1])
+		if err := a.writeRegisters(a.address, uint8(value), uint8(value>>8)); err != nil {
+			return a.wrap("write", err)
+		}
+		a.setCache(uint8(value))
+		b.setCache(uint8(value >> 8))
+		return nil
+	}
+	for ix := range dev.ports {
+		if err := reg(&dev.ports[ix]).writeValue(uint8(value>>(8*ix)), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// portPin returns the pin number n, or ErrInvalidPin if it's out of range.
+func (dev *Dev) portPin(n int) (*portpin, error) {
+	pin := dev.Pin(n)
+	if pin == nil {
+		return nil, fmt.Errorf("%s: %w %d", dev, ErrInvalidPin, n)
+	}
+	return pin.(*portpin), nil
+}
+
+// Halt returns all the pins to inputs with the pull-ups disabled, and
+// disables interrupt on change. The interrupt goroutine keeps running, but
+// no events are sent until EnableInterrupt() is called again.
+func (dev *Dev) Halt() error {
+	dev.intMu.Lock()
+	clear(dev.interrupts.modes)
+	dev.intMu.Unlock()
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	for ix := range dev.ports {
+		p := &dev.ports[ix]
+		if p.supportInterrupt {
+			if err := p.gpinten.writeValue(0, false); err != nil {
+				return err
+			}
+		}
+		if err := p.iodir.writeValue(0xFF, false); err != nil {
+			return err
+		}
+		if p.supportPullup {
+			if err := p.gppu.writeValue(0, false); err != nil {
+				return err
+			}
+		}
+		for _, pin := range dev.Pins[ix] {
+			pin.(*portpin).edge = gpio.NoEdge
+		}
+	}
+	return nil
+}
+
+func (dev *Dev) String() string {
+	return fmt.Sprintf("%s{%s}", dev.variant, dev.ra)
+}
+
+func mcp23x178ports(devicename string, ra registerAccess) []port {
+	ports := []port{{name: devicename + "_PORTA"}, {name: devicename + "_PORTB"}}
+	for ix := range ports {
+		p := &ports[ix]
+		for reg, rc := range p.registers() {
+			*rc = ra.define(x17Address(uint8(reg), ix, false))
+		}
+		p.supportPullup = true
+		p.supportInterrupt = true
+		p.supportIOCON = true
+	}
+	return ports
 }
 
 func mcp23x089port(devicename string, ra registerAccess) []port {
@@ -193,10 +532,14 @@ func mcp23x089port(devicename string, ra registerAccess) []port {
 
 		// interrupt handling registers
 		gpinten:          ra.define(0x02),
+		defval:           ra.define(0x03),
 		intcon:           ra.define(0x04),
 		intf:             ra.define(0x07),
 		intcap:           ra.define(0x08),
 		supportInterrupt: true,
+
+		iocon:        ra.define(0x05),
+		supportIOCON: true,
 	}}
 }
 
@@ -235,3 +578,5 @@ func mcp23x16ports(devicename string, ra registerAccess) []port {
 		intcap:           ra.define(0x09),
 	}}
 }
+
+var _ conn.Resource = &Dev{}
@@ -0,0 +1,331 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package mcp23xxxtest simulates MCP23xxx devices, so that drivers built on
+// top of them, such as character display backpacks and keypads, can be
+// tested without hardware.
+package mcp23xxxtest
+
+import (
+	"fmt"
+	"sync"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Registers of the MCP23008.
+const (
+	IODIR = iota
+	IPOL
+	GPINTEN
+	DEFVAL
+	INTCON
+	IOCON
+	GPPU
+	INTF
+	INTCAP
+	GPIO
+	OLAT
+	numRegisters
+)
+
+const (
+	ioconINTPOL = 1 << 1
+	ioconSEQOP  = 1 << 5
+	// ioconMask is the implemented bits of IOCON.
+	ioconMask = 0x3e
+)
+
+// MCP23008 simulates an MCP23008 as an i2c.Bus. It answers transactions to
+// its address, and returns an error for other addresses, as a missing device
+// would.
+//
+// The register file implements the sequential addressing of IOCON.SEQOP, the
+// direction, polarity and pull-up semantics of the pins, and interrupt on
+// change. The level of an input is the level it's driven to with Drive(), or
+// of the outputs it's connected to with Connect(), or high if its pull-up is
+// enabled, or low.
+//
+// All the transactions are recorded, so that tests can compare them with an
+// expected sequence byte for byte.
+type MCP23008 struct {
+	addr uint16
+
+	mu     sync.Mutex
+	regs   [numRegisters]uint8
+	driven uint8
+	drive  uint8
+	// links are the pins connected to each pin by Connect().
+	links [8]uint8
+	// last is the level of the pins when interrupts were last evaluated.
+	last     uint8
+	ops      []i2ctest.IO
+	onInt    func()
+	intPin   *gpiotest.Pin
+	asserted bool
+}
+
+// NewMCP23008 returns a simulated MCP23008 at addr, with the registers in
+// their power on state.
+func NewMCP23008(addr uint16) *MCP23008 {
+	s := &MCP23008{
+		addr:   addr,
+		intPin: &gpiotest.Pin{N: fmt.Sprintf("MCP23008_%x_INT", addr), L: gpio.High, EdgesChan: make(chan gpio.Level, 1)},
+	}
+	s.regs[IODIR] = 0xff
+	return s
+}
+
+// String implements i2c.Bus.
+func (s *MCP23008) String() string {
+	return fmt.Sprintf("MCP23008Sim(0x%x)", s.addr)
+}
+
+// SetSpeed implements i2c.Bus.
+func (s *MCP23008) SetSpeed(f physic.Frequency) error {
+	return nil
+}
+
+// Tx implements i2c.Bus. w starts with the register address, followed by the
+// values written.
+func (s *MCP23008) Tx(addr uint16, w, r []byte) error {
+	s.mu.Lock()
+	if addr != s.addr {
+		s.mu.Unlock()
+		return fmt.Errorf("%s: no device at address 0x%x", s, addr)
+	}
+	if len(w) == 0 {
+		s.mu.Unlock()
+		return fmt.Errorf("%s: missing register address", s)
+	}
+	reg := w[0]
+	if reg >= numRegisters {
+		s.mu.Unlock()
+		return fmt.Errorf("%s: invalid register 0x%x", s, reg)
+	}
+	for _, v := range w[1:] {
+		s.write(reg, v)
+		reg = s.next(reg)
+	}
+	for ix := range r {
+		r[ix] = s.read(reg)
+		reg = s.next(reg)
+	}
+	s.ops = append(s.ops, i2ctest.IO{Addr: addr, W: append([]byte(nil), w...), R: append([]byte(nil), r...)})
+	notify := s.evaluate()
+	s.mu.Unlock()
+	notify()
+	return nil
+}
+
+// Drive drives input pin to l, as a button or another device would.
+func (s *MCP23008) Drive(pin int, l gpio.Level) {
+	s.mu.Lock()
+	s.driven |= 1 << pin
+	s.drive = s.drive&^(1<<pin) | levelBits(l, 1<<pin)
+	notify := s.evaluate()
+	s.mu.Unlock()
+	notify()
+}
+
+// Release stops driving pin.
+func (s *MCP23008) Release(pin int) {
+	s.mu.Lock()
+	s.driven &^= 1 << pin
+	notify := s.evaluate()
+	s.mu.Unlock()
+	notify()
+}
+
+// Connect connects pins a and b, as a key of a matrix keypad does. An input
+// connected to outputs is low if any of them is low. Connecting a pin to
+// itself has no effect.
+func (s *MCP23008) Connect(a, b int) {
+	s.mu.Lock()
+	s.links[a] |= 1 << b
+	s.links[b] |= 1 << a
+	notify := s.evaluate()
+	s.mu.Unlock()
+	notify()
+}
+
+// Disconnect disconnects pins a and b, connected by Connect().
+func (s *MCP23008) Disconnect(a, b int) {
+	s.mu.Lock()
+	s.links[a] &^= 1 << b
+	s.links[b] &^= 1 << a
+	notify := s.evaluate()
+	s.mu.Unlock()
+	notify()
+}
+
+// Level returns the level of pin, as measured on the device.
+func (s *MCP23008) Level(pin int) gpio.Level {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.levels()&(1<<pin) != 0
+}
+
+// Register returns the value of reg, without the side effects of reading it
+// over the bus.
+func (s *MCP23008) Register(reg int) uint8 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if reg == GPIO {
+		return s.gpio()
+	}
+	return s.regs[reg]
+}
+
+// Ops returns the transactions received since the device was created, or
+// since the last call to ClearOps().
+func (s *MCP23008) Ops() []i2ctest.IO {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]i2ctest.IO(nil), s.ops...)
+}
+
+// ClearOps clears the recorded transactions.
+func (s *MCP23008) ClearOps() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ops = nil
+}
+
+// OnInterrupt sets a function called each time the INT output is asserted.
+// It's called without locks held, and can access the device.
+func (s *MCP23008) OnInterrupt(f func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onInt = f
+}
+
+// Interrupt returns true while the INT output is asserted.
+func (s *MCP23008) Interrupt() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.asserted
+}
+
+// INT returns a host pin connected to the INT output, which can be supplied
+// to SetEdgePin(). An edge is signalled each time INT is asserted, at the
+// level selected by IOCON.INTPOL.
+func (s *MCP23008) INT() gpio.PinIn {
+	return s.intPin
+}
+
+// write writes a register over the bus. s.mu must be held.
+func (s *MCP23008) write(reg, v uint8) {
+	switch reg {
+	case INTF, INTCAP:
+		// Read only.
+	case GPIO:
+		s.regs[OLAT] = v
+	case IOCON:
+		s.regs[IOCON] = v & ioconMask
+	default:
+		s.regs[reg] = v
+	}
+}
+
+// read reads a register over the bus. Reading GPIO or INTCAP clears the
+// interrupt. s.mu must be held.
+func (s *MCP23008) read(reg uint8) uint8 {
+	switch reg {
+	case GPIO:
+		v := s.gpio()
+		s.regs[INTF] = 0
+		s.asserted = false
+		return v
+	case INTCAP:
+		v := s.regs[INTCAP]
+		s.regs[INTF] = 0
+		s.asserted = false
+		return v
+	default:
+		return s.regs[reg]
+	}
+}
+
+// next returns the register read or written after reg in a transaction.
+// s.mu must be held.
+func (s *MCP23008) next(reg uint8) uint8 {
+	if s.regs[IOCON]&ioconSEQOP != 0 {
+		return reg
+	}
+	return (reg + 1) % numRegisters
+}
+
+// levels returns the level of the pins. s.mu must be held.
+func (s *MCP23008) levels() uint8 {
+	outputs := ^s.regs[IODIR]
+	v := s.regs[OLAT] & outputs
+	for pin := range 8 {
+		bit := uint8(1) << pin
+		switch {
+		case outputs&bit != 0:
+		case s.driven&bit != 0:
+			v |= s.drive & bit
+		case s.links[pin]&outputs != 0:
+			if s.links[pin]&outputs&^s.regs[OLAT] == 0 {
+				v |= bit
+			}
+		case s.regs[GPPU]&bit != 0:
+			v |= bit
+		}
+	}
+	return v
+}
+
+// gpio returns the value of the GPIO register. s.mu must be held.
+func (s *MCP23008) gpio() uint8 {
+	return s.levels() ^ (s.regs[IPOL] & s.regs[IODIR])
+}
+
+// evaluate flags interrupt on change, and returns a function that notifies
+// the INT pin and the OnInterrupt() function if INT was asserted. s.mu must
+// be held.
+func (s *MCP23008) evaluate() func() {
+	levels := s.levels()
+	enabled := s.regs[GPINTEN] & s.regs[IODIR]
+	compare := s.regs[INTCON]
+	flags := enabled & ^compare & (levels ^ s.last)
+	flags |= enabled & compare & (levels ^ s.regs[DEFVAL])
+	s.last = levels
+	if flags != 0 {
+		if s.regs[INTF] == 0 {
+			s.regs[INTCAP] = s.gpio()
+		}
+		s.regs[INTF] |= flags
+	}
+	wasAsserted := s.asserted
+	s.asserted = s.regs[INTF] != 0
+	if !s.asserted || wasAsserted {
+		return func() {}
+	}
+	f := s.onInt
+	level := gpio.Level(s.regs[IOCON]&ioconINTPOL != 0)
+	return func() {
+		select {
+		case s.intPin.EdgesChan <- level:
+		default:
+		}
+		if f != nil {
+			f()
+		}
+	}
+}
+
+func levelBits(l gpio.Level, bits uint8) uint8 {
+	if l {
+		return bits
+	}
+	return 0
+}
+
+var _ i2c.Bus = &MCP23008{}
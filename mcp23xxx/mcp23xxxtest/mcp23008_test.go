@@ -0,0 +1,112 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxxtest_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/devices/v3/mcp23xxx"
+	"periph.io/x/devices/v3/mcp23xxx/mcp23xxxtest"
+)
+
+func newDev(t *testing.T) (*mcp23xxx.Dev, *mcp23xxxtest.MCP23008) {
+	sim := mcp23xxxtest.NewMCP23008(0x20)
+	dev, err := mcp23xxx.NewI2C(sim, mcp23xxx.MCP23008, 0x20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = dev.Close() })
+	return dev, sim
+}
+
+func TestMCP23008_pins(t *testing.T) {
+	dev, sim := newDev(t)
+	if _, err := mcp23xxx.NewI2C(sim, mcp23xxx.MCP23008, 0x21); err == nil {
+		t.Error("expected error for a missing device")
+	}
+	sim.ClearOps()
+	if err := dev.Pin(2).Out(gpio.High); err != nil {
+		t.Fatal(err)
+	}
+	if !sim.Level(2) || sim.Register(mcp23xxxtest.IODIR) != 0xfb {
+		t.Errorf("unexpected pin level %s IODIR 0x%x", sim.Level(2), sim.Register(mcp23xxxtest.IODIR))
+	}
+	// The direction is set, then the output latch is read and written.
+	expected := [][]byte{{mcp23xxxtest.IODIR, 0xfb}, {mcp23xxxtest.OLAT}, {mcp23xxxtest.OLAT, 0x04}}
+	ops := sim.Ops()
+	if len(ops) != len(expected) {
+		t.Fatalf("unexpected transactions %v", ops)
+	}
+	for ix := range ops {
+		if !bytes.Equal(ops[ix].W, expected[ix]) {
+			t.Errorf("transaction %d wrote % x, expected % x", ix, ops[ix].W, expected[ix])
+		}
+	}
+	p := dev.Pin(5)
+	if err := p.In(gpio.PullUp, gpio.NoEdge); err != nil {
+		t.Fatal(err)
+	}
+	if l := p.Read(); l != gpio.High {
+		t.Errorf("pulled up pin read %s", l)
+	}
+	sim.Drive(5, gpio.Low)
+	if l := p.Read(); l != gpio.Low {
+		t.Errorf("driven pin read %s", l)
+	}
+	if err := dev.SetInputPolarity(5, true); err != nil {
+		t.Fatal(err)
+	}
+	if l := p.Read(); l != gpio.High {
+		t.Errorf("inverted pin read %s", l)
+	}
+}
+
+func TestMCP23008_interrupt(t *testing.T) {
+	dev, sim := newDev(t)
+	intPin := sim.INT()
+	dev.SetEdgePin(&intPin)
+	if err := dev.Pin(1).In(gpio.PullUp, gpio.NoEdge); err != nil {
+		t.Fatal(err)
+	}
+	if err := dev.EnableInterrupt(1, mcp23xxx.ChangeFalling); err != nil {
+		t.Fatal(err)
+	}
+	sim.Drive(1, gpio.Low)
+	select {
+	case ev := <-dev.Events():
+		if ev.Pin != 1 || ev.Level != gpio.Low {
+			t.Errorf("unexpected event %#v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no event")
+	}
+	if sim.Interrupt() {
+		t.Error("interrupt not cleared")
+	}
+}
+
+func TestMCP23008_keypad(t *testing.T) {
+	dev, sim := newDev(t)
+	k, err := mcp23xxx.NewKeypad(dev, []int{0, 1}, []int{4, 5, 6})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The key at row 1, column 2.
+	sim.Connect(1, 6)
+	events, err := k.Scan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Row != 1 || events[0].Col != 2 || !events[0].Pressed {
+		t.Errorf("unexpected events %v", events)
+	}
+	sim.Disconnect(1, 6)
+	if events, err = k.Scan(); err != nil || len(events) != 1 || events[0].Pressed {
+		t.Errorf("unexpected events %v, %v", events, err)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// Option is a configuration option passed to NewI2C(), NewSPI() and
+// NewSPIAddress(). Each option is the equivalent of a setter called after
+// the device is created.
+type Option func(*options)
+
+type options struct {
+	edgePin gpio.PinIn
+	poll    time.Duration
+	verify  bool
+}
+
+// WithEdgePin supplies the host pin connected to the INT pin of the device.
+// See SetEdgePin().
+func WithEdgePin(pin gpio.PinIn) Option {
+	return func(o *options) {
+		o.edgePin = pin
+	}
+}
+
+// WithInterruptPolling makes EnableInterrupt() poll the interrupt flags
+// every interval. See SetInterruptPolling().
+func WithInterruptPolling(interval time.Duration) Option {
+	return func(o *options) {
+		o.poll = interval
+	}
+}
+
+// WithWriteVerification enables the verification of register writes. See
+// SetWriteVerification().
+func WithWriteVerification() Option {
+	return func(o *options) {
+		o.verify = true
+	}
+}
+
+// configure applies opts to the device just created. If an option fails, the
+// device is closed.
+func configure(dev *Dev, opts []Option) (*Dev, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.edgePin != nil {
+		dev.SetEdgePin(&o.edgePin)
+	}
+	if o.poll != 0 {
+		if err := dev.SetInterruptPolling(o.poll); err != nil {
+			_ = dev.Close()
+			return nil, err
+		}
+	}
+	if o.verify {
+		dev.SetWriteVerification(true)
+	}
+	return dev, nil
+}
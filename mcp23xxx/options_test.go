@@ -0,0 +1,33 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOptions(t *testing.T) {
+	bus := &registerBus{}
+	bus.regs[regIODIR] = 0xff
+	ip := newIntPin()
+	dev, err := NewI2C(bus, MCP23008, 0x20, WithEdgePin(ip), WithInterruptPolling(time.Millisecond), WithWriteVerification())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	if dev.hostEdgePin() != ip {
+		t.Error("edge pin not set")
+	}
+	if dev.interrupts.poll != time.Millisecond {
+		t.Errorf("polling interval %s", dev.interrupts.poll)
+	}
+	if !dev.verify {
+		t.Error("write verification not enabled")
+	}
+	if _, err := NewI2C(bus, MCP23008, 0x21, WithInterruptPolling(-time.Second)); err == nil {
+		t.Error("expected error for a negative polling interval")
+	}
+}
@@ -5,6 +5,7 @@
 package mcp23xxx
 
 import (
+	"context"
 	"errors"
 	"strconv"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/physic"
 	"periph.io/x/conn/v3/pin"
+	"periph.io/x/devices/v3/internal/wait"
 )
 
 // Pin extends gpio.PinIO interface with features supported by MCP23xxx devices.
@@ -21,10 +23,14 @@ type Pin interface {
 	SetPolarityInverted(p bool) error
 	// IsPolarityInverted returns true if the value of the input pin reflects inverted logic state.
 	IsPolarityInverted() (bool, error)
+	// WaitForEdgeContext is WaitForEdge(-1), returning false when ctx is
+	// done.
+	WaitForEdgeContext(ctx context.Context) bool
 }
 
 type port struct {
 	name string
+	dev  *Dev
 
 	// GPIO basic registers
 	iodir registerCache
@@ -42,14 +48,66 @@ type port struct {
 	// interrupt handling registers
 	supportInterrupt bool
 	gpinten          registerCache
+	defval           registerCache
 	intcon           registerCache
 	intf             registerCache
 	intcap           registerCache
+
+	// configuration register. Not present on the MCP23016.
+	iocon        registerCache
+	supportIOCON bool
+
+	// openDrain is true if the outputs can only pull low. See
+	// Dev.OpenDrain().
+	openDrain bool
+}
+
+// registers returns the port registers indexed by their MCP23x08 register
+// address.
+func (p *port) registers() []*registerCache {
+	return []*registerCache{&p.iodir, &p.ipol, &p.gpinten, &p.defval, &p.intcon,
+		&p.iocon, &p.gppu, &p.intf, &p.intcap, &p.gpio, &p.olat}
+}
+
+// cachedRegisters returns the registers of the port whose values are cached
+// by the driver. The GPIO and interrupt flag and capture registers change
+// without being written, so they're not included.
+func (p *port) cachedRegisters() []*registerCache {
+	regs := []*registerCache{&p.iodir, &p.ipol}
+	if p.supportPullup {
+		regs = append(regs, &p.gppu)
+	}
+	if p.supportInterrupt {
+		regs = append(regs, &p.gpinten, &p.defval, &p.intcon)
+	}
+	if p.supportIOCON {
+		regs = append(regs, &p.iocon)
+	}
+	return append(regs, &p.olat)
+}
+
+// nameRegisters sets the names of the port registers used in errors. suffix
+// is the port identifier appended to the datasheet names.
+func (p *port) nameRegisters(suffix string) {
+	for _, reg := range []struct {
+		rc   *registerCache
+		name string
+	}{
+		{&p.iodir, "IODIR"}, {&p.ipol, "IPOL"}, {&p.gpinten, "GPINTEN"},
+		{&p.defval, "DEFVAL"}, {&p.intcon, "INTCON"}, {&p.iocon, "IOCON"},
+		{&p.gppu, "GPPU"}, {&p.intf, "INTF"}, {&p.intcap, "INTCAP"},
+		{&p.gpio, "GPIO"}, {&p.olat, "OLAT"},
+	} {
+		reg.rc.name = reg.name + suffix
+	}
 }
 
 type portpin struct {
 	port   *port
 	pinbit uint8
+	// edge is the edge passed to In(). If it's not gpio.NoEdge, interrupt on
+	// change is enabled for the pin.
+	edge gpio.Edge
 }
 
 func (p *port) pins() []Pin {
@@ -69,8 +127,10 @@ func (p *portpin) String() string {
 }
 
 func (p *portpin) Halt() error {
+	p.port.dev.mu.Lock()
+	defer p.port.dev.mu.Unlock()
 	// To halt all drive, set to high-impedance input
-	return p.In(gpio.Float, gpio.NoEdge)
+	return p.in(gpio.Float, gpio.NoEdge)
 }
 
 func (p *portpin) Name() string {
@@ -81,20 +141,28 @@ func (p *portpin) Number() int {
 	return int(p.pinbit)
 }
 
+// Function returns the function of the pin, followed by its pull for inputs
+// and open-drain outputs, for example "IN/PullUp".
 func (p *portpin) Function() string {
-	return string(p.Func())
+	f := p.Func()
+	if f == gpio.OUT {
+		return string(f)
+	}
+	return string(f) + "/" + p.Pull().String()
 }
 
 func (p *portpin) In(pull gpio.Pull, edge gpio.Edge) error {
-	// Set pin to input
-	err := p.port.iodir.getAndSetBit(p.pinbit, true, true)
-	if err != nil {
-		return err
-	}
-	// Set pullup
+	p.port.dev.mu.Lock()
+	defer p.port.dev.mu.Unlock()
+	return p.in(pull, edge)
+}
+
+// in configures the pin as an input. gpio.PullUp enables the pull-up of the
+// pin, gpio.Float disables it, and gpio.PullNoChange leaves it as is. The
+// pull is checked before the pin is changed.
+func (p *portpin) in(pull gpio.Pull, edge gpio.Edge) error {
 	switch pull {
-	case gpio.PullNoChange:
-		// don't check, don't change
+	case gpio.PullNoChange, gpio.Float:
 	case gpio.PullDown:
 		// pull down is not supported by any device
 		return errors.New("MCP23xxx: PullDown is not supported")
@@ -102,24 +170,48 @@ func (p *portpin) In(pull gpio.Pull, edge gpio.Edge) error {
 		if !p.port.supportPullup {
 			return errors.New("MCP23xxx: PullUp is not supported by this device")
 		}
-		err = p.port.gppu.getAndSetBit(p.pinbit, true, true)
-		if err != nil {
+	default:
+		return errors.New("MCP23xxx: Pull not supported: " + pull.String())
+	}
+	// Set pin to input
+	err := p.port.iodir.getAndSetBit(p.pinbit, true, true)
+	if err != nil {
+		return err
+	}
+	// Set pullup
+	if pull == gpio.PullUp || (pull == gpio.Float && p.port.supportPullup) {
+		if err = p.port.gppu.getAndSetBit(p.pinbit, pull == gpio.PullUp, true); err != nil {
 			return err
 		}
-	case gpio.Float:
-		if p.port.supportPullup {
-			err = p.port.gppu.getAndSetBit(p.pinbit, false, true)
-			if err != nil {
+	}
+	return p.setEdge(edge)
+}
+
+// setEdge enables interrupt on change for the pin if edge is not
+// gpio.NoEdge. The MCP23xxx interrupts on any change, so rising and falling
+// edges are distinguished by the captured value of the pin.
+func (p *portpin) setEdge(edge gpio.Edge) error {
+	if edge != gpio.NoEdge && !p.port.supportInterrupt {
+		return errors.New("MCP23xxx: edge detection is not supported by this device")
+	}
+	if p.port.supportInterrupt && (edge != gpio.NoEdge || p.edge != gpio.NoEdge) {
+		if edge != gpio.NoEdge {
+			// Compare against the previous value of the pin.
+			if err := p.port.intcon.getAndSetBit(p.pinbit, false, true); err != nil {
 				return err
 			}
 		}
+		if err := p.port.gpinten.getAndSetBit(p.pinbit, edge != gpio.NoEdge, true); err != nil {
+			return err
+		}
 	}
-	// check edge detection
-	// TODO interrupt support
+	p.edge = edge
 	return nil
 }
 
 func (p *portpin) Read() gpio.Level {
+	p.port.dev.mu.Lock()
+	defer p.port.dev.mu.Unlock()
 	v, _ := p.port.gpio.getBit(p.pinbit, false)
 	if v {
 		return gpio.High
@@ -127,12 +219,96 @@ func (p *portpin) Read() gpio.Level {
 	return gpio.Low
 }
 
+// WaitForEdge waits for the edge passed to In(). The MCP23xxx signals
+// interrupts using its INT pin, which must be connected to a host GPIO pin
+// configured for falling edge detection, and supplied to Dev.SetEdgePin().
+// If no edge pin was supplied, or In() wasn't called with an edge,
+// WaitForEdge returns false.
+//
+// Reading the interrupt capture register clears the interrupt for all pins
+// of the port, so only one pin per port should be waited on at a time. For
+// the same reason, WaitForEdge returns false while the edge pin is used by
+// Dev.EnableInterrupt() or Keypad.Start().
 func (p *portpin) WaitForEdge(timeout time.Duration) bool {
-	// TODO interrupt handling
-	return false
+	return p.waitForEdge(context.Background(), timeout)
+}
+
+// WaitForEdgeContext waits for the edge passed to In(), as WaitForEdge(-1),
+// until ctx is done.
+func (p *portpin) WaitForEdgeContext(ctx context.Context) bool {
+	return p.waitForEdge(ctx, -1)
+}
+
+func (p *portpin) waitForEdge(ctx context.Context, timeout time.Duration) bool {
+	p.port.dev.mu.Lock()
+	edge := p.edge
+	p.port.dev.mu.Unlock()
+	if edge == gpio.NoEdge {
+		return false
+	}
+	edgePin, err := p.port.dev.acquireEdgePin(edgeUserWaitForEdge)
+	if err != nil || edgePin == nil {
+		return false
+	}
+	defer p.port.dev.releaseEdgePin()
+	var deadline time.Time
+	if timeout >= 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	for {
+		// An interrupt may be pending from before the wait started. The
+		// INT pin stays asserted until it's cleared, so no edge would be
+		// seen on the host pin.
+		if matched, err := p.checkInterrupt(); err != nil {
+			return false
+		} else if matched {
+			return true
+		}
+		remaining := time.Duration(-1)
+		if timeout >= 0 {
+			if remaining = time.Until(deadline); remaining <= 0 {
+				return false
+			}
+		}
+		if !wait.Edge(ctx, edgePin, remaining) {
+			return false
+		}
+	}
+}
+
+// pendingInterrupt reads the interrupt flags of the port. If any are set, the
+// interrupt capture register is read, which clears the interrupt.
+func (p *port) pendingInterrupt() (intf, captured uint8, err error) {
+	if intf, err = p.intf.readValue(false); err != nil || intf == 0 {
+		return
+	}
+	captured, err = p.intcap.readValue(false)
+	return
+}
+
+// checkInterrupt reads the interrupt flags of the port. If any are set, the
+// interrupt is cleared by reading the capture register. It returns true if
+// the pin caused the interrupt with the edge passed to In().
+func (p *portpin) checkInterrupt() (bool, error) {
+	p.port.dev.mu.Lock()
+	defer p.port.dev.mu.Unlock()
+	intf, captured, err := p.port.pendingInterrupt()
+	if err != nil || intf&(1<<p.pinbit) == 0 {
+		return false, err
+	}
+	high := captured&(1<<p.pinbit) != 0
+	switch p.edge {
+	case gpio.RisingEdge:
+		return high, nil
+	case gpio.FallingEdge:
+		return !high, nil
+	}
+	return true, nil
 }
 
 func (p *portpin) Pull() gpio.Pull {
+	p.port.dev.mu.Lock()
+	defer p.port.dev.mu.Unlock()
 	if !p.port.supportPullup {
 		return gpio.Float
 	}
@@ -150,7 +326,12 @@ func (p *portpin) DefaultPull() gpio.Pull {
 	return gpio.Float
 }
 
+// Out configures the pin as an output. For the open-drain variants, setting
+// l to gpio.High releases the pin, and the pull-up set by In() is left
+// enabled, so that the pin is pulled high.
 func (p *portpin) Out(l gpio.Level) error {
+	p.port.dev.mu.Lock()
+	defer p.port.dev.mu.Unlock()
 	err := p.port.iodir.getAndSetBit(p.pinbit, false, true)
 	if err != nil {
 		return err
@@ -163,23 +344,35 @@ func (p *portpin) PWM(duty gpio.Duty, f physic.Frequency) error {
 }
 
 func (p *portpin) Func() pin.Func {
+	p.port.dev.mu.Lock()
+	defer p.port.dev.mu.Unlock()
 	v, _ := p.port.iodir.getBit(p.pinbit, true)
 	if v {
 		return gpio.IN
 	}
-	return gpio.OUT
+	return p.port.outFunc()
 }
 
 func (p *portpin) SupportedFuncs() []pin.Func {
-	return supportedFuncs[:]
+	return []pin.Func{gpio.IN, p.port.outFunc()}
+}
+
+// outFunc returns the function of an output pin of the port.
+func (p *port) outFunc() pin.Func {
+	if p.openDrain {
+		return gpio.OUT_OC
+	}
+	return gpio.OUT
 }
 
 func (p *portpin) SetFunc(f pin.Func) error {
+	p.port.dev.mu.Lock()
+	defer p.port.dev.mu.Unlock()
 	var v bool
 	switch f {
 	case gpio.IN:
 		v = true
-	case gpio.OUT:
+	case p.port.outFunc():
 		v = false
 	default:
 		return errors.New("MCP23xxx: Function not supported: " + string(f))
@@ -188,10 +381,12 @@ func (p *portpin) SetFunc(f pin.Func) error {
 }
 
 func (p *portpin) SetPolarityInverted(pol bool) error {
+	p.port.dev.mu.Lock()
+	defer p.port.dev.mu.Unlock()
 	return p.port.ipol.getAndSetBit(p.pinbit, pol, true)
 }
 func (p *portpin) IsPolarityInverted() (bool, error) {
+	p.port.dev.mu.Lock()
+	defer p.port.dev.mu.Unlock()
 	return p.port.ipol.getBit(p.pinbit, true)
 }
-
-var supportedFuncs = [...]pin.Func{gpio.IN, gpio.OUT}
@@ -5,14 +5,58 @@
 package mcp23xxx
 
 import (
+	"errors"
+	"fmt"
+
 	"periph.io/x/conn/v3/i2c"
 	"periph.io/x/conn/v3/spi"
 )
 
+var (
+	// ErrInvalidPin is returned when a pin number is out of range for the
+	// device.
+	ErrInvalidPin = errors.New("MCP23xxx: invalid pin")
+	// ErrInvalidPort is returned when a port number is out of range for the
+	// device.
+	ErrInvalidPort = errors.New("MCP23xxx: invalid port")
+	// ErrVerifyFailed is wrapped in the RegisterError returned when write
+	// verification is enabled, and a register doesn't read back the value
+	// written to it. See Dev.SetWriteVerification().
+	ErrVerifyFailed = errors.New("MCP23xxx: register verification failed")
+	// ErrDeviceNotFound is returned by NewI2C() when the device doesn't
+	// respond at its address. It wraps the RegisterError of the first read.
+	ErrDeviceNotFound = errors.New("MCP23xxx: device not found")
+)
+
+// RegisterError is returned when a register of the device can't be read or
+// written. Err is the error returned by the bus.
+type RegisterError struct {
+	// Op is "read" or "write".
+	Op string
+	// Register is the datasheet name of the register, for example "IODIRA".
+	Register string
+	Err      error
+}
+
+func (e *RegisterError) Error() string {
+	return fmt.Sprintf("MCP23xxx: %s %s: %v", e.Op, e.Register, e.Err)
+}
+
+func (e *RegisterError) Unwrap() error {
+	return e.Err
+}
+
 type registerAccess interface {
+	fmt.Stringer
 	define(address uint8) registerCache
 	readRegister(address uint8) (uint8, error)
 	writeRegister(address uint8, value uint8) error
+	// readRegisters reads len(r) consecutive registers starting at address.
+	// IOCON.SEQOP must be clear.
+	readRegisters(address uint8, r []uint8) error
+	// writeRegisters writes values starting at address. The address is
+	// incremented after each value unless IOCON.SEQOP is set.
+	writeRegisters(address uint8, values ...uint8) error
 }
 
 type i2cRegisterAccess struct {
@@ -29,22 +73,47 @@ func (ra *i2cRegisterAccess) writeRegister(address uint8, value uint8) error {
 	return ra.Tx([]byte{address, value}, nil)
 }
 
+func (ra *i2cRegisterAccess) readRegisters(address uint8, r []uint8) error {
+	return ra.Tx([]byte{address}, r)
+}
+
+func (ra *i2cRegisterAccess) writeRegisters(address uint8, values ...uint8) error {
+	return ra.Tx(append([]byte{address}, values...), nil)
+}
+
 func (ra *i2cRegisterAccess) define(address uint8) registerCache {
 	return newRegister(ra, address)
 }
 
+// The SPI opcode is 0x40 | (hardware address << 1) | read.
+const (
+	spiOpcodeWrite uint8 = 0x40
+	spiOpcodeRead  uint8 = 0x41
+)
+
 type spiRegisterAccess struct {
 	spi.Conn
+	// hwAddress is the hardware address of the device, shifted into the
+	// opcode position. It's 0 unless IOCON.HAEN is set.
+	hwAddress uint8
 }
 
 func (ra *spiRegisterAccess) readRegister(address uint8) (uint8, error) {
 	r := make([]byte, 1)
-	err := ra.Tx([]byte{0x41, address}, r)
+	err := ra.Tx([]byte{spiOpcodeRead | ra.hwAddress, address}, r)
 	return r[0], err
 }
 
 func (ra *spiRegisterAccess) writeRegister(address uint8, value uint8) error {
-	return ra.Tx([]byte{0x40, address, value}, nil)
+	return ra.Tx([]byte{spiOpcodeWrite | ra.hwAddress, address, value}, nil)
+}
+
+func (ra *spiRegisterAccess) readRegisters(address uint8, r []uint8) error {
+	return ra.Tx([]byte{spiOpcodeRead | ra.hwAddress, address}, r)
+}
+
+func (ra *spiRegisterAccess) writeRegisters(address uint8, values ...uint8) error {
+	return ra.Tx(append([]byte{spiOpcodeWrite | ra.hwAddress, address}, values...), nil)
 }
 
 func (ra *spiRegisterAccess) define(address uint8) registerCache {
@@ -53,9 +122,13 @@ func (ra *spiRegisterAccess) define(address uint8) registerCache {
 
 type registerCache struct {
 	registerAccess
+	// name is the datasheet name of the register, used in errors.
+	name    string
 	address uint8
 	got     bool
 	cache   uint8
+	// verify is true if writes are read back. See Dev.SetWriteVerification().
+	verify bool
 }
 
 func newRegister(ra registerAccess, address uint8) registerCache {
@@ -71,11 +144,12 @@ func (r *registerCache) readValue(cached bool) (uint8, error) {
 		return r.cache, nil
 	}
 	v, err := r.readRegister(r.address)
-	if err == nil {
-		r.got = true
-		r.cache = v
+	if err != nil {
+		return v, r.wrap("read", err)
 	}
-	return v, err
+	r.got = true
+	r.cache = v
+	return v, nil
 }
 
 func (r *registerCache) writeValue(value uint8, cached bool) error {
@@ -85,13 +159,49 @@ func (r *registerCache) writeValue(value uint8, cached bool) error {
 
 	err := r.writeRegister(r.address, value)
 	if err != nil {
-		return err
+		return r.wrap("write", err)
+	}
+	if r.verify {
+		if err = r.verifyValue(value); err != nil {
+			return err
+		}
 	}
 	r.got = true
 	r.cache = value
 	return nil
 }
 
+// verifyValue reads the register back after value was written. If it
+// doesn't match, the value is written and read once more.
+func (r *registerCache) verifyValue(value uint8) error {
+	for retry := 0; ; retry++ {
+		got, err := r.readRegister(r.address)
+		if err != nil {
+			return r.wrap("read", err)
+		}
+		if got == value {
+			return nil
+		}
+		if retry > 0 {
+			return r.wrap("verify", fmt.Errorf("%w: wrote 0x%02x, read 0x%02x", ErrVerifyFailed, value, got))
+		}
+		if err = r.writeRegister(r.address, value); err != nil {
+			return r.wrap("write", err)
+		}
+	}
+}
+
+// wrap returns err as a RegisterError for the register.
+func (r *registerCache) wrap(op string, err error) error {
+	return &RegisterError{Op: op, Register: r.name, Err: err}
+}
+
+// setCache sets the cached value after the register was accessed directly.
+func (r *registerCache) setCache(value uint8) {
+	r.got = true
+	r.cache = value
+}
+
 func (r *registerCache) getAndSetBit(bit uint8, value bool, cached bool) error {
 	v, err := r.readValue(cached)
 	if err != nil {
@@ -0,0 +1,236 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// InterruptService reads the interrupts of several devices whose INT pins
+// are connected to a few host pins, for example with a wired-or of the
+// open-drain INT outputs of all the devices on a bus. Each time an edge is
+// seen on a host pin, the interrupt flags of every device connected to it
+// are read, and the changes are sent to the Events() channel of the device
+// they belong to, as if the device had started its own interrupt goroutine.
+//
+// A goroutine is started for each host pin, rather than for each device.
+type InterruptService struct {
+	mu    sync.Mutex
+	lines []serviceLine
+	stop  chan struct{}
+	wg    sync.WaitGroup
+}
+
+// serviceLine is a host pin, and the devices whose INT pins are connected to
+// it.
+type serviceLine struct {
+	pin  gpio.PinIn
+	devs []*Dev
+}
+
+// NewInterruptService returns an InterruptService with no devices.
+func NewInterruptService() *InterruptService {
+	return &InterruptService{}
+}
+
+// Add adds devices whose INT pins are connected to pin, which must be
+// configured for falling edge detection, or rising edge detection if the
+// devices drive INT active high. The INT pins of each port of the 16 bit
+// variants must be connected to pin, or SetMirror(true) must be called.
+// When several devices share pin, their INT pins must be open-drain, see
+// SetInterruptOutput().
+//
+// Devices must be added before Start(), and can only be added to one
+// service. The edge pin and polling interval of the devices are ignored, and
+// EnableInterrupt() doesn't start a goroutine for them. If a device can't be
+// added, none is.
+func (s *InterruptService) Add(pin gpio.PinIn, devs ...*Dev) error {
+	if pin == nil {
+		return errors.New("MCP23xxx: the interrupt service requires a host pin")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		return errors.New("MCP23xxx: devices must be added before the interrupt service is started")
+	}
+	for ix, dev := range devs {
+		if !dev.ports[0].supportInterrupt {
+			return fmt.Errorf("%s: interrupts are not supported by this device", dev)
+		}
+		if slices.Contains(devs[:ix], dev) {
+			return fmt.Errorf("%s: already added to an interrupt service", dev)
+		}
+	}
+	// The devices are all checked before any is added, and stay locked
+	// in between.
+	for _, dev := range devs {
+		dev.intMu.Lock()
+		defer dev.intMu.Unlock()
+	}
+	for _, dev := range devs {
+		switch {
+		case dev.interrupts.service != nil:
+			return fmt.Errorf("%s: already added to an interrupt service", dev)
+		case dev.interrupts.stop != nil:
+			return fmt.Errorf("%s: EnableInterrupt() already started the interrupt goroutine", dev)
+		}
+	}
+	for _, dev := range devs {
+		dev.interrupts.service = s
+	}
+	for ix := range s.lines {
+		if s.lines[ix].pin == pin {
+			s.lines[ix].devs = append(s.lines[ix].devs, devs...)
+			return nil
+		}
+	}
+	s.lines = append(s.lines, serviceLine{pin: pin, devs: devs})
+	return nil
+}
+
+// Start starts a goroutine for each host pin that waits for edges, and
+// dispatches the interrupts of the devices connected to the pin. While the
+// service runs, the WaitForEdge() of pins and groups of the devices, their
+// Keypad.Start() and PendingInterrupts() fail with an error wrapping
+// ErrEdgePinBusy.
+func (s *InterruptService) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		return errors.New("MCP23xxx: interrupt service already started")
+	}
+	var started []*Dev
+	for _, l := range s.lines {
+		for _, dev := range l.devs {
+			if err := dev.startService(); err != nil {
+				for _, d := range started {
+					d.stopService()
+				}
+				return err
+			}
+			started = append(started, dev)
+		}
+	}
+	s.stop = make(chan struct{})
+	for _, l := range s.lines {
+		s.wg.Add(1)
+		go s.watch(l, s.stop)
+	}
+	return nil
+}
+
+// Stop stops the goroutines started by Start(), and waits for them to exit.
+// This can take up to 100ms, since the host pins aren't halted.
+func (s *InterruptService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop == nil {
+		return
+	}
+	for _, l := range s.lines {
+		for _, dev := range l.devs {
+			dev.stopService()
+		}
+	}
+	close(s.stop)
+	s.stop = nil
+	s.wg.Wait()
+}
+
+// Halt implements conn.Resource. It stops the service.
+func (s *InterruptService) Halt() error {
+	s.Stop()
+	return nil
+}
+
+func (s *InterruptService) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, len(s.lines))
+	for ix, l := range s.lines {
+		names[ix] = l.pin.String()
+	}
+	return "InterruptService(" + strings.Join(names, ", ") + ")"
+}
+
+// Run starts the service, and stops it when ctx is done. It returns the
+// error of Start(), or ctx.Err() once the service is stopped.
+func (s *InterruptService) Run(ctx context.Context) error {
+	if err := s.Start(); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	s.Stop()
+	return ctx.Err()
+}
+
+// watch dispatches the interrupts of the devices of l each time an edge is
+// seen on its pin, until stop is closed.
+func (s *InterruptService) watch(l serviceLine, stop chan struct{}) {
+	defer s.wg.Done()
+	for {
+		// As for watchInterrupts(), the flags are checked before waiting,
+		// and after a timeout, in case an edge was missed. With a wired-or,
+		// a device that isn't read keeps the line asserted, and no further
+		// edges are seen.
+		for _, dev := range l.devs {
+			dev.serviceInterrupts()
+		}
+		l.pin.WaitForEdge(interruptPollInterval)
+		select {
+		case <-stop:
+			return
+		default:
+		}
+	}
+}
+
+// startService claims the edge pin for the interrupt service.
+func (dev *Dev) startService() error {
+	dev.intMu.Lock()
+	defer dev.intMu.Unlock()
+	if dev.interrupts.closed {
+		return fmt.Errorf("%s: the interrupt service was started after Close()", dev)
+	}
+	if err := dev.claimEdgePin(edgeUserInterrupts); err != nil {
+		return err
+	}
+	dev.interrupts.stop = make(chan struct{})
+	return nil
+}
+
+// stopService releases the claim made by startService(), unless Close()
+// already did.
+func (dev *Dev) stopService() {
+	dev.intMu.Lock()
+	defer dev.intMu.Unlock()
+	if dev.interrupts.stop == nil {
+		return
+	}
+	close(dev.interrupts.stop)
+	dev.interrupts.stop = nil
+	dev.unclaimEdgePin()
+}
+
+// serviceInterrupts reads and dispatches the interrupts of the device for
+// the interrupt service.
+func (dev *Dev) serviceInterrupts() {
+	dev.interrupts.dispatchMu.Lock()
+	defer dev.interrupts.dispatchMu.Unlock()
+	dev.intMu.Lock()
+	stop := dev.interrupts.stop
+	dev.intMu.Unlock()
+	if stop == nil {
+		return
+	}
+	dev.dispatchInterrupts(stop)
+}
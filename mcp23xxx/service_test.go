@@ -0,0 +1,132 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+func TestInterruptService(t *testing.T) {
+	var buses [2]*registerBus
+	var devs [2]*Dev
+	for ix := range devs {
+		buses[ix] = &registerBus{}
+		buses[ix].regs[regIODIR] = 0xff
+		dev, err := NewI2C(buses[ix], MCP23008, 0x20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer dev.Close()
+		devs[ix] = dev
+	}
+	if err := devs[0].EnableInterrupt(1, ChangeAny); err == nil {
+		t.Error("EnableInterrupt succeeded without an edge pin")
+	}
+	ip := newIntPin()
+	s := NewInterruptService()
+	other := NewInterruptService()
+	if err := other.Add(ip, devs[1]); err != nil {
+		t.Fatal(err)
+	}
+	// The first device isn't added, since the second one can't be.
+	if err := s.Add(ip, devs[:]...); err == nil {
+		t.Error("expected error adding a device of another service")
+	}
+	if devs[0].interrupts.service != nil {
+		t.Error("the first device was added")
+	}
+	devs[1].interrupts.service = nil
+	if err := s.Add(ip, devs[0], devs[0]); err == nil {
+		t.Error("expected error adding a device twice")
+	}
+	if err := s.Add(ip, devs[:]...); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add(ip, devs[0]); err == nil {
+		t.Error("expected error adding a device twice")
+	}
+	if err := devs[0].EnableInterrupt(1, ChangeAny); err != nil {
+		t.Fatal(err)
+	}
+	if err := devs[1].EnableInterrupt(5, ChangeFalling); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	if err := s.Start(); err == nil {
+		t.Error("expected error starting twice")
+	}
+	if _, err := devs[0].PendingInterrupts(); !errors.Is(err, ErrEdgePinBusy) {
+		t.Errorf("PendingInterrupts returned %v", err)
+	}
+	// Pin 5 of the second device falls.
+	buses[1].mu.Lock()
+	buses[1].regs[regINTF] = 0x20
+	buses[1].mu.Unlock()
+	ip.edges <- struct{}{}
+	if ev := <-devs[1].Events(); ev.Pin != 5 || ev.Level != gpio.Low {
+		t.Errorf("unexpected event %#v", ev)
+	}
+	buses[1].mu.Lock()
+	buses[1].regs[regINTF] = 0
+	buses[1].mu.Unlock()
+	select {
+	case ev := <-devs[0].Events():
+		t.Errorf("unexpected event %#v for the first device", ev)
+	default:
+	}
+	// Closing a device while the service runs closes its channel.
+	if err := devs[1].Close(); err != nil {
+		t.Fatal(err)
+	}
+	for range devs[1].Events() {
+	}
+	s.Stop()
+	if _, err := devs[0].PendingInterrupts(); err != nil {
+		t.Errorf("PendingInterrupts returned %v after Stop", err)
+	}
+	if err := s.Add(ip, devs[0]); err == nil {
+		t.Error("expected error adding a device twice")
+	}
+}
+
+func TestWaitForEdgeContext(t *testing.T) {
+	bus := &registerBus{}
+	bus.regs[regIODIR] = 0xff
+	dev, err := NewI2C(bus, MCP23008, 0x20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	var ip gpio.PinIn = newIntPin()
+	dev.SetEdgePin(&ip)
+	p := dev.Pin(3)
+	if err := p.In(gpio.Float, gpio.BothEdges); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if p.WaitForEdgeContext(ctx) {
+		t.Error("expected no edge")
+	}
+
+	s := NewInterruptService()
+	if err := s.Add(newIntPin(), dev); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Run(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Run returned %v", err)
+	}
+	if _, err := dev.PendingInterrupts(); err != nil {
+		t.Errorf("PendingInterrupts returned %v after Run", err)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Sample is the levels of the pins of the device read by StreamGPIO().
+type Sample struct {
+	// Value has bit n set if pin n, as numbered by Dev.Pin(), is high.
+	Value uint16
+	// Time is when the pins were read.
+	Time time.Time
+}
+
+// ReadPortSequence reads the GPIO register of port len(r) times, in one bus
+// transaction, and stores the values in r. IOCON.SEQOP is set, so that the
+// register address isn't incremented. The reads are as close together as
+// the bus allows, which is useful to sample a fast signal, or to debounce a
+// switch without a transaction per read.
+//
+// The 16 bit variants with IOCON.BANK clear toggle the register address
+// between the port A and port B registers, so twice as many bytes are read.
+// The MCP23016 doesn't support sequential reads, and each value is read in a
+// separate transaction.
+func (dev *Dev) ReadPortSequence(port int, r []uint8) error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if port < 0 || port >= len(dev.ports) {
+		return fmt.Errorf("%s: %w %d", dev, ErrInvalidPort, port)
+	}
+	if len(r) == 0 {
+		return nil
+	}
+	gpio := &dev.ports[port].gpio
+	if !dev.ports[port].supportIOCON {
+		for ix := range r {
+			v, err := gpio.readValue(false)
+			if err != nil {
+				return err
+			}
+			r[ix] = v
+		}
+		return nil
+	}
+	if err := dev.setIOCONBits(1<<ioconSEQOP, 1<<ioconSEQOP); err != nil {
+		return err
+	}
+	if !dev.is16Bit() || dev.bank1 {
+		if err := gpio.readRegisters(gpio.address, r); err != nil {
+			return gpio.wrap("read", err)
+		}
+		gpio.setCache(r[len(r)-1])
+		return nil
+	}
+	// The first byte is from port, and the reads alternate between the
+	// ports.
+	both := make([]uint8, 2*len(r)-1)
+	if err := gpio.readRegisters(gpio.address, both); err != nil {
+		return gpio.wrap("read", err)
+	}
+	for ix := range r {
+		r[ix] = both[2*ix]
+	}
+	gpio.setCache(r[len(r)-1])
+	return nil
+}
+
+// StreamGPIO starts a goroutine that reads the pins of the device every
+// interval, and sends them to the returned channel until ctx is done, when
+// the channel is closed. While it runs, IOCON.SEQOP is set, so that the
+// register address doesn't leave the GPIO registers, and the ports of the 16
+// bit variants with IOCON.BANK clear are read in one transaction that
+// toggles between them, as for ReadGPIO16(). IOCON.SEQOP is restored when
+// ctx is done.
+//
+// Bus errors are ignored, and the pins are read again at the next interval.
+// If the channel is full, the sample is dropped.
+func (dev *Dev) StreamGPIO(ctx context.Context, interval time.Duration) (<-chan Sample, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("%s: invalid stream interval %s", dev, interval)
+	}
+	var seqop uint8
+	if dev.ports[0].supportIOCON {
+		dev.mu.Lock()
+		iocon, err := dev.ports[0].iocon.readValue(true)
+		if err == nil {
+			err = dev.setIOCONBits(1<<ioconSEQOP, 1<<ioconSEQOP)
+		}
+		dev.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		seqop = iocon & (1 << ioconSEQOP)
+	}
+	samples := make(chan Sample, eventBufferSize)
+	go dev.streamGPIO(ctx, interval, seqop, samples)
+	return samples, nil
+}
+
+// streamGPIO sends the samples of the pins, until ctx is done. IOCON.SEQOP
+// is then set to seqop.
+func (dev *Dev) streamGPIO(ctx context.Context, interval time.Duration, seqop uint8, samples chan<- Sample) {
+	defer close(samples)
+	if dev.ports[0].supportIOCON {
+		defer func() {
+			dev.mu.Lock()
+			defer dev.mu.Unlock()
+			_ = dev.setIOCONBits(1<<ioconSEQOP, seqop)
+		}()
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		if v, err := dev.readGPIO(); err == nil {
+			select {
+			case samples <- Sample{Value: v, Time: time.Now()}:
+			default:
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// readGPIO returns the levels of the pins of all ports, with bit n for pin
+// n. The 8 bit variants, and the 16 bit variants with IOCON.BANK clear, are
+// read in one transaction.
+func (dev *Dev) readGPIO() (uint16, error) {
+	if dev.is16Bit() {
+		return dev.ReadGPIO16()
+	}
+	var v uint16
+	for ix := range dev.ports {
+		b, err := dev.ReadPort(ix)
+		if err != nil {
+			return 0, err
+		}
+		v |= uint16(b) << (8 * ix)
+	}
+	return v, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestMCP23017_readPortSequence(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0xFF}},
+			// iocon is read, and slew rate control disabled
+			{Addr: address, W: []byte{0x0a}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x0a, 0x10}},
+			// seqop is set
+			{Addr: address, W: []byte{0x0a, 0x30}},
+			// the address toggles between gpiob and gpioa
+			{Addr: address, W: []byte{0x13}, R: []byte{0x01, 0xaa, 0x03, 0xaa, 0x07}},
+			// sequential operation is enabled again
+			{Addr: address, W: []byte{0x0a, 0x10}},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23017, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if err = dev.SetSlewRateControl(false); err != nil {
+		t.Fatal(err)
+	}
+	r := make([]uint8, 3)
+	if err = dev.ReadPortSequence(1, r); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(r, []uint8{0x01, 0x03, 0x07}) {
+		t.Errorf("unexpected values % x", r)
+	}
+	if err = dev.SetSequentialOperation(true); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.ReadPortSequence(2, r); err == nil {
+		t.Error("expected error for invalid port")
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23008_streamGPIO(t *testing.T) {
+	bus := &registerBus{}
+	bus.regs[regIODIR] = 0xff
+	bus.regs[regGPIO] = 0x5a
+	dev, err := NewI2C(bus, MCP23008, 0x20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	if err = dev.SetSlewRateControl(true); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err = dev.StreamGPIO(ctx, 0); err == nil {
+		t.Error("expected error for invalid interval")
+	}
+	samples, err := dev.StreamGPIO(ctx, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := <-samples; s.Value != 0x5a {
+		t.Errorf("unexpected sample 0x%x", s.Value)
+	}
+	bus.mu.Lock()
+	iocon := bus.regs[regIOCON]
+	bus.mu.Unlock()
+	if iocon != 1<<ioconSEQOP {
+		t.Errorf("unexpected iocon 0x%x", iocon)
+	}
+	cancel()
+	for range samples {
+	}
+	bus.mu.Lock()
+	iocon = bus.regs[regIOCON]
+	bus.mu.Unlock()
+	if iocon != 0 {
+		t.Errorf("iocon 0x%x wasn't restored", iocon)
+	}
+}
+
+func TestMCP23017_streamGPIO(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			{Addr: address, W: []byte{0x01}, R: []byte{0xFF}},
+			// iocon is read, and seqop is set
+			{Addr: address, W: []byte{0x0a}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x0a, 0x20}},
+			// both ports are read in one transaction
+			{Addr: address, W: []byte{0x12}, R: []byte{0x34, 0x12}},
+			// seqop is restored
+			{Addr: address, W: []byte{0x0a, 0x00}},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23017, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	samples, err := dev.StreamGPIO(ctx, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := <-samples; s.Value != 0x1234 {
+		t.Errorf("unexpected sample 0x%x", s.Value)
+	}
+	cancel()
+	for range samples {
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
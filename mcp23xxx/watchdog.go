@@ -0,0 +1,149 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// watchdog is the state of the goroutine started by StartWatchdog().
+type watchdog struct {
+	resets chan time.Time
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// StartWatchdog starts a goroutine that checks every interval whether the
+// device has been reset, for example by a dip of its supply. If it has, the
+// configuration and output latch values cached by the driver are written back
+// to the device, and the time of the reset is sent to the returned channel,
+// so that devices built on top of the expander, such as a character display,
+// can be initialized again. If the channel is full, the notification is
+// dropped.
+//
+// Most registers power on to values that are also valid configurations, so a
+// reset can't be detected by comparing them with the cache. Instead, a bit of
+// IOCON that has no effect on the variant is set as a sentinel, and the
+// device is assumed to have been reset when it reads as clear. This is
+// IOCON.HAEN for the MCP23008 and MCP23017, which always use their address
+// pins, and IOCON.DISSLW for the MCP23S08 and MCP23S17, which have no SDA
+// pin. The other variants, and devices that share a chip select, aren't
+// supported.
+//
+// Only registers that have been read or written by the driver are restored.
+// IOCON.BANK must be clear, which is its power on default. The channel is
+// closed by StopWatchdog() or Close().
+func (dev *Dev) StartWatchdog(interval time.Duration) (<-chan time.Time, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("%s: invalid watchdog interval %s", dev, interval)
+	}
+	sentinel, err := dev.watchdogSentinel()
+	if err != nil {
+		return nil, err
+	}
+	dev.intMu.Lock()
+	defer dev.intMu.Unlock()
+	if dev.watchdog.stop != nil {
+		return nil, errors.New("MCP23xxx: watchdog already started")
+	}
+	dev.mu.Lock()
+	if dev.bank1 {
+		err = fmt.Errorf("%s: the watchdog requires IOCON.BANK to be clear", dev)
+	} else {
+		err = dev.setIOCONBits(sentinel, sentinel)
+	}
+	dev.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	dev.watchdog = watchdog{
+		resets: make(chan time.Time, 1),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go dev.runWatchdog(interval, sentinel, dev.watchdog.resets, dev.watchdog.stop, dev.watchdog.done)
+	return dev.watchdog.resets, nil
+}
+
+// watchdogSentinel returns the IOCON bit used to detect a reset of the
+// device.
+func (dev *Dev) watchdogSentinel() (uint8, error) {
+	if ra, ok := dev.ra.(*spiRegisterAccess); ok && ra.hwAddress != 0 {
+		return 0, fmt.Errorf("%s: the watchdog doesn't support a shared chip select", dev)
+	}
+	switch dev.variant {
+	case MCP23008, MCP23017:
+		return 1 << ioconHAEN, nil
+	case MCP23S08, MCP23S17:
+		return 1 << ioconDISSLW, nil
+	default:
+		return 0, fmt.Errorf("%s: the watchdog is not supported by this variant", dev)
+	}
+}
+
+// StopWatchdog stops the goroutine started by StartWatchdog(), and waits for
+// it to exit.
+func (dev *Dev) StopWatchdog() {
+	dev.intMu.Lock()
+	stop, done := dev.watchdog.stop, dev.watchdog.done
+	dev.watchdog.stop = nil
+	dev.intMu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+func (dev *Dev) runWatchdog(interval time.Duration, sentinel uint8, resets chan<- time.Time, stop, done chan struct{}) {
+	defer close(done)
+	defer close(resets)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+		// Bus errors are ignored, the device is checked again at the next
+		// tick.
+		if reset, _ := dev.checkReset(sentinel); reset {
+			select {
+			case resets <- time.Now():
+			default:
+			}
+		}
+	}
+}
+
+// checkReset reads IOCON, and restores the cached registers if the sentinel
+// bit is clear. It returns true if the registers were restored.
+func (dev *Dev) checkReset(sentinel uint8) (bool, error) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if dev.bank1 {
+		return false, fmt.Errorf("%s: the watchdog requires IOCON.BANK to be clear", dev)
+	}
+	iocon := &dev.ports[0].iocon
+	v, err := iocon.readRegister(iocon.address)
+	if err != nil {
+		return false, iocon.wrap("read", err)
+	}
+	if v&sentinel != 0 {
+		return false, nil
+	}
+	shadow := make(map[ChipRegister]byte)
+	for ix := range dev.ports {
+		for _, rc := range dev.dumpRegisters(ix) {
+			if rc.got {
+				shadow[ChipRegister(rc.name)] = rc.cache
+			}
+		}
+	}
+	return true, dev.restoreRegisters(shadow)
+}
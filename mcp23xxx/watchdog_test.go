@@ -0,0 +1,119 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestMCP23008_checkReset(t *testing.T) {
+	const address uint16 = 0x20
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x00}, R: []byte{0xFF}},
+			// the sentinel is set
+			{Addr: address, W: []byte{0x05}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x05, 0x08}, R: nil},
+			// pin 0 is a high output with pin 1 pulled up
+			{Addr: address, W: []byte{0x0A}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x0A, 0x01}, R: nil},
+			{Addr: address, W: []byte{0x00, 0xFE}, R: nil},
+			{Addr: address, W: []byte{0x06}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x06, 0x02}, R: nil},
+			// no reset
+			{Addr: address, W: []byte{0x05}, R: []byte{0x08}},
+			// the device reset, and the cached registers are restored
+			{Addr: address, W: []byte{0x05}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x05, 0x08}, R: nil},
+			{Addr: address, W: []byte{0x0A, 0x01}, R: nil},
+			{Addr: address, W: []byte{0x06, 0x02}, R: nil},
+			{Addr: address, W: []byte{0x00, 0xFE}, R: nil},
+		},
+	}
+	dev, err := NewI2C(scenario, MCP23008, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	sentinel, err := dev.watchdogSentinel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev.mu.Lock()
+	err = dev.setIOCONBits(sentinel, sentinel)
+	dev.mu.Unlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.WriteGPIOMasked(0x01, 0x01); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetDirectionMasked(0x01, 0x00); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetPullupMasked(0x02, 0x02); err != nil {
+		t.Fatal(err)
+	}
+	for ix, want := range []bool{false, true} {
+		reset, err := dev.checkReset(sentinel)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reset != want {
+			t.Errorf("check %d: expected reset %t", ix, want)
+		}
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMCP23008_watchdog(t *testing.T) {
+	bus := &registerBus{}
+	bus.regs[regIODIR] = 0xFF
+	dev, err := NewI2C(bus, MCP23008, 0x20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+	if _, err = dev.StartWatchdog(0); err == nil {
+		t.Error("expected error for invalid interval")
+	}
+	if err = dev.WriteGPIOPin(3, true); err != nil {
+		t.Fatal(err)
+	}
+	resets, err := dev.StartWatchdog(time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = dev.StartWatchdog(time.Millisecond); err == nil {
+		t.Error("expected error for second watchdog")
+	}
+
+	// Simulate a power on reset.
+	bus.mu.Lock()
+	bus.regs = [11]byte{regIODIR: 0xFF}
+	bus.mu.Unlock()
+	select {
+	case <-resets:
+	case <-time.After(10 * time.Second):
+		t.Fatal("reset not detected")
+	}
+	bus.mu.Lock()
+	iodir, olat, iocon := bus.regs[regIODIR], bus.regs[regOLAT], bus.regs[regIOCON]
+	bus.mu.Unlock()
+	if iodir != 0xF7 || olat != 0x08 || iocon != 1<<ioconHAEN {
+		t.Errorf("unexpected iodir 0x%x olat 0x%x iocon 0x%x", iodir, olat, iocon)
+	}
+	dev.StopWatchdog()
+	if _, ok := <-resets; ok {
+		t.Error("expected resets channel to be closed")
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"errors"
+	"strings"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+)
+
+// Wide is a set of expanders managed as one wide virtual port. Pins are
+// numbered consecutively across the devices, in the order they were
+// supplied. For example, three MCP23008 devices have pins 0-23, with pins
+// 8-15 on the second device. This is convenient for front panels with many
+// LEDs and buttons.
+type Wide struct {
+	devs []*Dev
+	// pins is the devices' pins in virtual port order.
+	pins []Pin
+}
+
+// NewWide returns a Wide made up of devs.
+func NewWide(devs ...*Dev) (*Wide, error) {
+	if len(devs) == 0 {
+		return nil, errors.New("MCP23xxx: NewWide requires at least one device")
+	}
+	w := &Wide{devs: devs}
+	for _, dev := range devs {
+		for _, port := range dev.Pins {
+			w.pins = append(w.pins, port...)
+		}
+	}
+	return w, nil
+}
+
+// NewI2CWide creates an expander of variant on bus b for each address in
+// addrs, and returns them as a Wide. The addresses must be in the range
+// 0x20-0x27.
+func NewI2CWide(b i2c.Bus, variant Variant, addrs ...uint16) (*Wide, error) {
+	var devs []*Dev
+	for _, addr := range addrs {
+		dev, err := NewI2C(b, variant, addr)
+		if err != nil {
+			for _, d := range devs {
+				_ = d.Close()
+			}
+			return nil, err
+		}
+		devs = append(devs, dev)
+	}
+	return NewWide(devs...)
+}
+
+// Devices returns the devices that make up the virtual port.
+func (w *Wide) Devices() []*Dev {
+	return w.devs
+}
+
+// Len returns the number of pins of the virtual port.
+func (w *Wide) Len() int {
+	return len(w.pins)
+}
+
+// Pin returns the pin number n of the virtual port, or nil if n is out of
+// range.
+func (w *Wide) Pin(n int) Pin {
+	if n < 0 || n >= len(w.pins) {
+		return nil
+	}
+	return w.pins[n]
+}
+
+// Out writes value to the pins of the virtual port selected by mask, which
+// are configured as outputs. Bit 0 is pin 0. Each 8 bit port with a mask bit
+// set is written in one bus transaction.
+func (w *Wide) Out(value, mask uint64) error {
+	offset := 0
+	for _, dev := range w.devs {
+		for port := range dev.Pins {
+			portMask := uint8(mask >> offset)
+			if portMask != 0 {
+				g := *dev.Port(port)
+				if err := g.Out(gpio.GPIOValue(uint8(value>>offset)), gpio.GPIOValue(portMask)); err != nil {
+					return err
+				}
+			}
+			offset += 8
+		}
+	}
+	return nil
+}
+
+// Read returns the levels of the pins of the virtual port. Bit 0 is pin 0.
+// The pin directions are not changed.
+func (w *Wide) Read() (uint64, error) {
+	var result uint64
+	offset := 0
+	for _, dev := range w.devs {
+		for port := range dev.Pins {
+			v, err := dev.ReadPort(port)
+			if err != nil {
+				return 0, err
+			}
+			result |= uint64(v) << offset
+			offset += 8
+		}
+	}
+	return result, nil
+}
+
+// Close closes each of the devices.
+func (w *Wide) Close() error {
+	var errs []error
+	for _, dev := range w.devs {
+		errs = append(errs, dev.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// Halt implements conn.Resource. It halts each of the devices.
+func (w *Wide) Halt() error {
+	var errs []error
+	for _, dev := range w.devs {
+		errs = append(errs, dev.Halt())
+	}
+	return errors.Join(errs...)
+}
+
+func (w *Wide) String() string {
+	names := make([]string, len(w.devs))
+	for ix, dev := range w.devs {
+		names[ix] = dev.String()
+	}
+	return "Wide(" + strings.Join(names, ", ") + ")"
+}
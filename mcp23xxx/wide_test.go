@@ -0,0 +1,59 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp23xxx
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestWide(t *testing.T) {
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: 0x20, W: []byte{0x00}, R: []byte{0xFF}},
+			{Addr: 0x21, W: []byte{0x00}, R: []byte{0xFF}},
+			// pin 0 is set high
+			{Addr: 0x20, W: []byte{0x00, 0xFE}, R: nil},
+			{Addr: 0x20, W: []byte{0x0A}, R: []byte{0x00}},
+			{Addr: 0x20, W: []byte{0x0A, 0x01}, R: nil},
+			// pin 8 is set low, and pin 9 high
+			{Addr: 0x21, W: []byte{0x00, 0xFC}, R: nil},
+			{Addr: 0x21, W: []byte{0x0A}, R: []byte{0x00}},
+			{Addr: 0x21, W: []byte{0x0A, 0x02}, R: nil},
+			// gpio registers are read
+			{Addr: 0x20, W: []byte{0x09}, R: []byte{0x01}},
+			{Addr: 0x21, W: []byte{0x09}, R: []byte{0x82}},
+		},
+	}
+	if _, err := NewI2CWide(scenario, MCP23008, 0x30); err == nil {
+		t.Error("expected error for invalid address")
+	}
+	w, err := NewI2CWide(scenario, MCP23008, 0x20, 0x21)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if w.Len() != 16 || w.Pin(16) != nil {
+		t.Errorf("expected 16 pins, got %d", w.Len())
+	}
+	if name := w.Pin(9).Name(); name != "MCP23008_21_1" {
+		t.Errorf("unexpected pin 9 %s", name)
+	}
+	if err = w.Out(0x0201, 0x0301); err != nil {
+		t.Fatal(err)
+	}
+	v, err := w.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 0x8201 {
+		t.Errorf("expected 0x8201, got 0x%x", v)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
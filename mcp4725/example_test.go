@@ -0,0 +1,42 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp4725_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/mcp4725"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer bus.Close()
+
+	dac, err := mcp4725.NewI2C(bus, mcp4725.DefaultAddress, 3300*physic.MilliVolt)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := dac.SetVoltage(1200 * physic.MilliVolt); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s outputs %s\n", dac, dac.Voltage())
+
+	// Start at mid scale at the next power on.
+	if err := dac.WriteEEPROM(mcp4725.MaxCode/2, mcp4725.Normal); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package mcp4725 controls a Microchip MCP4725 12 bit digital to analog
+// converter.
+//
+// Dev implements analog.PinDAC, so it can drive the contrast of an HD44780
+// with hd44780.NewDACContrast(). The output ranges from 0 to the supply
+// voltage, which is the reference of the DAC.
+//
+// The value and the power down mode at power on are stored in EEPROM, see
+// WriteEEPROM().
+//
+// # Datasheet
+//
+// https://ww1.microchip.com/downloads/en/devicedoc/22039d.pdf
+package mcp4725
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/analog"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/pin"
+)
+
+// DefaultAddress is the address with the A0 pin low. It's 0x61 with A0 high.
+// Some parts are made with the other address bits set, up to 0x67.
+const DefaultAddress uint16 = 0x60
+
+// MaxCode is the highest code of the DAC.
+const MaxCode = 4095
+
+// PowerDown is the mode of the output.
+type PowerDown uint8
+
+const (
+	// Normal drives the output.
+	Normal PowerDown = iota
+	// PowerDown1k powers the DAC down, and pulls the output down with 1kΩ.
+	PowerDown1k
+	// PowerDown100k pulls the output down with 100kΩ.
+	PowerDown100k
+	// PowerDown500k pulls the output down with 500kΩ.
+	PowerDown500k
+)
+
+func (p PowerDown) String() string {
+	switch p {
+	case Normal:
+		return "Normal"
+	case PowerDown1k:
+		return "PowerDown1k"
+	case PowerDown100k:
+		return "PowerDown100k"
+	case PowerDown500k:
+		return "PowerDown500k"
+	default:
+		return fmt.Sprintf("PowerDown(%d)", uint8(p))
+	}
+}
+
+// Status is the state of the DAC register, and of the EEPROM.
+type Status struct {
+	Code      uint16
+	PowerDown PowerDown
+	// EEPROMCode and EEPROMPowerDown are loaded at power on.
+	EEPROMCode      uint16
+	EEPROMPowerDown PowerDown
+	// Ready is false while the EEPROM is written.
+	Ready bool
+	// PowerOnReset is true if the supply voltage is high enough.
+	PowerOnReset bool
+}
+
+const (
+	cmdWriteDACEEPROM = 0x60
+	// eepromTimeout is longer than the 50ms maximum of an EEPROM write.
+	eepromTimeout = 100 * time.Millisecond
+)
+
+// Dev is a handle to an MCP4725.
+type Dev struct {
+	c    i2c.Dev
+	vref physic.ElectricPotential
+
+	mu   sync.Mutex
+	code uint16
+	pd   PowerDown
+
+	// sleep is replaced by tests.
+	sleep func(time.Duration)
+}
+
+// NewI2C returns a handle to an MCP4725 at addr on b, powered with vdd. If
+// vdd is 0, the voltages are unknown, and SetVoltage() can't be used. The
+// output isn't changed.
+func NewI2C(b i2c.Bus, addr uint16, vdd physic.ElectricPotential) (*Dev, error) {
+	if vdd < 0 {
+		return nil, fmt.Errorf("mcp4725: invalid supply voltage %s", vdd)
+	}
+	d := &Dev{c: i2c.Dev{Bus: b, Addr: addr}, vref: vdd, sleep: time.Sleep}
+	s, err := d.Status()
+	if err != nil {
+		return nil, err
+	}
+	d.code, d.pd = s.Code, s.PowerDown
+	return d, nil
+}
+
+// Out implements analog.PinDAC. It sets the code of the DAC, from 0 to
+// MaxCode, and powers the output up.
+func (d *Dev) Out(v int32) error {
+	if v < 0 || v > MaxCode {
+		return fmt.Errorf("mcp4725: invalid code %d", v)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.fastWrite(uint16(v), Normal)
+}
+
+// SetVoltage sets the output to the nearest voltage to v, and powers it up.
+func (d *Dev) SetVoltage(v physic.ElectricPotential) error {
+	if d.vref == 0 {
+		return errors.New("mcp4725: supply voltage unknown")
+	}
+	if v < 0 || v > d.vref {
+		return fmt.Errorf("mcp4725: voltage %s out of range", v)
+	}
+	code := (int64(v)*(MaxCode+1) + int64(d.vref)/2) / int64(d.vref)
+	return d.Out(int32(min(code, MaxCode)))
+}
+
+// Voltage returns the voltage of the code set, or 0 if the supply voltage is
+// unknown or the output is powered down.
+func (d *Dev) Voltage() physic.ElectricPotential {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.pd != Normal {
+		return 0
+	}
+	return d.voltage(d.code)
+}
+
+// SetPowerDown sets the power down mode, and keeps the code.
+func (d *Dev) SetPowerDown(pd PowerDown) error {
+	if pd > PowerDown500k {
+		return fmt.Errorf("mcp4725: invalid power down mode %d", pd)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.fastWrite(d.code, pd)
+}
+
+// WriteEEPROM sets the code and the power down mode, and stores them in
+// EEPROM to be loaded at power on. It waits for the write to complete. The
+// EEPROM can be written about a million times.
+func (d *Dev) WriteEEPROM(code uint16, pd PowerDown) error {
+	if code > MaxCode {
+		return fmt.Errorf("mcp4725: invalid code %d", code)
+	}
+	if pd > PowerDown500k {
+		return fmt.Errorf("mcp4725: invalid power down mode %d", pd)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	w := []byte{cmdWriteDACEEPROM | byte(pd)<<1, byte(code >> 4), byte(code << 4)}
+	if err := d.c.Tx(w, nil); err != nil {
+		return fmt.Errorf("mcp4725: %w", err)
+	}
+	d.code, d.pd = code, pd
+	for waited := time.Duration(0); ; waited += 5 * time.Millisecond {
+		d.sleep(5 * time.Millisecond)
+		s, err := d.status()
+		if err != nil {
+			return err
+		}
+		if s.Ready {
+			return nil
+		}
+		if waited >= eepromTimeout {
+			return errors.New("mcp4725: timeout writing the EEPROM")
+		}
+	}
+}
+
+// Status reads the state of the DAC and of the EEPROM.
+func (d *Dev) Status() (Status, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.status()
+}
+
+// Range implements analog.PinDAC.
+func (d *Dev) Range() (analog.Sample, analog.Sample) {
+	return analog.Sample{}, analog.Sample{Raw: MaxCode, V: d.voltage(MaxCode)}
+}
+
+// Name implements pin.Pin.
+func (d *Dev) Name() string {
+	return fmt.Sprintf("MCP4725(%#x)", d.c.Addr)
+}
+
+// Number implements pin.Pin.
+func (d *Dev) Number() int {
+	return 0
+}
+
+// Function implements pin.Pin.
+func (d *Dev) Function() string {
+	return string(d.Func())
+}
+
+// Func implements pin.PinFunc.
+func (d *Dev) Func() pin.Func {
+	return analog.DAC
+}
+
+// SupportedFuncs implements pin.PinFunc.
+func (d *Dev) SupportedFuncs() []pin.Func {
+	return []pin.Func{analog.DAC}
+}
+
+// SetFunc implements pin.PinFunc.
+func (d *Dev) SetFunc(f pin.Func) error {
+	if f == analog.DAC {
+		return nil
+	}
+	return errors.New("mcp4725: pin function cannot be changed")
+}
+
+// Halt implements conn.Resource. It powers the output down, pulled down with
+// 500kΩ, until the next call to Out() or SetVoltage().
+func (d *Dev) Halt() error {
+	return d.SetPowerDown(PowerDown500k)
+}
+
+func (d *Dev) String() string {
+	return d.Name()
+}
+
+// fastWrite sets the DAC register with the fast write command. d.mu must be
+// held.
+func (d *Dev) fastWrite(code uint16, pd PowerDown) error {
+	if err := d.c.Tx([]byte{byte(pd)<<4 | byte(code>>8), byte(code)}, nil); err != nil {
+		return fmt.Errorf("mcp4725: %w", err)
+	}
+	d.code, d.pd = code, pd
+	return nil
+}
+
+// status implements Status(). d.mu must be held.
+func (d *Dev) status() (Status, error) {
+	var r [5]byte
+	if err := d.c.Tx(nil, r[:]); err != nil {
+		return Status{}, fmt.Errorf("mcp4725: %w", err)
+	}
+	return Status{
+		Code:            uint16(r[1])<<4 | uint16(r[2])>>4,
+		PowerDown:       PowerDown(r[0]>>1) & 3,
+		EEPROMCode:      uint16(r[3]&0x0f)<<8 | uint16(r[4]),
+		EEPROMPowerDown: PowerDown(r[3]>>5) & 3,
+		Ready:           r[0]&0x80 != 0,
+		PowerOnReset:    r[0]&0x40 != 0,
+	}, nil
+}
+
+func (d *Dev) voltage(code uint16) physic.ElectricPotential {
+	return physic.ElectricPotential(int64(d.vref) * int64(code) / (MaxCode + 1))
+}
+
+var _ analog.PinDAC = &Dev{}
+var _ pin.PinFunc = &Dev{}
@@ -0,0 +1,137 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mcp4725
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/analog"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// statusIO is the read of the status with the DAC at 0x800 powered up, and
+// the EEPROM at 0x123 with PowerDown100k.
+var statusIO = i2ctest.IO{Addr: DefaultAddress, R: []byte{0xc0, 0x80, 0x00, 0x41, 0x23}}
+
+func newTest(t *testing.T, vdd physic.ElectricPotential, ops ...i2ctest.IO) (*Dev, *i2ctest.Playback) {
+	b := &i2ctest.Playback{Ops: append([]i2ctest.IO{statusIO}, ops...), DontPanic: true}
+	d, err := NewI2C(b, DefaultAddress, vdd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.sleep = func(time.Duration) {}
+	return d, b
+}
+
+func TestStatus(t *testing.T) {
+	d, b := newTest(t, 0, statusIO)
+	s, err := d.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Status{Code: 0x800, EEPROMCode: 0x123, EEPROMPowerDown: PowerDown100k, Ready: true, PowerOnReset: true}
+	if s != want {
+		t.Errorf("got %+v, want %+v", s, want)
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOut(t *testing.T) {
+	d, b := newTest(t, 3300*physic.MilliVolt,
+		i2ctest.IO{Addr: DefaultAddress, W: []byte{0x0f, 0xff}},
+		i2ctest.IO{Addr: DefaultAddress, W: []byte{0x08, 0x00}},
+		i2ctest.IO{Addr: DefaultAddress, W: []byte{0x38, 0x00}},
+		i2ctest.IO{Addr: DefaultAddress, W: []byte{0x08, 0x00}},
+	)
+	if err := d.Out(MaxCode); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetVoltage(1650 * physic.MilliVolt); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Voltage(); v != 1650*physic.MilliVolt {
+		t.Errorf("Voltage() = %s", v)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Voltage(); v != 0 {
+		t.Errorf("Voltage() = %s when powered down", v)
+	}
+	if err := d.SetPowerDown(Normal); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Out(MaxCode + 1); err == nil {
+		t.Error("expected an error for an invalid code")
+	}
+	if err := d.SetVoltage(3301 * physic.MilliVolt); err == nil {
+		t.Error("expected an error above the supply voltage")
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetVoltage_unknown(t *testing.T) {
+	d, _ := newTest(t, 0)
+	if err := d.SetVoltage(physic.Volt); err == nil {
+		t.Error("expected an error without a supply voltage")
+	}
+	if _, max := d.Range(); max != (analog.Sample{Raw: MaxCode}) {
+		t.Errorf("Range() = %+v", max)
+	}
+}
+
+func TestWriteEEPROM(t *testing.T) {
+	d, b := newTest(t, 0,
+		i2ctest.IO{Addr: DefaultAddress, W: []byte{0x64, 0x12, 0x30}},
+		// Busy, then ready.
+		i2ctest.IO{Addr: DefaultAddress, R: []byte{0x44, 0x12, 0x30, 0x41, 0x23}},
+		i2ctest.IO{Addr: DefaultAddress, R: []byte{0xc4, 0x12, 0x30, 0x41, 0x23}},
+	)
+	if err := d.WriteEEPROM(0x123, PowerDown100k); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.WriteEEPROM(MaxCode+1, Normal); err == nil {
+		t.Error("expected an error for an invalid code")
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWriteEEPROM_timeout(t *testing.T) {
+	ops := []i2ctest.IO{{Addr: DefaultAddress, W: []byte{0x60, 0x00, 0x00}}}
+	for range 21 {
+		ops = append(ops, i2ctest.IO{Addr: DefaultAddress, R: []byte{0x40, 0, 0, 0, 0}})
+	}
+	d, _ := newTest(t, 0, ops...)
+	if err := d.WriteEEPROM(0, Normal); err == nil {
+		t.Error("expected a timeout")
+	}
+}
+
+func TestPin(t *testing.T) {
+	d, _ := newTest(t, 5*physic.Volt)
+	if s := d.String(); s != "MCP4725(0x60)" {
+		t.Error(s)
+	}
+	if d.Func() != analog.DAC || d.Function() != "DAC" {
+		t.Errorf("Func() = %s", d.Func())
+	}
+	if err := d.SetFunc(analog.ADC); err == nil {
+		t.Error("expected an error")
+	}
+	if _, max := d.Range(); max.V != 4998779296*physic.NanoVolt {
+		t.Errorf("Range() = %s", max.V)
+	}
+	if s := PowerDown500k.String(); s != "PowerDown500k" {
+		t.Error(s)
+	}
+}
@@ -174,3 +174,40 @@ func ExampleDev_ReadUID() {
 		}
 	}
 }
+
+func ExampleDev_Start() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Using SPI as an example. See package "periph.io/x/conn/v3/spi/spireg" for more details.
+	p, err := spireg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer p.Close()
+
+	rfid, err := mfrc522.NewSPI(p, rpi.P1_22, rpi.P1_18)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Idling device on exit.
+	defer rfid.Halt()
+
+	events, err := rfid.Start(200 * time.Millisecond)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for ev := range events {
+		if ev.Present {
+			log.Printf("Card %s presented", hex.EncodeToString(ev.UID))
+		} else {
+			log.Printf("Card %s removed", hex.EncodeToString(ev.UID))
+		}
+	}
+	if err := rfid.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -4,6 +4,10 @@
 
 // Package mfrc522 controls a Mifare RFID card reader.
 //
+// ReadUID(), ReadCard() and WriteCard() wait for a card to be presented.
+// Start() instead polls for cards, and reports their arrivals and removals
+// as events, as needed to drive a door lock while a badge is presented.
+//
 // # Datasheet
 //
 // https://www.nxp.com/docs/en/data-sheet/MFRC522.pdf
@@ -27,6 +31,13 @@ type Dev struct {
 	beforeCall       func()
 	afterCall        func()
 	bogusUID         bool
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+	err  error
+	// poll is Poll(), replaced by tests.
+	poll func() ([]byte, error)
 }
 
 // Key is the access key that consists of 6 bytes. There could be two types of keys - keyA and keyB.
@@ -109,6 +120,7 @@ func NewSPI(spiPort spi.Port, resetPin gpio.PinOut, irqPin gpio.PinIn, configs .
 		afterCall:        cfg.afterCall,
 		bogusUID:         cfg.bogusUID,
 	}
+	dev.poll = dev.Poll
 	return dev, nil
 }
 
@@ -119,8 +131,10 @@ func (r *Dev) String() string {
 
 // Halt implements conn.Resource.
 //
-// It soft-stops the chip - PowerDown bit set, command IDLE
+// It stops the goroutine started by Start(), and soft-stops the chip -
+// PowerDown bit set, command IDLE
 func (r *Dev) Halt() error {
+	r.Stop()
 	r.beforeCall()
 	defer r.afterCall()
 	return r.LowLevel.Halt()
@@ -405,7 +419,12 @@ func (r *Dev) selectCard(timeout time.Duration) ([]byte, error) {
 		return nil, err
 	}
 	defer r.LowLevel.ClearInterrupt()
+	return r.identify()
+}
 
+// identify selects the card in the field, and returns its UID. The chip must
+// have been initialized.
+func (r *Dev) identify() ([]byte, error) {
 	if _, err := r.request(); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,147 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mfrc522
+
+import (
+	"bytes"
+	"time"
+)
+
+// CardEvent is the arrival or the removal of a card.
+type CardEvent struct {
+	// UID is the UID of the card, as returned by ReadUID().
+	UID []byte
+	// Present is true if the card arrived, and false if it was removed.
+	Present bool
+	// Time is when the change was detected.
+	Time time.Time
+}
+
+const (
+	// missedPolls is the number of consecutive polls a card must be missing
+	// from before it's reported removed, since a card at the edge of the
+	// field doesn't answer every poll.
+	missedPolls = 2
+	// eventBufferSize is the size of the buffer of the events channel.
+	eventBufferSize = 16
+)
+
+// Poll checks whether a card is in the field, without waiting for the IRQ
+// pin, and returns its UID. It returns nil if there is no card, or if it
+// didn't answer.
+func (r *Dev) Poll() (uid []byte, err error) {
+	r.beforeCall()
+	defer func() {
+		r.afterCall()
+		if err == nil && uid != nil {
+			err = r.LowLevel.StopCrypto()
+		}
+	}()
+	// A missing card is reported as an error by the transceive command, as
+	// is a collision or a card leaving the field, so that errors after the
+	// chip has been initialized mean that no card could be read.
+	if err := r.LowLevel.Init(); err != nil {
+		return nil, err
+	}
+	uid, err = r.identify()
+	if err != nil {
+		return nil, nil
+	}
+	return uid, nil
+}
+
+// Start starts a goroutine that polls for cards every interval, and sends
+// their arrivals and removals to the returned channel. The channel is closed
+// when Stop() is called, or a poll returns an error. See Err().
+//
+// The card in the field is reset at each poll, so the other methods must not
+// be called while the goroutine runs, unless WithSync() was used, and only
+// from the arrival of a card to the next poll.
+func (r *Dev) Start(interval time.Duration) (<-chan CardEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stop != nil {
+		return nil, wrapf("already started")
+	}
+	if interval <= 0 {
+		return nil, wrapf("invalid interval %s", interval)
+	}
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	r.err = nil
+	events := make(chan CardEvent, eventBufferSize)
+	go r.run(interval, events, r.stop, r.done)
+	return events, nil
+}
+
+// Stop stops the goroutine started by Start(), and waits for it to exit.
+func (r *Dev) Stop() {
+	r.mu.Lock()
+	stop, done := r.stop, r.done
+	r.stop = nil
+	r.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Err returns the error that stopped the polling goroutine, if any.
+func (r *Dev) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+func (r *Dev) run(interval time.Duration, events chan<- CardEvent, stop, done chan struct{}) {
+	defer close(done)
+	defer close(events)
+	var current []byte
+	missed := 0
+	send := func(ev CardEvent) bool {
+		select {
+		case events <- ev:
+			return true
+		case <-stop:
+			return false
+		}
+	}
+	for {
+		uid, err := r.poll()
+		if err != nil {
+			r.mu.Lock()
+			r.err = err
+			r.mu.Unlock()
+			return
+		}
+		now := time.Now()
+		switch {
+		case uid == nil && current != nil:
+			if missed++; missed >= missedPolls {
+				if !send(CardEvent{UID: current, Time: now}) {
+					return
+				}
+				current = nil
+			}
+		case uid != nil && !bytes.Equal(uid, current):
+			// A card swapped for another one between two polls.
+			if current != nil && !send(CardEvent{UID: current, Time: now}) {
+				return
+			}
+			current, missed = uid, 0
+			if !send(CardEvent{UID: uid, Present: true, Time: now}) {
+				return
+			}
+		case uid != nil:
+			missed = 0
+		}
+		select {
+		case <-stop:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
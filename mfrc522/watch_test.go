@@ -0,0 +1,81 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mfrc522
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStart(t *testing.T) {
+	a, b := []byte{1, 2, 3, 4}, []byte{5, 6, 7, 8, 9, 10, 11}
+	errDone := errors.New("done")
+	polls := [][]byte{nil, a, a, nil, a, nil, nil, nil, b, a, nil, nil}
+	r := &Dev{}
+	r.poll = func() ([]byte, error) {
+		if len(polls) == 0 {
+			return nil, errDone
+		}
+		uid := polls[0]
+		polls = polls[1:]
+		return uid, nil
+	}
+	events, err := r.Start(time.Microsecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Start(time.Microsecond); err == nil {
+		t.Error("expected an error when started twice")
+	}
+	want := []CardEvent{
+		{UID: a, Present: true},
+		// A single missed poll isn't a removal.
+		{UID: a},
+		{UID: b, Present: true},
+		{UID: b},
+		{UID: a, Present: true},
+		{UID: a},
+	}
+	var got []CardEvent
+	for ev := range events {
+		got = append(got, ev)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v", got)
+	}
+	for i := range want {
+		if !bytes.Equal(got[i].UID, want[i].UID) || got[i].Present != want[i].Present || got[i].Time.IsZero() {
+			t.Errorf("event %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if err := r.Err(); err != errDone {
+		t.Errorf("Err() = %v", err)
+	}
+	r.Stop()
+	if _, err := r.Start(0); err == nil {
+		t.Error("expected an error for an invalid interval")
+	}
+}
+
+func TestStop(t *testing.T) {
+	r := &Dev{}
+	r.poll = func() ([]byte, error) { return []byte{1, 2, 3, 4}, nil }
+	events, err := r.Start(time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev := <-events; !ev.Present {
+		t.Errorf("got %v", ev)
+	}
+	r.Stop()
+	if _, ok := <-events; ok {
+		t.Error("channel not closed")
+	}
+	if err := r.Err(); err != nil {
+		t.Error(err)
+	}
+}
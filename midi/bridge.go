@@ -0,0 +1,124 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package midi
+
+import (
+	"fmt"
+	"sync"
+
+	"periph.io/x/devices/v3/rotary"
+)
+
+// Bridge converts the changes of named controls to MIDI messages, according
+// to a Mapping. It's safe for concurrent use.
+type Bridge struct {
+	w *Writer
+	m Mapping
+
+	mu      sync.Mutex
+	values  map[string]int
+	toggled map[string]bool
+}
+
+// NewBridge returns a Bridge sending the messages of m to w. m must have been
+// validated, which ParseMapping() does.
+func NewBridge(w *Writer, m *Mapping) *Bridge {
+	b := &Bridge{w: w, m: *m, values: map[string]int{}, toggled: map[string]bool{}}
+	for name, e := range m.Encoders {
+		b.values[name] = int(e.Initial)
+	}
+	return b
+}
+
+// Turn changes the value of the encoder name by steps detents, and sends it
+// if it changed.
+func (b *Bridge) Turn(name string, steps int) error {
+	e, ok := b.m.Encoders[name]
+	if !ok {
+		return fmt.Errorf("midi: unknown encoder %q", name)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	v := min(max(b.values[name]+steps*int(e.Step), int(e.Min)), int(e.Max))
+	if v == b.values[name] {
+		return nil
+	}
+	b.values[name] = v
+	return b.w.ControlChange(e.Channel, e.Controller, uint8(v))
+}
+
+// Button sends the messages of the button name when it's pressed or
+// released.
+func (b *Bridge) Button(name string, pressed bool) error {
+	m, ok := b.m.Buttons[name]
+	if !ok {
+		return fmt.Errorf("midi: unknown button %q", name)
+	}
+	switch m.Type {
+	case Momentary:
+		if pressed {
+			return b.w.ControlChange(m.Channel, m.Controller, m.Value)
+		}
+		return b.w.ControlChange(m.Channel, m.Controller, 0)
+	case Toggle:
+		if !pressed {
+			return nil
+		}
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		on := !b.toggled[name]
+		b.toggled[name] = on
+		if on {
+			return b.w.ControlChange(m.Channel, m.Controller, m.Value)
+		}
+		return b.w.ControlChange(m.Channel, m.Controller, 0)
+	default:
+		if pressed {
+			return b.w.NoteOn(m.Channel, m.Note, m.Velocity)
+		}
+		return b.w.NoteOff(m.Channel, m.Note, 0)
+	}
+}
+
+// Value returns the current value of the encoder name.
+func (b *Bridge) Value(name string) (int, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	v, ok := b.values[name]
+	return v, ok
+}
+
+// Run sends the events of a rotary encoder until the channel is closed. The
+// turns are sent as the encoder name, and the presses of its switch as the
+// button name. Either name may be empty to ignore them.
+func (b *Bridge) Run(events <-chan rotary.Event, encoder, button string) error {
+	for ev := range events {
+		var err error
+		switch ev.Kind {
+		case rotary.Turn:
+			if encoder != "" {
+				err = b.Turn(encoder, ev.Steps)
+			}
+		case rotary.Press, rotary.Release:
+			if button != "" {
+				err = b.Button(button, ev.Kind == rotary.Press)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Halt implements conn.Resource. It halts the Writer, so that no note is
+// left sounding.
+func (b *Bridge) Halt() error {
+	return b.w.Halt()
+}
+
+func (b *Bridge) String() string {
+	return fmt.Sprintf("midi.Bridge{%s}", b.w)
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package midi_test
+
+import (
+	"log"
+	"os"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/devices/v3/midi"
+	"periph.io/x/devices/v3/rotary"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+	f, err := os.Open("mapping.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	m, err := midi.ParseMapping(f)
+	f.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+	// The USB MIDI gadget is usually the first card.
+	out, err := midi.OpenRawMIDI(1, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+	bridge := midi.NewBridge(midi.NewWriter(out), m)
+
+	e, err := rotary.New(gpioreg.ByName("GPIO17"), gpioreg.ByName("GPIO27"), gpioreg.ByName("GPIO22"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer e.Halt()
+	events, err := e.Start()
+	if err != nil {
+		log.Fatal(err)
+	}
+	// The encoder controls "volume", and its switch "mute", of mapping.json.
+	if err := bridge.Run(events, "volume", "mute"); err != nil {
+		log.Fatal(err)
+	}
+}
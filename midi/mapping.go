@@ -0,0 +1,126 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package midi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Mapping maps named controls to MIDI messages. The names are chosen by the
+// application, and passed to the methods of Bridge.
+//
+// In JSON:
+//
+//	{
+//	  "encoders": {
+//	    "volume": {"channel": 0, "controller": 7, "initial": 100, "step": 2}
+//	  },
+//	  "buttons": {
+//	    "kick": {"channel": 9, "note": 36, "velocity": 100},
+//	    "mute": {"type": "toggle", "channel": 0, "controller": 20}
+//	  }
+//	}
+type Mapping struct {
+	Encoders map[string]EncoderMapping `json:"encoders"`
+	Buttons  map[string]ButtonMapping  `json:"buttons"`
+}
+
+// EncoderMapping maps an encoder to the value of a controller, sent with
+// Control Change messages as it's turned.
+type EncoderMapping struct {
+	Channel    uint8 `json:"channel"`
+	Controller uint8 `json:"controller"`
+	// Min and Max bound the value. If both are 0, Max is MaxValue.
+	Min uint8 `json:"min"`
+	Max uint8 `json:"max"`
+	// Step is the change of the value per detent. If 0, it's 1.
+	Step uint8 `json:"step"`
+	// Initial is the value before the encoder is turned. It's clamped to
+	// Min and Max, and isn't sent.
+	Initial uint8 `json:"initial"`
+}
+
+// ButtonType is the kind of messages sent by a button.
+type ButtonType string
+
+const (
+	// Note sends a Note On on press, and a Note Off on release. It's the
+	// default.
+	Note ButtonType = "note"
+	// Momentary sends a Control Change of Value on press, and of 0 on
+	// release.
+	Momentary ButtonType = "momentary"
+	// Toggle sends a Control Change alternating between Value and 0 on each
+	// press.
+	Toggle ButtonType = "toggle"
+)
+
+// ButtonMapping maps a button to a note or a controller.
+type ButtonMapping struct {
+	Type    ButtonType `json:"type"`
+	Channel uint8      `json:"channel"`
+	// Note and Velocity are used by Note. If Velocity is 0, it's MaxValue.
+	Note     uint8 `json:"note"`
+	Velocity uint8 `json:"velocity"`
+	// Controller and Value are used by Momentary and Toggle. If Value is 0,
+	// it's MaxValue.
+	Controller uint8 `json:"controller"`
+	Value      uint8 `json:"value"`
+}
+
+// ParseMapping reads a Mapping in JSON from r, fills the defaults and
+// validates it.
+func ParseMapping(r io.Reader) (*Mapping, error) {
+	m := &Mapping{}
+	d := json.NewDecoder(r)
+	d.DisallowUnknownFields()
+	if err := d.Decode(m); err != nil {
+		return nil, fmt.Errorf("midi: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Validate fills the defaults of m and checks its values.
+func (m *Mapping) Validate() error {
+	for name, e := range m.Encoders {
+		if e.Min == 0 && e.Max == 0 {
+			e.Max = MaxValue
+		}
+		if e.Step == 0 {
+			e.Step = 1
+		}
+		if e.Channel > MaxChannel || e.Controller > MaxValue || e.Max > MaxValue || e.Min > e.Max || e.Step > MaxValue {
+			return fmt.Errorf("midi: invalid encoder %q: %+v", name, e)
+		}
+		e.Initial = min(max(e.Initial, e.Min), e.Max)
+		m.Encoders[name] = e
+	}
+	for name, b := range m.Buttons {
+		if b.Type == "" {
+			b.Type = Note
+		}
+		if b.Velocity == 0 {
+			b.Velocity = MaxValue
+		}
+		if b.Value == 0 {
+			b.Value = MaxValue
+		}
+		switch b.Type {
+		case Note, Momentary, Toggle:
+		default:
+			return fmt.Errorf("midi: invalid button %q: unknown type %q", name, b.Type)
+		}
+		if b.Channel > MaxChannel || b.Note > MaxValue || b.Velocity > MaxValue || b.Controller > MaxValue || b.Value > MaxValue {
+			return fmt.Errorf("midi: invalid button %q: %+v", name, b)
+		}
+		m.Buttons[name] = b
+	}
+	return nil
+}
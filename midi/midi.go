@@ -0,0 +1,112 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package midi sends MIDI messages, and bridges rotary encoders and buttons
+// to MIDI Control Change and Note messages, to build a control surface.
+//
+// The messages are written to an io.Writer: either a serial port configured
+// at 31250 baud, for a DIN MIDI output, or an ALSA rawmidi device opened with
+// OpenRawMIDI(), such as the USB MIDI gadget of a Raspberry Pi.
+//
+// The mapping of the controls to messages is described by a Mapping, which
+// can be loaded from JSON with ParseMapping().
+//
+// # Wiring
+//
+// A DIN MIDI output is driven by the UART TX pin through a 220Ω resistor to
+// pin 5 of the socket, with pin 4 connected to 3.3V through a 33Ω resistor,
+// as specified by the MIDI 1.0 electrical specification for 3.3V.
+package midi
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+const (
+	statusNoteOff       = 0x80
+	statusNoteOn        = 0x90
+	statusControlChange = 0xB0
+
+	// controllerAllNotesOff is the channel mode message that ends the notes
+	// playing on a channel.
+	controllerAllNotesOff = 123
+
+	// MaxChannel is the highest channel, which is displayed as 16 by most
+	// instruments. Channels are numbered from 0.
+	MaxChannel = 15
+	// MaxValue is the highest note, velocity, controller and value.
+	MaxValue = 127
+)
+
+// Writer writes MIDI messages to an io.Writer. It's safe for concurrent
+// use.
+type Writer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriter returns a Writer of MIDI messages to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// OpenRawMIDI opens the ALSA rawmidi device of a sound card for writing. The
+// card and device numbers are listed by `amidi -l`, as hw:card,device.
+func OpenRawMIDI(card, device int) (io.WriteCloser, error) {
+	f, err := os.OpenFile(fmt.Sprintf("/dev/snd/midiC%dD%d", card, device), os.O_WRONLY, 0)
+	if err != nil {
+		return nil, fmt.Errorf("midi: %w", err)
+	}
+	return f, nil
+}
+
+// NoteOn sends a Note On message. A velocity of 0 is a Note Off.
+func (w *Writer) NoteOn(channel, note, velocity uint8) error {
+	return w.send(statusNoteOn, channel, note, velocity)
+}
+
+// NoteOff sends a Note Off message.
+func (w *Writer) NoteOff(channel, note, velocity uint8) error {
+	return w.send(statusNoteOff, channel, note, velocity)
+}
+
+// ControlChange sends a Control Change message.
+func (w *Writer) ControlChange(channel, controller, value uint8) error {
+	return w.send(statusControlChange, channel, controller, value)
+}
+
+// Halt implements conn.Resource. It sends All Notes Off on every channel, so
+// that no note is left sounding.
+func (w *Writer) Halt() error {
+	for channel := uint8(0); channel <= MaxChannel; channel++ {
+		if err := w.ControlChange(channel, controllerAllNotesOff, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Writer) String() string {
+	return fmt.Sprintf("midi.Writer{%v}", w.w)
+}
+
+func (w *Writer) send(status, channel, d1, d2 uint8) error {
+	if channel > MaxChannel {
+		return fmt.Errorf("midi: invalid channel %d", channel)
+	}
+	if d1 > MaxValue || d2 > MaxValue {
+		return fmt.Errorf("midi: invalid data %d, %d", d1, d2)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	// Messages are always sent whole, without running status, so that a
+	// receiver connected mid-stream synchronizes on the next message.
+	if _, err := w.w.Write([]byte{status | channel, d1, d2}); err != nil {
+		return fmt.Errorf("midi: %w", err)
+	}
+	return nil
+}
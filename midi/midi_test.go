@@ -0,0 +1,173 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package midi
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"periph.io/x/devices/v3/rotary"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	if err := w.NoteOn(9, 36, 100); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.NoteOff(9, 36, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.ControlChange(0, 7, 127); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x99, 36, 100, 0x89, 36, 0, 0xB0, 7, 127}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("got % x, want % x", buf.Bytes(), want)
+	}
+	if err := w.NoteOn(16, 0, 0); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := w.ControlChange(0, 128, 0); err == nil {
+		t.Fatal("expected error")
+	}
+
+	buf.Reset()
+	if err := w.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 3*(MaxChannel+1) {
+		t.Fatalf("got % x", buf.Bytes())
+	}
+	if got := buf.Bytes()[3*MaxChannel:]; !bytes.Equal(got, []byte{0xBF, 123, 0}) {
+		t.Fatalf("got % x for the last channel", got)
+	}
+}
+
+const mappingJSON = `{
+  "encoders": {
+    "volume": {"channel": 1, "controller": 7, "initial": 100, "step": 10},
+    "pan": {"controller": 10, "min": 32, "max": 96, "initial": 0}
+  },
+  "buttons": {
+    "kick": {"channel": 9, "note": 36},
+    "hold": {"type": "momentary", "controller": 64},
+    "mute": {"type": "toggle", "controller": 20, "value": 1}
+  }
+}`
+
+func TestParseMapping(t *testing.T) {
+	m, err := ParseMapping(strings.NewReader(mappingJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := m.Encoders["volume"]; e.Max != MaxValue || e.Initial != 100 {
+		t.Fatalf("%+v", e)
+	}
+	if e := m.Encoders["pan"]; e.Step != 1 || e.Initial != 32 {
+		t.Fatalf("%+v", e)
+	}
+	if b := m.Buttons["kick"]; b.Type != Note || b.Velocity != MaxValue {
+		t.Fatalf("%+v", b)
+	}
+	for _, s := range []string{
+		`{"encoders": {"a": {"channel": 16}}}`,
+		`{"encoders": {"a": {"min": 10, "max": 5}}}`,
+		`{"buttons": {"a": {"type": "pad"}}}`,
+		`{"buttons": {"a": {"note": 128}}}`,
+		`{"knobs": {}}`,
+		`{`,
+	} {
+		if _, err := ParseMapping(strings.NewReader(s)); err == nil {
+			t.Fatalf("%s: expected error", s)
+		}
+	}
+}
+
+func TestBridge(t *testing.T) {
+	m, err := ParseMapping(strings.NewReader(mappingJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	b := NewBridge(NewWriter(&buf), m)
+	check := func(want ...byte) {
+		t.Helper()
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Fatalf("got % x, want % x", buf.Bytes(), want)
+		}
+		buf.Reset()
+	}
+	if err := b.Turn("volume", 1); err != nil {
+		t.Fatal(err)
+	}
+	check(0xB1, 7, 110)
+	// Clamped to Max, then unchanged.
+	if err := b.Turn("volume", 3); err != nil {
+		t.Fatal(err)
+	}
+	check(0xB1, 7, 127)
+	if err := b.Turn("volume", 1); err != nil {
+		t.Fatal(err)
+	}
+	check()
+	if v, ok := b.Value("volume"); !ok || v != 127 {
+		t.Fatal(v, ok)
+	}
+	if err := b.Turn("pan", -1); err != nil {
+		t.Fatal(err)
+	}
+	check()
+
+	if err := b.Button("kick", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Button("kick", false); err != nil {
+		t.Fatal(err)
+	}
+	check(0x99, 36, 127, 0x89, 36, 0)
+	if err := b.Button("hold", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Button("hold", false); err != nil {
+		t.Fatal(err)
+	}
+	check(0xB0, 64, 127, 0xB0, 64, 0)
+	for _, pressed := range []bool{true, false, true, false} {
+		if err := b.Button("mute", pressed); err != nil {
+			t.Fatal(err)
+		}
+	}
+	check(0xB0, 20, 1, 0xB0, 20, 0)
+
+	if err := b.Turn("kick", 1); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := b.Button("volume", true); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestBridge_Run(t *testing.T) {
+	m, err := ParseMapping(strings.NewReader(mappingJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	b := NewBridge(NewWriter(&buf), m)
+	events := make(chan rotary.Event, 3)
+	events <- rotary.Event{Kind: rotary.Turn, Steps: -2}
+	events <- rotary.Event{Kind: rotary.Press}
+	events <- rotary.Event{Kind: rotary.Release}
+	close(events)
+	if err := b.Run(events, "volume", "kick"); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0xB1, 7, 80, 0x99, 36, 127, 0x89, 36, 0}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("got % x, want % x", buf.Bytes(), want)
+	}
+}
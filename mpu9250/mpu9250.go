@@ -68,6 +68,16 @@ func New(transport Transport) (*MPU9250, error) {
 	return &MPU9250{transport: transport, debug: noop}, nil
 }
 
+// Halt implements conn.Resource. It puts the device in the sleep mode. Init()
+// wakes it up.
+func (m *MPU9250) Halt() error {
+	return m.transport.writeMaskedReg(reg.MPU9250_PWR_MGMT_1, reg.MPU9250_SLEEP_MASK, reg.MPU9250_SLEEP_MASK)
+}
+
+func (m *MPU9250) String() string {
+	return fmt.Sprintf("MPU9250{%s}", &m.transport)
+}
+
 // Debug sets the debug logger implementation.
 func (m *MPU9250) Debug(f DebugF) {
 	m.debug = f
@@ -42,6 +42,13 @@ func NewI2cTransport(bus i2c.Bus, address uint16) (*Transport, error) {
 	return &Transport{d: &i2c.Dev{Bus: bus, Addr: address}, debug: noop}, nil
 }
 
+func (t *Transport) String() string {
+	if t.d == nil {
+		return t.device.String()
+	}
+	return t.d.String()
+}
+
 // EnableDebug Sets the debugging output using the local print function.
 func (t *Transport) EnableDebug(f DebugF) {
 	t.debug = f
@@ -0,0 +1,37 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package nmea_test
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"periph.io/x/devices/v3/nmea"
+)
+
+func Example() {
+	// The UART must be configured beforehand, for example with
+	// `stty -F /dev/serial0 9600 raw`.
+	f, err := os.Open("/dev/serial0")
+	if err != nil {
+		log.Fatal(err)
+	}
+	d, err := nmea.New(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+	updates, err := d.Start()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for fix := range updates {
+		fmt.Println(fix)
+	}
+	if err := d.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package nmea
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// Fix is the state of the receiver, merged from the sentences of a fix.
+type Fix struct {
+	// Time is the UTC date and time of the fix.
+	Time time.Time
+	// Valid is true when the receiver has a fix.
+	Valid     bool
+	Quality   Quality
+	Latitude  float64
+	Longitude float64
+	Altitude  physic.Distance
+	Speed     physic.Speed
+	Course    float64
+	// Satellites is the number of satellites used for the fix.
+	Satellites int
+	HDOP       float64
+	// InView is the satellites in view, of all the constellations, sorted by
+	// talker and PRN.
+	InView []Satellite
+}
+
+func (f Fix) String() string {
+	if !f.Valid {
+		return fmt.Sprintf("no fix, %d satellites in view", len(f.InView))
+	}
+	return fmt.Sprintf("%s %.6f,%.6f %s %s, %d satellites", f.Time.Format(time.RFC3339), f.Latitude, f.Longitude, f.Altitude, f.Quality, f.Satellites)
+}
+
+// eventBufferSize is the size of the buffer of the updates channel.
+const eventBufferSize = 16
+
+// Dev is a handle to a GPS receiver.
+type Dev struct {
+	r io.Reader
+
+	mu   sync.Mutex
+	last Fix
+	// gsv holds the satellites in view by talker, and partial holds those of
+	// the GSV sentences being received.
+	gsv     map[string][]Satellite
+	partial map[string][]Satellite
+	stop    chan struct{}
+	done    chan struct{}
+	err     error
+}
+
+// New returns a handle to a GPS receiver sending NMEA sentences to r,
+// usually a serial port.
+func New(r io.Reader) (*Dev, error) {
+	if r == nil {
+		return nil, errors.New("nmea: reader is required")
+	}
+	return &Dev{r: r, gsv: map[string][]Satellite{}, partial: map[string][]Satellite{}}, nil
+}
+
+// Start starts a goroutine reading the sentences, and sends the Fix to the
+// returned channel after each RMC sentence, which most receivers send last
+// of the sentences of a fix, or first of the next one. The channel is closed
+// when Stop() is called, or the reader returns an error. See Err().
+//
+// Invalid sentences, such as those corrupted by noise on the line, are
+// ignored.
+func (d *Dev) Start() (<-chan Fix, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return nil, errors.New("nmea: already started")
+	}
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
+	d.err = nil
+	updates := make(chan Fix, eventBufferSize)
+	go d.run(updates, d.stop, d.done)
+	return updates, nil
+}
+
+// Stop stops the goroutine started by Start(), and waits for it to exit. A
+// pending read isn't interrupted, so it returns after the next sentence,
+// unless the reader is closed first, which Halt() does.
+func (d *Dev) Stop() {
+	d.mu.Lock()
+	stop, done := d.stop, d.done
+	d.stop = nil
+	d.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Err returns the error that stopped the goroutine, if any. The end of the
+// input isn't an error.
+func (d *Dev) Err() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.err
+}
+
+// Last returns the last fix received.
+func (d *Dev) Last() Fix {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	f := d.last
+	f.InView = append([]Satellite(nil), f.InView...)
+	return f
+}
+
+// Update updates the fix with a sentence, and returns true if it's an RMC
+// sentence completing a fix. It's used by Start(), and can process
+// sentences read otherwise.
+func (d *Dev) Update(line string) (bool, error) {
+	s, err := Parse(line)
+	if err != nil {
+		return false, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	switch s := s.(type) {
+	case *RMC:
+		d.last.Time = s.Time
+		d.last.Valid = s.Valid
+		if s.Valid {
+			d.last.Latitude, d.last.Longitude = s.Latitude, s.Longitude
+			d.last.Speed, d.last.Course = s.Speed, s.Course
+		}
+		return true, nil
+	case *GGA:
+		d.last.Quality = s.Quality
+		d.last.Satellites = s.Satellites
+		d.last.HDOP = s.HDOP
+		if s.Quality != Invalid {
+			d.last.Latitude, d.last.Longitude = s.Latitude, s.Longitude
+			d.last.Altitude = s.Altitude
+		}
+	case *GSV:
+		if s.Index == 1 {
+			d.partial[s.Talker] = nil
+		}
+		d.partial[s.Talker] = append(d.partial[s.Talker], s.Satellites...)
+		if s.Index == s.Count {
+			d.gsv[s.Talker] = d.partial[s.Talker]
+			delete(d.partial, s.Talker)
+			d.last.InView = d.inView()
+		}
+	}
+	return false, nil
+}
+
+// Halt implements conn.Resource. It stops the goroutine started by Start(),
+// closing the reader first if it implements io.Closer.
+func (d *Dev) Halt() error {
+	d.mu.Lock()
+	stop, done := d.stop, d.done
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+	}
+	var err error
+	if c, ok := d.r.(io.Closer); ok {
+		err = c.Close()
+	}
+	if done != nil {
+		<-done
+	}
+	return err
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("nmea{%v}", d.r)
+}
+
+func (d *Dev) inView() []Satellite {
+	var all []Satellite
+	for _, s := range d.gsv {
+		all = append(all, s...)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Talker != all[j].Talker {
+			return all[i].Talker < all[j].Talker
+		}
+		return all[i].PRN < all[j].PRN
+	})
+	return all
+}
+
+func (d *Dev) run(updates chan<- Fix, stop, done chan struct{}) {
+	defer close(done)
+	defer close(updates)
+	s := bufio.NewScanner(d.r)
+	for s.Scan() {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		fix, err := d.Update(s.Text())
+		if err != nil || !fix {
+			continue
+		}
+		select {
+		case updates <- d.Last():
+		case <-stop:
+			return
+		}
+	}
+	if err := s.Err(); err != nil {
+		select {
+		case <-stop:
+			// The reader was closed by Halt().
+		default:
+			d.mu.Lock()
+			d.err = fmt.Errorf("nmea: %w", err)
+			d.mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,298 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package nmea reads the position and time of GPS receivers, such as the
+// u-blox NEO-6M, the MediaTek MT3339 and the Quectel L80, from the NMEA 0183
+// sentences they send on their serial port.
+//
+// The RMC, GGA and GSV sentences are decoded, from any talker, so receivers
+// tracking multiple constellations (GN, GL, GA, BD) are supported.
+//
+// # Wiring
+//
+// Connect the TX pin of the receiver to the RX pin of the UART of the host.
+// Most receivers default to 9600 baud. The PPS output, if any, isn't used.
+//
+// # References
+//
+// https://gpsd.gitlab.io/gpsd/NMEA.html
+package nmea
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// ErrUnsupported is returned by Parse for valid sentences of other types.
+var ErrUnsupported = errors.New("nmea: unsupported sentence")
+
+// Quality is the quality of the position, as reported by GGA.
+type Quality int
+
+const (
+	Invalid    Quality = 0
+	GPS        Quality = 1
+	DGPS       Quality = 2
+	PPS        Quality = 3
+	RTK        Quality = 4
+	FloatRTK   Quality = 5
+	Estimated  Quality = 6
+	Manual     Quality = 7
+	Simulation Quality = 8
+)
+
+var qualityNames = [...]string{"Invalid", "GPS", "DGPS", "PPS", "RTK", "FloatRTK", "Estimated", "Manual", "Simulation"}
+
+func (q Quality) String() string {
+	if q < 0 || int(q) >= len(qualityNames) {
+		return fmt.Sprintf("Quality(%d)", int(q))
+	}
+	return qualityNames[q]
+}
+
+// RMC is the Recommended Minimum Specific GNSS Data sentence, sent once per
+// fix.
+type RMC struct {
+	Talker string
+	// Time is the UTC date and time of the fix.
+	Time time.Time
+	// Valid is false when the receiver has no fix; the other fields may
+	// then be stale or zero.
+	Valid bool
+	// Latitude and Longitude are in decimal degrees, positive north and
+	// east.
+	Latitude  float64
+	Longitude float64
+	// Speed is the speed over ground.
+	Speed physic.Speed
+	// Course is the course over ground, in degrees from the true north.
+	Course float64
+}
+
+// GGA is the Global Positioning System Fix Data sentence.
+type GGA struct {
+	Talker string
+	// Time is the UTC time of the fix, on January 1st of year 0, since GGA
+	// doesn't include the date.
+	Time      time.Time
+	Quality   Quality
+	Latitude  float64
+	Longitude float64
+	// Satellites is the number of satellites used for the fix.
+	Satellites int
+	// HDOP is the horizontal dilution of precision.
+	HDOP float64
+	// Altitude is the altitude above the mean sea level.
+	Altitude physic.Distance
+}
+
+// Satellite is a satellite in view, as reported by GSV.
+type Satellite struct {
+	// Talker is the constellation of the satellite, GP for GPS, GL for
+	// GLONASS, GA for Galileo, GB or BD for BeiDou.
+	Talker string
+	PRN    int
+	// Elevation and Azimuth are in degrees.
+	Elevation int
+	Azimuth   int
+	// SNR is the signal to noise ratio in dB-Hz, or -1 if it's not tracked.
+	SNR int
+}
+
+// GSV is one of the GNSS Satellites in View sentences. The satellites in
+// view are split across Count sentences, of up to 4 satellites each.
+type GSV struct {
+	Talker string
+	// Count is the number of sentences, and Index the index of this one,
+	// from 1.
+	Count int
+	Index int
+	// InView is the total number of satellites in view.
+	InView     int
+	Satellites []Satellite
+}
+
+// Parse parses a sentence, with or without its line ending, and returns a
+// *RMC, *GGA or *GSV. It returns ErrUnsupported for well-formed sentences of
+// other types. The checksum is verified if present.
+func Parse(line string) (any, error) {
+	line = strings.TrimRight(line, "\r\n")
+	if !strings.HasPrefix(line, "$") {
+		return nil, fmt.Errorf("nmea: invalid sentence %q", line)
+	}
+	body := line[1:]
+	if i := strings.LastIndexByte(body, '*'); i >= 0 {
+		want, err := strconv.ParseUint(body[i+1:], 16, 8)
+		if err != nil || len(body)-i-1 != 2 {
+			return nil, fmt.Errorf("nmea: invalid checksum in %q", line)
+		}
+		body = body[:i]
+		var sum byte
+		for j := 0; j < len(body); j++ {
+			sum ^= body[j]
+		}
+		if sum != byte(want) {
+			return nil, fmt.Errorf("nmea: checksum mismatch in %q: got %02X", line, sum)
+		}
+	}
+	f := strings.Split(body, ",")
+	if len(f[0]) != 5 {
+		return nil, fmt.Errorf("nmea: invalid address in %q", line)
+	}
+	p := parser{f: f}
+	talker := f[0][:2]
+	var s any
+	switch f[0][2:] {
+	case "RMC":
+		s = p.rmc(talker)
+	case "GGA":
+		s = p.gga(talker)
+	case "GSV":
+		s = p.gsv(talker)
+	default:
+		return nil, ErrUnsupported
+	}
+	if p.err != nil {
+		return nil, fmt.Errorf("nmea: invalid %s sentence %q: %w", f[0][2:], line, p.err)
+	}
+	return s, nil
+}
+
+// parser decodes the fields of a sentence, recording the first error.
+type parser struct {
+	f   []string
+	err error
+}
+
+func (p *parser) rmc(talker string) *RMC {
+	if !p.need(10) {
+		return nil
+	}
+	r := &RMC{Talker: talker, Valid: p.f[2] == "A"}
+	r.Latitude = p.coord(3, 'N', 'S')
+	r.Longitude = p.coord(5, 'E', 'W')
+	// 1 knot is 1852m/h.
+	r.Speed = physic.Speed(math.Round(p.float(7) * 1852 / 3600 * float64(physic.MetrePerSecond)))
+	r.Course = p.float(8)
+	if p.f[9] != "" {
+		d, err := time.Parse("020106", p.f[9])
+		if err != nil {
+			p.fail(err)
+		}
+		r.Time = d.Add(p.clock(1))
+	}
+	return r
+}
+
+func (p *parser) gga(talker string) *GGA {
+	if !p.need(10) {
+		return nil
+	}
+	g := &GGA{Talker: talker}
+	g.Time = time.Time{}.Add(p.clock(1))
+	g.Latitude = p.coord(2, 'N', 'S')
+	g.Longitude = p.coord(4, 'E', 'W')
+	g.Quality = Quality(p.int(6))
+	g.Satellites = p.int(7)
+	g.HDOP = p.float(8)
+	g.Altitude = physic.Distance(math.Round(p.float(9) * float64(physic.Metre)))
+	return g
+}
+
+func (p *parser) gsv(talker string) *GSV {
+	if !p.need(4) {
+		return nil
+	}
+	g := &GSV{Talker: talker, Count: p.int(1), Index: p.int(2), InView: p.int(3)}
+	// NMEA 4.10 appends a signal ID after the satellites.
+	for i := 4; i+4 <= len(p.f); i += 4 {
+		s := Satellite{Talker: talker, PRN: p.int(i), Elevation: p.int(i + 1), Azimuth: p.int(i + 2), SNR: -1}
+		if p.f[i+3] != "" {
+			s.SNR = p.int(i + 3)
+		}
+		g.Satellites = append(g.Satellites, s)
+	}
+	return g
+}
+
+func (p *parser) need(n int) bool {
+	if len(p.f) < n {
+		p.fail(fmt.Errorf("%d fields, want %d", len(p.f), n))
+		return false
+	}
+	return true
+}
+
+func (p *parser) fail(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+// float returns field i, or 0 if it's empty.
+func (p *parser) float(i int) float64 {
+	if p.f[i] == "" {
+		return 0
+	}
+	v, err := strconv.ParseFloat(p.f[i], 64)
+	if err != nil {
+		p.fail(err)
+	}
+	return v
+}
+
+// int returns field i, or 0 if it's empty.
+func (p *parser) int(i int) int {
+	if p.f[i] == "" {
+		return 0
+	}
+	v, err := strconv.Atoi(p.f[i])
+	if err != nil {
+		p.fail(err)
+	}
+	return v
+}
+
+// coord returns the coordinate in fields i and i+1, as [d]ddmm.mmmm and the
+// hemisphere, in decimal degrees.
+func (p *parser) coord(i int, pos, neg byte) float64 {
+	v := p.float(i)
+	deg := math.Trunc(v / 100)
+	d := deg + (v-deg*100)/60
+	switch {
+	case p.f[i+1] == string(neg):
+		return -d
+	case p.f[i+1] == string(pos) || p.f[i] == "":
+		return d
+	default:
+		p.fail(fmt.Errorf("invalid hemisphere %q", p.f[i+1]))
+		return 0
+	}
+}
+
+// clock returns the time of day in field i, as hhmmss.sss.
+func (p *parser) clock(i int) time.Duration {
+	s := p.f[i]
+	if s == "" {
+		return 0
+	}
+	if len(s) < 6 {
+		p.fail(fmt.Errorf("invalid time %q", s))
+		return 0
+	}
+	h, err1 := strconv.Atoi(s[0:2])
+	m, err2 := strconv.Atoi(s[2:4])
+	sec, err3 := strconv.ParseFloat(s[4:], 64)
+	if err := errors.Join(err1, err2, err3); err != nil {
+		p.fail(err)
+		return 0
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(math.Round(sec*1000))*time.Millisecond
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package nmea
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/physic"
+)
+
+// sentence returns body framed with its checksum.
+func sentence(body string) string {
+	var sum byte
+	for i := 0; i < len(body); i++ {
+		sum ^= body[i]
+	}
+	return fmt.Sprintf("$%s*%02X\r\n", body, sum)
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestParse_RMC(t *testing.T) {
+	s, err := Parse("$GPRMC,123519,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*6A\r\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := s.(*RMC)
+	if r.Talker != "GP" || !r.Valid || !near(r.Latitude, 48+7.038/60) || !near(r.Longitude, 11+31.0/60) || !near(r.Course, 84.4) {
+		t.Fatalf("%+v", r)
+	}
+	if want := time.Date(1994, 3, 23, 12, 35, 19, 0, time.UTC); !r.Time.Equal(want) {
+		t.Fatalf("got %s, want %s", r.Time, want)
+	}
+	if want := 11523555 * physic.MicroMetrePerSecond; r.Speed/physic.MicroMetrePerSecond != want/physic.MicroMetrePerSecond {
+		t.Fatalf("got %s, want %s", r.Speed, want)
+	}
+}
+
+func TestParse_GGA(t *testing.T) {
+	s, err := Parse("$GPGGA,123519,4807.038,S,01131.000,W,1,08,0.9,545.4,M,46.9,M,,*48")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := s.(*GGA)
+	if g.Quality != GPS || g.Satellites != 8 || !near(g.HDOP, 0.9) || g.Altitude != 5454*physic.Metre/10 {
+		t.Fatalf("%+v", g)
+	}
+	if !near(g.Latitude, -(48+7.038/60)) || !near(g.Longitude, -(11+31.0/60)) {
+		t.Fatalf("%+v", g)
+	}
+	if g.Time.Hour() != 12 || g.Time.Minute() != 35 || g.Time.Second() != 19 {
+		t.Fatal(g.Time)
+	}
+}
+
+func TestParse_GSV(t *testing.T) {
+	s, err := Parse(sentence("GLGSV,2,2,05,70,10,020,,1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := s.(*GSV)
+	want := Satellite{Talker: "GL", PRN: 70, Elevation: 10, Azimuth: 20, SNR: -1}
+	if g.Count != 2 || g.Index != 2 || g.InView != 5 || len(g.Satellites) != 1 || g.Satellites[0] != want {
+		t.Fatalf("%+v", g)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, line := range []string{
+		"GPRMC,123519",
+		"$GPRMC,123519,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*6B",
+		"$GPRMC,123519,A*6",
+		sentence("GPRMC,123519,A"),
+		sentence("GPGGA,123519,4807.038,X,01131.000,E,1,08,0.9,545.4,M"),
+		sentence("GPGGA,12:35,4807.038,N,01131.000,E,1,08,0.9,545.4,M"),
+		sentence("GPGGA,123519,4807.038,N,01131.000,E,one,08,0.9,545.4,M"),
+		sentence("X"),
+	} {
+		if _, err := Parse(line); err == nil || errors.Is(err, ErrUnsupported) {
+			t.Fatalf("%q: got %v", line, err)
+		}
+	}
+	if _, err := Parse(sentence("GPVTG,,T,,M,0.0,N,0.0,K,A")); err != ErrUnsupported {
+		t.Fatal(err)
+	}
+}
+
+func TestQuality_String(t *testing.T) {
+	if s := FloatRTK.String(); s != "FloatRTK" {
+		t.Fatal(s)
+	}
+	if s := Quality(9).String(); s != "Quality(9)" {
+		t.Fatal(s)
+	}
+}
+
+var epoch = sentence("GPGSV,2,1,05,01,40,083,46,02,17,308,41,12,07,344,39,14,22,228,45") +
+	sentence("GPGSV,2,2,05,25,05,100,") +
+	sentence("GLGSV,1,1,01,70,10,020,30") +
+	"garbage\r\n" +
+	sentence("GNGGA,123519.50,4807.038,N,01131.000,E,2,09,0.9,545.4,M,46.9,M,,") +
+	sentence("GNRMC,123519.50,A,4807.038,N,01131.000,E,0.0,,230394,,,D")
+
+func TestDev(t *testing.T) {
+	r, w := io.Pipe()
+	d, err := New(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updates, err := d.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Start(); err == nil {
+		t.Fatal("expected error")
+	}
+	go func() {
+		_, _ = io.WriteString(w, sentence("GPRMC,,V,,,,,,,,,,N"))
+		_, _ = io.WriteString(w, epoch)
+	}()
+	if f := <-updates; f.Valid {
+		t.Fatalf("%+v", f)
+	}
+	f := <-updates
+	if !f.Valid || f.Quality != DGPS || f.Satellites != 9 || f.Altitude != 5454*physic.Metre/10 || !near(f.Latitude, 48+7.038/60) {
+		t.Fatalf("%+v", f)
+	}
+	if want := time.Date(1994, 3, 23, 12, 35, 19, 5e8, time.UTC); !f.Time.Equal(want) {
+		t.Fatalf("got %s, want %s", f.Time, want)
+	}
+	if len(f.InView) != 6 || f.InView[0].Talker != "GL" || f.InView[1].PRN != 1 || f.InView[5].SNR != -1 {
+		t.Fatalf("%+v", f.InView)
+	}
+	if l := d.Last(); !l.Time.Equal(f.Time) || len(l.InView) != 6 {
+		t.Fatalf("%+v", l)
+	}
+	if s := f.String(); s != "1994-03-23T12:35:19Z 48.117300,11.516667 545.400m DGPS, 9 satellites" {
+		t.Fatal(s)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-updates; ok {
+		t.Fatal("updates not closed")
+	}
+	if err := d.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDev_EOF(t *testing.T) {
+	d, err := New(strings.NewReader(epoch + epoch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	updates, err := d.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for range updates {
+		n++
+	}
+	if n != 2 || d.Err() != nil {
+		t.Fatal(n, d.Err())
+	}
+	d.Stop()
+}
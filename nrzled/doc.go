@@ -18,6 +18,9 @@
 // You may also need to increase your SPI buffer size to 12*num_pixels+3, or just max it out
 // with `spidev.bufsize=65536`. That should allopw you to buffer over 5400 Neopixels.
 //
+// NewFrame returns a frame buffer of colors for a strip, with brightness
+// scaling and gamma correction applied when it is flushed to the LEDs.
+//
 // # Datasheet
 //
 // This directory contains datasheets for ws2812, ws2812b, ucs190x and various
@@ -0,0 +1,93 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package nrzled
+
+import (
+	"image/color"
+	"math"
+)
+
+// DefaultGamma is the gamma correction commonly used for WS2812B LEDs, which
+// are linear, so that the steps of brightness look even.
+const DefaultGamma = 2.8
+
+// Frame is a frame buffer for a strip, with one color per LED. The changes
+// are sent to the LEDs by Flush().
+//
+// Brightness and gamma correction are applied on Flush(), so Pixels keeps the
+// colors as set.
+type Frame struct {
+	// Pixels is the color of each LED. The alpha channel is used as the white
+	// channel of RGBW LEDs, and ignored otherwise.
+	Pixels []color.NRGBA
+	// Brightness scales all the channels, 255 is full brightness. Lowering it
+	// also limits the current drawn by the strip.
+	Brightness uint8
+	// Gamma is the exponent of the gamma correction. 0 or 1 disables it.
+	Gamma float64
+
+	d   *Dev
+	buf []byte
+	// lut maps the channel values, for lutB and lutG.
+	lut   [256]byte
+	lutB  uint8
+	lutG  float64
+	lutOK bool
+}
+
+// NewFrame returns a frame buffer for d, with all LEDs off, full brightness
+// and DefaultGamma.
+func NewFrame(d *Dev) *Frame {
+	return &Frame{
+		Pixels:     make([]color.NRGBA, d.numPixels),
+		Brightness: 255,
+		Gamma:      DefaultGamma,
+		d:          d,
+		buf:        make([]byte, d.numPixels*d.channels),
+	}
+}
+
+// Fill sets all the LEDs to c.
+func (f *Frame) Fill(c color.NRGBA) {
+	for i := range f.Pixels {
+		f.Pixels[i] = c
+	}
+}
+
+// Clear turns all the LEDs off.
+func (f *Frame) Clear() {
+	f.Fill(color.NRGBA{})
+}
+
+// Flush sends the frame buffer to the LEDs.
+func (f *Frame) Flush() error {
+	f.initLUT()
+	n := min(len(f.Pixels), f.d.numPixels)
+	b := f.buf[:n*f.d.channels]
+	for i, c := range f.Pixels[:n] {
+		j := i * f.d.channels
+		b[j], b[j+1], b[j+2] = f.lut[c.R], f.lut[c.G], f.lut[c.B]
+		if f.d.channels == 4 {
+			b[j+3] = f.lut[c.A]
+		}
+	}
+	_, err := f.d.Write(b)
+	return err
+}
+
+// initLUT updates the lookup table if Brightness or Gamma changed.
+func (f *Frame) initLUT() {
+	if f.lutOK && f.lutB == f.Brightness && f.lutG == f.Gamma {
+		return
+	}
+	f.lutB, f.lutG, f.lutOK = f.Brightness, f.Gamma, true
+	for i := range f.lut {
+		v := float64(i) / 255
+		if f.Gamma > 0 && f.Gamma != 1 {
+			v = math.Pow(v, f.Gamma)
+		}
+		f.lut[i] = byte(math.Round(v * float64(f.Brightness)))
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package nrzled
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func TestFrame_Flush(t *testing.T) {
+	o := Opts{NumPixels: 2, Channels: 3, Freq: 2500 * physic.KiloHertz}
+	data := []struct {
+		name       string
+		brightness uint8
+		gamma      float64
+		want       []byte
+	}{
+		{"raw", 255, 0, []byte{0xFF, 0x80, 0x00, 0x10, 0x20, 0x30}},
+		{"brightness", 128, 1, []byte{0x80, 0x40, 0x00, 0x08, 0x10, 0x18}},
+		{"gamma", 255, 2, []byte{0xFF, 0x40, 0x00, 0x01, 0x04, 0x09}},
+	}
+	for _, line := range data {
+		t.Run(line.name, func(t *testing.T) {
+			var got, want bytes.Buffer
+			d, err := NewSPI(spitest.NewRecordRaw(&got), &o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f := NewFrame(d)
+			f.Brightness, f.Gamma = line.brightness, line.gamma
+			f.Pixels[0] = color.NRGBA{R: 0xFF, G: 0x80, A: 0xFF}
+			f.Pixels[1] = color.NRGBA{R: 0x10, G: 0x20, B: 0x30}
+			if err := f.Flush(); err != nil {
+				t.Fatal(err)
+			}
+			ref, err := NewSPI(spitest.NewRecordRaw(&want), &o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := ref.Write(line.want); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got.Bytes(), want.Bytes()) {
+				t.Errorf("\nGot:  %#02v\nWant: %#02v", got.Bytes(), want.Bytes())
+			}
+		})
+	}
+}
+
+func TestFrame_Fill(t *testing.T) {
+	o := Opts{NumPixels: 3, Channels: 4, Freq: 800 * physic.KiloHertz}
+	d := &Dev{numPixels: o.NumPixels, channels: o.Channels}
+	f := NewFrame(d)
+	c := color.NRGBA{R: 1, G: 2, B: 3, A: 4}
+	f.Fill(c)
+	for i, p := range f.Pixels {
+		if p != c {
+			t.Fatalf("pixel %d = %v", i, p)
+		}
+	}
+	f.Clear()
+	if f.Pixels[1] != (color.NRGBA{}) {
+		t.Errorf("pixel 1 = %v after Clear", f.Pixels[1])
+	}
+	if len(f.buf) != 12 {
+		t.Errorf("buffer of %d bytes", len(f.buf))
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// The 74HC165 is a parallel in, serial out shift register. It converts 8
+// parallel inputs to a serial stream that can be read over SPI. Devices can be
+// chained by connecting the serial output (QH) of one to the serial input (DS)
+// of the next, so that a large panel of buttons or switches can be read using
+// only the SPI clock and MISO lines, and one GPIO for the parallel load.
+//
+// # Wiring
+//
+// Connect CP to the SPI clock, and QH of the device nearest to the controller
+// to MISO. SH/LD is connected to a GPIO output, which is pulsed low to latch
+// the inputs before they are shifted out. CE (clock inhibit) is tied low.
+// Inputs of the device nearest to the controller are pins 0 to 7, the inputs
+// of the next one are pins 8 to 15, and so on.
+//
+// # Datasheet
+//
+// https://www.nexperia.com/product/74HC165D
+package nxp74hc165
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/spi"
+)
+
+const (
+	devName = "74HC165"
+	numPins = 8
+	// maxChained is the number of devices whose inputs fit in a
+	// gpio.GPIOValue.
+	maxChained = 8
+	// eventBufferSize is the size of the channel returned by Start().
+	eventBufferSize = 16
+)
+
+var (
+	ErrNotImplemented = errors.New("nxp74hc165: not implemented")
+)
+
+// Event is a change of the level of an input, sent by the goroutine started
+// by Dev.Start().
+type Event struct {
+	// Pin is the number of the input that changed, as an index of Dev.Pins.
+	Pin int
+	// Level is the new level of the input.
+	Level gpio.Level
+	// Time is when the change was read from the device.
+	Time time.Time
+}
+
+// Dev represents a chain of 74HC165 devices.
+type Dev struct {
+	Pins []gpio.PinIn
+
+	mu   sync.Mutex
+	conn spi.Conn
+	load gpio.PinOut
+	n    int
+
+	// pollMu guards the state of the goroutine started by Start().
+	pollMu sync.Mutex
+	stop   chan struct{}
+	done   chan struct{}
+	err    error
+}
+
+// New accepts an spi.Conn, the GPIO connected to SH/LD, and the number of
+// chained devices, and returns a new 74HC165 device. Up to 8 devices can be
+// chained.
+//
+// If load is nil, SH/LD must be driven by other means, for example by the
+// chip select through an inverter, so that the inputs are latched before each
+// SPI transaction.
+func New(conn spi.Conn, load gpio.PinOut, chained int) (*Dev, error) {
+	if chained < 1 || chained > maxChained {
+		return nil, fmt.Errorf("nxp74hc165: invalid number of chained devices %d", chained)
+	}
+	if load != nil {
+		if err := load.Out(gpio.High); err != nil {
+			return nil, fmt.Errorf("nxp74hc165: %w", err)
+		}
+	}
+	dev := &Dev{conn: conn, load: load, n: chained, Pins: make([]gpio.PinIn, chained*numPins)}
+	for ix := range dev.Pins {
+		dev.Pins[ix] = &Pin{number: ix, name: fmt.Sprintf("%s_GPI%d", devName, ix), dev: dev}
+	}
+	return dev, nil
+}
+
+// Read latches the inputs of all the chained devices and returns their
+// levels. Bit n of the result is the level of Pins[n].
+func (dev *Dev) Read() (gpio.GPIOValue, error) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if dev.conn == nil {
+		return 0, fmt.Errorf("nxp74hc165: device halted")
+	}
+	if dev.load != nil {
+		if err := dev.load.Out(gpio.Low); err != nil {
+			return 0, err
+		}
+		if err := dev.load.Out(gpio.High); err != nil {
+			return 0, err
+		}
+	}
+	w := make([]byte, dev.n)
+	r := make([]byte, dev.n)
+	if err := dev.conn.Tx(w, r); err != nil {
+		return 0, err
+	}
+	// D7 of the device nearest to the controller is shifted out first.
+	var value gpio.GPIOValue
+	for ix, b := range r {
+		value |= gpio.GPIOValue(b) << (ix * numPins)
+	}
+	return value, nil
+}
+
+// Start starts a goroutine that reads the inputs every interval, and sends
+// the inputs that changed since the previous read to the returned channel.
+// The levels read when the goroutine starts aren't reported. The channel is
+// closed when Stop() is called, or a read returns an error. See Err().
+func (dev *Dev) Start(interval time.Duration) (<-chan Event, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("nxp74hc165: invalid polling interval %s", interval)
+	}
+	dev.pollMu.Lock()
+	defer dev.pollMu.Unlock()
+	if dev.stop != nil {
+		return nil, errors.New("nxp74hc165: polling already started")
+	}
+	last, err := dev.Read()
+	if err != nil {
+		return nil, err
+	}
+	dev.stop = make(chan struct{})
+	dev.done = make(chan struct{})
+	dev.err = nil
+	events := make(chan Event, eventBufferSize)
+	go dev.run(interval, last, events, dev.stop, dev.done)
+	return events, nil
+}
+
+// Stop stops the goroutine started by Start(), and waits for it to exit.
+func (dev *Dev) Stop() {
+	dev.pollMu.Lock()
+	stop, done := dev.stop, dev.done
+	dev.stop = nil
+	dev.pollMu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Err returns the error that stopped the polling goroutine, if any.
+func (dev *Dev) Err() error {
+	dev.pollMu.Lock()
+	defer dev.pollMu.Unlock()
+	return dev.err
+}
+
+func (dev *Dev) run(interval time.Duration, last gpio.GPIOValue, events chan<- Event, stop, done chan struct{}) {
+	defer close(done)
+	defer close(events)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(interval):
+		}
+		value, err := dev.Read()
+		if err != nil {
+			dev.pollMu.Lock()
+			dev.err = err
+			dev.pollMu.Unlock()
+			return
+		}
+		now := time.Now()
+		changed := value ^ last
+		last = value
+		for ix := range dev.Pins {
+			bit := gpio.GPIOValue(1) << ix
+			if changed&bit == 0 {
+				continue
+			}
+			select {
+			case events <- Event{Pin: ix, Level: value&bit != 0, Time: now}:
+			case <-stop:
+				return
+			}
+		}
+	}
+}
+
+// Halt stops the polling goroutine, and prevents the device from being used
+// again.
+func (dev *Dev) Halt() error {
+	dev.Stop()
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	dev.conn = nil
+	return nil
+}
+
+func (dev *Dev) String() string {
+	return devName
+}
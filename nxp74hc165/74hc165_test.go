@@ -0,0 +1,115 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package nxp74hc165
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/conntest"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+	"periph.io/x/conn/v3/spi/spitest"
+)
+
+func newDev(t *testing.T, chained int, ops ...conntest.IO) (*Dev, *spitest.Playback) {
+	pb := &spitest.Playback{Playback: conntest.Playback{Ops: ops}}
+	conn, err := pb.Connect(physic.MegaHertz, spi.Mode0, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev, err := New(conn, &gpiotest.Pin{N: "LOAD"}, chained)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dev, pb
+}
+
+func TestNew(t *testing.T) {
+	for _, n := range []int{0, 9} {
+		if _, err := New(nil, nil, n); err == nil {
+			t.Errorf("New(%d) succeeded", n)
+		}
+	}
+	dev, pb := newDev(t, 3)
+	defer pb.Close()
+	if len(dev.Pins) != 24 {
+		t.Errorf("got %d pins, expected 24", len(dev.Pins))
+	}
+	if s := dev.Pins[9].String(); s != "74HC165_GPI9" {
+		t.Errorf("got pin name %q", s)
+	}
+}
+
+func TestRead(t *testing.T) {
+	dev, pb := newDev(t, 2,
+		conntest.IO{W: []byte{0, 0}, R: []byte{0x81, 0x02}},
+		conntest.IO{W: []byte{0, 0}, R: []byte{0x81, 0x02}},
+		conntest.IO{W: []byte{0, 0}, R: []byte{0x81, 0x02}},
+	)
+	defer pb.Close()
+	v, err := dev.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 0x0281 {
+		t.Errorf("got 0x%x, expected 0x0281", v)
+	}
+	if l := dev.Pins[9].Read(); l != gpio.High {
+		t.Errorf("pin 9 read %s", l)
+	}
+	if l := dev.Pins[8].Read(); l != gpio.Low {
+		t.Errorf("pin 8 read %s", l)
+	}
+	if err := dev.Pins[0].In(gpio.PullUp, gpio.NoEdge); err == nil {
+		t.Error("In(PullUp) succeeded")
+	}
+	if err := pb.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStart(t *testing.T) {
+	dev, pb := newDev(t, 1,
+		conntest.IO{W: []byte{0}, R: []byte{0x00}},
+		conntest.IO{W: []byte{0}, R: []byte{0x00}},
+		conntest.IO{W: []byte{0}, R: []byte{0x11}},
+		conntest.IO{W: []byte{0}, R: []byte{0x10}},
+	)
+	pb.DontPanic = true
+	events, err := dev.Start(time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dev.Start(time.Millisecond); err == nil {
+		t.Error("second Start succeeded")
+	}
+	var got []Event
+	for ev := range events {
+		got = append(got, ev)
+	}
+	expected := []Event{{Pin: 0, Level: gpio.High}, {Pin: 4, Level: gpio.High}, {Pin: 0, Level: gpio.Low}}
+	if len(got) != len(expected) {
+		t.Fatalf("got %v, expected %v", got, expected)
+	}
+	for ix := range got {
+		if got[ix].Pin != expected[ix].Pin || got[ix].Level != expected[ix].Level {
+			t.Errorf("event %d: got %v, expected %v", ix, got[ix], expected[ix])
+		}
+	}
+	// The playback ran out of operations, which stops the goroutine.
+	if dev.Err() == nil {
+		t.Error("expected an error")
+	}
+	dev.Stop()
+	if err := dev.Halt(); err != nil {
+		t.Error(err)
+	}
+	if _, err := dev.Read(); err == nil {
+		t.Error("Read after Halt succeeded")
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package nxp74hc165_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+	"periph.io/x/conn/v3/spi/spireg"
+	"periph.io/x/devices/v3/nxp74hc165"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+	// Open the SPI Bus
+	pc, err := spireg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer pc.Close()
+	conn, err := pc.Connect(physic.MegaHertz, spi.Mode0, 8)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Two chained devices, with SH/LD connected to GPIO25.
+	dev, err := nxp74hc165.New(conn, gpioreg.ByName("GPIO25"), 2)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer dev.Halt()
+	events, err := dev.Start(10 * time.Millisecond)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for ev := range events {
+		fmt.Printf("input %d is %s\n", ev.Pin, ev.Level)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package nxp74hc165
+
+import (
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// Pin is an input of a 74HC165 device.
+type Pin struct {
+	dev    *Dev
+	name   string
+	number int
+}
+
+// Halt implements conn.Resource.
+func (pin *Pin) Halt() error {
+	return nil
+}
+
+// Name returns the name of the GPIO pin.
+func (pin *Pin) Name() string {
+	return pin.name
+}
+
+// Number returns the number of the GPIO pin.
+func (pin *Pin) Number() int {
+	return pin.number
+}
+
+// Deprecated: returns "In"
+func (pin *Pin) Function() string {
+	return "In"
+}
+
+// In only accepts gpio.Float or gpio.PullNoChange, and gpio.NoEdge. The
+// device has no pull resistors, and edges are detected by Dev.Start().
+func (pin *Pin) In(pull gpio.Pull, edge gpio.Edge) error {
+	if pull != gpio.Float && pull != gpio.PullNoChange {
+		return ErrNotImplemented
+	}
+	if edge != gpio.NoEdge {
+		return ErrNotImplemented
+	}
+	return nil
+}
+
+// Read returns the level of the pin. All the inputs of the chain are read,
+// use Dev.Read() to read several pins at once. If the device can't be read,
+// gpio.Low is returned.
+func (pin *Pin) Read() gpio.Level {
+	v, err := pin.dev.Read()
+	if err != nil {
+		return gpio.Low
+	}
+	return v&(gpio.GPIOValue(1)<<pin.number) != 0
+}
+
+// WaitForEdge is not available for this device, use Dev.Start() instead.
+func (pin *Pin) WaitForEdge(timeout time.Duration) bool {
+	return false
+}
+
+// Pull returns gpio.Float.
+func (pin *Pin) Pull() gpio.Pull {
+	return gpio.Float
+}
+
+// DefaultPull returns gpio.Float.
+func (pin *Pin) DefaultPull() gpio.Pull {
+	return gpio.Float
+}
+
+func (pin *Pin) String() string {
+	return pin.name
+}
+
+var _ gpio.PinIn = &Pin{}
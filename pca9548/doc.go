@@ -4,7 +4,11 @@
 
 // Package pca9548 is a driver for an 8 port I²C multiplexer that is available
 // from multiple vendors. The main features of this multiplexer is that its has
-// 8 channels and is capable of voltage level translation.
+// 8 channels and is capable of voltage level translation. The TI TCA9548A is
+// compatible, and is supported by this package.
+//
+// Ports can be registered with the host using Dev.RegisterPorts(), or used
+// directly as an i2c.Bus returned by Dev.Port().
 //
 // # Adjusting the Bus CLK
 //
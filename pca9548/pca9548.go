@@ -78,6 +78,20 @@ func (d *Dev) RegisterPorts(alias string) ([]string, error) {
 	return portNames, nil
 }
 
+// Port returns the port number of the multiplexer as an i2c.Bus, without
+// registering it with the host. Each transaction on the port selects the
+// channel first if another port was used since, so devices with the same
+// address, for example several identical displays or GPIO expanders, can be
+// connected to different ports and used concurrently.
+func (d *Dev) Port(number int) (i2c.BusCloser, error) {
+	if number < 0 || number >= int(d.numPorts) {
+		return nil, errors.New("port number outside valid range of 0-" + strconv.Itoa(int(d.numPorts)-1))
+	}
+	addrStr := strconv.FormatUint(uint64(d.address), 16)
+	name := d.c.String() + "-pca9548-" + addrStr + "-" + strconv.Itoa(number)
+	return &port{name: name, mux: d, number: uint8(number)}, nil
+}
+
 // Halt does nothing.
 func (d *Dev) Halt() error {
 	return nil
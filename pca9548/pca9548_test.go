@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"testing"
 
+	"periph.io/x/conn/v3/i2c"
 	"periph.io/x/conn/v3/i2c/i2creg"
 	"periph.io/x/conn/v3/i2c/i2ctest"
 	"periph.io/x/conn/v3/physic"
@@ -337,3 +338,45 @@ func Test_port_SetSpeed(t *testing.T) {
 		t.Errorf("expected error but got none")
 	}
 }
+
+func TestDev_Port(t *testing.T) {
+	bus := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			{Addr: 0x70, W: nil, R: []byte{0xFF}},
+			{Addr: 0x70, W: []byte{0x02}},
+			{Addr: 0x20, W: []byte{0x09, 0x01}},
+			{Addr: 0x70, W: []byte{0x08}},
+			{Addr: 0x20, W: []byte{0x09, 0x02}},
+			{Addr: 0x20, W: []byte{0x09, 0x03}},
+			{Addr: 0x70, W: []byte{0x02}},
+			{Addr: 0x20, W: []byte{0x09, 0x04}},
+		},
+	}
+	mux, err := New(bus, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mux.Port(8); err == nil {
+		t.Error("expected an error for port 8")
+	}
+	p1, err := mux.Port(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p3, err := mux.Port(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Two devices at the same address on different ports.
+	for _, tx := range []struct {
+		p i2c.Bus
+		v byte
+	}{{p1, 1}, {p3, 2}, {p3, 3}, {p1, 4}} {
+		if err := tx.p.Tx(0x20, []byte{0x09, tx.v}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := bus.Close(); err != nil {
+		t.Error(err)
+	}
+}
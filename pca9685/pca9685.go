@@ -44,6 +44,8 @@ const (
 type Dev struct {
 	dev  *i2c.Dev
 	freq physic.Frequency
+	// prescale is the value written to the PRE_SCALE register.
+	prescale byte
 }
 
 // NewI2C returns a Dev object that communicates over I2C.
@@ -121,6 +123,36 @@ func (d *Dev) SetPwmFreq(freqHz physic.Frequency) error {
 
 	_, err := d.dev.Write([]byte{mode1, oldmode | restart})
 	d.freq = freqHz
+	d.prescale = byte(p)
+	return err
+}
+
+// SetPulseWidth sets the output of channel high for width at the start of
+// each PWM period, for example 1.5ms to center a servo. The width is
+// converted to the 4096 steps of the period set by SetPwmFreq(), and is
+// limited to the period.
+func (d *Dev) SetPulseWidth(channel int, width time.Duration) error {
+	if width < 0 {
+		return fmt.Errorf("PCA9685: invalid pulse width: %s", width)
+	}
+	// Each of the 4096 steps lasts prescale+1 cycles of the 25MHz
+	// oscillator.
+	step := (time.Duration(d.prescale) + 1) * time.Second / 25000000
+	off := (width + step/2) / step
+	if off > 4095 {
+		off = 4095
+	}
+	return d.SetPwm(channel, 0, gpio.Duty(off))
+}
+
+// SetAllOff turns all the outputs off with one write, using the full-off bit
+// of the ALL_LED registers. Use SetPwm() or SetAllPwm() to turn outputs back
+// on.
+func (d *Dev) SetAllOff() error {
+	_, err := d.dev.Write([]byte{
+		allLedOnL + 3, // ALL_LED_OFF_H
+		0x10,          // bit 4 is full-off
+	})
 	return err
 }
 
@@ -142,6 +174,15 @@ func (d *Dev) SetAllPwm(on, off gpio.Duty) error {
 	return d.setPWM(allLedOnL, on, off)
 }
 
+// Halt implements conn.Resource. It turns off all the outputs.
+func (d *Dev) Halt() error {
+	return d.SetAllPwm(0, 0)
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("PCA9685{%s}", d.dev)
+}
+
 // SetPwm set a PWM value for a given PCA9685 channel.
 func (d *Dev) SetPwm(channel int, on, off gpio.Duty) error {
 	err := verifyChannel(channel)
@@ -6,6 +6,7 @@ package pca9685
 
 import (
 	"testing"
+	"time"
 
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/gpio/gpioreg"
@@ -137,3 +138,59 @@ func TestPCA9685_invalidCh(t *testing.T) {
 		t.Fatal("Error expected")
 	}
 }
+
+func TestPCA9685_pulseWidth(t *testing.T) {
+	scenario := &i2ctest.Playback{
+		Ops: append(initializationSequence(),
+			// 1.5ms is 305 steps of 123/25MHz.
+			i2ctest.IO{Addr: I2CAddr, W: []byte{led0OnL + 12, 0, 0, 0x31, 0x01}, R: nil},
+			// Limited to the period.
+			i2ctest.IO{Addr: I2CAddr, W: []byte{led0OnL + 12, 0, 0, 0xff, 0x0f}, R: nil},
+			// All outputs off.
+			i2ctest.IO{Addr: I2CAddr, W: []byte{allLedOnL + 3, 0x10}, R: nil},
+		),
+	}
+
+	dev, err := NewI2C(scenario, I2CAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	servo := NewServoGroup(dev, 0, 0, 0, 0).GetServo(3)
+	if err = servo.SetPulseWidth(1500 * time.Microsecond); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetPulseWidth(3, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.SetPulseWidth(3, -time.Millisecond); err == nil {
+		t.Error("Error expected")
+	}
+	if err = dev.SetAllOff(); err != nil {
+		t.Fatal(err)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPCA9685_pulseWidthMaxPrescale(t *testing.T) {
+	scenario := &i2ctest.Playback{
+		Ops: append(initializationSequence(),
+			// 1.5ms is 146 steps of 256/25MHz.
+			i2ctest.IO{Addr: I2CAddr, W: []byte{led0OnL + 12, 0, 0, 0x92, 0x00}, R: nil},
+		),
+	}
+
+	dev, err := NewI2C(scenario, I2CAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The prescaler of the lowest frequency, about 24Hz.
+	dev.prescale = 0xff
+	if err = dev.SetPulseWidth(3, 1500*time.Microsecond); err != nil {
+		t.Fatal(err)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
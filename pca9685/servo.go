@@ -5,6 +5,8 @@
 package pca9685
 
 import (
+	"time"
+
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/physic"
 )
@@ -85,6 +87,12 @@ func (s *Servo) SetPwm(pwm gpio.Duty) error {
 	return s.group.SetPwm(s.channel, 0, pwm)
 }
 
+// SetPulseWidth set the pulse width of the servo, typically between 1ms and
+// 2ms. See Dev.SetPulseWidth().
+func (s *Servo) SetPulseWidth(width time.Duration) error {
+	return s.group.SetPulseWidth(s.channel, width)
+}
+
 func mapValue(x, inMin, inMax, outMin, outMax int) int {
 	return (x-inMin)*(outMax-outMin)/(inMax-inMin) + outMin
 }
@@ -0,0 +1,39 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package pcd8544_test
+
+import (
+	"log"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/spi/spireg"
+	"periph.io/x/devices/v3/pcd8544"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	p, err := spireg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer p.Close()
+
+	dev, err := pcd8544.NewSPI(p, gpioreg.ByName("GPIO23"), gpioreg.ByName("GPIO24"), &pcd8544.DefaultOpts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	lcd, err := pcd8544.NewTextMode(dev, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := lcd.WriteString("Hello,\nworld!"); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package pcd8544 controls the 84x48 monochrome LCD of the Nokia 5110 and
+// 3310 phones, driven by a PCD8544 controller over SPI.
+//
+// Dev implements display.Drawer. NewTextMode() emulates a character LCD
+// implementing display.TextDisplay, with 3 rows of 12 columns with the
+// default font.
+//
+// The contrast of these displays varies a lot between modules, and with the
+// temperature. If the display is blank or black, adjust Opts.Contrast first,
+// then Opts.Bias.
+//
+// # Wiring
+//
+// Connect DIN to SPI_MOSI, CLK to SPI_CLK, CE to SPI_CS, D/C and RST to
+// GPIOs. The controller is 3.3V only.
+//
+// # Datasheet
+//
+// https://www.sparkfun.com/datasheets/LCD/Monochrome/Nokia5110.pdf
+package pcd8544
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"time"
+
+	"golang.org/x/image/font"
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/display"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+	"periph.io/x/devices/v3/ssd1306/image1bit"
+	"periph.io/x/devices/v3/textdisplay"
+)
+
+const (
+	// Width is the width of the display in pixels.
+	Width = 84
+	// Height is the height of the display in pixels.
+	Height = 48
+)
+
+const (
+	// Function set, with the PD, V and H bits.
+	cmdFunctionSet = 0x20
+	powerDown      = 0x04
+	extended       = 0x01
+
+	// Basic instruction set.
+	cmdDisplayControl = 0x08
+	displayNormal     = 0x04
+	displayInverse    = 0x05
+	cmdSetY           = 0x40
+	cmdSetX           = 0x80
+
+	// Extended instruction set.
+	cmdTempCoeff = 0x04
+	cmdBias      = 0x10
+	cmdVop       = 0x80
+)
+
+// Opts holds the configuration options.
+type Opts struct {
+	// Contrast is the operating voltage of the LCD, between 0 and 127.
+	Contrast uint8
+	// Bias is the bias system, between 0 and 7. 4 is a multiplex rate of
+	// 1:48, which matches the display.
+	Bias uint8
+	// TempCoeff is the temperature coefficient of the operating voltage,
+	// between 0 and 3.
+	TempCoeff uint8
+}
+
+// DefaultOpts is the configuration that suits most modules.
+var DefaultOpts = Opts{
+	Contrast:  0x3c,
+	Bias:      4,
+	TempCoeff: 0,
+}
+
+// Dev is a handle to a PCD8544.
+type Dev struct {
+	c   conn.Conn
+	dc  gpio.PinOut
+	rst gpio.PinOut
+
+	opts    Opts
+	inverse bool
+	// buffer is the content of the display RAM. next is lazy initialized on
+	// the first Draw() that isn't an exact frame.
+	buffer []byte
+	next   *image1bit.VerticalLSB
+	dirty  bool
+}
+
+// NewSPI returns a handle to a PCD8544 on p, with its D/C pin connected to dc
+// and its reset pin to rst. rst may be nil if it is driven externally.
+func NewSPI(p spi.Port, dc, rst gpio.PinOut, opts *Opts) (*Dev, error) {
+	if dc == nil {
+		return nil, errors.New("pcd8544: dc is required")
+	}
+	if opts == nil {
+		opts = &DefaultOpts
+	}
+	if opts.Contrast > 0x7f || opts.Bias > 7 || opts.TempCoeff > 3 {
+		return nil, fmt.Errorf("pcd8544: invalid options %+v", *opts)
+	}
+	c, err := p.Connect(4*physic.MegaHertz, spi.Mode0, 8)
+	if err != nil {
+		return nil, fmt.Errorf("pcd8544: %w", err)
+	}
+	d := &Dev{c: c, dc: dc, rst: rst, opts: *opts, buffer: make([]byte, Width*Height/8), dirty: true}
+	if rst != nil {
+		// The controller must be reset within 30ms of power on.
+		if err := rst.Out(gpio.Low); err != nil {
+			return nil, fmt.Errorf("pcd8544: %w", err)
+		}
+		time.Sleep(time.Millisecond)
+		if err := rst.Out(gpio.High); err != nil {
+			return nil, fmt.Errorf("pcd8544: %w", err)
+		}
+	}
+	if err := d.sendCommand(d.initCmd()); err != nil {
+		return nil, err
+	}
+	// Clear the RAM, which is random after the reset.
+	if err := d.drawInternal(d.buffer); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// NewTextMode returns a character display emulated on d, implementing
+// display.TextDisplay. If face is nil, basicfont.Face7x13 is used, which gives
+// 3 rows of 12 columns.
+func NewTextMode(d *Dev, face font.Face) (*textdisplay.Dev, error) {
+	return textdisplay.New(d, face)
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("PCD8544{%s, %s}", d.c, d.dc)
+}
+
+// ColorModel implements display.Drawer.
+//
+// It is a one bit color model, as implemented by image1bit.Bit.
+func (d *Dev) ColorModel() color.Model {
+	return image1bit.BitModel
+}
+
+// Bounds implements display.Drawer. Min is guaranteed to be {0, 0}.
+func (d *Dev) Bounds() image.Rectangle {
+	return image.Rect(0, 0, Width, Height)
+}
+
+// Draw implements display.Drawer.
+//
+// It only sends the frame when it changed.
+func (d *Dev) Draw(r image.Rectangle, src image.Image, sp image.Point) error {
+	var next []byte
+	if img, ok := src.(*image1bit.VerticalLSB); ok && r == d.Bounds() && img.Rect == d.Bounds() && sp.X == 0 && sp.Y == 0 {
+		// Exact size, full frame, image1bit encoding: fast path!
+		next = img.Pix
+	} else {
+		if d.next == nil {
+			d.next = image1bit.NewVerticalLSB(d.Bounds())
+			copy(d.next.Pix, d.buffer)
+		}
+		next = d.next.Pix
+		draw.Src.Draw(d.next, r, src, sp)
+	}
+	return d.drawInternal(next)
+}
+
+// Write writes a frame of pixels, in the format of image1bit.VerticalLSB.Pix:
+// 6 horizontal bands of 8 pixels high, each byte being 8 vertical pixels.
+func (d *Dev) Write(pixels []byte) (int, error) {
+	if len(pixels) != len(d.buffer) {
+		return 0, fmt.Errorf("pcd8544: invalid pixel stream length; expected %d bytes, got %d bytes", len(d.buffer), len(pixels))
+	}
+	if err := d.drawInternal(pixels); err != nil {
+		return 0, err
+	}
+	return len(pixels), nil
+}
+
+// SetContrast sets the operating voltage of the LCD, between 0 and 127.
+func (d *Dev) SetContrast(contrast uint8) error {
+	if contrast > 0x7f {
+		return fmt.Errorf("pcd8544: invalid contrast %d", contrast)
+	}
+	d.opts.Contrast = contrast
+	return d.sendCommand([]byte{cmdFunctionSet | extended, cmdVop | contrast, cmdFunctionSet})
+}
+
+// SetBias sets the bias system, between 0 and 7.
+func (d *Dev) SetBias(bias uint8) error {
+	if bias > 7 {
+		return fmt.Errorf("pcd8544: invalid bias %d", bias)
+	}
+	d.opts.Bias = bias
+	return d.sendCommand([]byte{cmdFunctionSet | extended, cmdBias | bias, cmdFunctionSet})
+}
+
+// Invert inverts the display (black on white vs white on black).
+func (d *Dev) Invert(blackOnWhite bool) error {
+	d.inverse = blackOnWhite
+	return d.sendCommand([]byte{d.displayControl()})
+}
+
+// Display turns the display on, or puts the controller in power down mode,
+// which keeps the content of its RAM.
+func (d *Dev) Display(on bool) error {
+	if !on {
+		return d.sendCommand([]byte{cmdFunctionSet | powerDown})
+	}
+	return d.sendCommand([]byte{cmdFunctionSet, d.displayControl()})
+}
+
+// Halt implements conn.Resource. It puts the controller in power down mode.
+func (d *Dev) Halt() error {
+	return d.Display(false)
+}
+
+func (d *Dev) initCmd() []byte {
+	return []byte{
+		cmdFunctionSet | extended,
+		cmdVop | d.opts.Contrast,
+		cmdTempCoeff | d.opts.TempCoeff,
+		cmdBias | d.opts.Bias,
+		cmdFunctionSet,
+		d.displayControl(),
+	}
+}
+
+func (d *Dev) displayControl() byte {
+	if d.inverse {
+		return cmdDisplayControl | displayInverse
+	}
+	return cmdDisplayControl | displayNormal
+}
+
+// drawInternal sends next if it differs from the content of the RAM.
+func (d *Dev) drawInternal(next []byte) error {
+	if !d.dirty && string(next) == string(d.buffer) {
+		return nil
+	}
+	// With horizontal addressing, the address wraps to the next bank after
+	// each row of 84 bytes.
+	if err := d.sendCommand([]byte{cmdSetX, cmdSetY}); err != nil {
+		return err
+	}
+	if err := d.sendData(next); err != nil {
+		return err
+	}
+	copy(d.buffer, next)
+	d.dirty = false
+	return nil
+}
+
+func (d *Dev) sendCommand(c []byte) error {
+	if err := d.dc.Out(gpio.Low); err != nil {
+		return fmt.Errorf("pcd8544: %w", err)
+	}
+	if err := d.c.Tx(c, nil); err != nil {
+		return fmt.Errorf("pcd8544: %w", err)
+	}
+	return nil
+}
+
+func (d *Dev) sendData(b []byte) error {
+	if err := d.dc.Out(gpio.High); err != nil {
+		return fmt.Errorf("pcd8544: %w", err)
+	}
+	if err := d.c.Tx(b, nil); err != nil {
+		return fmt.Errorf("pcd8544: %w", err)
+	}
+	return nil
+}
+
+var _ display.Drawer = &Dev{}
+var _ textdisplay.Switcher = &Dev{}
@@ -0,0 +1,160 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package pcd8544
+
+import (
+	"bytes"
+	"image"
+	"testing"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+	"periph.io/x/devices/v3/ssd1306/image1bit"
+)
+
+// tx is a transfer, with the level of D/C.
+type tx struct {
+	data bool
+	w    []byte
+}
+
+// port records the transfers.
+type port struct {
+	dc  *gpiotest.Pin
+	txs []tx
+}
+
+func (p *port) String() string { return "port" }
+
+func (p *port) Connect(f physic.Frequency, mode spi.Mode, bits int) (spi.Conn, error) {
+	return p, nil
+}
+
+func (p *port) LimitSpeed(f physic.Frequency) error { return nil }
+
+func (p *port) Tx(w, r []byte) error {
+	p.txs = append(p.txs, tx{bool(p.dc.L), append([]byte(nil), w...)})
+	return nil
+}
+
+func (p *port) TxPackets(pkts []spi.Packet) error { return nil }
+
+func (p *port) Duplex() conn.Duplex { return conn.Half }
+
+func newTestDev(t *testing.T) (*Dev, *port, *gpiotest.Pin) {
+	dc := &gpiotest.Pin{N: "DC"}
+	rst := &gpiotest.Pin{N: "RST"}
+	p := &port{dc: dc}
+	d, err := NewSPI(p, dc, rst, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d, p, rst
+}
+
+func TestNewSPI(t *testing.T) {
+	d, p, rst := newTestDev(t)
+	if rst.L != gpio.High {
+		t.Error("still in reset")
+	}
+	want := []tx{
+		{false, []byte{0x21, 0xbc, 0x04, 0x14, 0x20, 0x0c}},
+		{false, []byte{0x80, 0x40}},
+		{true, make([]byte, 504)},
+	}
+	if len(p.txs) != len(want) {
+		t.Fatalf("%d transfers", len(p.txs))
+	}
+	for i := range want {
+		if p.txs[i].data != want[i].data || !bytes.Equal(p.txs[i].w, want[i].w) {
+			t.Errorf("transfer %d = %v, want %v", i, p.txs[i], want[i])
+		}
+	}
+	if s := d.String(); s != "PCD8544{port, DC(0)}" {
+		t.Errorf("String() = %q", s)
+	}
+	if _, err := NewSPI(p, nil, nil, nil); err == nil {
+		t.Error("expected an error without dc")
+	}
+	if _, err := NewSPI(p, &gpiotest.Pin{}, nil, &Opts{Contrast: 0x80}); err == nil {
+		t.Error("expected an error for an invalid contrast")
+	}
+}
+
+func TestDraw(t *testing.T) {
+	d, p, _ := newTestDev(t)
+	p.txs = nil
+	img := image1bit.NewVerticalLSB(d.Bounds())
+	img.SetBit(1, 9, image1bit.On)
+	if err := d.Draw(d.Bounds(), img, image.Point{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.txs) != 2 || !p.txs[1].data || p.txs[1].w[84+1] != 0x02 {
+		t.Fatalf("transfers %v", p.txs)
+	}
+	// An unchanged frame isn't sent.
+	p.txs = nil
+	if err := d.Draw(d.Bounds(), img, image.Point{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.txs) != 0 {
+		t.Errorf("%d transfers for an unchanged frame", len(p.txs))
+	}
+	// Partial draw keeps the rest of the frame.
+	small := image1bit.NewVerticalLSB(image.Rect(0, 0, 8, 8))
+	small.SetBit(0, 0, image1bit.On)
+	if err := d.Draw(image.Rect(80, 40, 84, 48), small, image.Point{}); err != nil {
+		t.Fatal(err)
+	}
+	if f := p.txs[1].w; f[85] != 0x02 || f[5*84+80] != 0x01 {
+		t.Errorf("frame %v", f)
+	}
+	if _, err := d.Write(make([]byte, 10)); err == nil {
+		t.Error("expected an error for a short frame")
+	}
+}
+
+func TestCommands(t *testing.T) {
+	d, p, _ := newTestDev(t)
+	p.txs = nil
+	if err := d.SetContrast(0x40); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetBias(3); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Invert(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Display(true); err != nil {
+		t.Fatal(err)
+	}
+	want := [][]byte{{0x21, 0xc0, 0x20}, {0x21, 0x13, 0x20}, {0x0d}, {0x24}, {0x20, 0x0d}}
+	for i := range want {
+		if p.txs[i].data || !bytes.Equal(p.txs[i].w, want[i]) {
+			t.Errorf("transfer %d = %v, want %#v", i, p.txs[i], want[i])
+		}
+	}
+	if err := d.SetContrast(0x80); err == nil {
+		t.Error("expected an error for an invalid contrast")
+	}
+}
+
+func TestNewTextMode(t *testing.T) {
+	d, _, _ := newTestDev(t)
+	tm, err := NewTextMode(d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tm.Rows() != 3 || tm.Cols() != 12 {
+		t.Errorf("%dx%d", tm.Cols(), tm.Rows())
+	}
+}
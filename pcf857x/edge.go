@@ -0,0 +1,98 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package pcf857x
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/devices/v3/internal/wait"
+)
+
+// SetEdgePin supplies a host GPIO pin connected to the INTR pin of the
+// device. The pin must be configured for falling edge detection. INTR is
+// open-drain, so the host pin requires a pull-up.
+//
+// The pins and groups also have a WaitForEdgeContext() method, which waits
+// until the context passed is done.
+func (dev *Dev) SetEdgePin(pin *gpio.PinIn) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	dev.edgePin = pin
+}
+
+func (dev *Dev) hasEdgePin() bool {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	return dev.edgePin != nil && *dev.edgePin != nil
+}
+
+// readPins reads the state of all pins without writing to the device. Reading
+// clears the interrupt.
+func (dev *Dev) readPins() (gpio.GPIOValue, error) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	r := make([]byte, dev.width/8)
+	if err := dev.d.Tx(nil, r); err != nil {
+		return 0, fmt.Errorf("pcf857x: %w", err)
+	}
+	var result gpio.GPIOValue
+	for ix, b := range r {
+		result |= gpio.GPIOValue(b) << (8 * ix)
+	}
+	return result, nil
+}
+
+// waitForChange waits for an interrupt, reads the pins, and calls match with
+// the previous and current state of the pins until it returns true, or the
+// timeout expires or ctx is done. If no read has been performed, the pins are read first to
+// establish the previous state.
+func (dev *Dev) waitForChange(ctx context.Context, timeout time.Duration, match func(previous, current gpio.GPIOValue) bool) (bool, error) {
+	dev.mu.Lock()
+	edgePin := *dev.edgePin
+	valid := dev.lastValid
+	dev.mu.Unlock()
+	if !valid {
+		v, err := dev.readPins()
+		if err != nil {
+			return false, err
+		}
+		dev.setLast(v)
+	}
+	var deadline time.Time
+	if timeout >= 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	for {
+		remaining := time.Duration(-1)
+		if timeout >= 0 {
+			if remaining = time.Until(deadline); remaining <= 0 {
+				return false, nil
+			}
+		}
+		if !wait.Edge(ctx, edgePin, remaining) {
+			return false, nil
+		}
+		current, err := dev.readPins()
+		if err != nil {
+			return false, err
+		}
+		if match(dev.setLast(current), current) {
+			return true, nil
+		}
+	}
+}
+
+// setLast records the state of the pins, and returns the previous state.
+func (dev *Dev) setLast(v gpio.GPIOValue) gpio.GPIOValue {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	previous := dev.last
+	dev.last = v
+	dev.lastValid = true
+	return previous
+}
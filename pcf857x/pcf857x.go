@@ -9,7 +9,8 @@
 //
 // The PCF8575 is a 16-pin device that is functionally identical to the PCF8574.
 // When communicating with the PCF8575 reads and writes are 2 bytes wide, while
-// they're one byte wide with the PCF85754
+// they're one byte wide with the PCF85754. The PCF8574A is identical to the
+// PCF8574, but uses addresses 0x38-0x3f.
 //
 // # Datasheet
 //
@@ -32,9 +33,10 @@
 //
 // Setting a pin to Low activates an Open Drain to ground.
 //
-// You cannot detect edge change on a specific pin. There is an interrupt pin
-// that can be used to detect a change on the GPIO pins, but it doesn't tell you
-// which pin changed.
+// There is an interrupt pin that can be used to detect a change on the GPIO
+// pins, but it doesn't tell you which pin changed. If the interrupt pin is
+// connected to a host GPIO pin supplied to SetEdgePin(), WaitForEdge() reads
+// the pins after each interrupt, and compares them to the previous read.
 //
 // This chip doesn't implement normal i2c register architectures. You write 8 or
 // 16 bits out, and that sets the corresponding pins, or you read 8/16 bits and
@@ -42,8 +44,10 @@
 package pcf857x
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 
@@ -51,20 +55,35 @@ import (
 	"periph.io/x/conn/v3/gpio/gpioreg"
 	"periph.io/x/conn/v3/i2c"
 	"periph.io/x/conn/v3/pin"
+	"periph.io/x/devices/v3/internal/logging"
 )
 
+// logger receives the errors of the gpio.PinIn methods that can't return
+// them, and the traces of the bus at slog.LevelDebug.
+var logger logging.Logger
+
+// SetLogger sets the logger of the package. The records are discarded by
+// default.
+func SetLogger(l *slog.Logger) {
+	logger.Set(l)
+}
+
 // Variant represents the actual chip model.
 type Variant string
 
 const (
-	PCF8574 Variant = "PCF8574"
-	PCF8575 Variant = "PCF8575"
+	PCF8574  Variant = "PCF8574"
+	PCF8574A Variant = "PCF8574A"
+	PCF8575  Variant = "PCF8575"
 
 	DefaultAddress uint16 = 0x20
 )
 
 var (
 	ErrNotImplmented error = errors.New("pcf857x: not implemented")
+	// ErrInvalidPin is returned when a pin number is out of range for the
+	// device.
+	ErrInvalidPin error = errors.New("pcf857x: invalid pin")
 )
 
 // Dev is representation of a PCF857x device.
@@ -80,6 +99,12 @@ type Dev struct {
 	d      *i2c.Dev
 	value  gpio.GPIOValue
 	groups []Group
+
+	edgePin *gpio.PinIn
+	// last is the state of the pins from the previous read by WaitForEdge(),
+	// and lastValid is true once it has been read.
+	last      gpio.GPIOValue
+	lastValid bool
 }
 
 type Group struct {
@@ -88,14 +113,28 @@ type Group struct {
 }
 
 // New creates a new PCF857x io expander and returns it. chip should be one of
-// the Variant constants above.
+// the Variant constants above. The address must be in the range of the
+// variant, 0x20-0x27 for the PCF8574 and PCF8575, and 0x38-0x3f for the
+// PCF8574A.
 func New(bus i2c.Bus, address uint16, chip Variant) (*Dev, error) {
+	var base uint16
+	switch chip {
+	case PCF8574, PCF8575:
+		base = 0x20
+	case PCF8574A:
+		base = 0x38
+	default:
+		return nil, fmt.Errorf("pcf857x: unsupported variant %q", chip)
+	}
+	if address&0xfff8 != base {
+		return nil, fmt.Errorf("pcf857x: address 0x%x out of range 0x%x-0x%x for %s", address, base, base+7, chip)
+	}
 	dev := &Dev{d: &i2c.Dev{Bus: bus, Addr: address},
 		chipType: chip}
-	if chip == PCF8574 {
-		dev.width = 8
-	} else {
+	if chip == PCF8575 {
 		dev.width = 16
+	} else {
+		dev.width = 8
 	}
 	dev.mask = gpio.GPIOValue((1 << dev.width) - 1)
 	dev.Pins = make([]gpio.PinIO, dev.width)
@@ -123,6 +162,85 @@ func (dev *Dev) Group(pinNumbers ...int) (gpio.Group, error) {
 	return &gr, nil
 }
 
+// Pin returns the pin number n of the device, or nil if n is out of range.
+func (dev *Dev) Pin(n int) gpio.PinIO {
+	if n < 0 || n >= len(dev.Pins) {
+		return nil
+	}
+	return dev.Pins[n]
+}
+
+// ReadGPIOPin returns the level of pin, where true is high. The pin is
+// written high before it's read, so that it acts as an input.
+func (dev *Dev) ReadGPIOPin(pin int) (bool, error) {
+	if pin < 0 || pin >= dev.width {
+		return false, fmt.Errorf("%w %d", ErrInvalidPin, pin)
+	}
+	mask := gpio.GPIOValue(1) << pin
+	v, err := dev.read(mask)
+	return v&mask != 0, err
+}
+
+// WriteGPIOPin sets the level of pin to high if value is true. A low pin is
+// driven to ground, and a high pin is weakly pulled up.
+func (dev *Dev) WriteGPIOPin(pin int, value bool) error {
+	if pin < 0 || pin >= dev.width {
+		return fmt.Errorf("%w %d", ErrInvalidPin, pin)
+	}
+	mask := gpio.GPIOValue(1) << pin
+	if value {
+		return dev.write(mask, mask)
+	}
+	return dev.write(0, mask)
+}
+
+// WriteGPIOMasked sets the pins selected by mask to the bits of value in one
+// bus transaction. Bit n is pin number n.
+func (dev *Dev) WriteGPIOMasked(mask, value uint16) error {
+	if gpio.GPIOValue(mask)&^dev.mask != 0 {
+		return fmt.Errorf("%w in mask 0x%x", ErrInvalidPin, mask)
+	}
+	return dev.write(gpio.GPIOValue(value), gpio.GPIOValue(mask))
+}
+
+// ReadGPIOMasked returns the levels of the pins selected by mask. Bit n is
+// pin number n. The selected pins are written high before they're read, so
+// that they act as inputs.
+func (dev *Dev) ReadGPIOMasked(mask uint16) (uint16, error) {
+	if gpio.GPIOValue(mask)&^dev.mask != 0 {
+		return 0, fmt.Errorf("%w in mask 0x%x", ErrInvalidPin, mask)
+	}
+	v, err := dev.read(gpio.GPIOValue(mask))
+	return uint16(v), err
+}
+
+// WriteSequence writes each of values to the pins, in order, in one bus
+// transaction. Every byte written sets the outputs, so this generates a
+// waveform, like the strobe of a character display's enable line, without
+// the overhead of a transaction per value.
+func (dev *Dev) WriteSequence(values ...uint16) error {
+	if len(values) == 0 {
+		return nil
+	}
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	byteCount := dev.width / 8
+	w := make([]byte, 0, byteCount*len(values))
+	for _, value := range values {
+		if gpio.GPIOValue(value)&^dev.mask != 0 {
+			return fmt.Errorf("%w in value 0x%x", ErrInvalidPin, value)
+		}
+		for ix := range byteCount {
+			w = append(w, byte(value>>(ix*8)))
+		}
+	}
+	if err := dev.d.Tx(w, nil); err != nil {
+		return fmt.Errorf("pcf857x: %w", err)
+	}
+	dev.value = gpio.GPIOValue(values[len(values)-1])
+	return nil
+}
+
 // Halt shuts down the device, and frees any pin groups.
 func (dev *Dev) Halt() error {
 	dev.mu.Lock()
@@ -162,6 +280,11 @@ func (dev *Dev) read(mask gpio.GPIOValue) (gpio.GPIOValue, error) {
 		result |= gpio.GPIOValue(r[1]) << 8
 
 	}
+	// The arguments are only built when they're logged, since this is the
+	// hot path of the pins.
+	if l := logger.Get(); l.Enabled(context.Background(), slog.LevelDebug) {
+		l.Debug("pcf857x: read", "dev", dev, "value", uint64(result))
+	}
 	// turn off the bits we just read so that the next time through, we force
 	// the write high on them.
 	dev.value = result
@@ -188,6 +311,9 @@ func (dev *Dev) write(value, mask gpio.GPIOValue) error {
 	for ix := range byteCount {
 		w[ix] = byte(wrValue >> (ix * 8))
 	}
+	if l := logger.Get(); l.Enabled(context.Background(), slog.LevelDebug) {
+		l.Debug("pcf857x: write", "dev", dev, "value", uint64(wrValue))
+	}
 	err := dev.d.Tx(w, nil)
 	if err == nil {
 		dev.value = wrValue
@@ -296,9 +422,48 @@ func (gr *Group) Read(mask gpio.GPIOValue) (gpio.GPIOValue, error) {
 // is an interrupt pin, but you can't set a mask of pins that will trigger it. To
 // do that, you connect a GPIO pin from the host device that supports WaitForEdge
 // to monitor the INTR pin.
+//
+// If the INTR pin is connected to a host GPIO pin supplied to
+// Dev.SetEdgePin(), WaitForEdge waits for a pin of the group to change, and
+// returns its pin number on the device and the edge. If the timeout expires,
+// -1 is returned with no error. Otherwise, ErrNotImplmented is returned.
 func (gr *Group) WaitForEdge(timeout time.Duration) (number int, edge gpio.Edge, err error) {
-	// TODO: Implement wait for edge in the same way that it is for mcp23008
-	return 0, gpio.NoEdge, ErrNotImplmented
+	return gr.waitForEdge(context.Background(), timeout)
+}
+
+// WaitForEdgeContext waits for a pin of the group to change, as
+// WaitForEdge(-1), until ctx is done, in which case -1 and ctx.Err() are
+// returned.
+func (gr *Group) WaitForEdgeContext(ctx context.Context) (number int, edge gpio.Edge, err error) {
+	number, edge, err = gr.waitForEdge(ctx, -1)
+	if err == nil && number == -1 {
+		err = ctx.Err()
+	}
+	return number, edge, err
+}
+
+func (gr *Group) waitForEdge(ctx context.Context, timeout time.Duration) (number int, edge gpio.Edge, err error) {
+	if !gr.dev.hasEdgePin() {
+		return 0, gpio.NoEdge, ErrNotImplmented
+	}
+	groupMask := gr.groupMaskToDevMask((1 << len(gr.pins)) - 1)
+	matched, err := gr.dev.waitForChange(ctx, timeout, func(previous, current gpio.GPIOValue) bool {
+		changed := (previous ^ current) & groupMask
+		if changed == 0 {
+			return false
+		}
+		for number = 0; changed&(1<<number) == 0; number++ {
+		}
+		edge = gpio.FallingEdge
+		if current&(1<<number) != 0 {
+			edge = gpio.RisingEdge
+		}
+		return true
+	})
+	if err != nil || !matched {
+		return -1, gpio.NoEdge, err
+	}
+	return number, edge, nil
 }
 
 // Halt stops the pin group. It cannot be used after this call.
@@ -12,12 +12,15 @@
 package pcf857x
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/gpio/gpiotest"
 	"periph.io/x/conn/v3/i2c/i2ctest"
 )
 
@@ -214,3 +217,125 @@ func TestGroup(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestPinAPI(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		{Addr: 0x38, W: []byte{0x01}},
+		{Addr: 0x38, W: []byte{0xa1}},
+		// pin 3 is written high before it's read
+		{Addr: 0x38, W: []byte{0xa9}},
+		{Addr: 0x38, R: []byte{0xa9}},
+		// pin 4 is set as an input
+		{Addr: 0x38, W: []byte{0xb9}},
+		// the pins are read before waiting, and after each interrupt
+		{Addr: 0x38, R: []byte{0xb9}},
+		{Addr: 0x38, R: []byte{0x99}},
+		{Addr: 0x38, R: []byte{0x89}},
+	}}
+	dev, err := New(bus, 0x38, PCF8574A)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = dev.Halt() }()
+	if len(dev.Pins) != 8 || dev.Pin(8) != nil {
+		t.Errorf("expected 8 pins, got %d", len(dev.Pins))
+	}
+	if err = dev.WriteGPIOPin(8, true); !errors.Is(err, ErrInvalidPin) {
+		t.Errorf("expected ErrInvalidPin, got %v", err)
+	}
+	if err = dev.WriteGPIOPin(0, true); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.WriteGPIOMasked(0xf0, 0xa0); err != nil {
+		t.Fatal(err)
+	}
+	if high, err := dev.ReadGPIOPin(3); err != nil || !high {
+		t.Errorf("expected pin 3 high, got %t %v", high, err)
+	}
+
+	p := dev.Pin(4)
+	if p.WaitForEdge(0) {
+		t.Error("expected no edge without an edge pin")
+	}
+	edges := make(chan gpio.Level, 2)
+	var intPin gpio.PinIn = &gpiotest.Pin{N: "INTR", EdgesChan: edges}
+	dev.SetEdgePin(&intPin)
+	if err = p.In(gpio.Float, gpio.FallingEdge); err != nil {
+		t.Fatal(err)
+	}
+	// pin 5 changes, and then pin 4 falls
+	edges <- gpio.Low
+	edges <- gpio.Low
+	if !p.WaitForEdge(time.Second) {
+		t.Error("expected falling edge on pin 4")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if p.(*pcfPin).WaitForEdgeContext(ctx) {
+		t.Error("expected no edge once the context is done")
+	}
+	g, err := dev.Group(4, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _, err := g.(*Group).WaitForEdgeContext(ctx); n != -1 || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded, got %d %v", n, err)
+	}
+	if err = bus.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	bus := &i2ctest.Playback{Ops: []i2ctest.IO{
+		// every byte written sets the outputs
+		{Addr: 0x27, W: []byte{0x08, 0x0c, 0x08}},
+		// the data pins are set high, so reading them needs no further write
+		{Addr: 0x27, W: []byte{0xf8}},
+		{Addr: 0x27, R: []byte{0x78}},
+	}}
+	dev, err := New(bus, 0x27, PCF8574)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = dev.Halt() }()
+	if err = dev.WriteSequence(0x08, 0x0c, 0x08); err != nil {
+		t.Fatal(err)
+	}
+	if err = dev.WriteSequence(0x100); !errors.Is(err, ErrInvalidPin) {
+		t.Errorf("expected ErrInvalidPin, got %v", err)
+	}
+	if err = dev.WriteGPIOMasked(0xf0, 0xf0); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := dev.ReadGPIOMasked(0xf0); err != nil || v != 0x70 {
+		t.Errorf("expected 0x70, got 0x%x %v", v, err)
+	}
+	if err = bus.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAddress(t *testing.T) {
+	for _, test := range []struct {
+		address uint16
+		chip    Variant
+		ok      bool
+	}{
+		{0x20, PCF8574, true},
+		{0x27, PCF8575, true},
+		{0x38, PCF8574, false},
+		{0x38, PCF8574A, true},
+		{0x3f, PCF8574A, true},
+		{0x27, PCF8574A, false},
+		{0x20, "PCF8576", false},
+	} {
+		dev, err := New(&i2ctest.Record{}, test.address, test.chip)
+		if (err == nil) != test.ok {
+			t.Errorf("New(0x%x, %s) returned %v", test.address, test.chip, err)
+		}
+		if dev != nil {
+			_ = dev.Halt()
+		}
+	}
+}
@@ -5,7 +5,7 @@
 package pcf857x
 
 import (
-	"log"
+	"context"
 	"time"
 
 	"periph.io/x/conn/v3/gpio"
@@ -16,6 +16,8 @@ type pcfPin struct {
 	dev    *Dev
 	number int
 	name   string
+	// edge is the edge passed to In().
+	edge gpio.Edge
 }
 
 func (pin *pcfPin) DefaultPull() gpio.Pull {
@@ -36,6 +38,9 @@ func (pin *pcfPin) In(pull gpio.Pull, edge gpio.Edge) error {
 	//
 	// Refer to the datasheet for more information.
 	v := gpio.GPIOValue(1 << pin.number)
+	pin.dev.mu.Lock()
+	pin.edge = edge
+	pin.dev.mu.Unlock()
 	return pin.dev.write(v, v)
 }
 
@@ -69,7 +74,7 @@ func (pin *pcfPin) Read() gpio.Level {
 	if err == nil {
 		result = (value & mask) == mask
 	} else {
-		log.Println(err)
+		logger.Get().Error("pcf857x: read failed", "pin", pin.name, "err", err)
 	}
 
 	return result
@@ -84,9 +89,39 @@ func (pin *pcfPin) String() string {
 }
 
 // This device has an interrupt pin that can detect a change on the GPIO lines,
-// however it doesn't let you detect a change on a specific pin.
+// however it doesn't let you detect a change on a specific pin. If the
+// interrupt pin is connected to a host pin supplied to Dev.SetEdgePin(),
+// WaitForEdge waits for the edge passed to In() by reading the pins after each
+// interrupt. Otherwise, it returns false.
 func (pin *pcfPin) WaitForEdge(timeout time.Duration) bool {
-	return false
+	return pin.waitForEdge(context.Background(), timeout)
+}
+
+// WaitForEdgeContext waits for the edge passed to In(), as WaitForEdge(-1),
+// until ctx is done.
+func (pin *pcfPin) WaitForEdgeContext(ctx context.Context) bool {
+	return pin.waitForEdge(ctx, -1)
+}
+
+func (pin *pcfPin) waitForEdge(ctx context.Context, timeout time.Duration) bool {
+	pin.dev.mu.Lock()
+	edge := pin.edge
+	pin.dev.mu.Unlock()
+	if edge == gpio.NoEdge || !pin.dev.hasEdgePin() {
+		return false
+	}
+	mask := gpio.GPIOValue(1) << pin.number
+	matched, err := pin.dev.waitForChange(ctx, timeout, func(previous, current gpio.GPIOValue) bool {
+		if (previous^current)&mask == 0 {
+			return false
+		}
+		high := current&mask != 0
+		return edge == gpio.BothEdges || (edge == gpio.RisingEdge) == high
+	})
+	if err != nil {
+		logger.Get().Error("pcf857x: wait for edge failed", "pin", pin.name, "err", err)
+	}
+	return matched
 }
 
 var _ gpio.PinIO = &pcfPin{}
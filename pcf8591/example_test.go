@@ -0,0 +1,46 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package pcf8591_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/pcf8591"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer bus.Close()
+
+	d, err := pcf8591.NewI2C(bus, pcf8591.DefaultAddress, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	samples, err := d.ReadAll()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for ch, s := range samples {
+		fmt.Printf("AIN%d: %s\n", ch, s.V)
+	}
+
+	// Output half of the reference voltage.
+	if err := d.DAC().Out(128); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package pcf8591 controls an NXP PCF8591 8 bit analog to digital and digital
+// to analog converter, with four inputs and one output.
+//
+// The inputs are combined as single-ended or differential channels depending
+// on the InputMode, and are read with the analog.PinADC returned by ADC(), or
+// all at once with ReadAll(). The output is the analog.PinDAC returned by
+// DAC().
+//
+// # Datasheet
+//
+// https://www.nxp.com/docs/en/data-sheet/PCF8591.pdf
+package pcf8591
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"periph.io/x/conn/v3/analog"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/pin"
+)
+
+// DefaultAddress is the address with the A0 to A2 pins low. The address is
+// 0x48 to 0x4f depending on these pins.
+const DefaultAddress uint16 = 0x48
+
+// InputMode is the combination of the inputs into channels.
+type InputMode uint8
+
+const (
+	// FourSingleEnded has channels 0 to 3 on AIN0 to AIN3.
+	FourSingleEnded InputMode = iota
+	// ThreeDifferential has channels 0 to 2 on AIN0 to AIN2 minus AIN3.
+	ThreeDifferential
+	// Mixed has channels 0 and 1 on AIN0 and AIN1, and channel 2 on AIN2
+	// minus AIN3.
+	Mixed
+	// TwoDifferential has channel 0 on AIN0 minus AIN1, and channel 1 on
+	// AIN2 minus AIN3.
+	TwoDifferential
+)
+
+func (m InputMode) String() string {
+	switch m {
+	case FourSingleEnded:
+		return "FourSingleEnded"
+	case ThreeDifferential:
+		return "ThreeDifferential"
+	case Mixed:
+		return "Mixed"
+	case TwoDifferential:
+		return "TwoDifferential"
+	default:
+		return fmt.Sprintf("InputMode(%d)", uint8(m))
+	}
+}
+
+// Channels returns the number of channels of the mode.
+func (m InputMode) Channels() int {
+	return [...]int{4, 3, 3, 2}[m&3]
+}
+
+// differential returns true if channel ch of the mode is differential.
+func (m InputMode) differential(ch int) bool {
+	switch m {
+	case ThreeDifferential, TwoDifferential:
+		return true
+	case Mixed:
+		return ch == 2
+	default:
+		return false
+	}
+}
+
+// Opts holds the configuration options.
+type Opts struct {
+	Mode InputMode
+	// VRef is the voltage of the VREF pin, relative to AGND. If 0, the
+	// samples have no voltage.
+	VRef physic.ElectricPotential
+}
+
+// DefaultOpts is four single-ended inputs with a 3.3V reference.
+var DefaultOpts = Opts{
+	Mode: FourSingleEnded,
+	VRef: 3300 * physic.MilliVolt,
+}
+
+const (
+	ctrlOutputEnable  = 0x40
+	ctrlAutoIncrement = 0x04
+)
+
+// Dev is a handle to a PCF8591.
+type Dev struct {
+	c    i2c.Dev
+	opts Opts
+	adc  []*ADCPin
+	dac  *DACPin
+
+	mu sync.Mutex
+	// output is true if the output is enabled, which must be preserved by
+	// each control byte.
+	output bool
+}
+
+// NewI2C returns a handle to a PCF8591 at addr on b. If opts is nil,
+// DefaultOpts is used. The output is disabled.
+func NewI2C(b i2c.Bus, addr uint16, opts *Opts) (*Dev, error) {
+	if opts == nil {
+		opts = &DefaultOpts
+	}
+	if opts.Mode > TwoDifferential {
+		return nil, fmt.Errorf("pcf8591: invalid input mode %s", opts.Mode)
+	}
+	if opts.VRef < 0 {
+		return nil, fmt.Errorf("pcf8591: invalid reference voltage %s", opts.VRef)
+	}
+	d := &Dev{c: i2c.Dev{Bus: b, Addr: addr}, opts: *opts}
+	for ch := range opts.Mode.Channels() {
+		d.adc = append(d.adc, &ADCPin{d: d, ch: ch})
+	}
+	d.dac = &DACPin{d: d}
+	// Select the mode, which also starts a conversion of channel 0.
+	if err := d.c.Tx([]byte{d.control(0)}, nil); err != nil {
+		return nil, fmt.Errorf("pcf8591: %w", err)
+	}
+	return d, nil
+}
+
+// ADC returns the input channel ch, from 0 to Mode.Channels()-1.
+func (d *Dev) ADC(ch int) (*ADCPin, error) {
+	if ch < 0 || ch >= len(d.adc) {
+		return nil, fmt.Errorf("pcf8591: invalid channel %d for %s", ch, d.opts.Mode)
+	}
+	return d.adc[ch], nil
+}
+
+// DAC returns the output.
+func (d *Dev) DAC() *DACPin {
+	return d.dac
+}
+
+// ReadAll converts all the channels in one transaction, with the auto
+// increment of the channel.
+func (d *Dev) ReadAll() ([]analog.Sample, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	// The first byte is the result of the previous conversion.
+	r := make([]byte, len(d.adc)+1)
+	if err := d.c.Tx([]byte{d.control(0) | ctrlAutoIncrement}, r); err != nil {
+		return nil, fmt.Errorf("pcf8591: %w", err)
+	}
+	s := make([]analog.Sample, len(d.adc))
+	for ch := range s {
+		s[ch] = d.sample(ch, r[ch+1])
+	}
+	return s, nil
+}
+
+// Halt implements conn.Resource. It disables the output, which is then high
+// impedance, until DACPin.Out() is called.
+func (d *Dev) Halt() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.output = false
+	if err := d.c.Tx([]byte{d.control(0)}, nil); err != nil {
+		return fmt.Errorf("pcf8591: %w", err)
+	}
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("PCF8591{%s, %s}", &d.c, d.opts.Mode)
+}
+
+// control returns the control byte to convert ch. d.mu must be held.
+func (d *Dev) control(ch int) byte {
+	c := byte(d.opts.Mode)<<4 | byte(ch)
+	if d.output {
+		c |= ctrlOutputEnable
+	}
+	return c
+}
+
+// read converts ch. d.mu must be held.
+func (d *Dev) read(ch int) (analog.Sample, error) {
+	// The first byte is the result of the previous conversion.
+	var r [2]byte
+	if err := d.c.Tx([]byte{d.control(ch)}, r[:]); err != nil {
+		return analog.Sample{}, fmt.Errorf("pcf8591: %w", err)
+	}
+	return d.sample(ch, r[1]), nil
+}
+
+// sample converts the result of a conversion of ch. Differential channels
+// are in two's complement.
+func (d *Dev) sample(ch int, b byte) analog.Sample {
+	raw := int32(b)
+	if d.opts.Mode.differential(ch) {
+		raw = int32(int8(b))
+	}
+	return analog.Sample{Raw: raw, V: d.opts.VRef * physic.ElectricPotential(raw) / 256}
+}
+
+// ADCPin is an input channel.
+type ADCPin struct {
+	d  *Dev
+	ch int
+}
+
+// Range implements analog.PinADC.
+func (p *ADCPin) Range() (analog.Sample, analog.Sample) {
+	if p.d.opts.Mode.differential(p.ch) {
+		return p.d.sample(p.ch, 0x80), p.d.sample(p.ch, 0x7f)
+	}
+	return p.d.sample(p.ch, 0), p.d.sample(p.ch, 0xff)
+}
+
+// Read implements analog.PinADC.
+func (p *ADCPin) Read() (analog.Sample, error) {
+	p.d.mu.Lock()
+	defer p.d.mu.Unlock()
+	return p.d.read(p.ch)
+}
+
+// Name implements pin.Pin.
+func (p *ADCPin) Name() string {
+	return fmt.Sprintf("PCF8591_AIN%d", p.ch)
+}
+
+// Number implements pin.Pin.
+func (p *ADCPin) Number() int {
+	return p.ch
+}
+
+// Function implements pin.Pin.
+func (p *ADCPin) Function() string {
+	return string(p.Func())
+}
+
+// Func implements pin.PinFunc.
+func (p *ADCPin) Func() pin.Func {
+	return analog.ADC
+}
+
+// SupportedFuncs implements pin.PinFunc.
+func (p *ADCPin) SupportedFuncs() []pin.Func {
+	return []pin.Func{analog.ADC}
+}
+
+// SetFunc implements pin.PinFunc.
+func (p *ADCPin) SetFunc(f pin.Func) error {
+	if f == analog.ADC {
+		return nil
+	}
+	return errors.New("pcf8591: pin function cannot be changed")
+}
+
+// Halt implements conn.Resource. It does nothing.
+func (p *ADCPin) Halt() error {
+	return nil
+}
+
+func (p *ADCPin) String() string {
+	return p.Name()
+}
+
+// DACPin is the output.
+type DACPin struct {
+	d *Dev
+}
+
+// Range implements analog.PinDAC.
+func (p *DACPin) Range() (analog.Sample, analog.Sample) {
+	return analog.Sample{}, analog.Sample{Raw: 0xff, V: p.d.opts.VRef * 0xff / 256}
+}
+
+// Out implements analog.PinDAC. It sets the output from 0 to 255, and
+// enables it.
+func (p *DACPin) Out(v int32) error {
+	if v < 0 || v > 0xff {
+		return fmt.Errorf("pcf8591: invalid output value %d", v)
+	}
+	p.d.mu.Lock()
+	defer p.d.mu.Unlock()
+	p.d.output = true
+	if err := p.d.c.Tx([]byte{p.d.control(0), byte(v)}, nil); err != nil {
+		return fmt.Errorf("pcf8591: %w", err)
+	}
+	return nil
+}
+
+// Name implements pin.Pin.
+func (p *DACPin) Name() string {
+	return "PCF8591_AOUT"
+}
+
+// Number implements pin.Pin.
+func (p *DACPin) Number() int {
+	return 0
+}
+
+// Function implements pin.Pin.
+func (p *DACPin) Function() string {
+	return string(p.Func())
+}
+
+// Func implements pin.PinFunc.
+func (p *DACPin) Func() pin.Func {
+	return analog.DAC
+}
+
+// SupportedFuncs implements pin.PinFunc.
+func (p *DACPin) SupportedFuncs() []pin.Func {
+	return []pin.Func{analog.DAC}
+}
+
+// SetFunc implements pin.PinFunc.
+func (p *DACPin) SetFunc(f pin.Func) error {
+	if f == analog.DAC {
+		return nil
+	}
+	return errors.New("pcf8591: pin function cannot be changed")
+}
+
+// Halt implements conn.Resource. It disables the output, as Dev.Halt().
+func (p *DACPin) Halt() error {
+	return p.d.Halt()
+}
+
+func (p *DACPin) String() string {
+	return p.Name()
+}
+
+var _ analog.PinADC = &ADCPin{}
+var _ analog.PinDAC = &DACPin{}
+var _ pin.PinFunc = &ADCPin{}
+var _ pin.PinFunc = &DACPin{}
@@ -0,0 +1,133 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package pcf8591
+
+import (
+	"testing"
+
+	"periph.io/x/conn/v3/analog"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+	"periph.io/x/conn/v3/physic"
+)
+
+func newTest(t *testing.T, opts *Opts, ops ...i2ctest.IO) (*Dev, *i2ctest.Playback) {
+	mode := byte(DefaultOpts.Mode)
+	if opts != nil {
+		mode = byte(opts.Mode)
+	}
+	b := &i2ctest.Playback{Ops: append([]i2ctest.IO{{Addr: DefaultAddress, W: []byte{mode << 4}}}, ops...), DontPanic: true}
+	d, err := NewI2C(b, DefaultAddress, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d, b
+}
+
+func TestRead(t *testing.T) {
+	d, b := newTest(t, nil,
+		i2ctest.IO{Addr: DefaultAddress, W: []byte{0x02}, R: []byte{0x11, 0x80}},
+		i2ctest.IO{Addr: DefaultAddress, W: []byte{0x04}, R: []byte{0x80, 0x00, 0x40, 0x80, 0xff}},
+	)
+	p, err := d.ADC(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := p.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (analog.Sample{Raw: 0x80, V: 1650 * physic.MilliVolt}); s != want {
+		t.Errorf("Read() = %+v, want %+v", s, want)
+	}
+	all, err := d.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for ch, raw := range []int32{0x00, 0x40, 0x80, 0xff} {
+		if all[ch].Raw != raw {
+			t.Errorf("channel %d = %d, want %d", ch, all[ch].Raw, raw)
+		}
+	}
+	if _, err := d.ADC(4); err == nil {
+		t.Error("expected an error for an invalid channel")
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRead_differential(t *testing.T) {
+	opts := Opts{Mode: Mixed, VRef: 2560 * physic.MilliVolt}
+	d, b := newTest(t, &opts,
+		i2ctest.IO{Addr: DefaultAddress, W: []byte{0x24}, R: []byte{0x00, 0x10, 0xff, 0xf0}},
+	)
+	all, err := d.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []analog.Sample{
+		{Raw: 0x10, V: 160 * physic.MilliVolt},
+		{Raw: 0xff, V: 2550 * physic.MilliVolt},
+		// AIN2 minus AIN3 is negative.
+		{Raw: -16, V: -160 * physic.MilliVolt},
+	}
+	for ch := range want {
+		if all[ch] != want[ch] {
+			t.Errorf("channel %d = %+v, want %+v", ch, all[ch], want[ch])
+		}
+	}
+	p, _ := d.ADC(2)
+	if min, max := p.Range(); min.Raw != -128 || max.Raw != 127 {
+		t.Errorf("Range() = %+v, %+v", min, max)
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDAC(t *testing.T) {
+	d, b := newTest(t, &Opts{Mode: TwoDifferential},
+		i2ctest.IO{Addr: DefaultAddress, W: []byte{0x70, 0x80}},
+		// The output stays enabled while reading.
+		i2ctest.IO{Addr: DefaultAddress, W: []byte{0x71}, R: []byte{0x00, 0x01}},
+		i2ctest.IO{Addr: DefaultAddress, W: []byte{0x30}},
+	)
+	if err := d.DAC().Out(0x80); err != nil {
+		t.Fatal(err)
+	}
+	p, _ := d.ADC(1)
+	if _, err := p.Read(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DAC().Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DAC().Out(256); err == nil {
+		t.Error("expected an error for an invalid value")
+	}
+	if _, max := d.DAC().Range(); max.Raw != 0xff {
+		t.Errorf("Range() = %+v", max)
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNames(t *testing.T) {
+	d, _ := newTest(t, nil)
+	p, _ := d.ADC(3)
+	if p.String() != "PCF8591_AIN3" || p.Number() != 3 || p.Function() != "ADC" {
+		t.Errorf("%s %d %s", p, p.Number(), p.Function())
+	}
+	if s := d.DAC().String(); s != "PCF8591_AOUT" {
+		t.Error(s)
+	}
+	if s := d.String(); s != "PCF8591{playback(72), FourSingleEnded}" {
+		t.Error(s)
+	}
+	if _, err := NewI2C(&i2ctest.Playback{}, DefaultAddress, &Opts{Mode: 4}); err == nil {
+		t.Error("expected an error for an invalid mode")
+	}
+}
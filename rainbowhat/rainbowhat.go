@@ -5,6 +5,8 @@
 package rainbowhat
 
 import (
+	"fmt"
+
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/i2c/i2creg"
 	"periph.io/x/conn/v3/spi/spireg"
@@ -142,6 +144,10 @@ func (d *Dev) GetServo() gpio.PinOut {
 	return d.servo
 }
 
+func (d *Dev) String() string {
+	return fmt.Sprintf("RainbowHAT{%s, %s, %s}", d.ledstrip, d.bmp280, d.display)
+}
+
 // Halt all internal devices.
 func (d *Dev) Halt() error {
 	if err := d.bmp280.Halt(); err != nil {
@@ -0,0 +1,45 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package relay_test
+
+import (
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/devices/v3/relay"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	channels := []relay.Channel{
+		{Name: "open", Pin: gpioreg.ByName("GPIO5"), ActiveLow: true},
+		{Name: "close", Pin: gpioreg.ByName("GPIO6"), ActiveLow: true},
+		{Name: "light", Pin: gpioreg.ByName("GPIO13"), ActiveLow: true, FailSafe: true},
+	}
+	opts := relay.Opts{
+		MinInterval: 500 * time.Millisecond,
+		// The motor of the door can't be driven both ways at once.
+		Interlocks: [][]string{{"open", "close"}},
+	}
+	b, err := relay.New(channels, &opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer b.Halt()
+
+	if err := b.On("open"); err != nil {
+		log.Fatal(err)
+	}
+	time.Sleep(5 * time.Second)
+	if err := b.Off("open"); err != nil {
+		log.Fatal(err)
+	}
+}
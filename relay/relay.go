@@ -0,0 +1,228 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package relay drives relay boards, with each relay connected to a GPIO,
+// either of the host or of an I/O expander such as the MCP23017.
+//
+// Relays are switched by name. Board refuses to switch a relay again before
+// Opts.MinInterval, which protects the contacts and the loads, such as
+// compressors, that must not be cycled quickly. Relays in an interlock group
+// are mutually exclusive, for example the two directions of a motor: a relay
+// of the group can only be turned on when all the others are off.
+//
+// Halt() puts all the relays in their fail-safe state.
+//
+// # Wiring
+//
+// Most relay boards with optocouplers are active low: the relay is on when
+// its input is driven low.
+package relay
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+var (
+	// ErrTooSoon is returned when a relay is switched again before
+	// Opts.MinInterval.
+	ErrTooSoon = errors.New("relay: switched too soon")
+	// ErrInterlock is returned when a relay can't be turned on because
+	// another relay of its interlock group is on.
+	ErrInterlock = errors.New("relay: interlocked")
+)
+
+// Channel is the configuration of a relay.
+type Channel struct {
+	// Name identifies the relay.
+	Name string
+	// Pin drives the relay.
+	Pin gpio.PinOut
+	// ActiveLow is true if the relay is on when Pin is low.
+	ActiveLow bool
+	// FailSafe is the state of the relay on New() and Halt(), true for on.
+	FailSafe bool
+}
+
+// Opts holds the configuration options.
+type Opts struct {
+	// MinInterval is the minimum time between two switches of a relay.
+	MinInterval time.Duration
+	// Interlocks are groups of relay names, of which at most one can be on.
+	Interlocks [][]string
+}
+
+type channel struct {
+	Channel
+	on      bool
+	changed time.Time
+	// groups are the indexes of the interlock groups of the relay.
+	groups []int
+}
+
+// Board is a handle to a set of relays.
+type Board struct {
+	mu         sync.Mutex
+	channels   []*channel
+	byName     map[string]*channel
+	interlocks [][]*channel
+	opts       Opts
+
+	// now is replaced by tests.
+	now func() time.Time
+}
+
+// New returns a handle to the relays of channels, which are put in their
+// fail-safe state. If opts is nil, the relays can be switched at any time,
+// without interlocks.
+func New(channels []Channel, opts *Opts) (*Board, error) {
+	if opts == nil {
+		opts = &Opts{}
+	}
+	if opts.MinInterval < 0 {
+		return nil, fmt.Errorf("relay: invalid interval %s", opts.MinInterval)
+	}
+	b := &Board{byName: map[string]*channel{}, opts: *opts, now: time.Now}
+	for _, c := range channels {
+		if c.Name == "" || c.Pin == nil {
+			return nil, errors.New("relay: channels require a name and a pin")
+		}
+		if _, ok := b.byName[c.Name]; ok {
+			return nil, fmt.Errorf("relay: duplicate channel %q", c.Name)
+		}
+		ch := &channel{Channel: c}
+		b.channels = append(b.channels, ch)
+		b.byName[c.Name] = ch
+	}
+	for i, group := range opts.Interlocks {
+		var g []*channel
+		for _, name := range group {
+			ch, ok := b.byName[name]
+			if !ok {
+				return nil, fmt.Errorf("relay: unknown channel %q in interlock", name)
+			}
+			ch.groups = append(ch.groups, i)
+			g = append(g, ch)
+		}
+		b.interlocks = append(b.interlocks, g)
+	}
+	for i, g := range b.interlocks {
+		n := 0
+		for _, ch := range g {
+			if ch.FailSafe {
+				n++
+			}
+		}
+		if n > 1 {
+			return nil, fmt.Errorf("relay: interlock %d has more than one fail-safe channel on", i)
+		}
+	}
+	if err := b.Halt(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// On turns the relay name on.
+func (b *Board) On(name string) error {
+	return b.Set(name, true)
+}
+
+// Off turns the relay name off.
+func (b *Board) Off(name string) error {
+	return b.Set(name, false)
+}
+
+// Set turns the relay name on or off. It is a no-op if the relay is already
+// in this state.
+func (b *Board) Set(name string, on bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ch, ok := b.byName[name]
+	if !ok {
+		return fmt.Errorf("relay: unknown channel %q", name)
+	}
+	if ch.on == on {
+		return nil
+	}
+	now := b.now()
+	if since := now.Sub(ch.changed); since < b.opts.MinInterval {
+		return fmt.Errorf("%w: %s was switched %s ago", ErrTooSoon, name, since)
+	}
+	if on {
+		for _, i := range ch.groups {
+			for _, other := range b.interlocks[i] {
+				if other != ch && other.on {
+					return fmt.Errorf("%w: %s is on", ErrInterlock, other.Name)
+				}
+			}
+		}
+	}
+	return b.write(ch, on)
+}
+
+// State returns true if the relay name is on.
+func (b *Board) State(name string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ch, ok := b.byName[name]
+	if !ok {
+		return false, fmt.Errorf("relay: unknown channel %q", name)
+	}
+	return ch.on, nil
+}
+
+// Names returns the names of the relays, in the order passed to New().
+func (b *Board) Names() []string {
+	names := make([]string, len(b.channels))
+	for i, ch := range b.channels {
+		names[i] = ch.Name
+	}
+	return names
+}
+
+// Halt implements conn.Resource. It puts all the relays in their fail-safe
+// state, regardless of Opts.MinInterval. The relays that are turned off are
+// switched first, so that interlocks are respected.
+func (b *Board) Halt() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var errs []error
+	for _, on := range []bool{false, true} {
+		for _, ch := range b.channels {
+			if ch.FailSafe != on {
+				continue
+			}
+			if err := b.write(ch, on); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (b *Board) String() string {
+	var s []string
+	for _, ch := range b.channels {
+		s = append(s, fmt.Sprintf("%s: %s", ch.Name, ch.Pin))
+	}
+	return "relay{" + strings.Join(s, ", ") + "}"
+}
+
+// write drives the pin of ch. b.mu must be held.
+func (b *Board) write(ch *channel, on bool) error {
+	if err := ch.Pin.Out(gpio.Level(on != ch.ActiveLow)); err != nil {
+		return fmt.Errorf("relay: %s: %w", ch.Name, err)
+	}
+	if ch.on != on {
+		ch.on = on
+		ch.changed = b.now()
+	}
+	return nil
+}
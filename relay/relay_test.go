@@ -0,0 +1,116 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package relay
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+)
+
+func newTestBoard(t *testing.T, opts *Opts) (*Board, map[string]*gpiotest.Pin, *time.Time) {
+	pins := map[string]*gpiotest.Pin{}
+	var channels []Channel
+	for _, c := range []struct {
+		name      string
+		activeLow bool
+		failSafe  bool
+	}{
+		{"up", true, false},
+		{"down", true, false},
+		{"pump", false, false},
+		{"vent", false, true},
+	} {
+		p := &gpiotest.Pin{N: c.name}
+		pins[c.name] = p
+		channels = append(channels, Channel{Name: c.name, Pin: p, ActiveLow: c.activeLow, FailSafe: c.failSafe})
+	}
+	b, err := New(channels, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	b.now = func() time.Time { return now }
+	return b, pins, &now
+}
+
+func TestNew(t *testing.T) {
+	b, pins, _ := newTestBoard(t, nil)
+	want := map[string]gpio.Level{"up": gpio.High, "down": gpio.High, "pump": gpio.Low, "vent": gpio.High}
+	for name, l := range want {
+		if pins[name].L != l {
+			t.Errorf("%s = %s, want %s", name, pins[name].L, l)
+		}
+	}
+	if on, err := b.State("vent"); err != nil || !on {
+		t.Errorf("State(vent) = %t, %v", on, err)
+	}
+	if s := b.String(); s != "relay{up: up(0), down: down(0), pump: pump(0), vent: vent(0)}" {
+		t.Errorf("String() = %q", s)
+	}
+	p := &gpiotest.Pin{N: "p"}
+	for _, line := range []struct {
+		channels []Channel
+		opts     *Opts
+	}{
+		{[]Channel{{Name: "a"}}, nil},
+		{[]Channel{{Name: "a", Pin: p}, {Name: "a", Pin: p}}, nil},
+		{[]Channel{{Name: "a", Pin: p}}, &Opts{Interlocks: [][]string{{"a", "b"}}}},
+		{[]Channel{{Name: "a", Pin: p, FailSafe: true}, {Name: "b", Pin: p, FailSafe: true}}, &Opts{Interlocks: [][]string{{"a", "b"}}}},
+	} {
+		if _, err := New(line.channels, line.opts); err == nil {
+			t.Errorf("New(%v, %v) succeeded", line.channels, line.opts)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	b, pins, now := newTestBoard(t, &Opts{MinInterval: time.Second, Interlocks: [][]string{{"up", "down"}}})
+	if err := b.On("up"); err != nil {
+		t.Fatal(err)
+	}
+	if pins["up"].L != gpio.Low {
+		t.Error("up not driven low")
+	}
+	if err := b.On("down"); !errors.Is(err, ErrInterlock) {
+		t.Errorf("On(down) = %v", err)
+	}
+	if err := b.Off("up"); !errors.Is(err, ErrTooSoon) {
+		t.Errorf("Off(up) = %v", err)
+	}
+	*now = now.Add(time.Second)
+	if err := b.Off("up"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.On("down"); err != nil {
+		t.Fatal(err)
+	}
+	// Setting the current state isn't a switch.
+	if err := b.On("down"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.On("pump"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.On("nope"); err == nil {
+		t.Error("expected an error for an unknown channel")
+	}
+	// Halt ignores the minimum interval.
+	if err := b.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range b.Names() {
+		on, _ := b.State(name)
+		if on != (name == "vent") {
+			t.Errorf("%s is %t after Halt", name, on)
+		}
+	}
+	if pins["down"].L != gpio.High || pins["pump"].L != gpio.Low {
+		t.Errorf("down %s, pump %s", pins["down"].L, pins["pump"].L)
+	}
+}
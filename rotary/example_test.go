@@ -0,0 +1,46 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package rotary_test
+
+import (
+	"fmt"
+	"log"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/devices/v3/rotary"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+	a := gpioreg.ByName("GPIO17")
+	b := gpioreg.ByName("GPIO27")
+	sw := gpioreg.ByName("GPIO22")
+	if a == nil || b == nil || sw == nil {
+		log.Fatal("failed to find the pins")
+	}
+	e, err := rotary.New(a, b, sw)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer e.Halt()
+	events, err := e.Start()
+	if err != nil {
+		log.Fatal(err)
+	}
+	position := 0
+	for ev := range events {
+		switch ev.Kind {
+		case rotary.Turn:
+			position += ev.Steps
+			fmt.Println("position:", position)
+		case rotary.Press:
+			position = 0
+			fmt.Println("reset")
+		}
+	}
+}
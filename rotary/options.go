@@ -0,0 +1,39 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package rotary
+
+import "time"
+
+// Option is a configuration option passed to New().
+type Option func(*options)
+
+type options struct {
+	perStep  int
+	debounce time.Duration
+}
+
+// WithTransitionsPerStep sets the number of valid transitions per detent, 4
+// for most encoders, 2 or 1 for others. The default is 4.
+func WithTransitionsPerStep(n int) Option {
+	return func(o *options) {
+		o.perStep = n
+	}
+}
+
+// WithDebounce sets the time the switch must be stable. The default is
+// 10ms.
+func WithDebounce(d time.Duration) Option {
+	return func(o *options) {
+		o.debounce = d
+	}
+}
+
+func applyOptions(opts []Option) *options {
+	o := &options{perStep: 4, debounce: 10 * time.Millisecond}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
@@ -0,0 +1,301 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package rotary decodes incremental rotary encoders, such as the EC11, with
+// two quadrature outputs and an optional push switch, connected to GPIOs.
+//
+// The outputs go through a cycle of four states, with one output changing at
+// a time, in an order depending on the direction. Invalid transitions, from
+// contact bounce, are ignored.
+//
+// The package is a driver on its own, for any application reading a knob.
+// The midi package uses it to send the events of an encoder as MIDI
+// messages, see midi.Bridge.
+//
+// # Wiring
+//
+// Connect the common pin of the encoder and of the switch to ground, and the
+// outputs A and B and the switch to GPIOs, with the pull-ups enabled. A 10nF
+// capacitor from each output to ground reduces the bounce.
+package rotary
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// Kind is the kind of an Event.
+type Kind int
+
+const (
+	// Turn is a rotation by Event.Steps.
+	Turn Kind = iota
+	// Press is a press of the switch.
+	Press
+	// Release is a release of the switch.
+	Release
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Turn:
+		return "Turn"
+	case Press:
+		return "Press"
+	case Release:
+		return "Release"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Event is a change of the encoder.
+type Event struct {
+	Kind Kind
+	// Steps is the number of detents turned, positive clockwise, for a Turn.
+	Steps int
+	// Time is when the change was detected.
+	Time time.Time
+}
+
+func (e Event) String() string {
+	if e.Kind == Turn {
+		return fmt.Sprintf("Turn(%+d)", e.Steps)
+	}
+	return e.Kind.String()
+}
+
+const (
+	// edgeTimeout is the longest time the goroutines wait for an edge between
+	// checks of Stop().
+	edgeTimeout = 100 * time.Millisecond
+	// eventBufferSize is the size of the buffer of the events channel.
+	eventBufferSize = 16
+)
+
+// transitions is the direction of a transition from the state of the
+// outputs, A<<1|B, to the next state, indexed by prev<<2|next. It's 0 for no
+// change and for invalid transitions. Clockwise, A leads B.
+var transitions = [16]int8{
+	0, -1, 1, 0,
+	1, 0, 0, -1,
+	-1, 0, 0, 1,
+	0, 1, -1, 0,
+}
+
+// Decoder counts the steps of a quadrature signal. It's used by Encoder,
+// and can decode levels sampled otherwise.
+type Decoder struct {
+	perStep int
+	state   uint8
+	count   int
+	// Invalid is the number of invalid transitions, where both outputs
+	// changed, caused by bounce or missed edges.
+	Invalid int
+}
+
+// NewDecoder returns a Decoder with the outputs at a, b, for encoders with
+// perStep transitions per detent.
+func NewDecoder(a, b gpio.Level, perStep int) *Decoder {
+	return &Decoder{perStep: max(perStep, 1), state: levels(a, b)}
+}
+
+// Update decodes the levels of the outputs, and returns the steps completed,
+// positive clockwise.
+func (d *Decoder) Update(a, b gpio.Level) int {
+	next := levels(a, b)
+	if next == d.state {
+		return 0
+	}
+	dir := transitions[d.state<<2|next]
+	if dir == 0 {
+		d.Invalid++
+	}
+	d.state = next
+	d.count += int(dir)
+	steps := d.count / d.perStep
+	d.count -= steps * d.perStep
+	return steps
+}
+
+func levels(a, b gpio.Level) uint8 {
+	var s uint8
+	if a {
+		s |= 2
+	}
+	if b {
+		s |= 1
+	}
+	return s
+}
+
+// Encoder is a handle to a rotary encoder.
+type Encoder struct {
+	a, b, sw gpio.PinIn
+	opts     options
+
+	mu      sync.Mutex
+	dec     *Decoder
+	pressed bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// New returns a handle to an encoder with its outputs connected to a and b,
+// and its switch to sw, which can be nil. Without options, the encoder has 4
+// transitions per detent, and the switch is debounced for 10ms.
+func New(a, b, sw gpio.PinIn, opts ...Option) (*Encoder, error) {
+	if a == nil || b == nil {
+		return nil, errors.New("rotary: a and b are required")
+	}
+	o := applyOptions(opts)
+	if o.perStep < 1 || o.perStep > 4 || o.debounce < 0 {
+		return nil, fmt.Errorf("rotary: invalid options: %d transitions per step, debounce %s", o.perStep, o.debounce)
+	}
+	for _, p := range []gpio.PinIn{a, b, sw} {
+		if p == nil {
+			continue
+		}
+		if err := p.In(gpio.PullUp, gpio.BothEdges); err != nil {
+			return nil, fmt.Errorf("rotary: %w", err)
+		}
+	}
+	e := &Encoder{a: a, b: b, sw: sw, opts: *o}
+	e.dec = NewDecoder(a.Read(), b.Read(), o.perStep)
+	return e, nil
+}
+
+// Start starts goroutines waiting for the edges of the pins, and sends the
+// changes to the returned channel. The channel is closed when Stop() is
+// called.
+func (e *Encoder) Start() (<-chan Event, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.stop != nil {
+		return nil, errors.New("rotary: already started")
+	}
+	e.stop = make(chan struct{})
+	e.done = make(chan struct{})
+	events := make(chan Event, eventBufferSize)
+	var wg sync.WaitGroup
+	for _, p := range []gpio.PinIn{e.a, e.b} {
+		wg.Add(1)
+		go func(p gpio.PinIn) {
+			defer wg.Done()
+			e.runOutput(p, events, e.stop)
+		}(p)
+	}
+	if e.sw != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e.runSwitch(events, e.stop)
+		}()
+	}
+	go func(done chan struct{}) {
+		wg.Wait()
+		close(events)
+		close(done)
+	}(e.done)
+	return events, nil
+}
+
+// Stop stops the goroutines started by Start(), and waits for them to exit.
+func (e *Encoder) Stop() {
+	e.mu.Lock()
+	stop, done := e.stop, e.done
+	e.stop = nil
+	e.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Invalid returns the number of invalid transitions decoded, a measure of
+// the bounce of the outputs.
+func (e *Encoder) Invalid() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.dec.Invalid
+}
+
+// Halt implements conn.Resource. It stops the goroutines started by Start().
+func (e *Encoder) Halt() error {
+	e.Stop()
+	return nil
+}
+
+func (e *Encoder) String() string {
+	if e.sw == nil {
+		return fmt.Sprintf("rotary{%s, %s}", e.a, e.b)
+	}
+	return fmt.Sprintf("rotary{%s, %s, %s}", e.a, e.b, e.sw)
+}
+
+// runOutput decodes the edges of p, which is output A or B.
+func (e *Encoder) runOutput(p gpio.PinIn, events chan<- Event, stop chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		if !p.WaitForEdge(edgeTimeout) {
+			continue
+		}
+		// Both outputs are read, since the other one may have changed
+		// without its edge being processed yet.
+		e.mu.Lock()
+		steps := e.dec.Update(e.a.Read(), e.b.Read())
+		e.mu.Unlock()
+		if steps == 0 {
+			continue
+		}
+		select {
+		case events <- Event{Kind: Turn, Steps: steps, Time: time.Now()}:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// runSwitch debounces the switch.
+func (e *Encoder) runSwitch(events chan<- Event, stop chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		if !e.sw.WaitForEdge(edgeTimeout) {
+			continue
+		}
+		// Wait for the bounce to settle, ignoring the edges meanwhile.
+		for e.sw.WaitForEdge(e.opts.debounce) {
+		}
+		pressed := e.sw.Read() == gpio.Low
+		e.mu.Lock()
+		changed := pressed != e.pressed
+		e.pressed = pressed
+		e.mu.Unlock()
+		if !changed {
+			continue
+		}
+		ev := Event{Kind: Release, Time: time.Now()}
+		if pressed {
+			ev.Kind = Press
+		}
+		select {
+		case events <- ev:
+		case <-stop:
+			return
+		}
+	}
+}
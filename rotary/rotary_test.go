@@ -0,0 +1,128 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package rotary
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+)
+
+func TestDecoder(t *testing.T) {
+	// Clockwise, A leads B: 00, 10, 11, 01, 00.
+	cw := [][2]gpio.Level{{true, false}, {true, true}, {false, true}, {false, false}}
+	d := NewDecoder(gpio.Low, gpio.Low, 4)
+	total := 0
+	for i := 0; i < 3; i++ {
+		for _, l := range cw {
+			total += d.Update(l[0], l[1])
+		}
+	}
+	if total != 3 {
+		t.Fatalf("clockwise: got %d steps, want 3", total)
+	}
+	// Counter-clockwise, in reverse.
+	for i := len(cw) - 2; i >= 0; i-- {
+		total += d.Update(cw[i][0], cw[i][1])
+	}
+	total += d.Update(gpio.Low, gpio.Low)
+	if total != 2 {
+		t.Fatalf("counter-clockwise: got %d steps, want 2", total)
+	}
+	// Bounce back and forth doesn't count.
+	for i := 0; i < 5; i++ {
+		total += d.Update(gpio.High, gpio.Low)
+		total += d.Update(gpio.Low, gpio.Low)
+	}
+	if total != 2 || d.Invalid != 0 {
+		t.Fatalf("bounce: got %d steps, %d invalid", total, d.Invalid)
+	}
+	if d.Update(gpio.High, gpio.High) != 0 || d.Invalid != 1 {
+		t.Fatalf("invalid transition: got %d invalid", d.Invalid)
+	}
+}
+
+func TestDecoder_HalfStep(t *testing.T) {
+	d := NewDecoder(gpio.Low, gpio.Low, 2)
+	if s := d.Update(gpio.High, gpio.Low); s != 0 {
+		t.Fatalf("got %d", s)
+	}
+	if s := d.Update(gpio.High, gpio.High); s != 1 {
+		t.Fatalf("got %d", s)
+	}
+}
+
+func TestNew_Invalid(t *testing.T) {
+	a := &gpiotest.Pin{N: "A", EdgesChan: make(chan gpio.Level)}
+	if _, err := New(a, nil, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	b := &gpiotest.Pin{N: "B", EdgesChan: make(chan gpio.Level)}
+	if _, err := New(a, b, nil, WithTransitionsPerStep(5)); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	a := &gpiotest.Pin{N: "A", L: gpio.High, EdgesChan: make(chan gpio.Level)}
+	b := &gpiotest.Pin{N: "B", L: gpio.High, EdgesChan: make(chan gpio.Level)}
+	sw := &gpiotest.Pin{N: "SW", L: gpio.High, EdgesChan: make(chan gpio.Level, 4)}
+	e, err := New(a, b, sw, WithDebounce(time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := e.String(); s != "rotary{A(0), B(0), SW(0)}" {
+		t.Fatal(s)
+	}
+	events, err := e.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Start(); err == nil {
+		t.Fatal("expected error")
+	}
+	// With the pull-ups, the idle state is 11. Counter-clockwise, B leads:
+	// 11, 10, 00, 01, 11. Each edge is decoded before the next one is sent,
+	// since the goroutines of A and B would otherwise race.
+	edge := func(p *gpiotest.Pin, l gpio.Level, state uint8) {
+		p.EdgesChan <- l
+		for {
+			e.mu.Lock()
+			s := e.dec.state
+			e.mu.Unlock()
+			if s == state {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	edge(b, gpio.Low, 2)
+	edge(a, gpio.Low, 0)
+	edge(b, gpio.High, 1)
+	edge(a, gpio.High, 3)
+	ev := <-events
+	if ev.Kind != Turn || ev.Steps != -1 {
+		t.Fatalf("got %s", ev)
+	}
+	sw.EdgesChan <- gpio.Low
+	if ev := <-events; ev.Kind != Press {
+		t.Fatalf("got %s", ev)
+	}
+	sw.EdgesChan <- gpio.High
+	if ev := <-events; ev.Kind != Release {
+		t.Fatalf("got %s", ev)
+	}
+	if err := e.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-events; ok {
+		t.Fatal("events not closed")
+	}
+	if e.Invalid() != 0 {
+		t.Fatal(e.Invalid())
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package servo_test
+
+import (
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/servo"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	s, err := servo.New(gpioreg.ByName("GPIO18"), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer s.Detach()
+
+	// This servo reaches its end stops at 0.6ms and 2.4ms.
+	if err := s.Calibrate(600*time.Microsecond, 2400*time.Microsecond); err != nil {
+		log.Fatal(err)
+	}
+	if err := s.SetAngle(90 * physic.Degree); err != nil {
+		log.Fatal(err)
+	}
+	time.Sleep(500 * time.Millisecond)
+	if err := s.Sweep(30*physic.Degree, 150*physic.Degree, 60*physic.Degree, 3); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package servo drives hobby servo motors, which turn to an angle set by the
+// width of a pulse repeated every 20ms, typically 1ms to 2ms for their whole
+// range.
+//
+// A Servo is driven either by a GPIO that supports PWM, or by any output that
+// can set a pulse width, such as a channel of a PCA9685 returned by
+// pca9685.ServoGroup.GetServo().
+//
+// The pulse widths of the ends of the range differ between models, and
+// between units of the same model. Calibrate() sets them, so that angles are
+// accurate and the servo isn't driven against its end stops.
+package servo
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Pulser sets the width of the pulses sent to a servo. A width of 0 stops the
+// pulses. *pca9685.Servo implements it.
+type Pulser interface {
+	SetPulseWidth(width time.Duration) error
+}
+
+// Opts holds the configuration options.
+type Opts struct {
+	// MinPulse and MaxPulse are the pulse widths of the ends of the range.
+	MinPulse time.Duration
+	MaxPulse time.Duration
+	// Range is the angle between the ends of the range.
+	Range physic.Angle
+	// Frequency is the rate of the pulses, for servos driven by a GPIO.
+	Frequency physic.Frequency
+}
+
+// DefaultOpts is the nominal range of most servos.
+var DefaultOpts = Opts{
+	MinPulse:  time.Millisecond,
+	MaxPulse:  2 * time.Millisecond,
+	Range:     180 * physic.Degree,
+	Frequency: 50 * physic.Hertz,
+}
+
+// updateInterval is the time between the steps of slow moves, which is the
+// period of the pulses at 50Hz.
+const updateInterval = 20 * time.Millisecond
+
+// Servo is a handle to a servo motor.
+type Servo struct {
+	p    Pulser
+	name string
+
+	mu       sync.Mutex
+	minPulse time.Duration
+	maxPulse time.Duration
+	rng      physic.Angle
+	// angle is the last angle set, or -1 if the servo is detached.
+	angle physic.Angle
+
+	// sleep is replaced by tests.
+	sleep func(time.Duration)
+}
+
+// New returns a Servo driven by the PWM of pin. If opts is nil, DefaultOpts
+// is used. The servo is detached until an angle is set.
+func New(pin gpio.PinOut, opts *Opts) (*Servo, error) {
+	if opts == nil {
+		opts = &DefaultOpts
+	}
+	if opts.Frequency <= 0 {
+		return nil, fmt.Errorf("servo: invalid frequency %s", opts.Frequency)
+	}
+	if opts.MaxPulse >= opts.Frequency.Period() {
+		return nil, fmt.Errorf("servo: pulse width %s longer than the period at %s", opts.MaxPulse, opts.Frequency)
+	}
+	return newServo(&pwmPulser{pin: pin, f: opts.Frequency}, pin.Name(), opts)
+}
+
+// NewPulser returns a Servo driven by p. If opts is nil, DefaultOpts is used,
+// and Opts.Frequency is ignored.
+func NewPulser(p Pulser, opts *Opts) (*Servo, error) {
+	if opts == nil {
+		opts = &DefaultOpts
+	}
+	return newServo(p, fmt.Sprintf("%v", p), opts)
+}
+
+func newServo(p Pulser, name string, opts *Opts) (*Servo, error) {
+	if opts.Range <= 0 {
+		return nil, fmt.Errorf("servo: invalid range %s", opts.Range)
+	}
+	s := &Servo{p: p, name: name, rng: opts.Range, angle: -1, sleep: time.Sleep}
+	if err := s.Calibrate(opts.MinPulse, opts.MaxPulse); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Calibrate sets the pulse widths of the ends of the range. If the servo is
+// attached, it moves to the current angle with the new calibration.
+func (s *Servo) Calibrate(minPulse, maxPulse time.Duration) error {
+	if minPulse <= 0 || maxPulse <= minPulse {
+		return fmt.Errorf("servo: invalid pulse widths %s, %s", minPulse, maxPulse)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.minPulse, s.maxPulse = minPulse, maxPulse
+	if s.angle < 0 {
+		return nil
+	}
+	return s.setAngle(s.angle)
+}
+
+// SetAngle moves the servo to angle, between 0 and Opts.Range, as fast as it
+// can. Angles out of the range are clamped.
+func (s *Servo) SetAngle(angle physic.Angle) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.setAngle(angle)
+}
+
+// Angle returns the last angle set. It returns false if the servo is
+// detached.
+func (s *Servo) Angle() (physic.Angle, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.angle, s.angle >= 0
+}
+
+// SetPulseWidth sends pulses of width, bypassing the calibration. It can be
+// used to find the ends of the range of a servo.
+func (s *Servo) SetPulseWidth(width time.Duration) error {
+	if width <= 0 {
+		return fmt.Errorf("servo: invalid pulse width %s", width)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.p.SetPulseWidth(width); err != nil {
+		return err
+	}
+	s.angle = s.angleOf(width)
+	return nil
+}
+
+// MoveTo moves the servo to angle at speed, in angle per second, and returns
+// when it has been reached. The servo must be attached, since its position
+// is unknown otherwise.
+func (s *Servo) MoveTo(angle, speed physic.Angle) error {
+	if speed <= 0 {
+		return fmt.Errorf("servo: invalid speed %s", speed)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.angle < 0 {
+		return errors.New("servo: detached, set an angle first")
+	}
+	angle = s.clamp(angle)
+	// Split the move in equal steps, one per update interval.
+	start, d := s.angle, angle-s.angle
+	perSecond := physic.Angle(time.Second / updateInterval)
+	n := (max(d, -d)*perSecond + speed - 1) / speed
+	for i := physic.Angle(1); i <= n; i++ {
+		if err := s.setAngle(start + d*i/n); err != nil {
+			return err
+		}
+		if i != n {
+			s.sleep(updateInterval)
+		}
+	}
+	return nil
+}
+
+// Sweep moves the servo back and forth between from and to at speed, cycles
+// times, starting with a move to from.
+func (s *Servo) Sweep(from, to, speed physic.Angle, cycles int) error {
+	if _, ok := s.Angle(); !ok {
+		if err := s.SetAngle(from); err != nil {
+			return err
+		}
+	}
+	if err := s.MoveTo(from, speed); err != nil {
+		return err
+	}
+	for i := 0; i < cycles; i++ {
+		if err := s.MoveTo(to, speed); err != nil {
+			return err
+		}
+		if err := s.MoveTo(from, speed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Detach stops the pulses, so that the servo stops holding its position.
+func (s *Servo) Detach() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.angle = -1
+	return s.p.SetPulseWidth(0)
+}
+
+// Halt implements conn.Resource. It detaches the servo.
+func (s *Servo) Halt() error {
+	return s.Detach()
+}
+
+func (s *Servo) String() string {
+	return fmt.Sprintf("servo{%s}", s.name)
+}
+
+// setAngle implements SetAngle(). s.mu must be held.
+func (s *Servo) setAngle(angle physic.Angle) error {
+	angle = s.clamp(angle)
+	width := s.minPulse + time.Duration(int64(s.maxPulse-s.minPulse)*int64(angle)/int64(s.rng))
+	if err := s.p.SetPulseWidth(width); err != nil {
+		return err
+	}
+	s.angle = angle
+	return nil
+}
+
+// angleOf returns the angle of a pulse width, clamped to the range. s.mu
+// must be held.
+func (s *Servo) angleOf(width time.Duration) physic.Angle {
+	return s.clamp(physic.Angle(int64(width-s.minPulse) * int64(s.rng) / int64(s.maxPulse-s.minPulse)))
+}
+
+func (s *Servo) clamp(angle physic.Angle) physic.Angle {
+	return min(max(angle, 0), s.rng)
+}
+
+// pwmPulser sets the pulse width with the duty cycle of a GPIO.
+type pwmPulser struct {
+	pin gpio.PinOut
+	f   physic.Frequency
+}
+
+func (p *pwmPulser) SetPulseWidth(width time.Duration) error {
+	if width == 0 {
+		return p.pin.Out(gpio.Low)
+	}
+	duty := gpio.Duty(int64(gpio.DutyMax) * int64(width) / int64(p.f.Period()))
+	return p.pin.PWM(duty, p.f)
+}
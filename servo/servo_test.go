@@ -0,0 +1,166 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package servo
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// fakePulser records the pulse widths.
+type fakePulser struct {
+	widths []time.Duration
+}
+
+func (f *fakePulser) SetPulseWidth(width time.Duration) error {
+	f.widths = append(f.widths, width)
+	return nil
+}
+
+func (f *fakePulser) String() string { return "fake" }
+
+func (f *fakePulser) last() time.Duration {
+	return f.widths[len(f.widths)-1]
+}
+
+func TestNew_pwm(t *testing.T) {
+	pin := &gpiotest.Pin{N: "GPIO18"}
+	s, err := New(pin, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.Angle(); ok {
+		t.Error("attached after New")
+	}
+	if err := s.SetAngle(90 * physic.Degree); err != nil {
+		t.Fatal(err)
+	}
+	// 1.5ms out of 20ms.
+	if want := gpio.Duty(int64(gpio.DutyMax) * 15 / 200); pin.D != want || pin.F != 50*physic.Hertz {
+		t.Errorf("duty %s at %s, want %s", pin.D, pin.F, want)
+	}
+	if err := s.Detach(); err != nil {
+		t.Fatal(err)
+	}
+	if pin.L != gpio.Low {
+		t.Errorf("level %s after Detach", pin.L)
+	}
+	if _, ok := s.Angle(); ok {
+		t.Error("attached after Detach")
+	}
+	if s := s.String(); s != "servo{GPIO18}" {
+		t.Errorf("String() = %q", s)
+	}
+	if _, err := New(pin, &Opts{MinPulse: time.Millisecond, MaxPulse: 25 * time.Millisecond, Range: physic.Degree, Frequency: 50 * physic.Hertz}); err == nil {
+		t.Error("expected an error for a pulse longer than the period")
+	}
+}
+
+func TestSetAngle(t *testing.T) {
+	p := &fakePulser{}
+	s, err := NewPulser(p, &Opts{MinPulse: 500 * time.Microsecond, MaxPulse: 2500 * time.Microsecond, Range: 180 * physic.Degree})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []struct {
+		angle physic.Angle
+		width time.Duration
+	}{
+		{0, 500 * time.Microsecond},
+		{45 * physic.Degree, 1000 * time.Microsecond},
+		{180 * physic.Degree, 2500 * time.Microsecond},
+		{-10 * physic.Degree, 500 * time.Microsecond},
+		{200 * physic.Degree, 2500 * time.Microsecond},
+	}
+	for _, line := range data {
+		if err := s.SetAngle(line.angle); err != nil {
+			t.Fatal(err)
+		}
+		if p.last() != line.width {
+			t.Errorf("SetAngle(%s): %s, want %s", line.angle, p.last(), line.width)
+		}
+	}
+	// Recalibrating moves to the same angle with the new widths.
+	if err := s.Calibrate(time.Millisecond, 2*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if p.last() != 2*time.Millisecond {
+		t.Errorf("after Calibrate: %s", p.last())
+	}
+	if err := s.Calibrate(2*time.Millisecond, time.Millisecond); err == nil {
+		t.Error("expected an error for inverted widths")
+	}
+	if err := s.SetPulseWidth(1250 * time.Microsecond); err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := s.Angle(); !ok || a != 45*physic.Degree {
+		t.Errorf("Angle() = %s, %t", a, ok)
+	}
+}
+
+func TestMoveTo(t *testing.T) {
+	p := &fakePulser{}
+	s, err := NewPulser(p, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sleeps int
+	s.sleep = func(time.Duration) { sleeps++ }
+	if err := s.MoveTo(90*physic.Degree, 90*physic.Degree); err == nil {
+		t.Error("expected an error while detached")
+	}
+	if err := s.SetAngle(0); err != nil {
+		t.Fatal(err)
+	}
+	// 90° at 90°/s takes 1s, which is 50 steps of 1.8°.
+	if err := s.MoveTo(90*physic.Degree, 90*physic.Degree); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.widths) != 51 || sleeps != 49 {
+		t.Errorf("%d widths, %d sleeps", len(p.widths), sleeps)
+	}
+	for ix := 1; ix < len(p.widths); ix++ {
+		if p.widths[ix] <= p.widths[ix-1] {
+			t.Fatalf("width %d = %s, not increasing", ix, p.widths[ix])
+		}
+	}
+	if a, _ := s.Angle(); a != 90*physic.Degree || p.last() != 1500*time.Microsecond {
+		t.Errorf("Angle() = %s, width %s", a, p.last())
+	}
+}
+
+func TestSweep(t *testing.T) {
+	p := &fakePulser{}
+	s, err := NewPulser(p, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.sleep = func(time.Duration) {}
+	if err := s.Sweep(0, 180*physic.Degree, 900*physic.Degree, 2); err != nil {
+		t.Fatal(err)
+	}
+	var ends []time.Duration
+	for _, w := range p.widths {
+		if w == time.Millisecond || w == 2*time.Millisecond {
+			ends = append(ends, w)
+		}
+	}
+	want := []time.Duration{time.Millisecond, 2 * time.Millisecond, time.Millisecond, 2 * time.Millisecond, time.Millisecond}
+	if len(ends) != len(want) {
+		t.Fatalf("ends %v, want %v", ends, want)
+	}
+	for ix := range want {
+		if ends[ix] != want[ix] {
+			t.Fatalf("ends %v, want %v", ends, want)
+		}
+	}
+	if err := s.Halt(); err != nil || p.last() != 0 {
+		t.Errorf("Halt() = %v, width %s", err, p.last())
+	}
+}
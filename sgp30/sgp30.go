@@ -8,15 +8,27 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
-	"log"
+	"fmt"
+	"log/slog"
 	"strconv"
 	"sync"
 	"time"
 
 	"periph.io/x/conn/v3"
 	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/devices/v3/internal/logging"
 )
 
+// logger receives the errors of the periodic measurements, which are
+// required by the baseline compensation of the sensor.
+var logger logging.Logger
+
+// SetLogger sets the logger of the package, by default one discarding the
+// records.
+func SetLogger(l *slog.Logger) {
+	logger.Set(l)
+}
+
 const (
 	initAirQuality       uint16 = 0x2003
 	measureAirQuality    uint16 = 0x2008
@@ -106,6 +118,10 @@ type Dev struct {
 	d   conn.Conn
 	mu  sync.Mutex
 	env Env
+	// stop is closed by Halt() to stop the measurements, and done is closed
+	// when they stopped.
+	stop chan struct{}
+	done chan struct{}
 }
 
 // AirQuality return the value struct for the sensor
@@ -125,26 +141,51 @@ func (d *Dev) makeDev(ctx context.Context) error {
 	// After the "sgp30_iaq_init" command, a "sgp30_measure_iaq" command has to be sent in regular
 	// intervals of 1s to ensure proper operation of the dynamic baseline compensation algorithm.
 	if err := d.measure(); err != nil {
-		log.Print(err)
+		logger.Get().Error("sgp30: measurement failed", "err", err)
 	}
 
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
 	ticker := time.NewTicker(1 * time.Second)
-	go func() {
+	go func(stop, done chan struct{}) {
+		defer close(done)
+		defer ticker.Stop()
 		for {
 			select {
 			case <-ticker.C:
 				if err := d.measure(); err != nil {
-					log.Print(err)
+					logger.Get().Error("sgp30: measurement failed", "err", err)
 				}
 			case <-ctx.Done():
 				return
+			case <-stop:
+				return
 			}
 		}
-	}()
+	}(d.stop, d.done)
 
 	return nil
 }
 
+// Halt implements conn.Resource. It stops the measurements, as cancelling
+// the context passed to NewI2C() does. AirQuality() then returns the last
+// values measured.
+func (d *Dev) Halt() error {
+	d.mu.Lock()
+	stop := d.stop
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		<-d.done
+	}
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("SGP30{%s}", d.d)
+}
+
 func (d *Dev) initAirQuality() error {
 	err := d.writeCommand(initAirQuality)
 	if err == nil {
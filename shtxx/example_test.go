@@ -0,0 +1,44 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package shtxx_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/shtxx"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Use i2creg I²C bus registry to find the first available I²C bus.
+	b, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer b.Close()
+
+	d, err := shtxx.NewI2C(b, shtxx.DefaultAddress, &shtxx.Opts{Variant: shtxx.SHT3x})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+
+	ch, err := d.SenseContinuous(time.Second)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for range 10 {
+		env := <-ch
+		fmt.Printf("%8s %9s\n", env.Temperature, env.Humidity)
+	}
+}
@@ -0,0 +1,456 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package shtxx controls the Sensirion SHT3x (SHT30, SHT31, SHT35) and SHT4x
+// (SHT40, SHT41, SHT45) temperature and humidity sensors.
+//
+// The measurements are validated with their CRC. Both families have a heater
+// to drive off condensation: the heater of the SHT3x is switched on and off
+// with SetHeater(), while the SHT4x runs it in pulses with Heat().
+//
+// The SHT3x measures periodically on its own while SenseContinuous() runs.
+// The SHT4x has no periodic mode, so measurements are triggered by the
+// goroutine.
+//
+// # Datasheets
+//
+// https://sensirion.com/media/documents/213E6A3B/63A5A569/Datasheet_SHT3x_DIS.pdf
+//
+// https://sensirion.com/media/documents/33FD6951/67EB9032/HT_DS_Datasheet_SHT4x_5.pdf
+package shtxx
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// DefaultAddress is the I²C address of most sensors. The SHT3x can use 0x45
+// with its ADDR pin high, and some SHT4x are made with 0x45 or 0x46.
+const DefaultAddress uint16 = 0x44
+
+// Variant is a family of sensors.
+type Variant int
+
+const (
+	// SHT3x is the SHT30, SHT31 or SHT35.
+	SHT3x Variant = iota
+	// SHT4x is the SHT40, SHT41 or SHT45.
+	SHT4x
+)
+
+func (v Variant) String() string {
+	switch v {
+	case SHT3x:
+		return "SHT3x"
+	case SHT4x:
+		return "SHT4x"
+	default:
+		return fmt.Sprintf("Variant(%d)", int(v))
+	}
+}
+
+// Repeatability trades the noise of the measurements for their duration,
+// and the power used.
+type Repeatability int
+
+const (
+	High Repeatability = iota
+	Medium
+	Low
+)
+
+// HeaterPower is the power of the heater of the SHT4x.
+type HeaterPower int
+
+const (
+	Heater20mW HeaterPower = iota
+	Heater110mW
+	Heater200mW
+)
+
+// Opts holds the configuration options.
+type Opts struct {
+	Variant       Variant
+	Repeatability Repeatability
+}
+
+// command is a command of the SHT3x, or the first byte of a command of the
+// SHT4x.
+type command uint16
+
+// SHT3x commands.
+var (
+	sht3xSingleShot = [...]command{High: 0x2400, Medium: 0x240b, Low: 0x2416}
+	// sht3xPeriodic are the commands of the periodic mode, by rate then
+	// repeatability.
+	sht3xPeriodic = [...][3]command{
+		{0x2032, 0x2024, 0x202f},
+		{0x2130, 0x2126, 0x212d},
+		{0x2236, 0x2220, 0x222b},
+		{0x2334, 0x2322, 0x2329},
+		{0x2737, 0x2721, 0x272a},
+	}
+	sht3xPeriods = [...]time.Duration{2 * time.Second, time.Second, 500 * time.Millisecond, 250 * time.Millisecond, 100 * time.Millisecond}
+	// sht3xDurations are the maximum durations of a measurement.
+	sht3xDurations = [...]time.Duration{High: 16 * time.Millisecond, Medium: 7 * time.Millisecond, Low: 5 * time.Millisecond}
+)
+
+const (
+	sht3xFetch      command = 0xe000
+	sht3xBreak      command = 0x3093
+	sht3xHeaterOn   command = 0x306d
+	sht3xHeaterOff  command = 0x3066
+	sht3xSoftReset  command = 0x30a2
+	sht3xReadSerial command = 0x3780
+)
+
+// SHT4x commands.
+var (
+	sht4xMeasure   = [...]command{High: 0xfd, Medium: 0xf6, Low: 0xe0}
+	sht4xDurations = [...]time.Duration{High: 9 * time.Millisecond, Medium: 5 * time.Millisecond, Low: 2 * time.Millisecond}
+	// sht4xHeat are the heater commands by power, for 1s then 0.1s.
+	sht4xHeat = [...][2]command{
+		Heater20mW:  {0x1e, 0x15},
+		Heater110mW: {0x2f, 0x24},
+		Heater200mW: {0x39, 0x32},
+	}
+)
+
+const (
+	sht4xSoftReset  command = 0x94
+	sht4xReadSerial command = 0x89
+)
+
+var errInvalidCRC = errors.New("shtxx: invalid crc")
+
+// Dev is a handle to an SHT3x or SHT4x sensor.
+type Dev struct {
+	d    *i2c.Dev
+	opts Opts
+
+	mu sync.Mutex
+	// periodic is true while the SHT3x measures periodically.
+	periodic bool
+	stop     chan struct{}
+	done     chan struct{}
+
+	// sleep is replaced by tests.
+	sleep func(time.Duration)
+}
+
+// NewI2C returns a handle to a sensor at addr on b. If opts is nil, an SHT3x
+// with High repeatability is assumed. The sensor is reset.
+func NewI2C(b i2c.Bus, addr uint16, opts *Opts) (*Dev, error) {
+	if opts == nil {
+		opts = &Opts{}
+	}
+	if opts.Variant != SHT3x && opts.Variant != SHT4x {
+		return nil, fmt.Errorf("shtxx: invalid variant %s", opts.Variant)
+	}
+	if opts.Repeatability < High || opts.Repeatability > Low {
+		return nil, fmt.Errorf("shtxx: invalid repeatability %d", opts.Repeatability)
+	}
+	d := &Dev{d: &i2c.Dev{Bus: b, Addr: addr}, opts: *opts, sleep: time.Sleep}
+	if err := d.Reset(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Reset resets the sensor with a soft reset, which stops the periodic mode
+// and the heater of the SHT3x.
+func (d *Dev) Reset() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	cmd := sht3xSoftReset
+	if d.opts.Variant == SHT4x {
+		cmd = sht4xSoftReset
+	} else if d.periodic {
+		// The SHT3x ignores commands other than break in periodic mode.
+		if err := d.breakPeriodic(); err != nil {
+			return err
+		}
+	}
+	if err := d.write(cmd); err != nil {
+		return err
+	}
+	d.sleep(2 * time.Millisecond)
+	return nil
+}
+
+// SerialNumber returns the unique serial number of the sensor.
+func (d *Dev) SerialNumber() (uint32, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.periodic {
+		return 0, errors.New("shtxx: serial number can't be read while sensing continuously")
+	}
+	cmd, wait := sht3xReadSerial, time.Duration(0)
+	if d.opts.Variant == SHT4x {
+		cmd, wait = sht4xReadSerial, time.Millisecond
+	}
+	w, err := d.read(cmd, wait)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(w[0])<<16 | uint32(w[1]), nil
+}
+
+// SetHeater switches the heater of an SHT3x on or off. The heater raises
+// the temperature of the sensor by a few degrees, so measurements are wrong
+// while it's on.
+func (d *Dev) SetHeater(on bool) error {
+	if d.opts.Variant != SHT3x {
+		return errors.New("shtxx: the SHT4x heater runs in pulses, see Heat()")
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.periodic {
+		return errors.New("shtxx: heater can't be switched while sensing continuously")
+	}
+	cmd := sht3xHeaterOff
+	if on {
+		cmd = sht3xHeaterOn
+	}
+	return d.write(cmd)
+}
+
+// Heat runs the heater of an SHT4x at power for pulse, which must be 100ms
+// or 1s, and returns the measurement taken at its end in env. The sensor is
+// hot, so the temperature measured is high. The heater must not run more
+// than 10% of the time.
+func (d *Dev) Heat(power HeaterPower, pulse time.Duration, env *physic.Env) error {
+	if d.opts.Variant != SHT4x {
+		return errors.New("shtxx: the SHT3x heater is switched, see SetHeater()")
+	}
+	if power < Heater20mW || power > Heater200mW {
+		return fmt.Errorf("shtxx: invalid heater power %d", power)
+	}
+	var cmd command
+	switch pulse {
+	case time.Second:
+		cmd = sht4xHeat[power][0]
+	case 100 * time.Millisecond:
+		cmd = sht4xHeat[power][1]
+	default:
+		return fmt.Errorf("shtxx: invalid heater pulse %s", pulse)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	w, err := d.read(cmd, pulse*11/10)
+	if err != nil {
+		return err
+	}
+	d.convert(w, env)
+	return nil
+}
+
+// Sense implements physic.SenseEnv. It returns the temperature and the
+// humidity, or the last periodic measurement of an SHT3x while
+// SenseContinuous() runs.
+func (d *Dev) Sense(env *physic.Env) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.sense(env)
+}
+
+// SenseContinuous implements physic.SenseEnv. An SHT3x measures periodically
+// at the highest rate that isn't faster than interval, from 0.5Hz to 10Hz.
+// Intervals longer than 2s skip measurements. Measurements that fail are
+// skipped.
+func (d *Dev) SenseContinuous(interval time.Duration) (<-chan physic.Env, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("shtxx: invalid interval %s", interval)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return nil, errors.New("shtxx: SenseContinuous already running")
+	}
+	if d.opts.Variant == SHT3x {
+		rate := 0
+		for rate+1 < len(sht3xPeriods) && sht3xPeriods[rate+1] >= interval {
+			rate++
+		}
+		if err := d.write(sht3xPeriodic[rate][d.opts.Repeatability]); err != nil {
+			return nil, err
+		}
+		d.periodic = true
+		// Wait for the first measurement.
+		d.sleep(sht3xDurations[d.opts.Repeatability])
+	}
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
+	ch := make(chan physic.Env, 16)
+	go d.run(interval, ch, d.stop, d.done)
+	return ch, nil
+}
+
+// Precision implements physic.SenseEnv.
+func (d *Dev) Precision(env *physic.Env) {
+	env.Temperature = physic.Temperature(175 * int64(physic.Celsius) / 65535)
+	env.Humidity = physic.RelativeHumidity(100 * int64(physic.PercentRH) / 65535)
+	if d.opts.Variant == SHT4x {
+		env.Humidity = physic.RelativeHumidity(125 * int64(physic.PercentRH) / 65535)
+	}
+	env.Pressure = 0
+}
+
+// Halt implements conn.Resource. It stops SenseContinuous(), and the
+// periodic mode of an SHT3x.
+func (d *Dev) Halt() error {
+	d.mu.Lock()
+	stop, done := d.stop, d.done
+	d.stop = nil
+	d.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.periodic {
+		return d.breakPeriodic()
+	}
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("%s{%s}", d.opts.Variant, d.d)
+}
+
+func (d *Dev) run(interval time.Duration, ch chan<- physic.Env, stop, done chan struct{}) {
+	defer close(done)
+	defer close(ch)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+		var env physic.Env
+		d.mu.Lock()
+		err := d.sense(&env)
+		d.mu.Unlock()
+		if err != nil {
+			continue
+		}
+		select {
+		case ch <- env:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// sense implements Sense(). d.mu must be held.
+func (d *Dev) sense(env *physic.Env) error {
+	var w []uint16
+	var err error
+	switch {
+	case d.periodic:
+		w, err = d.read(sht3xFetch, 0)
+	case d.opts.Variant == SHT3x:
+		w, err = d.read(sht3xSingleShot[d.opts.Repeatability], sht3xDurations[d.opts.Repeatability])
+	default:
+		w, err = d.read(sht4xMeasure[d.opts.Repeatability], sht4xDurations[d.opts.Repeatability])
+	}
+	if err != nil {
+		return err
+	}
+	d.convert(w, env)
+	return nil
+}
+
+// convert converts the words of a measurement.
+func (d *Dev) convert(w []uint16, env *physic.Env) {
+	env.Temperature = physic.ZeroCelsius - 45*physic.Celsius + physic.Temperature(175*int64(physic.Celsius)*int64(w[0])/65535)
+	env.Pressure = 0
+	if d.opts.Variant == SHT3x {
+		env.Humidity = physic.RelativeHumidity(100 * int64(physic.PercentRH) * int64(w[1]) / 65535)
+		return
+	}
+	// The SHT4x can return values out of range, which must be clamped.
+	rh := -6*physic.PercentRH + physic.RelativeHumidity(125*int64(physic.PercentRH)*int64(w[1])/65535)
+	env.Humidity = min(max(rh, 0), 100*physic.PercentRH)
+}
+
+// breakPeriodic stops the periodic mode of an SHT3x. d.mu must be held.
+func (d *Dev) breakPeriodic() error {
+	if err := d.write(sht3xBreak); err != nil {
+		return err
+	}
+	d.periodic = false
+	d.sleep(time.Millisecond)
+	return nil
+}
+
+// write sends cmd.
+func (d *Dev) write(cmd command) error {
+	if err := d.d.Tx(d.encode(cmd), nil); err != nil {
+		return fmt.Errorf("shtxx: %w", err)
+	}
+	return nil
+}
+
+// read sends cmd, waits for wait, and reads two words validated with their
+// CRC.
+func (d *Dev) read(cmd command, wait time.Duration) ([]uint16, error) {
+	if err := d.write(cmd); err != nil {
+		return nil, err
+	}
+	if wait > 0 {
+		d.sleep(wait)
+	}
+	var r [6]byte
+	if err := d.d.Tx(nil, r[:]); err != nil {
+		return nil, fmt.Errorf("shtxx: %w", err)
+	}
+	w := make([]uint16, 2)
+	for i := range w {
+		b := r[3*i : 3*i+3]
+		if crc8(b[:2]) != b[2] {
+			return nil, errInvalidCRC
+		}
+		w[i] = uint16(b[0])<<8 | uint16(b[1])
+	}
+	return w, nil
+}
+
+// encode returns the bytes of cmd, one byte for the SHT4x, and two for the
+// SHT3x.
+func (d *Dev) encode(cmd command) []byte {
+	if d.opts.Variant == SHT4x {
+		return []byte{byte(cmd)}
+	}
+	return []byte{byte(cmd >> 8), byte(cmd)}
+}
+
+// crc8 is the CRC of Sensirion sensors, with the polynomial 0x31 and the
+// initial value 0xff.
+func crc8(b []byte) byte {
+	crc := byte(0xff)
+	for _, v := range b {
+		crc ^= v
+		for range 8 {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x31
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+var _ conn.Resource = &Dev{}
+var _ physic.SenseEnv = &Dev{}
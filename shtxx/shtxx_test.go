@@ -0,0 +1,248 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package shtxx
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+)
+
+// fakeBus records the commands written, and answers the reads with a
+// measurement.
+type fakeBus struct {
+	mu     sync.Mutex
+	writes [][]byte
+	t, rh  uint16
+	badCRC bool
+}
+
+func (b *fakeBus) String() string { return "fake" }
+
+func (b *fakeBus) SetSpeed(physic.Frequency) error { return nil }
+
+func (b *fakeBus) Tx(addr uint16, w, r []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if addr != DefaultAddress {
+		return errors.New("no device")
+	}
+	if len(w) != 0 {
+		b.writes = append(b.writes, append([]byte(nil), w...))
+	}
+	if len(r) == 6 {
+		copy(r, []byte{byte(b.t >> 8), byte(b.t), 0, byte(b.rh >> 8), byte(b.rh), 0})
+		r[2], r[5] = crc8(r[:2]), crc8(r[3:5])
+		if b.badCRC {
+			r[5]++
+		}
+	}
+	return nil
+}
+
+func (b *fakeBus) commands() [][]byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([][]byte(nil), b.writes...)
+}
+
+var _ i2c.Bus = &fakeBus{}
+
+func newTest(t *testing.T, opts *Opts) (*Dev, *fakeBus) {
+	b := &fakeBus{t: 0x6666, rh: 0x8000}
+	d, err := NewI2C(b, DefaultAddress, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.sleep = func(time.Duration) {}
+	return d, b
+}
+
+func checkCommands(t *testing.T, b *fakeBus, want ...[]byte) {
+	t.Helper()
+	got := b.commands()
+	if len(got) != len(want) {
+		t.Fatalf("commands % x, want % x", got, want)
+	}
+	for i := range want {
+		if !bytes.Equal(got[i], want[i]) {
+			t.Errorf("command %d = % x, want % x", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCRC(t *testing.T) {
+	// Example of the datasheets.
+	if c := crc8([]byte{0xbe, 0xef}); c != 0x92 {
+		t.Errorf("crc8 = %#x", c)
+	}
+}
+
+func TestSense(t *testing.T) {
+	data := []struct {
+		opts    Opts
+		cmd     []byte
+		wantRH  physic.RelativeHumidity
+		wantStr string
+	}{
+		{Opts{Variant: SHT3x}, []byte{0x24, 0x00}, 5000076, "SHT3x{fake(68)}"},
+		{Opts{Variant: SHT3x, Repeatability: Low}, []byte{0x24, 0x16}, 5000076, "SHT3x{fake(68)}"},
+		{Opts{Variant: SHT4x, Repeatability: Medium}, []byte{0xf6}, 5650095, "SHT4x{fake(68)}"},
+	}
+	for _, line := range data {
+		d, b := newTest(t, &line.opts)
+		var env physic.Env
+		if err := d.Sense(&env); err != nil {
+			t.Fatal(err)
+		}
+		if want := physic.ZeroCelsius + 25*physic.Celsius; env.Temperature != want {
+			t.Errorf("%s: temperature %s, want %s", d, env.Temperature, want)
+		}
+		if env.Humidity != line.wantRH {
+			t.Errorf("%s: humidity %d, want %d", d, env.Humidity, line.wantRH)
+		}
+		reset := []byte{0x30, 0xa2}
+		if line.opts.Variant == SHT4x {
+			reset = []byte{0x94}
+		}
+		checkCommands(t, b, reset, line.cmd)
+		if s := d.String(); s != line.wantStr {
+			t.Error(s)
+		}
+	}
+}
+
+func TestSense_errors(t *testing.T) {
+	d, b := newTest(t, &Opts{Variant: SHT4x})
+	b.badCRC = true
+	var env physic.Env
+	if err := d.Sense(&env); err != errInvalidCRC {
+		t.Errorf("got %v", err)
+	}
+	// The SHT4x clamps the humidity.
+	b.badCRC, b.rh = false, 0
+	if err := d.Sense(&env); err != nil || env.Humidity != 0 {
+		t.Errorf("got %s, %v", env.Humidity, err)
+	}
+	if _, err := NewI2C(b, 0x45, nil); err == nil {
+		t.Error("expected an error without a device")
+	}
+	if _, err := NewI2C(b, DefaultAddress, &Opts{Variant: 2}); err == nil {
+		t.Error("expected an error for an invalid variant")
+	}
+}
+
+func TestHeater(t *testing.T) {
+	d, b := newTest(t, nil)
+	if err := d.SetHeater(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetHeater(false); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Heat(Heater20mW, time.Second, &physic.Env{}); err == nil {
+		t.Error("expected an error for an SHT3x")
+	}
+	checkCommands(t, b, []byte{0x30, 0xa2}, []byte{0x30, 0x6d}, []byte{0x30, 0x66})
+
+	d, b = newTest(t, &Opts{Variant: SHT4x})
+	var env physic.Env
+	if err := d.Heat(Heater200mW, 100*time.Millisecond, &env); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Heat(Heater20mW, time.Second, &env); err != nil {
+		t.Fatal(err)
+	}
+	if env.Temperature != physic.ZeroCelsius+25*physic.Celsius {
+		t.Errorf("temperature %s", env.Temperature)
+	}
+	if err := d.Heat(Heater110mW, time.Millisecond, &env); err == nil {
+		t.Error("expected an error for an invalid pulse")
+	}
+	if err := d.SetHeater(true); err == nil {
+		t.Error("expected an error for an SHT4x")
+	}
+	checkCommands(t, b, []byte{0x94}, []byte{0x32}, []byte{0x1e})
+}
+
+func TestSerialNumber(t *testing.T) {
+	d, b := newTest(t, &Opts{Variant: SHT4x})
+	if n, err := d.SerialNumber(); err != nil || n != 0x66668000 {
+		t.Errorf("got %#x, %v", n, err)
+	}
+	checkCommands(t, b, []byte{0x94}, []byte{0x89})
+}
+
+func TestSenseContinuous(t *testing.T) {
+	d, b := newTest(t, nil)
+	ch, err := d.SenseContinuous(10 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SenseContinuous(time.Second); err == nil {
+		t.Error("expected an error when running")
+	}
+	if err := d.SetHeater(true); err == nil {
+		t.Error("expected an error in periodic mode")
+	}
+	for range 2 {
+		if env := <-ch; env.Temperature != physic.ZeroCelsius+25*physic.Celsius {
+			t.Errorf("temperature %s", env.Temperature)
+		}
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-ch; ok {
+		t.Error("channel not closed")
+	}
+	got := b.commands()
+	// Reset, 10Hz with high repeatability, fetches, then break.
+	if !bytes.Equal(got[1], []byte{0x27, 0x37}) || !bytes.Equal(got[2], []byte{0xe0, 0x00}) || !bytes.Equal(got[len(got)-1], []byte{0x30, 0x93}) {
+		t.Errorf("commands % x", got)
+	}
+	// Single shot measurements again.
+	if err := d.Sense(&physic.Env{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.commands(); !bytes.Equal(got[len(got)-1], []byte{0x24, 0x00}) {
+		t.Errorf("commands % x", got)
+	}
+}
+
+func TestSenseContinuous_rate(t *testing.T) {
+	for _, line := range []struct {
+		interval time.Duration
+		cmd      []byte
+	}{
+		{time.Hour, []byte{0x20, 0x24}},
+		{time.Second, []byte{0x21, 0x26}},
+		{300 * time.Millisecond, []byte{0x22, 0x20}},
+	} {
+		d, b := newTest(t, &Opts{Repeatability: Medium})
+		if _, err := d.SenseContinuous(line.interval); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.Halt(); err != nil {
+			t.Fatal(err)
+		}
+		checkCommands(t, b, []byte{0x30, 0xa2}, line.cmd, []byte{0x30, 0x93})
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	d, _ := newTest(t, &Opts{Variant: SHT4x})
+	var env physic.Env
+	d.Precision(&env)
+	// 2.67mK and 0.0019%rH.
+	if env.Temperature != 2670328*physic.NanoKelvin || env.Humidity != 190 {
+		t.Errorf("got %d, %d", env.Temperature, env.Humidity)
+	}
+}
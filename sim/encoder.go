@@ -0,0 +1,104 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sim
+
+import (
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// DefaultTransitionDelay is the time between the transitions of the outputs
+// of an Encoder, long enough for a decoder to read each state.
+const DefaultTransitionDelay = 2 * time.Millisecond
+
+// Encoder simulates a quadrature rotary encoder, with a push switch, wired
+// to host pins. The outputs and the switch connect to ground, and read high
+// through the pull-ups of the pins when open.
+type Encoder struct {
+	A, B, SW *Pin
+	// TransitionDelay is the time between transitions of the outputs.
+	TransitionDelay time.Duration
+
+	mu       sync.Mutex
+	position int
+	pressed  bool
+	sleep    func(time.Duration)
+}
+
+// NewEncoder returns an encoder at a detent, with the switch released. The
+// pins are named name_A, name_B and name_SW.
+func NewEncoder(name string) *Encoder {
+	return &Encoder{
+		A:               NewPin(name+"_A", -1),
+		B:               NewPin(name+"_B", -1),
+		SW:              NewPin(name+"_SW", -1),
+		TransitionDelay: DefaultTransitionDelay,
+		sleep:           time.Sleep,
+	}
+}
+
+// Turn turns the encoder by detents, clockwise if positive. Each detent is
+// 4 transitions of the outputs, and output A leads clockwise. It returns
+// once the outputs are back at rest.
+func (e *Encoder) Turn(detents int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	first, second := e.A, e.B
+	if detents < 0 {
+		first, second = second, first
+	}
+	for range abs(detents) {
+		for _, step := range []struct {
+			p *Pin
+			l gpio.Level
+		}{{first, gpio.Low}, {second, gpio.Low}, {first, gpio.High}, {second, gpio.High}} {
+			e.set(step.p, step.l)
+			e.sleep(e.TransitionDelay)
+		}
+	}
+	e.position += detents
+}
+
+// Press closes the switch.
+func (e *Encoder) Press() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.SW.Drive(gpio.Low)
+	e.pressed = true
+}
+
+// Release opens the switch.
+func (e *Encoder) Release() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.SW.Release()
+	e.pressed = false
+}
+
+// Position returns the detents turned since the encoder was created,
+// positive clockwise, and whether the switch is pressed.
+func (e *Encoder) Position() (detents int, pressed bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.position, e.pressed
+}
+
+// set closes the contact of an output for low, or opens it.
+func (e *Encoder) set(p *Pin, l gpio.Level) {
+	if l {
+		p.Release()
+	} else {
+		p.Drive(gpio.Low)
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sim_test
+
+import (
+	"log"
+	"os"
+
+	"periph.io/x/devices/v3/hd44780"
+	"periph.io/x/devices/v3/sim"
+)
+
+func Example() {
+	// A 2x16 display on a PCF8574 backpack, at address 0x27.
+	bus := sim.NewBus("I2C1")
+	screen := sim.NewHD44780(2, 16)
+	bus.Attach(0x27, sim.NewPCF8574().WithLCD(screen, hd44780.PCF857xPinMap))
+
+	// The application drives it as the real display.
+	lcd, err := hd44780.NewPCF857xBackpack(bus, 0x27, 2, 16)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := lcd.WriteString("Hello, world!"); err != nil {
+		log.Fatal(err)
+	}
+
+	panel := &sim.Panel{}
+	panel.AddLCD("lcd", screen)
+	if err := panel.Render(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// lcd (backlight on)
+	// +----------------+
+	// |Hello, world!   |
+	// |                |
+	// +----------------+
+}
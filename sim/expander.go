@@ -0,0 +1,164 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sim
+
+import (
+	"errors"
+	"sync"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/devices/v3/hd44780"
+	"periph.io/x/devices/v3/mcp23xxx/mcp23xxxtest"
+)
+
+// PCF8574 simulates a PCF8574 I/O expander. Each byte written sets the
+// port, and each byte read returns the levels of the pins.
+//
+// The pins are quasi-bidirectional: a pin written high is weakly pulled up,
+// and reads low if it's driven low with Drive(). A pin written low is low.
+type PCF8574 struct {
+	mu     sync.Mutex
+	port   byte
+	driven byte
+	drive  byte
+	lcd    *lcdPort
+}
+
+// NewPCF8574 returns an expander with the pins high, as at power on.
+func NewPCF8574() *PCF8574 {
+	return &PCF8574{port: 0xff}
+}
+
+// WithLCD wires lcd to the pins of the expander, as pins, like a PCF8574
+// LCD backpack, and returns the expander.
+func (s *PCF8574) WithLCD(lcd *HD44780, pins hd44780.PinMap) *PCF8574 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lcd = newLCDPort(lcd, pins, s.port)
+	return s
+}
+
+// Tx implements Device.
+func (s *PCF8574) Tx(w, r []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, v := range w {
+		s.port = v
+		if s.lcd != nil {
+			s.lcd.set(v)
+		}
+	}
+	for ix := range r {
+		r[ix] = s.levels()
+	}
+	return nil
+}
+
+// Drive drives pin to l, as a button or another device would.
+func (s *PCF8574) Drive(pin int, l gpio.Level) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.driven |= 1 << pin
+	s.drive &^= 1 << pin
+	if l {
+		s.drive |= 1 << pin
+	}
+}
+
+// Release stops driving pin.
+func (s *PCF8574) Release(pin int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.driven &^= 1 << pin
+}
+
+// Level returns the level of pin.
+func (s *PCF8574) Level(pin int) gpio.Level {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.levels()&(1<<pin) != 0
+}
+
+// levels returns the levels of the pins. s.mu must be held.
+func (s *PCF8574) levels() byte {
+	v := s.port &^ (s.driven &^ s.drive)
+	if s.lcd != nil {
+		v &= s.lcd.get(0xff)
+	}
+	return v
+}
+
+// MCP23008 simulates an MCP23008 I/O expander, or an MCP23S08 on an
+// SPIPort. It embeds the register model of mcp23xxxtest, which implements
+// the pins and interrupts.
+type MCP23008 struct {
+	*mcp23xxxtest.MCP23008
+
+	addr uint16
+	mu   sync.Mutex
+	lcd  *lcdPort
+}
+
+// NewMCP23008 returns an expander in its power on state. addr is the
+// address recorded in its transactions.
+func NewMCP23008(addr uint16) *MCP23008 {
+	return &MCP23008{MCP23008: mcp23xxxtest.NewMCP23008(addr), addr: addr}
+}
+
+// WithLCD wires lcd to the pins of the expander, as pins, like the Adafruit
+// I2C/SPI LCD backpack, and returns the expander. R/W must be tied to
+// ground, as the pins aren't read.
+func (s *MCP23008) WithLCD(lcd *HD44780, pins hd44780.PinMap) *MCP23008 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lcd = newLCDPort(lcd, pins, s.Register(mcp23xxxtest.OLAT))
+	return s
+}
+
+// Tx implements Device for the I²C bus. w starts with the register address.
+func (s *MCP23008) Tx(w, r []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lcd == nil || len(w) < 2 {
+		return s.MCP23008.Tx(s.addr, w, r)
+	}
+	// The values are written one at a time, so that the display sees each
+	// state of the output latch.
+	reg := w[0]
+	for _, v := range w[1:] {
+		if err := s.MCP23008.Tx(s.addr, []byte{reg, v}, nil); err != nil {
+			return err
+		}
+		s.lcd.set(s.Register(mcp23xxxtest.OLAT) &^ s.Register(mcp23xxxtest.IODIR))
+		if s.Register(mcp23xxxtest.IOCON)&ioconSEQOP == 0 {
+			reg = (reg + 1) % (mcp23xxxtest.OLAT + 1)
+		}
+	}
+	if len(r) == 0 {
+		return nil
+	}
+	return s.MCP23008.Tx(s.addr, []byte{reg}, r)
+}
+
+// ioconSEQOP is the bit of IOCON that disables the sequential addressing.
+const ioconSEQOP = 1 << 5
+
+// SPI returns the expander as a Device for an SPIPort. Transactions start
+// with the opcode, and the register address. The hardware address in the
+// opcode is ignored.
+func (s *MCP23008) SPI() Device {
+	return spiMCP23008{s}
+}
+
+type spiMCP23008 struct {
+	s *MCP23008
+}
+
+func (d spiMCP23008) Tx(w, r []byte) error {
+	if len(w) < 2 || w[0]&0xf0 != 0x40 {
+		return errors.New("MCP23S08: invalid opcode")
+	}
+	return d.s.Tx(w[1:], r)
+}
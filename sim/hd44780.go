@@ -0,0 +1,338 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sim
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"periph.io/x/devices/v3/hd44780"
+)
+
+// HD44780 simulates the state of an HD44780 character display controller:
+// the display RAM, the address counter, the entry mode, and the display
+// control flags. It's connected to the application by a backpack model,
+// such as PCF8574 or MCP23008, through a 4 bit interface.
+//
+// The controller is never busy. Reading the status returns the address
+// counter, so that drivers verifying initialization succeed.
+type HD44780 struct {
+	rows, cols int
+
+	mu    sync.Mutex
+	ddram [0x80]byte
+	cgram [0x40]byte
+	// ac is the address counter, in CGRAM if cg is true.
+	ac        byte
+	cg        bool
+	increment bool
+	shiftOnWr bool
+	display   bool
+	cursor    bool
+	blink     bool
+	shift     int
+	backlight bool
+	// fourBit is true once the interface is set to 4 bits. nibble is the
+	// high nibble of the byte being transferred, if half is true.
+	fourBit bool
+	half    bool
+	nibble  byte
+	// read is the byte being read in 4 bit mode, and readLow is true when
+	// its low nibble is next.
+	read    byte
+	readLow bool
+}
+
+// NewHD44780 returns a display of rows and cols characters, in the state
+// of the controller at power on.
+func NewHD44780(rows, cols int) *HD44780 {
+	d := &HD44780{rows: rows, cols: cols, increment: true}
+	for ix := range d.ddram {
+		d.ddram[ix] = ' '
+	}
+	return d
+}
+
+// Lines returns the text shown on each row of the display. Characters that
+// aren't printable ASCII, such as the custom characters, are shown as '?'.
+// If the display is off, the lines are blank.
+func (d *HD44780) Lines() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	lines := make([]string, d.rows)
+	for row := range d.rows {
+		var b strings.Builder
+		for col := range d.cols {
+			c := byte(' ')
+			if d.display {
+				c = d.ddram[d.address(row, col+d.shift)]
+			}
+			if c < 0x20 || c > 0x7e {
+				c = '?'
+			}
+			b.WriteByte(c)
+		}
+		lines[row] = b.String()
+	}
+	return lines
+}
+
+// Cursor returns the position of the cursor, starting at 0, and whether
+// it's shown.
+func (d *HD44780) Cursor() (row, col int, visible bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for row = range d.rows {
+		for col = range d.cols {
+			if d.address(row, col) == d.ac {
+				return row, col, d.display && (d.cursor || d.blink) && !d.cg
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// Backlight returns true if the backlight is on.
+func (d *HD44780) Backlight() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.backlight
+}
+
+// CustomChar returns the 8 rows of the custom character c, 0 to 7.
+func (d *HD44780) CustomChar(c int) []byte {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]byte(nil), d.cgram[c*8:c*8+8]...)
+}
+
+// Render draws the display in a box.
+func (d *HD44780) Render(w io.Writer) error {
+	border := "+" + strings.Repeat("-", d.cols) + "+\n"
+	var b strings.Builder
+	b.WriteString(border)
+	for _, l := range d.Lines() {
+		fmt.Fprintf(&b, "|%s|\n", l)
+	}
+	b.WriteString(border)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// address returns the display RAM address of row and col, with the lines
+// wrapping at 40 characters. The rows after the second continue the first
+// two, as on the 4 row displays. d.mu must be held.
+func (d *HD44780) address(row, col int) byte {
+	line := byte(row % 2 * 0x40)
+	offset := row/2*d.cols + col
+	return line + byte((offset%40+40)%40)
+}
+
+// writeNibble writes the 4 bits on D7-D4 when enable falls. rs is true
+// for data.
+func (d *HD44780) writeNibble(rs bool, nibble byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.readLow = false
+	if !d.fourBit {
+		// In 8 bit mode, D3-D0 aren't connected, and read as 0.
+		d.write(rs, nibble<<4)
+		return
+	}
+	if !d.half {
+		d.nibble = nibble
+		d.half = true
+		return
+	}
+	d.half = false
+	d.write(rs, d.nibble<<4|nibble)
+}
+
+// readNibble returns the 4 bits driven on D7-D4 while enable is high. rs is
+// true to read the data RAM, and false for the busy flag and address
+// counter.
+func (d *HD44780) readNibble(rs bool) byte {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.half = false
+	if d.readLow {
+		d.readLow = false
+		return d.read & 0x0f
+	}
+	d.read = d.ac & 0x7f
+	if rs {
+		d.read = d.readRAM()
+	}
+	d.readLow = d.fourBit
+	return d.read >> 4
+}
+
+// setBacklight sets the state of the backlight.
+func (d *HD44780) setBacklight(on bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.backlight = on
+}
+
+// write executes a command, or writes data. d.mu must be held.
+func (d *HD44780) write(rs bool, v byte) {
+	if rs {
+		if d.cg {
+			d.cgram[d.ac&0x3f] = v
+		} else {
+			d.ddram[d.ac] = v
+		}
+		d.advance()
+		if d.shiftOnWr && !d.cg {
+			d.shiftDisplay(!d.increment)
+		}
+		return
+	}
+	switch {
+	case v&0x80 != 0:
+		d.ac, d.cg = v&0x7f, false
+	case v&0x40 != 0:
+		d.ac, d.cg = v&0x3f, true
+	case v&0x20 != 0:
+		d.fourBit = v&0x10 == 0
+	case v&0x10 != 0:
+		right := v&0x04 != 0
+		if v&0x08 != 0 {
+			d.shiftDisplay(right)
+		} else {
+			d.advanceBy(right)
+		}
+	case v&0x08 != 0:
+		d.display, d.cursor, d.blink = v&0x04 != 0, v&0x02 != 0, v&0x01 != 0
+	case v&0x04 != 0:
+		d.increment, d.shiftOnWr = v&0x02 != 0, v&0x01 != 0
+	case v&0x02 != 0:
+		d.ac, d.cg, d.shift = 0, false, 0
+	case v&0x01 != 0:
+		for ix := range d.ddram {
+			d.ddram[ix] = ' '
+		}
+		d.ac, d.cg, d.shift, d.increment = 0, false, 0, true
+	}
+}
+
+// readRAM returns the value at the address counter, and advances it. d.mu
+// must be held.
+func (d *HD44780) readRAM() byte {
+	v := d.ddram[d.ac]
+	if d.cg {
+		v = d.cgram[d.ac&0x3f]
+	}
+	d.advance()
+	return v
+}
+
+// advance moves the address counter in the direction of the entry mode.
+// d.mu must be held.
+func (d *HD44780) advance() {
+	d.advanceBy(d.increment)
+}
+
+// advanceBy moves the address counter by one. Display RAM addresses wrap
+// from the end of the first line to the second, and from the second to
+// the first. d.mu must be held.
+func (d *HD44780) advanceBy(increment bool) {
+	if d.cg {
+		if increment {
+			d.ac = (d.ac + 1) & 0x3f
+		} else {
+			d.ac = (d.ac - 1) & 0x3f
+		}
+		return
+	}
+	line, col := d.ac&0x40, int(d.ac&0x3f)
+	if increment {
+		col++
+	} else {
+		col--
+	}
+	switch {
+	case col >= 40:
+		line, col = line^0x40, 0
+	case col < 0:
+		line, col = line^0x40, 39
+	}
+	d.ac = line | byte(col)
+}
+
+// shiftDisplay shifts the display by one character. d.mu must be held.
+func (d *HD44780) shiftDisplay(right bool) {
+	if right {
+		d.shift = (d.shift + 39) % 40
+	} else {
+		d.shift = (d.shift + 1) % 40
+	}
+}
+
+// lcdPort decodes the levels of the expander pins wired to an HD44780 as
+// pins.
+type lcdPort struct {
+	lcd  *HD44780
+	pins hd44780.PinMap
+	// e and rw are the levels of the enable and R/W pins, and data is the
+	// nibble driven by the display while it's read.
+	e, rw bool
+	data  byte
+	read  bool
+}
+
+// newLCDPort returns an lcdPort with the pins at the levels of port.
+func newLCDPort(lcd *HD44780, pins hd44780.PinMap, port byte) *lcdPort {
+	p := &lcdPort{lcd: lcd, pins: pins}
+	p.e, p.rw = p.high(port, pins.E), p.high(port, pins.RW)
+	return p
+}
+
+// set sets the levels of the expander pins. Data is written when enable
+// falls after R/W was low, and read when enable rises with R/W high.
+func (p *lcdPort) set(port byte) {
+	if p.pins.Backlight >= 0 {
+		p.lcd.setBacklight(p.high(port, p.pins.Backlight))
+	}
+	e, rs, rw := p.high(port, p.pins.E), p.high(port, p.pins.RS), p.high(port, p.pins.RW)
+	switch {
+	case e && !p.e && rw:
+		p.data, p.read = p.lcd.readNibble(rs), true
+	case !e && p.e && !p.rw:
+		var nibble byte
+		for ix, pin := range p.pins.Data {
+			if p.high(port, pin) {
+				nibble |= 1 << ix
+			}
+		}
+		p.lcd.writeNibble(rs, nibble)
+	}
+	if !e || !rw {
+		p.read = false
+	}
+	p.e, p.rw = e, rw
+}
+
+// get returns port with the data pins driven by the display while it's
+// read.
+func (p *lcdPort) get(port byte) byte {
+	if !p.read {
+		return port
+	}
+	for ix, pin := range p.pins.Data {
+		port &^= 1 << pin
+		if p.data&(1<<ix) != 0 {
+			port |= 1 << pin
+		}
+	}
+	return port
+}
+
+// high returns true if pin is connected, and high in port.
+func (p *lcdPort) high(port byte, pin int) bool {
+	return pin >= 0 && port&(1<<pin) != 0
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sim
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clickDuration is how long the switch is held by the click command, longer
+// than the debounce of the rotary driver.
+const clickDuration = 50 * time.Millisecond
+
+// Panel is a fake front panel, with the displays and encoders of a
+// simulation. It renders them as text, and operates the encoders on
+// commands.
+type Panel struct {
+	lcds     []namedLCD
+	encoders []namedEncoder
+}
+
+type namedLCD struct {
+	name string
+	lcd  *HD44780
+}
+
+type namedEncoder struct {
+	name string
+	enc  *Encoder
+}
+
+// AddLCD adds a display named name to the panel.
+func (p *Panel) AddLCD(name string, lcd *HD44780) {
+	p.lcds = append(p.lcds, namedLCD{name, lcd})
+}
+
+// AddEncoder adds an encoder named name to the panel.
+func (p *Panel) AddEncoder(name string, e *Encoder) {
+	p.encoders = append(p.encoders, namedEncoder{name, e})
+}
+
+// Render draws the displays, followed by the state of the encoders.
+func (p *Panel) Render(w io.Writer) error {
+	var b strings.Builder
+	for _, l := range p.lcds {
+		backlight := "off"
+		if l.lcd.Backlight() {
+			backlight = "on"
+		}
+		fmt.Fprintf(&b, "%s (backlight %s)\n", l.name, backlight)
+		if err := l.lcd.Render(&b); err != nil {
+			return err
+		}
+	}
+	for _, e := range p.encoders {
+		position, pressed := e.enc.Position()
+		sw := "released"
+		if pressed {
+			sw = "pressed"
+		}
+		fmt.Fprintf(&b, "%s: %+d, %s\n", e.name, position, sw)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Do operates an encoder. cmd is the name of the encoder, followed by a
+// number of detents to turn, such as +2 or -1, or by press, release, or
+// click.
+func (p *Panel) Do(cmd string) error {
+	fields := strings.Fields(cmd)
+	if len(fields) != 2 {
+		return fmt.Errorf("sim: invalid command %q", cmd)
+	}
+	var e *Encoder
+	for _, item := range p.encoders {
+		if item.name == fields[0] {
+			e = item.enc
+		}
+	}
+	if e == nil {
+		return fmt.Errorf("sim: unknown encoder %q", fields[0])
+	}
+	switch fields[1] {
+	case "press":
+		e.Press()
+	case "release":
+		e.Release()
+	case "click":
+		e.Press()
+		time.Sleep(clickDuration)
+		e.Release()
+	default:
+		detents, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return fmt.Errorf("sim: invalid command %q", cmd)
+		}
+		e.Turn(detents)
+	}
+	return nil
+}
+
+// Run renders the panel to out, and executes each command read from in,
+// until in ends. An empty line renders the panel again. Invalid commands
+// are reported to out.
+func (p *Panel) Run(in io.Reader, out io.Writer) error {
+	s := bufio.NewScanner(in)
+	for {
+		if err := p.Render(out); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(out, "> "); err != nil {
+			return err
+		}
+		if !s.Scan() {
+			return s.Err()
+		}
+		if cmd := strings.TrimSpace(s.Text()); cmd != "" {
+			if err := p.Do(cmd); err != nil {
+				fmt.Fprintln(out, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sim
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Pin is a simulated host GPIO pin.
+//
+// The application configures and reads it as any gpio.PinIO, and the
+// simulation drives it with Drive() and Release(), as a button or another
+// device would. An input that isn't driven is at the level of its pull, or
+// low if it's floating.
+type Pin struct {
+	name   string
+	number int
+
+	mu     sync.Mutex
+	out    bool
+	outL   gpio.Level
+	driven bool
+	drive  gpio.Level
+	pull   gpio.Pull
+	edge   gpio.Edge
+	edges  chan struct{}
+	halt   chan struct{}
+}
+
+// NewPin returns an input pin named name, floating and not driven.
+func NewPin(name string, number int) *Pin {
+	return &Pin{
+		name:   name,
+		number: number,
+		pull:   gpio.Float,
+		edges:  make(chan struct{}, 1),
+		halt:   make(chan struct{}),
+	}
+}
+
+// String implements pin.Pin.
+func (p *Pin) String() string {
+	return p.name
+}
+
+// Name implements pin.Pin.
+func (p *Pin) Name() string {
+	return p.name
+}
+
+// Number implements pin.Pin.
+func (p *Pin) Number() int {
+	return p.number
+}
+
+// Function implements pin.Pin.
+func (p *Pin) Function() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.out {
+		return "Out/" + p.outL.String()
+	}
+	return "In/" + p.level().String()
+}
+
+// Halt implements conn.Resource. It unblocks WaitForEdge().
+func (p *Pin) Halt() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	close(p.halt)
+	p.halt = make(chan struct{})
+	return nil
+}
+
+// In implements gpio.PinIn.
+func (p *Pin) In(pull gpio.Pull, edge gpio.Edge) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.out = false
+	if pull != gpio.PullNoChange {
+		p.pull = pull
+	}
+	p.edge = edge
+	// Edges detected before are discarded.
+	select {
+	case <-p.edges:
+	default:
+	}
+	return nil
+}
+
+// Read implements gpio.PinIn. For an output, it returns the level written.
+func (p *Pin) Read() gpio.Level {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.level()
+}
+
+// WaitForEdge implements gpio.PinIn. A negative timeout waits forever. It
+// returns false on timeout, or if Halt() is called.
+func (p *Pin) WaitForEdge(timeout time.Duration) bool {
+	p.mu.Lock()
+	halt := p.halt
+	p.mu.Unlock()
+	select {
+	case <-p.edges:
+		return true
+	default:
+	}
+	var expired <-chan time.Time
+	if timeout >= 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		expired = t.C
+	}
+	select {
+	case <-p.edges:
+		return true
+	case <-expired:
+		return false
+	case <-halt:
+		return false
+	}
+}
+
+// Pull implements gpio.PinIn.
+func (p *Pin) Pull() gpio.Pull {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.pull
+}
+
+// DefaultPull implements gpio.PinIn.
+func (p *Pin) DefaultPull() gpio.Pull {
+	return gpio.Float
+}
+
+// Out implements gpio.PinOut.
+func (p *Pin) Out(l gpio.Level) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.out = true
+	p.outL = l
+	return nil
+}
+
+// PWM implements gpio.PinOut. It's not supported.
+func (p *Pin) PWM(gpio.Duty, physic.Frequency) error {
+	return errors.New("sim: PWM not supported")
+}
+
+// Drive drives the pin to l from outside the application. It signals an
+// edge if the level of an input changes, and edge detection is enabled for
+// it.
+func (p *Pin) Drive(l gpio.Level) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	before := p.level()
+	p.driven = true
+	p.drive = l
+	p.changed(before)
+}
+
+// Release stops driving the pin, which returns to the level of its pull.
+func (p *Pin) Release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	before := p.level()
+	p.driven = false
+	p.changed(before)
+}
+
+// level returns the level of the pin. p.mu must be held.
+func (p *Pin) level() gpio.Level {
+	switch {
+	case p.out:
+		return p.outL
+	case p.driven:
+		return p.drive
+	default:
+		return p.pull == gpio.PullUp
+	}
+}
+
+// changed signals an edge if the level of an input changed from before.
+// Edges are coalesced until WaitForEdge() returns. p.mu must be held.
+func (p *Pin) changed(before gpio.Level) {
+	l := p.level()
+	if p.out || l == before {
+		return
+	}
+	if !(p.edge == gpio.BothEdges || p.edge == gpio.RisingEdge && l || p.edge == gpio.FallingEdge && !l) {
+		return
+	}
+	select {
+	case p.edges <- struct{}{}:
+	default:
+	}
+}
+
+var _ gpio.PinIO = &Pin{}
@@ -0,0 +1,162 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package sim simulates the hardware used by the drivers of this module, so
+// that an application can run, and be tested, on a computer without it.
+//
+// Bus and SPIPort are virtual buses, with chip models attached to them, and
+// Pin is a virtual host GPIO pin. The models are:
+//
+//   - HD44780, the state of a character display, connected through a
+//     PCF8574 or MCP23008 backpack
+//   - MCP23008, an I/O expander, on I²C or SPI
+//   - Encoder, a rotary encoder with a switch, on host pins
+//
+// Panel renders the displays and encoders as a text front panel, and turns
+// the encoders on the operator's commands.
+//
+// The buses and pins can be registered with i2creg, spireg and gpioreg, so
+// that an application opening them by name runs unchanged:
+//
+//	bus := sim.NewBus("I2C1")
+//	lcd := sim.NewHD44780(2, 16)
+//	bus.Attach(0x27, sim.NewPCF8574().WithLCD(lcd, hd44780.PCF857xPinMap))
+//	i2creg.Register("I2C1", nil, 1, func() (i2c.BusCloser, error) { return bus, nil })
+package sim
+
+import (
+	"fmt"
+	"sync"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/conn/v3/spi"
+)
+
+// Device is a chip model attached to a Bus or an SPIPort. It receives the
+// transactions addressed to it.
+type Device interface {
+	Tx(w, r []byte) error
+}
+
+// Bus is a simulated I²C bus. Transactions are sent to the device attached
+// at their address, and fail if there's none, as a missing device would.
+type Bus struct {
+	name string
+
+	mu      sync.Mutex
+	devices map[uint16]Device
+}
+
+// NewBus returns a bus named name, without devices.
+func NewBus(name string) *Bus {
+	return &Bus{name: name, devices: map[uint16]Device{}}
+}
+
+// Attach attaches d to the bus at addr, replacing the device there if any.
+func (b *Bus) Attach(addr uint16, d Device) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.devices[addr] = d
+}
+
+// Detach removes the device at addr, as if it were unplugged.
+func (b *Bus) Detach(addr uint16) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.devices, addr)
+}
+
+// String implements i2c.Bus.
+func (b *Bus) String() string {
+	return b.name
+}
+
+// SetSpeed implements i2c.Bus.
+func (b *Bus) SetSpeed(f physic.Frequency) error {
+	return nil
+}
+
+// Tx implements i2c.Bus.
+func (b *Bus) Tx(addr uint16, w, r []byte) error {
+	b.mu.Lock()
+	d := b.devices[addr]
+	b.mu.Unlock()
+	if d == nil {
+		return fmt.Errorf("sim: %s: no device at address 0x%x", b, addr)
+	}
+	if err := d.Tx(w, r); err != nil {
+		return fmt.Errorf("sim: %s: 0x%x: %w", b, addr, err)
+	}
+	return nil
+}
+
+// Close implements i2c.BusCloser. The bus can still be used.
+func (b *Bus) Close() error {
+	return nil
+}
+
+// SPIPort is a simulated SPI port, with one device attached.
+type SPIPort struct {
+	name string
+	dev  Device
+}
+
+// NewSPIPort returns a port named name, with d attached.
+func NewSPIPort(name string, d Device) *SPIPort {
+	return &SPIPort{name: name, dev: d}
+}
+
+// String implements spi.Port.
+func (p *SPIPort) String() string {
+	return p.name
+}
+
+// Connect implements spi.Port.
+func (p *SPIPort) Connect(f physic.Frequency, mode spi.Mode, bits int) (spi.Conn, error) {
+	return &spiConn{port: p}, nil
+}
+
+// LimitSpeed implements spi.PortCloser.
+func (p *SPIPort) LimitSpeed(f physic.Frequency) error {
+	return nil
+}
+
+// Close implements spi.PortCloser.
+func (p *SPIPort) Close() error {
+	return nil
+}
+
+type spiConn struct {
+	port *SPIPort
+}
+
+func (c *spiConn) String() string {
+	return c.port.name
+}
+
+func (c *spiConn) Duplex() conn.Duplex {
+	return conn.Full
+}
+
+func (c *spiConn) Tx(w, r []byte) error {
+	if err := c.port.dev.Tx(w, r); err != nil {
+		return fmt.Errorf("sim: %s: %w", c, err)
+	}
+	return nil
+}
+
+func (c *spiConn) TxPackets(p []spi.Packet) error {
+	for _, pkt := range p {
+		if err := c.Tx(pkt.W, pkt.R); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ i2c.BusCloser = &Bus{}
+var _ spi.PortCloser = &SPIPort{}
+var _ spi.Conn = &spiConn{}
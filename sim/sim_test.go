@@ -0,0 +1,218 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sim
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/display"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/hd44780"
+	"periph.io/x/devices/v3/rotary"
+)
+
+// writeLines writes the lines of text to lcd, from the first row.
+func writeLines(t *testing.T, lcd *hd44780.HD44780, lines ...string) {
+	t.Helper()
+	for ix, l := range lines {
+		if err := lcd.MoveTo(ix+1, 1); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := lcd.WriteString(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func checkLines(t *testing.T, sim *HD44780, want ...string) {
+	t.Helper()
+	got := sim.Lines()
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got lines %q, want %q", got, want)
+	}
+}
+
+func TestPCF8574(t *testing.T) {
+	bus := NewBus("I2C1")
+	screen := NewHD44780(2, 16)
+	bus.Attach(0x27, NewPCF8574().WithLCD(screen, hd44780.PCF857xPinMap))
+	// The driver reads the status of the controller to verify it.
+	lcd, err := hd44780.NewPCF857xBackpack(bus, 0x27, 2, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeLines(t, lcd, "Hello", "world")
+	checkLines(t, screen, "Hello           ", "world           ")
+	if !screen.Backlight() {
+		t.Error("expected the backlight on")
+	}
+	if err := lcd.Backlight(0); err != nil {
+		t.Fatal(err)
+	}
+	if screen.Backlight() {
+		t.Error("expected the backlight off")
+	}
+	if err := lcd.Cursor(display.CursorBlink); err != nil {
+		t.Fatal(err)
+	}
+	if row, col, visible := screen.Cursor(); row != 1 || col != 5 || !visible {
+		t.Errorf("got cursor at %d,%d visible %t", row, col, visible)
+	}
+	if err := lcd.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	checkLines(t, screen, "                ", "                ")
+
+	if _, err := hd44780.NewPCF857xBackpack(bus, 0x3f, 2, 16); err == nil {
+		t.Error("expected an error without a device")
+	}
+}
+
+func TestMCP23008(t *testing.T) {
+	bus := NewBus("I2C1")
+	screen := NewHD44780(4, 20)
+	bus.Attach(0x20, NewMCP23008(0x20).WithLCD(screen, hd44780.AdafruitPinMap))
+	lcd, err := hd44780.NewAdafruitI2CBackpack(bus, 0x20, 4, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeLines(t, lcd, "one", "two", "three", "four")
+	checkLines(t, screen, "one                 ", "two                 ", "three               ", "four                ")
+
+	// The same expander on SPI.
+	screen = NewHD44780(2, 16)
+	port := NewSPIPort("SPI0.0", NewMCP23008(0).WithLCD(screen, hd44780.AdafruitPinMap).SPI())
+	conn, err := port.Connect(physic.MegaHertz, 0, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lcd, err = hd44780.NewMCP23S08Backpack(conn, 0, 2, 16); err != nil {
+		t.Fatal(err)
+	}
+	writeLines(t, lcd, "spi")
+	checkLines(t, screen, "spi             ", "                ")
+}
+
+func TestHD44780_shift(t *testing.T) {
+	s := NewHD44780(1, 4)
+	for _, cmd := range []byte{0x20, 0x0c, 0x06} {
+		s.write(false, cmd)
+	}
+	for _, c := range []byte("abcdef") {
+		s.write(true, c)
+	}
+	checkLines(t, s, "abcd")
+	// Shift the display left twice.
+	s.write(false, 0x18)
+	s.write(false, 0x18)
+	checkLines(t, s, "cdef")
+	// Custom characters are shown as '?'.
+	s.write(false, 0x02)
+	s.write(false, 0x40)
+	s.write(true, 0x1f)
+	s.write(false, 0x80)
+	s.write(true, 0)
+	checkLines(t, s, "?bcd")
+	if c := s.CustomChar(0); c[0] != 0x1f {
+		t.Errorf("got %v", c)
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	e := NewEncoder("knob")
+	e.TransitionDelay = time.Millisecond
+	enc, err := rotary.New(e.A, e.B, e.SW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := enc.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer enc.Stop()
+	next := func() rotary.Event {
+		select {
+		case ev := <-events:
+			return ev
+		case <-time.After(time.Second):
+			t.Fatal("no event")
+			return rotary.Event{}
+		}
+	}
+	for _, detents := range []int{2, -1} {
+		e.Turn(detents)
+		steps := 0
+		for steps != detents {
+			ev := next()
+			if ev.Kind != rotary.Turn {
+				t.Fatalf("got %s", ev)
+			}
+			steps += ev.Steps
+		}
+	}
+	e.Press()
+	if ev := next(); ev.Kind != rotary.Press {
+		t.Fatalf("got %s", ev)
+	}
+	if position, pressed := e.Position(); position != 1 || !pressed {
+		t.Errorf("got %d, %t", position, pressed)
+	}
+}
+
+func TestPin(t *testing.T) {
+	p := NewPin("GPIO1", 1)
+	if err := p.In(gpio.PullUp, gpio.FallingEdge); err != nil {
+		t.Fatal(err)
+	}
+	if p.Read() != gpio.High {
+		t.Error("expected the pull-up")
+	}
+	p.Drive(gpio.High)
+	if p.WaitForEdge(0) {
+		t.Error("unexpected edge")
+	}
+	p.Drive(gpio.Low)
+	if !p.WaitForEdge(0) || p.Read() != gpio.Low {
+		t.Error("expected a falling edge")
+	}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = p.Halt()
+	}()
+	if p.WaitForEdge(-1) {
+		t.Error("expected Halt() to interrupt the wait")
+	}
+}
+
+func TestPanel(t *testing.T) {
+	p := &Panel{}
+	screen := NewHD44780(1, 8)
+	screen.write(false, 0x0c)
+	for _, c := range []byte("menu") {
+		screen.write(true, c)
+	}
+	e := NewEncoder("knob")
+	e.sleep = func(time.Duration) {}
+	p.AddLCD("lcd", screen)
+	p.AddEncoder("knob", e)
+	var out bytes.Buffer
+	if err := p.Run(strings.NewReader("knob +3\nknob press\nfoo +1\n"), &out); err != nil {
+		t.Fatal(err)
+	}
+	const want = "lcd (backlight off)\n+--------+\n|menu    |\n+--------+\nknob: +3, pressed\n> "
+	if !strings.Contains(out.String(), want) {
+		t.Errorf("got:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), `sim: unknown encoder "foo"`) {
+		t.Errorf("expected an error, got:\n%s", out.String())
+	}
+	if err := p.Do("knob"); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -6,6 +6,7 @@ package sn3218
 
 import (
 	"errors"
+	"fmt"
 
 	"periph.io/x/conn/v3/i2c"
 )
@@ -42,6 +43,10 @@ func (d *Dev) Halt() error {
 	return d.reset()
 }
 
+func (d *Dev) String() string {
+	return fmt.Sprintf("SN3218{%s}", &d.i2c)
+}
+
 // WakeUp returns from sleep mode and switches the channels according to the states in the register of SN3218.
 func (d *Dev) WakeUp() error {
 	_, err := d.i2c.Write([]byte{cmdEnableOutput, 0x01})
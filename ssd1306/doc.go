@@ -19,6 +19,10 @@
 // High. When set to Low (Ground), it enables the reset circuitry. It can be
 // used externally to this driver, if used, the driver must be reinstantiated.
 //
+// NewTextMode emulates a character LCD implementing display.TextDisplay with
+// the textdisplay package, so that code written for character LCDs runs
+// unchanged on an OLED display.
+//
 // # More details
 //
 // See https://periph.io/device/ssd1306/ for more details about the device.
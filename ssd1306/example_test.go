@@ -102,3 +102,32 @@ func Example() {
 
 	_ = dev.Halt()
 }
+
+func ExampleNewTextMode() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	b, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer b.Close()
+
+	dev, err := ssd1306.NewI2C(b, &ssd1306.DefaultOpts)
+	if err != nil {
+		log.Fatalf("failed to initialize ssd1306: %v", err)
+	}
+	// With the default font, a 128x64 display has 4 rows of 18 columns.
+	lcd, err := ssd1306.NewTextMode(dev, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := lcd.MoveTo(2, 1); err != nil {
+		log.Fatal(err)
+	}
+	if _, err := lcd.WriteString("Hello, world!"); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -342,6 +342,18 @@ func (d *Dev) Halt() error {
 	return err
 }
 
+// Display turns the display on or off, keeping the content of its RAM.
+func (d *Dev) Display(on bool) error {
+	if !on {
+		return d.Halt()
+	}
+	if d.halted {
+		// sendCommand() prepends _DISPLAYON.
+		return d.sendCommand(nil)
+	}
+	return d.sendCommand([]byte{_DISPLAYON})
+}
+
 // Invert the display (black on white vs white on black).
 func (d *Dev) Invert(blackOnWhite bool) error {
 	b := []byte{_NORMALDISPLAY}
@@ -0,0 +1,25 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ssd1306
+
+import (
+	"golang.org/x/image/font"
+	"periph.io/x/devices/v3/textdisplay"
+)
+
+// TextMode emulates a character LCD on the display. It's the text mode of
+// the textdisplay package, which renders on any display.Drawer, and is kept
+// as an alias so that code using the type doesn't depend on that package.
+type TextMode = textdisplay.Dev
+
+// NewTextMode returns a character display emulated on d, implementing
+// display.TextDisplay, so that code written for character LCDs runs unchanged
+// on an OLED display. If face is nil, basicfont.Face7x13 is used, which gives
+// 4 rows of 18 columns on a 128x64 display.
+func NewTextMode(d *Dev, face font.Face) (*TextMode, error) {
+	return textdisplay.New(d, face)
+}
+
+var _ textdisplay.Switcher = &Dev{}
@@ -0,0 +1,46 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ssd1306
+
+import (
+	"bytes"
+	"testing"
+
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func TestNewTextMode(t *testing.T) {
+	bus := &i2ctest.Record{}
+	d, err := NewI2C(bus, &DefaultOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm, err := NewTextMode(d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tm.Rows() != 4 || tm.Cols() != 18 {
+		t.Fatalf("%dx%d", tm.Cols(), tm.Rows())
+	}
+	if err := tm.Display(false); err != nil {
+		t.Fatal(err)
+	}
+	if w := bus.Ops[len(bus.Ops)-1].W; !bytes.Equal(w, []byte{i2cCmd, _DISPLAYOFF}) {
+		t.Errorf("Display(false) sent %#v", w)
+	}
+	bus.Ops = nil
+	if _, err := tm.WriteString("hello"); err != nil {
+		t.Fatal(err)
+	}
+	if len(bus.Ops) != 0 {
+		t.Errorf("drawn while off: %d ops", len(bus.Ops))
+	}
+	if err := tm.Display(true); err != nil {
+		t.Fatal(err)
+	}
+	if len(bus.Ops) < 2 || !bytes.Equal(bus.Ops[0].W, []byte{i2cCmd, _DISPLAYON}) {
+		t.Errorf("Display(true) sent %v", bus.Ops)
+	}
+}
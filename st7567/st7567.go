@@ -330,6 +330,10 @@ func (d *Dev) Halt() error {
 	return d.reset()
 }
 
+func (d *Dev) String() string {
+	return fmt.Sprintf("ST7567{%s, %s}", d.c, d.dc)
+}
+
 // SetContrast sets the contrast
 func (d *Dev) SetContrast(value byte) error {
 	return d.sendCommand([]byte{setContrast, value})
@@ -0,0 +1,183 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package stepper
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+const (
+	// stepPulse is the width of the step pulse, and the setup time of the
+	// direction. The DRV8825 requires 1.9µs and 650ns.
+	stepPulse = 2 * time.Microsecond
+)
+
+// StepDir drives a step/dir driver board such as the A4988 or DRV8825. The
+// motor moves one step, or one microstep as configured on the board, on each
+// rising edge of STEP, in the direction set by DIR.
+type StepDir struct {
+	mu     sync.Mutex
+	step   gpio.PinOut
+	dir    gpio.PinOut
+	enable gpio.PinOut
+	last   Direction
+	// invert swaps the direction.
+	invert bool
+}
+
+// NewStepDir returns a Driver for a step/dir board. enable is connected to
+// the active low ENABLE pin of the board, and may be nil if it is tied low.
+// If invert is true, Forward drives DIR low, to match the wiring of the
+// motor.
+func NewStepDir(step, dir, enable gpio.PinOut, invert bool) (*StepDir, error) {
+	if step == nil || dir == nil {
+		return nil, errors.New("stepper: step and dir pins are required")
+	}
+	s := &StepDir{step: step, dir: dir, enable: enable, invert: invert}
+	if err := step.Out(gpio.Low); err != nil {
+		return nil, fmt.Errorf("stepper: %w", err)
+	}
+	if err := s.setDir(Forward); err != nil {
+		return nil, err
+	}
+	if enable != nil {
+		if err := enable.Out(gpio.Low); err != nil {
+			return nil, fmt.Errorf("stepper: %w", err)
+		}
+	}
+	return s, nil
+}
+
+// Step implements Driver.
+func (s *StepDir) Step(dir Direction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.enable != nil {
+		if err := s.enable.Out(gpio.Low); err != nil {
+			return fmt.Errorf("stepper: %w", err)
+		}
+	}
+	if dir != s.last {
+		if err := s.setDir(dir); err != nil {
+			return err
+		}
+		time.Sleep(stepPulse)
+	}
+	if err := s.step.Out(gpio.High); err != nil {
+		return fmt.Errorf("stepper: %w", err)
+	}
+	time.Sleep(stepPulse)
+	if err := s.step.Out(gpio.Low); err != nil {
+		return fmt.Errorf("stepper: %w", err)
+	}
+	return nil
+}
+
+// Release implements Driver. It disables the board, if the enable pin is
+// connected.
+func (s *StepDir) Release() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.enable == nil {
+		return nil
+	}
+	if err := s.enable.Out(gpio.High); err != nil {
+		return fmt.Errorf("stepper: %w", err)
+	}
+	return nil
+}
+
+// Halt implements conn.Resource. It's the same as Release().
+func (s *StepDir) Halt() error {
+	return s.Release()
+}
+
+func (s *StepDir) String() string {
+	return fmt.Sprintf("StepDir{%s, %s}", s.step, s.dir)
+}
+
+func (s *StepDir) setDir(dir Direction) error {
+	if err := s.dir.Out(gpio.Level((dir == Forward) != s.invert)); err != nil {
+		return fmt.Errorf("stepper: %w", err)
+	}
+	s.last = dir
+	return nil
+}
+
+// Sequences of the four phases. Bit n is the level of the n-th pin.
+var (
+	fullSteps = []byte{0b0011, 0b0110, 0b1100, 0b1001}
+	halfSteps = []byte{0b0001, 0b0011, 0b0010, 0b0110, 0b0100, 0b1100, 0b1000, 0b1001}
+)
+
+// FourPhase drives the four coils of a unipolar motor such as the 28BYJ-48,
+// through a Darlington array such as the ULN2003.
+type FourPhase struct {
+	mu    sync.Mutex
+	pins  [4]gpio.PinOut
+	seq   []byte
+	phase int
+}
+
+// NewFourPhase returns a Driver for a unipolar motor with its coils driven by
+// pins, in the order of the IN1 to IN4 inputs of a ULN2003 board. In half
+// step mode, the 28BYJ-48 makes 4096 steps per turn of its output shaft,
+// with more torque in full step mode, with 2048 steps per turn.
+func NewFourPhase(pins [4]gpio.PinOut, halfStep bool) (*FourPhase, error) {
+	for _, p := range pins {
+		if p == nil {
+			return nil, errors.New("stepper: four pins are required")
+		}
+	}
+	f := &FourPhase{pins: pins, seq: fullSteps}
+	if halfStep {
+		f.seq = halfSteps
+	}
+	if err := f.Release(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Step implements Driver.
+func (f *FourPhase) Step(dir Direction) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.phase = (f.phase + int(dir) + len(f.seq)) % len(f.seq)
+	return f.write(f.seq[f.phase])
+}
+
+// Release implements Driver.
+func (f *FourPhase) Release() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.write(0)
+}
+
+// Halt implements conn.Resource. It's the same as Release().
+func (f *FourPhase) Halt() error {
+	return f.Release()
+}
+
+func (f *FourPhase) String() string {
+	return fmt.Sprintf("FourPhase{%s, %s, %s, %s}", f.pins[0], f.pins[1], f.pins[2], f.pins[3])
+}
+
+func (f *FourPhase) write(v byte) error {
+	for ix, p := range f.pins {
+		if err := p.Out(gpio.Level(v&(1<<ix) != 0)); err != nil {
+			return fmt.Errorf("stepper: %w", err)
+		}
+	}
+	return nil
+}
+
+var _ Driver = &StepDir{}
+var _ Driver = &FourPhase{}
@@ -0,0 +1,51 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package stepper_test
+
+import (
+	"log"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/devices/v3/stepper"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	// Make sure periph is initialized.
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	// A 28BYJ-48 on a ULN2003 board.
+	pins := [4]gpio.PinOut{
+		gpioreg.ByName("GPIO5"),
+		gpioreg.ByName("GPIO6"),
+		gpioreg.ByName("GPIO13"),
+		gpioreg.ByName("GPIO19"),
+	}
+	drv, err := stepper.NewFourPhase(pins, true)
+	if err != nil {
+		log.Fatal(err)
+	}
+	motor, err := stepper.New(drv, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer motor.Halt()
+
+	// Home against a switch that pulls GPIO26 low.
+	endstop := gpioreg.ByName("GPIO26")
+	if err := endstop.In(gpio.PullUp, gpio.NoEdge); err != nil {
+		log.Fatal(err)
+	}
+	if err := motor.Home(endstop, gpio.Low, stepper.Backward, 8192); err != nil {
+		log.Fatal(err)
+	}
+	// Half a turn.
+	if err := motor.MoveTo(2048); err != nil {
+		log.Fatal(err)
+	}
+}
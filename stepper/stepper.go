@@ -0,0 +1,257 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package stepper drives stepper motors, either through a step/dir driver
+// board such as the A4988 or DRV8825, or directly with the four phases of a
+// unipolar motor such as the 28BYJ-48 on a ULN2003 board.
+//
+// Dev moves a motor with a trapezoidal speed profile: it accelerates from
+// standstill to the maximum speed, and decelerates to stop on the target
+// position. It keeps track of the absolute position, which Home() sets using
+// an endstop.
+//
+// The steps are timed with time.Sleep, so the maximum speed is limited by the
+// resolution of the timers of the host, typically to a few thousand steps
+// per second.
+//
+// # Datasheets
+//
+// https://www.pololu.com/file/0J450/A4988.pdf
+//
+// https://www.ti.com/lit/ds/symlink/drv8825.pdf
+package stepper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Direction is the direction of a step.
+type Direction int
+
+const (
+	// Forward increases the position.
+	Forward Direction = 1
+	// Backward decreases the position.
+	Backward Direction = -1
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Forward:
+		return "Forward"
+	case Backward:
+		return "Backward"
+	default:
+		return fmt.Sprintf("Direction(%d)", int(d))
+	}
+}
+
+// Driver moves a motor one step at a time.
+type Driver interface {
+	fmt.Stringer
+	// Step moves the motor one step in direction dir.
+	Step(dir Direction) error
+	// Release stops powering the coils of the motor, which then doesn't hold
+	// its position.
+	Release() error
+}
+
+// ErrHalted is returned by a move interrupted by Halt().
+var ErrHalted = errors.New("stepper: move interrupted by Halt()")
+
+// Opts holds the configuration options.
+type Opts struct {
+	// MaxSpeed is the maximum number of steps per second.
+	MaxSpeed physic.Frequency
+	// Ramp is the time to accelerate from standstill to MaxSpeed. If 0, the
+	// motor moves at MaxSpeed from the first step.
+	Ramp time.Duration
+	// HomingSpeed is the speed used by Home(). If 0, a quarter of MaxSpeed
+	// is used.
+	HomingSpeed physic.Frequency
+}
+
+// DefaultOpts is suitable for a 28BYJ-48 in half steps.
+var DefaultOpts = Opts{
+	MaxSpeed: 800 * physic.Hertz,
+	Ramp:     200 * time.Millisecond,
+}
+
+// Dev moves a stepper motor, and keeps track of its position in steps.
+type Dev struct {
+	drv  Driver
+	opts Opts
+
+	// mu serializes the moves.
+	mu       sync.Mutex
+	position int
+
+	// haltMu guards halt, which is closed by Halt() to interrupt the move in
+	// progress.
+	haltMu sync.Mutex
+	halt   chan struct{}
+
+	// sleep is replaced by tests.
+	sleep func(time.Duration)
+}
+
+// New returns a Dev that moves the motor with drv. If opts is nil,
+// DefaultOpts is used. The position starts at 0.
+func New(drv Driver, opts *Opts) (*Dev, error) {
+	if opts == nil {
+		opts = &DefaultOpts
+	}
+	if opts.MaxSpeed <= 0 || opts.Ramp < 0 || opts.HomingSpeed < 0 {
+		return nil, fmt.Errorf("stepper: invalid options %+v", *opts)
+	}
+	return &Dev{drv: drv, opts: *opts, sleep: time.Sleep}, nil
+}
+
+// Position returns the position of the motor, in steps.
+func (d *Dev) Position() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.position
+}
+
+// SetPosition sets the current position of the motor, without moving it.
+func (d *Dev) SetPosition(position int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.position = position
+}
+
+// Move moves the motor by steps, forward if positive, and returns when the
+// move is complete.
+func (d *Dev) Move(steps int) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.move(steps)
+}
+
+// MoveTo moves the motor to the absolute position, and returns when the move
+// is complete.
+func (d *Dev) MoveTo(position int) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.move(position - d.position)
+}
+
+// Home moves the motor in direction dir at Opts.HomingSpeed until endstop
+// reads level active, and sets the position to 0. It fails if the endstop
+// isn't reached within maxSteps.
+func (d *Dev) Home(endstop gpio.PinIn, active gpio.Level, dir Direction, maxSteps int) error {
+	if dir != Forward && dir != Backward {
+		return fmt.Errorf("stepper: invalid direction %s", dir)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	halt := d.startMove()
+	defer d.endMove()
+	speed := d.opts.HomingSpeed
+	if speed == 0 {
+		speed = d.opts.MaxSpeed / 4
+	}
+	interval := speed.Period()
+	for i := 0; ; i++ {
+		if endstop.Read() == active {
+			d.position = 0
+			return nil
+		}
+		if i == maxSteps {
+			return fmt.Errorf("stepper: endstop not reached after %d steps", maxSteps)
+		}
+		if err := d.step(dir, halt); err != nil {
+			return err
+		}
+		d.sleep(interval)
+	}
+}
+
+// Halt interrupts the move in progress, if any, and releases the motor.
+func (d *Dev) Halt() error {
+	d.haltMu.Lock()
+	if d.halt != nil {
+		close(d.halt)
+		d.halt = nil
+	}
+	d.haltMu.Unlock()
+	// Wait for the move to stop.
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.drv.Release()
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("stepper{%s}", d.drv)
+}
+
+// move implements Move(). d.mu must be held.
+func (d *Dev) move(steps int) error {
+	halt := d.startMove()
+	defer d.endMove()
+	dir := Forward
+	if steps < 0 {
+		dir, steps = Backward, -steps
+	}
+	for i := 0; i < steps; i++ {
+		if err := d.step(dir, halt); err != nil {
+			return err
+		}
+		if i < steps-1 {
+			d.sleep(d.interval(i, steps))
+		}
+	}
+	return nil
+}
+
+// step moves one step, unless the move was halted. d.mu must be held.
+func (d *Dev) step(dir Direction, halt <-chan struct{}) error {
+	select {
+	case <-halt:
+		return ErrHalted
+	default:
+	}
+	if err := d.drv.Step(dir); err != nil {
+		return err
+	}
+	d.position += int(dir)
+	return nil
+}
+
+// interval returns the time between step i and the next one of a move of
+// steps steps. The speed after n steps of constant acceleration a is
+// sqrt(2an), and n counts from the nearest end of the move, so that the motor
+// decelerates symmetrically.
+func (d *Dev) interval(i, steps int) time.Duration {
+	vmax := float64(d.opts.MaxSpeed) / float64(physic.Hertz)
+	if d.opts.Ramp == 0 {
+		return d.opts.MaxSpeed.Period()
+	}
+	a := vmax / d.opts.Ramp.Seconds()
+	n := float64(min(i+1, steps-1-i))
+	v := min(math.Sqrt(2*a*n), vmax)
+	return time.Duration(float64(time.Second) / v)
+}
+
+// startMove returns the channel closed by Halt() during the move.
+func (d *Dev) startMove() <-chan struct{} {
+	d.haltMu.Lock()
+	defer d.haltMu.Unlock()
+	d.halt = make(chan struct{})
+	return d.halt
+}
+
+func (d *Dev) endMove() {
+	d.haltMu.Lock()
+	defer d.haltMu.Unlock()
+	d.halt = nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package stepper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
+)
+
+// fakeDriver records the steps.
+type fakeDriver struct {
+	steps    []Direction
+	released bool
+	onStep   func(n int)
+}
+
+func (f *fakeDriver) String() string { return "fake" }
+
+func (f *fakeDriver) Step(dir Direction) error {
+	f.steps = append(f.steps, dir)
+	if f.onStep != nil {
+		f.onStep(len(f.steps))
+	}
+	return nil
+}
+
+func (f *fakeDriver) Release() error {
+	f.released = true
+	return nil
+}
+
+func newTestDev(t *testing.T, opts *Opts) (*Dev, *fakeDriver, *[]time.Duration) {
+	drv := &fakeDriver{}
+	d, err := New(drv, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sleeps []time.Duration
+	d.sleep = func(s time.Duration) { sleeps = append(sleeps, s) }
+	return d, drv, &sleeps
+}
+
+func TestMove(t *testing.T) {
+	d, drv, sleeps := newTestDev(t, &Opts{MaxSpeed: 1000 * physic.Hertz, Ramp: 100 * time.Millisecond})
+	if err := d.Move(200); err != nil {
+		t.Fatal(err)
+	}
+	if len(drv.steps) != 200 || d.Position() != 200 {
+		t.Fatalf("%d steps, position %d", len(drv.steps), d.Position())
+	}
+	s := *sleeps
+	if len(s) != 199 {
+		t.Fatalf("%d intervals", len(s))
+	}
+	// The motor accelerates, cruises at the maximum speed, and decelerates
+	// symmetrically.
+	for i := range s {
+		if s[i] != s[len(s)-1-i] {
+			t.Fatalf("interval %d = %s, interval %d = %s", i, s[i], len(s)-1-i, s[len(s)-1-i])
+		}
+		if s[i] < time.Millisecond {
+			t.Fatalf("interval %d = %s, faster than the maximum speed", i, s[i])
+		}
+	}
+	if s[0] <= s[10] || s[10] <= s[60] {
+		t.Errorf("no acceleration: %s, %s, %s", s[0], s[10], s[60])
+	}
+	if s[99] != time.Millisecond {
+		t.Errorf("cruise interval = %s", s[99])
+	}
+	// The ramp to 1000 steps/s at 10000 steps/s² takes 50 steps.
+	if s[48] == time.Millisecond || s[50] != time.Millisecond {
+		t.Errorf("ramp: %s, %s", s[48], s[50])
+	}
+
+	if err := d.MoveTo(150); err != nil {
+		t.Fatal(err)
+	}
+	if d.Position() != 150 || drv.steps[len(drv.steps)-1] != Backward {
+		t.Errorf("position %d", d.Position())
+	}
+}
+
+func TestMove_noRamp(t *testing.T) {
+	d, _, sleeps := newTestDev(t, &Opts{MaxSpeed: 500 * physic.Hertz})
+	if err := d.Move(-3); err != nil {
+		t.Fatal(err)
+	}
+	if d.Position() != -3 {
+		t.Errorf("position %d", d.Position())
+	}
+	for _, s := range *sleeps {
+		if s != 2*time.Millisecond {
+			t.Errorf("interval %s", s)
+		}
+	}
+}
+
+func TestHome(t *testing.T) {
+	d, drv, _ := newTestDev(t, nil)
+	d.SetPosition(1000)
+	endstop := &gpiotest.Pin{N: "endstop", L: gpio.High}
+	drv.onStep = func(n int) {
+		if n == 25 {
+			endstop.L = gpio.Low
+		}
+	}
+	if err := d.Home(endstop, gpio.Low, Backward, 100); err != nil {
+		t.Fatal(err)
+	}
+	if len(drv.steps) != 25 || d.Position() != 0 {
+		t.Errorf("%d steps, position %d", len(drv.steps), d.Position())
+	}
+	endstop.L = gpio.High
+	if err := d.Home(endstop, gpio.Low, Backward, 10); err == nil {
+		t.Error("expected an error when the endstop isn't reached")
+	}
+}
+
+func TestHalt(t *testing.T) {
+	d, drv, _ := newTestDev(t, nil)
+	halted := make(chan error)
+	drv.onStep = func(n int) {
+		if n == 5 {
+			go func() { halted <- d.Halt() }()
+			// Wait for Halt() to close the channel.
+			for {
+				d.haltMu.Lock()
+				h := d.halt
+				d.haltMu.Unlock()
+				if h == nil {
+					return
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}
+	if err := d.Move(100); !errors.Is(err, ErrHalted) {
+		t.Fatalf("Move() = %v", err)
+	}
+	if d.Position() != 5 {
+		t.Errorf("position %d", d.Position())
+	}
+	if err := <-halted; err != nil || !drv.released {
+		t.Errorf("Halt() = %v, released %t", err, drv.released)
+	}
+}
+
+func TestFourPhase(t *testing.T) {
+	var pins [4]gpio.PinOut
+	var test [4]*gpiotest.Pin
+	for ix := range pins {
+		test[ix] = &gpiotest.Pin{N: "IN"}
+		pins[ix] = test[ix]
+	}
+	read := func() byte {
+		var v byte
+		for ix, p := range test {
+			if p.L {
+				v |= 1 << ix
+			}
+		}
+		return v
+	}
+	f, err := NewFourPhase(pins, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []byte
+	for i := 0; i < 3; i++ {
+		if err := f.Step(Forward); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, read())
+	}
+	if err := f.Step(Backward); err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, read())
+	if want := []byte{0b0011, 0b0010, 0b0110, 0b0010}; string(got) != string(want) {
+		t.Errorf("phases %04b, want %04b", got, want)
+	}
+	if err := f.Release(); err != nil || read() != 0 {
+		t.Errorf("Release() = %v, phases %04b", err, read())
+	}
+}
+
+func TestStepDir(t *testing.T) {
+	step := &gpiotest.Pin{N: "step"}
+	dir := &gpiotest.Pin{N: "dir"}
+	enable := &gpiotest.Pin{N: "enable", L: gpio.High}
+	s, err := NewStepDir(step, dir, enable, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Step(Forward); err != nil {
+		t.Fatal(err)
+	}
+	if dir.L != gpio.Low || enable.L != gpio.Low || step.L != gpio.Low {
+		t.Errorf("dir %s, enable %s, step %s", dir.L, enable.L, step.L)
+	}
+	if err := s.Step(Backward); err != nil {
+		t.Fatal(err)
+	}
+	if dir.L != gpio.High {
+		t.Errorf("dir %s", dir.L)
+	}
+	if err := s.Release(); err != nil || enable.L != gpio.High {
+		t.Errorf("Release() = %v, enable %s", err, enable.L)
+	}
+	if _, err := NewStepDir(nil, dir, nil, false); err == nil {
+		t.Error("expected an error without a step pin")
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sx1509_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/sx1509"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+	bus, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer bus.Close()
+	dev, err := sx1509.New(bus, 0x3e)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer dev.Close()
+	// Fade an LED on pin 15 in and out every 2 seconds.
+	if err = dev.Breathe(15, 500*time.Millisecond, 500*time.Millisecond, 500*time.Millisecond, 500*time.Millisecond, 255, 0); err != nil {
+		log.Fatal(err)
+	}
+	// Scan a 4x3 keypad connected to pins 0-3 and 8-10.
+	keypad, err := dev.NewKeypad(4, 3, 8*time.Millisecond)
+	if err != nil {
+		log.Fatal(err)
+	}
+	events, err := keypad.Start(50 * time.Millisecond)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for ev := range events {
+		fmt.Printf("row %d col %d pressed %t\n", ev.Row, ev.Col, ev.Pressed)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sx1509
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sync"
+	"time"
+)
+
+// eventBufferSize is the size of the channel returned by Keypad.Start().
+const eventBufferSize = 16
+
+// KeyEvent is a key press or release, sent by the goroutine started by
+// Keypad.Start().
+type KeyEvent struct {
+	// Row and Col are the indexes of the key's row and column.
+	Row, Col int
+	// Pressed is true if the key was pressed, and false if it was released.
+	Pressed bool
+	// Time is when the change was detected.
+	Time time.Time
+}
+
+// Keypad uses the keypad engine of the SX1509 to scan a matrix of keys. The
+// rows are connected to pins 0 to rows-1, and the columns to pins 8 to
+// 8+cols-1.
+//
+// The engine reports a single key at a time. If several keys are held, only
+// one of them is reported as pressed.
+type Keypad struct {
+	dev        *Dev
+	rows, cols int
+
+	mu      sync.Mutex
+	pressed bool
+	row     int
+	col     int
+	stop    chan struct{}
+	done    chan struct{}
+	err     error
+}
+
+// NewKeypad configures the keypad engine to scan a matrix of rows by cols
+// keys, spending scan on each row. rows must be between 2 and 8, cols
+// between 1 and 8, and scan between 1ms and 128ms; it's rounded down to a
+// power of two milliseconds. Key presses are debounced for half the scan
+// time.
+func (dev *Dev) NewKeypad(rows, cols int, scan time.Duration) (*Keypad, error) {
+	if rows < 2 || rows > 8 || cols < 1 || cols > 8 {
+		return nil, fmt.Errorf("%s: invalid keypad size %dx%d", dev, rows, cols)
+	}
+	if scan < time.Millisecond || scan > 128*time.Millisecond {
+		return nil, fmt.Errorf("%s: invalid keypad scan time %s", dev, scan)
+	}
+	scanCode := uint8(bits.Len(uint(scan/time.Millisecond)) - 1)
+	rowMask := uint16(1)<<rows - 1
+	colMask := (uint16(1)<<cols - 1) << 8
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	// Rows are open drain outputs, columns are inputs with pull ups.
+	steps := []struct {
+		reg  uint8
+		mask uint16
+		set  bool
+	}{
+		{regLEDDriverEnable, rowMask | colMask, false},
+		{regOpenDrain, rowMask, true},
+		{regDir, rowMask, false},
+		{regDir, colMask, true},
+		{regInputDisable, colMask, false},
+		{regPullUp, colMask, true},
+		{regPullDown, colMask, false},
+		{regDebounceEnable, colMask, true},
+	}
+	for _, s := range steps {
+		if err := dev.setBits(s.reg, s.mask, s.set); err != nil {
+			return nil, err
+		}
+	}
+	if err := dev.writeReg(regDebounceConfig, scanCode); err != nil {
+		return nil, err
+	}
+	if err := dev.writeReg(regKeyConfig1, scanCode); err != nil {
+		return nil, err
+	}
+	if err := dev.writeReg(regKeyConfig2, uint8(rows-1)<<3|uint8(cols-1)); err != nil {
+		return nil, err
+	}
+	return &Keypad{dev: dev, rows: rows, cols: cols}, nil
+}
+
+// Scan reads the key reported by the keypad engine, and returns the changes
+// since the previous scan.
+func (k *Keypad) Scan() ([]KeyEvent, error) {
+	k.dev.mu.Lock()
+	data, err := k.dev.readWord(regKeyData1)
+	k.dev.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	// The registers are active low, columns in the high byte.
+	cols, rows := ^uint8(data>>8), ^uint8(data)
+	row, col := bits.TrailingZeros8(rows), bits.TrailingZeros8(cols)
+	pressed := row < k.rows && col < k.cols
+	now := time.Now()
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	var events []KeyEvent
+	if k.pressed && (!pressed || row != k.row || col != k.col) {
+		events = append(events, KeyEvent{Row: k.row, Col: k.col, Time: now})
+		k.pressed = false
+	}
+	if pressed && !k.pressed {
+		events = append(events, KeyEvent{Row: row, Col: col, Pressed: true, Time: now})
+		k.pressed, k.row, k.col = true, row, col
+	}
+	return events, nil
+}
+
+// Start starts a goroutine that scans the keypad every interval, and sends
+// the changes to the returned channel. The interval should be longer than the
+// time the engine takes to scan all the rows. The channel is closed when
+// Stop() is called, or a scan returns an error. See Err().
+func (k *Keypad) Start(interval time.Duration) (<-chan KeyEvent, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("%s: invalid keypad interval %s", k.dev, interval)
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.stop != nil {
+		return nil, errors.New("sx1509: keypad already started")
+	}
+	k.stop = make(chan struct{})
+	k.done = make(chan struct{})
+	k.err = nil
+	events := make(chan KeyEvent, eventBufferSize)
+	go k.run(interval, events, k.stop, k.done)
+	return events, nil
+}
+
+// Stop stops the goroutine started by Start(), and waits for it to exit.
+func (k *Keypad) Stop() {
+	k.mu.Lock()
+	stop, done := k.stop, k.done
+	k.stop = nil
+	k.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Err returns the error that stopped the scanning goroutine, if any.
+func (k *Keypad) Err() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.err
+}
+
+func (k *Keypad) run(interval time.Duration, events chan<- KeyEvent, stop, done chan struct{}) {
+	defer close(done)
+	defer close(events)
+	for {
+		changes, err := k.Scan()
+		if err != nil {
+			k.mu.Lock()
+			k.err = err
+			k.mu.Unlock()
+			return
+		}
+		for _, ev := range changes {
+			select {
+			case events <- ev:
+			case <-stop:
+				return
+			}
+		}
+		select {
+		case <-stop:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sx1509
+
+import (
+	"fmt"
+	"time"
+)
+
+// ledRegisters are the addresses of the LED driver registers of a pin.
+// tRise and tFall are 0 for the pins that can't breathe.
+type ledRegisters struct {
+	tOn, iOn, off, tRise, tFall uint8
+}
+
+// ledRegs returns the LED driver registers of pin.
+func ledRegs(pin int) ledRegisters {
+	switch {
+	case pin < 4:
+		b := uint8(0x29 + 3*pin)
+		return ledRegisters{tOn: b, iOn: b + 1, off: b + 2}
+	case pin < 8:
+		b := uint8(0x35 + 5*(pin-4))
+		return ledRegisters{tOn: b, iOn: b + 1, off: b + 2, tRise: b + 3, tFall: b + 4}
+	case pin < 12:
+		b := uint8(0x49 + 3*(pin-8))
+		return ledRegisters{tOn: b, iOn: b + 1, off: b + 2}
+	default:
+		b := uint8(0x55 + 5*(pin-12))
+		return ledRegisters{tOn: b, iOn: b + 1, off: b + 2, tRise: b + 3, tFall: b + 4}
+	}
+}
+
+// SetLEDIntensity drives an LED connected between the supply and pin with
+// the LED driver, at a constant intensity between 0 (off) and 255 (fully
+// on). The pin is changed to an open drain output.
+func (dev *Dev) SetLEDIntensity(pin int, intensity uint8) error {
+	if err := dev.checkPin(pin); err != nil {
+		return err
+	}
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	r := ledRegs(pin)
+	if err := dev.enableLED(pin); err != nil {
+		return err
+	}
+	if err := dev.writeReg(r.tOn, 0); err != nil {
+		return err
+	}
+	return dev.writeReg(r.iOn, intensity)
+}
+
+// Blink blinks an LED connected between the supply and pin with the LED
+// driver. The LED is on at onIntensity for the on time, then at offIntensity
+// for the off time. The device only supports off intensities that are
+// multiples of 4, up to 28, and offIntensity is rounded down. Times are
+// rounded to the nearest supported value, between about 65ms and 16s.
+func (dev *Dev) Blink(pin int, on, off time.Duration, onIntensity, offIntensity uint8) error {
+	return dev.blink(pin, on, off, 0, 0, onIntensity, offIntensity)
+}
+
+// Breathe is like Blink(), except that the intensity of the LED fades
+// between offIntensity and onIntensity during the rise and fall times. Only
+// pins 4-7 and 12-15 support it.
+func (dev *Dev) Breathe(pin int, on, off, rise, fall time.Duration, onIntensity, offIntensity uint8) error {
+	if err := dev.checkPin(pin); err != nil {
+		return err
+	}
+	if ledRegs(pin).tRise == 0 {
+		return fmt.Errorf("%s: pin %d doesn't support breathing", dev, pin)
+	}
+	return dev.blink(pin, on, off, rise, fall, onIntensity, offIntensity)
+}
+
+func (dev *Dev) blink(pin int, on, off, rise, fall time.Duration, onIntensity, offIntensity uint8) error {
+	if err := dev.checkPin(pin); err != nil {
+		return err
+	}
+	if on <= 0 || off <= 0 {
+		return fmt.Errorf("%s: invalid blink times %s and %s", dev, on, off)
+	}
+	iOff := min(offIntensity/4, 7)
+	if onIntensity <= 4*iOff {
+		return fmt.Errorf("%s: the on intensity must be greater than the off intensity", dev)
+	}
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	r := ledRegs(pin)
+	if err := dev.enableLED(pin); err != nil {
+		return err
+	}
+	unit := time.Duration(64*255) * time.Second / ledClock
+	if err := dev.writeReg(r.iOn, onIntensity); err != nil {
+		return err
+	}
+	if err := dev.writeReg(r.off, ledTime(off, unit, 8)<<3|iOff); err != nil {
+		return err
+	}
+	if r.tRise != 0 {
+		slope := time.Duration(onIntensity-4*iOff) * 255 * time.Second / ledClock
+		if err := dev.writeReg(r.tRise, ledTime(rise, slope, 16)); err != nil {
+			return err
+		}
+		if err := dev.writeReg(r.tFall, ledTime(fall, slope, 16)); err != nil {
+			return err
+		}
+	}
+	// Writing TOn last starts the blinking.
+	return dev.writeReg(r.tOn, ledTime(on, unit, 8))
+}
+
+// enableLED configures pin as an open drain output driven by the LED driver.
+// dev.mu must be held.
+func (dev *Dev) enableLED(pin int) error {
+	m := uint16(1) << pin
+	if err := dev.setBits(regInputDisable, m, true); err != nil {
+		return err
+	}
+	if err := dev.setBits(regPullUp, m, false); err != nil {
+		return err
+	}
+	if err := dev.setBits(regOpenDrain, m, true); err != nil {
+		return err
+	}
+	if err := dev.setBits(regDir, m, false); err != nil {
+		return err
+	}
+	if err := dev.setBits(regLEDDriverEnable, m, true); err != nil {
+		return err
+	}
+	// The LED driver is enabled while the output is low.
+	return dev.setBits(regData, m, false)
+}
+
+func (dev *Dev) checkPin(pin int) error {
+	if pin < 0 || pin >= numPins {
+		return fmt.Errorf("%s: invalid pin %d", dev, pin)
+	}
+	return nil
+}
+
+// ledTime returns the 5 bit register value closest to d. Values 1 to 15 are
+// multiples of unit, and values 16 to 31 multiples of ratio*unit. 0 is
+// returned for d <= 0.
+func ledTime(d, unit time.Duration, ratio int) uint8 {
+	if d <= 0 {
+		return 0
+	}
+	n := int((d + unit/2) / unit)
+	if n <= 15 {
+		return uint8(max(n, 1))
+	}
+	long := int((d + unit*time.Duration(ratio)/2) / (unit * time.Duration(ratio)))
+	if long < 16 {
+		// Between the two ranges, pick the nearest end.
+		if d-15*unit < 16*time.Duration(ratio)*unit-d {
+			return 15
+		}
+		return 16
+	}
+	return uint8(min(long, 31))
+}
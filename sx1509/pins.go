@@ -0,0 +1,155 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sx1509
+
+import (
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// Pin is a GPIO of the SX1509.
+type Pin struct {
+	dev    *Dev
+	number int
+	name   string
+}
+
+// Halt implements conn.Resource.
+func (pin *Pin) Halt() error {
+	return nil
+}
+
+// Name returns the name of the GPIO pin.
+func (pin *Pin) Name() string {
+	return pin.name
+}
+
+// Number returns the number of the GPIO pin.
+func (pin *Pin) Number() int {
+	return pin.number
+}
+
+// Deprecated: returns "In", "Out" or "LED", depending on how the pin is
+// configured.
+func (pin *Pin) Function() string {
+	pin.dev.mu.Lock()
+	defer pin.dev.mu.Unlock()
+	if led, err := pin.dev.readWord(regLEDDriverEnable); err == nil && led&pin.mask() != 0 {
+		return "LED"
+	}
+	dir, err := pin.dev.readWord(regDir)
+	if err != nil {
+		return ""
+	}
+	if dir&pin.mask() != 0 {
+		return "In"
+	}
+	return "Out"
+}
+
+// In configures the pin as an input. gpio.PullUp and gpio.PullDown enable the
+// internal resistors. Edge detection isn't supported, and edge must be
+// gpio.NoEdge.
+func (pin *Pin) In(pull gpio.Pull, edge gpio.Edge) error {
+	if edge != gpio.NoEdge {
+		return ErrNotImplemented
+	}
+	m := pin.mask()
+	pin.dev.mu.Lock()
+	defer pin.dev.mu.Unlock()
+	switch pull {
+	case gpio.PullNoChange:
+	case gpio.Float, gpio.PullUp, gpio.PullDown:
+		if err := pin.dev.setBits(regPullUp, m, pull == gpio.PullUp); err != nil {
+			return err
+		}
+		if err := pin.dev.setBits(regPullDown, m, pull == gpio.PullDown); err != nil {
+			return err
+		}
+	default:
+		return ErrNotImplemented
+	}
+	if err := pin.dev.setBits(regLEDDriverEnable, m, false); err != nil {
+		return err
+	}
+	if err := pin.dev.setBits(regInputDisable, m, false); err != nil {
+		return err
+	}
+	return pin.dev.setBits(regDir, m, true)
+}
+
+// Read returns the level of the pin. If the device can't be read, gpio.Low is
+// returned.
+func (pin *Pin) Read() gpio.Level {
+	v, err := pin.dev.Read()
+	if err != nil {
+		return gpio.Low
+	}
+	return v&pin.mask() != 0
+}
+
+// WaitForEdge is not supported, and returns false.
+func (pin *Pin) WaitForEdge(timeout time.Duration) bool {
+	return false
+}
+
+// Pull returns the pull resistor enabled on the pin.
+func (pin *Pin) Pull() gpio.Pull {
+	pin.dev.mu.Lock()
+	defer pin.dev.mu.Unlock()
+	if up, err := pin.dev.readWord(regPullUp); err == nil && up&pin.mask() != 0 {
+		return gpio.PullUp
+	}
+	if down, err := pin.dev.readWord(regPullDown); err == nil && down&pin.mask() != 0 {
+		return gpio.PullDown
+	}
+	return gpio.Float
+}
+
+// DefaultPull returns gpio.Float.
+func (pin *Pin) DefaultPull() gpio.Pull {
+	return gpio.Float
+}
+
+// Out configures the pin as a push-pull output driving l, and disables the LED
+// driver on the pin.
+func (pin *Pin) Out(l gpio.Level) error {
+	m := pin.mask()
+	pin.dev.mu.Lock()
+	defer pin.dev.mu.Unlock()
+	if err := pin.dev.setBits(regLEDDriverEnable, m, false); err != nil {
+		return err
+	}
+	if err := pin.dev.setBits(regOpenDrain, m, false); err != nil {
+		return err
+	}
+	if err := pin.dev.setBits(regData, m, bool(l)); err != nil {
+		return err
+	}
+	return pin.dev.setBits(regDir, m, false)
+}
+
+// PWM sets the intensity of an LED connected between the supply and the pin
+// using the LED driver, see Dev.SetLEDIntensity(). duty is the fraction of
+// the time the LED is on. The frequency is set by the LED driver clock, and f
+// must be 0.
+func (pin *Pin) PWM(duty gpio.Duty, f physic.Frequency) error {
+	if f != 0 {
+		return ErrNotImplemented
+	}
+	return pin.dev.SetLEDIntensity(pin.number, uint8(uint64(duty)*255/uint64(gpio.DutyMax)))
+}
+
+func (pin *Pin) String() string {
+	return pin.name
+}
+
+func (pin *Pin) mask() uint16 {
+	return 1 << pin.number
+}
+
+var _ gpio.PinIO = &Pin{}
@@ -0,0 +1,229 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package sx1509 provides an interface to the Semtech SX1509 16 bit I²C GPIO
+// expander.
+//
+// In addition to 16 GPIOs with programmable pull up and pull down resistors,
+// the SX1509 has a keypad scanning engine, and an LED driver that can dim,
+// blink and, on some pins, fade ("breathe") LEDs without intervention from the
+// host. Pins 0-7 are bank A, and pins 8-15 bank B. The pins implement
+// gpio.PinIO, and PWM() uses the LED driver, so they can also be used with
+// helpers that blink LEDs from the host, such as mcp23xxx.Blinker.
+//
+// Supported addresses are 0x3e, 0x3f, 0x70 and 0x71.
+//
+// # Datasheet
+//
+// https://cdn.sparkfun.com/datasheets/BreakoutBoards/sx1509.pdf
+package sx1509
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/i2c"
+)
+
+const (
+	devName = "SX1509"
+	numPins = 16
+)
+
+// Registers. The registers of bank B immediately precede the ones of bank A,
+// so that a 16 bit read or write starting at the bank B register maps bit n
+// to pin n.
+const (
+	regInputDisable    = 0x00
+	regPullUp          = 0x06
+	regPullDown        = 0x08
+	regOpenDrain       = 0x0a
+	regDir             = 0x0e
+	regData            = 0x10
+	regClock           = 0x1e
+	regMisc            = 0x1f
+	regLEDDriverEnable = 0x20
+	regDebounceConfig  = 0x22
+	regDebounceEnable  = 0x23
+	regKeyConfig1      = 0x25
+	regKeyConfig2      = 0x26
+	regKeyData1        = 0x27
+	regReset           = 0x7d
+)
+
+const (
+	// clockInternal selects the internal 2MHz oscillator in RegClock.
+	clockInternal = 0x40
+	// ledClockDivider is RegMisc.ClkX, which divides the oscillator by
+	// 2^(ledClockDivider-1) to clock the LED driver.
+	ledClockDivider = 4
+	// ledClock is the frequency of the LED driver clock, in Hz.
+	ledClock = 2000000 >> (ledClockDivider - 1)
+)
+
+var (
+	// ErrNotImplemented is returned for functions the device doesn't
+	// support.
+	ErrNotImplemented = errors.New("sx1509: not implemented")
+)
+
+// Dev is a SX1509 GPIO expander.
+type Dev struct {
+	// Pins are the 16 GPIOs of the device.
+	Pins []gpio.PinIO
+
+	mu   sync.Mutex
+	d    i2c.Dev
+	name string
+}
+
+// New resets the SX1509 at addr on bus, enables its internal oscillator, and
+// registers its pins.
+func New(bus i2c.Bus, addr uint16) (*Dev, error) {
+	switch addr {
+	case 0x3e, 0x3f, 0x70, 0x71:
+	default:
+		return nil, fmt.Errorf("sx1509: invalid address 0x%x", addr)
+	}
+	dev := &Dev{
+		d:    i2c.Dev{Bus: bus, Addr: addr},
+		name: fmt.Sprintf("%s_%x", devName, addr),
+		Pins: make([]gpio.PinIO, numPins),
+	}
+	if err := dev.Reset(); err != nil {
+		return nil, err
+	}
+	for ix := range dev.Pins {
+		p := &Pin{dev: dev, number: ix, name: fmt.Sprintf("%s_%d", dev.name, ix)}
+		dev.Pins[ix] = p
+		// Ignore registration failure.
+		_ = gpioreg.Register(p)
+	}
+	return dev, nil
+}
+
+// Reset performs a software reset of the device, which returns all the
+// registers to their power on values, and then enables the internal
+// oscillator used by the keypad engine and the LED driver.
+func (dev *Dev) Reset() error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if err := dev.writeReg(regReset, 0x12); err != nil {
+		return err
+	}
+	if err := dev.writeReg(regReset, 0x34); err != nil {
+		return err
+	}
+	if err := dev.writeReg(regClock, clockInternal); err != nil {
+		return err
+	}
+	return dev.writeReg(regMisc, ledClockDivider<<4)
+}
+
+// Pin returns the pin number n of the device, or nil if n is out of range.
+func (dev *Dev) Pin(n int) gpio.PinIO {
+	if n < 0 || n >= len(dev.Pins) {
+		return nil
+	}
+	return dev.Pins[n]
+}
+
+// Read returns the levels of all the pins. Bit n of the result is the level
+// of pin n.
+func (dev *Dev) Read() (uint16, error) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	return dev.readWord(regData)
+}
+
+// Halt implements conn.Resource. It disables the LED driver and the keypad
+// engine, and leaves the pins as inputs.
+func (dev *Dev) Halt() error {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	if err := dev.writeReg(regKeyConfig2, 0); err != nil {
+		return err
+	}
+	if err := dev.writeWord(regLEDDriverEnable, 0); err != nil {
+		return err
+	}
+	return dev.writeWord(regDir, 0xffff)
+}
+
+// Close unregisters the pins of the device.
+func (dev *Dev) Close() error {
+	for _, p := range dev.Pins {
+		if err := gpioreg.Unregister(p.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (dev *Dev) String() string {
+	return dev.name
+}
+
+// readReg reads an 8 bit register. dev.mu must be held.
+func (dev *Dev) readReg(reg uint8) (uint8, error) {
+	var r [1]byte
+	if err := dev.d.Tx([]byte{reg}, r[:]); err != nil {
+		return 0, fmt.Errorf("%s: read register 0x%02x: %w", dev, reg, err)
+	}
+	return r[0], nil
+}
+
+// writeReg writes an 8 bit register. dev.mu must be held.
+func (dev *Dev) writeReg(reg, value uint8) error {
+	if err := dev.d.Tx([]byte{reg, value}, nil); err != nil {
+		return fmt.Errorf("%s: write register 0x%02x: %w", dev, reg, err)
+	}
+	return nil
+}
+
+// readWord reads the bank B register reg and the following bank A register.
+// dev.mu must be held.
+func (dev *Dev) readWord(reg uint8) (uint16, error) {
+	var r [2]byte
+	if err := dev.d.Tx([]byte{reg}, r[:]); err != nil {
+		return 0, fmt.Errorf("%s: read register 0x%02x: %w", dev, reg, err)
+	}
+	return uint16(r[0])<<8 | uint16(r[1]), nil
+}
+
+// writeWord writes the bank B register reg and the following bank A
+// register. dev.mu must be held.
+func (dev *Dev) writeWord(reg uint8, value uint16) error {
+	if err := dev.d.Tx([]byte{reg, byte(value >> 8), byte(value)}, nil); err != nil {
+		return fmt.Errorf("%s: write register 0x%02x: %w", dev, reg, err)
+	}
+	return nil
+}
+
+// updateWord sets the bits of mask in the register pair at reg to the bits of
+// value. dev.mu must be held.
+func (dev *Dev) updateWord(reg uint8, mask, value uint16) error {
+	v, err := dev.readWord(reg)
+	if err != nil {
+		return err
+	}
+	n := v&^mask | value&mask
+	if n == v {
+		return nil
+	}
+	return dev.writeWord(reg, n)
+}
+
+// setBits sets or clears the bits of mask in the register pair at reg.
+// dev.mu must be held.
+func (dev *Dev) setBits(reg uint8, mask uint16, set bool) error {
+	var v uint16
+	if set {
+		v = mask
+	}
+	return dev.updateWord(reg, mask, v)
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sx1509
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/physic"
+)
+
+// registerBus is an i2c.Bus that simulates the registers of a SX1509.
+type registerBus struct {
+	mu   sync.Mutex
+	regs [0x80]byte
+}
+
+func (rb *registerBus) String() string                    { return "registerBus" }
+func (rb *registerBus) SetSpeed(f physic.Frequency) error { return nil }
+
+func (rb *registerBus) Tx(addr uint16, w, r []byte) error {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	copy(rb.regs[w[0]:], w[1:])
+	copy(r, rb.regs[w[0]:])
+	return nil
+}
+
+func newDev(t *testing.T) (*Dev, *registerBus) {
+	bus := &registerBus{}
+	dev, err := New(bus, 0x3e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = dev.Close() })
+	return dev, bus
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(&registerBus{}, 0x20); err == nil {
+		t.Error("New succeeded with an invalid address")
+	}
+	dev, bus := newDev(t)
+	if bus.regs[regClock] != clockInternal || bus.regs[regMisc] != ledClockDivider<<4 {
+		t.Errorf("unexpected clock 0x%x misc 0x%x", bus.regs[regClock], bus.regs[regMisc])
+	}
+	if dev.Pin(16) != nil || dev.Pin(15) == nil {
+		t.Error("unexpected Pin() result")
+	}
+}
+
+func TestPins(t *testing.T) {
+	dev, bus := newDev(t)
+	bus.regs[regDir], bus.regs[regDir+1] = 0xff, 0xff
+	if err := dev.Pin(9).Out(gpio.High); err != nil {
+		t.Fatal(err)
+	}
+	if bus.regs[regDir] != 0xfd || bus.regs[regData] != 0x02 {
+		t.Errorf("unexpected dir 0x%x data 0x%x", bus.regs[regDir], bus.regs[regData])
+	}
+	if f := dev.Pin(9).Function(); f != "Out" {
+		t.Errorf("got function %q", f)
+	}
+	if err := dev.Pin(2).In(gpio.PullDown, gpio.NoEdge); err != nil {
+		t.Fatal(err)
+	}
+	if bus.regs[regPullDown+1] != 0x04 || bus.regs[regPullUp+1] != 0 {
+		t.Errorf("unexpected pull down 0x%x pull up 0x%x", bus.regs[regPullDown+1], bus.regs[regPullUp+1])
+	}
+	if p := dev.Pin(2).Pull(); p != gpio.PullDown {
+		t.Errorf("got pull %s", p)
+	}
+	if err := dev.Pin(2).In(gpio.Float, gpio.RisingEdge); err == nil {
+		t.Error("In with an edge succeeded")
+	}
+	bus.regs[regData+1] = 0x04
+	if l := dev.Pin(2).Read(); l != gpio.High {
+		t.Errorf("got level %s", l)
+	}
+}
+
+func TestLED(t *testing.T) {
+	dev, bus := newDev(t)
+	if err := dev.Pin(1).PWM(gpio.DutyHalf, 0); err != nil {
+		t.Fatal(err)
+	}
+	r := ledRegs(1)
+	if bus.regs[r.iOn] != 127 || bus.regs[regLEDDriverEnable+1] != 0x02 || bus.regs[regOpenDrain+1] != 0x02 {
+		t.Errorf("unexpected iOn %d enable 0x%x", bus.regs[r.iOn], bus.regs[regLEDDriverEnable+1])
+	}
+	if f := dev.Pin(1).Function(); f != "LED" {
+		t.Errorf("got function %q", f)
+	}
+	if err := dev.Breathe(1, time.Second, time.Second, time.Second, time.Second, 255, 0); err == nil {
+		t.Error("Breathe succeeded on a pin without breathing")
+	}
+	if err := dev.Breathe(13, 130*time.Millisecond, 2*time.Second, time.Second, time.Second, 255, 8); err != nil {
+		t.Fatal(err)
+	}
+	r = ledRegs(13)
+	if r.tOn != 0x5a {
+		t.Errorf("unexpected registers %+v", r)
+	}
+	if bus.regs[r.tOn] != 2 || bus.regs[r.off] != 15<<3|2 || bus.regs[r.tRise] != 4 {
+		t.Errorf("got tOn %d off 0x%x tRise %d", bus.regs[r.tOn], bus.regs[r.off], bus.regs[r.tRise])
+	}
+	if err := dev.Blink(0, 0, time.Second, 255, 0); err == nil {
+		t.Error("Blink succeeded with a zero on time")
+	}
+}
+
+func TestKeypad(t *testing.T) {
+	dev, bus := newDev(t)
+	bus.regs[regDir], bus.regs[regDir+1] = 0xff, 0xff
+	k, err := dev.NewKeypad(4, 3, 8*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bus.regs[regKeyConfig1] != 3 || bus.regs[regKeyConfig2] != 3<<3|2 {
+		t.Errorf("got config 0x%x 0x%x", bus.regs[regKeyConfig1], bus.regs[regKeyConfig2])
+	}
+	if bus.regs[regDir] != 0xff || bus.regs[regDir+1] != 0xf0 {
+		t.Errorf("got dir 0x%x 0x%x", bus.regs[regDir], bus.regs[regDir+1])
+	}
+	bus.regs[regKeyData1], bus.regs[regKeyData1+1] = 0xff, 0xff
+	expect := func(want ...KeyEvent) {
+		t.Helper()
+		got, err := k.Scan()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("got %v, expected %v", got, want)
+		}
+		for ix := range got {
+			got[ix].Time = time.Time{}
+			if got[ix] != want[ix] {
+				t.Errorf("got %v, expected %v", got[ix], want[ix])
+			}
+		}
+	}
+	expect()
+	// Row 2, column 1.
+	bus.regs[regKeyData1], bus.regs[regKeyData1+1] = ^uint8(0x02), ^uint8(0x04)
+	expect(KeyEvent{Row: 2, Col: 1, Pressed: true})
+	expect()
+	bus.regs[regKeyData1], bus.regs[regKeyData1+1] = ^uint8(0x01), ^uint8(0x01)
+	expect(KeyEvent{Row: 2, Col: 1}, KeyEvent{Row: 0, Col: 0, Pressed: true})
+	bus.regs[regKeyData1], bus.regs[regKeyData1+1] = 0xff, 0xff
+	events, err := k.Start(time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev := <-events
+	if ev.Pressed || ev.Row != 0 || ev.Col != 0 {
+		t.Errorf("unexpected event %v", ev)
+	}
+	k.Stop()
+	if _, ok := <-events; ok {
+		t.Error("channel not closed")
+	}
+	if _, err := dev.NewKeypad(1, 3, time.Millisecond); err == nil {
+		t.Error("NewKeypad succeeded with one row")
+	}
+}
+
+func TestLEDTime(t *testing.T) {
+	unit := 10 * time.Millisecond
+	for _, tc := range []struct {
+		d    time.Duration
+		want uint8
+	}{
+		{0, 0},
+		{time.Millisecond, 1},
+		{34 * time.Millisecond, 3},
+		{500 * time.Millisecond, 15},
+		{time.Second, 16},
+		{2 * time.Second, 25},
+		{time.Minute, 31},
+	} {
+		if got := ledTime(tc.d, unit, 8); got != tc.want {
+			t.Errorf("ledTime(%s) = %d, expected %d", tc.d, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tca8418_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"periph.io/x/conn/v3/gpio/gpioreg"
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/devices/v3/tca8418"
+	"periph.io/x/host/v3"
+)
+
+func Example() {
+	if _, err := host.Init(); err != nil {
+		log.Fatal(err)
+	}
+	b, err := i2creg.Open("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer b.Close()
+	// A 4x4 keypad, with INT connected to GPIO4.
+	d, err := tca8418.NewI2C(b, tca8418.DefaultAddress, 4, 4, gpioreg.ByName("GPIO4"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer d.Halt()
+	keys := [4][4]string{
+		{"1", "2", "3", "A"},
+		{"4", "5", "6", "B"},
+		{"7", "8", "9", "C"},
+		{"*", "0", "#", "D"},
+	}
+	events, err := d.Start(50 * time.Millisecond)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for ev := range events {
+		if ev.Pressed {
+			fmt.Println(keys[ev.Row][ev.Col])
+		}
+	}
+}
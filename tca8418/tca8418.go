@@ -0,0 +1,284 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package tca8418 controls a Texas Instruments TCA8418 keypad scanner, which
+// scans a matrix of up to 8 rows by 10 columns on its own, debounces the
+// keys, and queues their presses and releases in a FIFO of 10 events, read
+// over I²C.
+//
+// If the INT pin is connected to a host pin, the FIFO is only read after an
+// interrupt; otherwise it's polled.
+//
+// # Wiring
+//
+// Connect the rows of the keypad to ROW0 and up, and the columns to COL0 and
+// up. INT is open drain and active low: the host pin's pull-up is enabled.
+//
+// # Datasheet
+//
+// https://www.ti.com/lit/ds/symlink/tca8418.pdf
+package tca8418
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"periph.io/x/conn/v3"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/i2c"
+)
+
+// DefaultAddress is the I²C address of the TCA8418, which is fixed.
+const DefaultAddress uint16 = 0x34
+
+const (
+	regCfg        = 0x01
+	regIntStat    = 0x02
+	regKeyLckEC   = 0x03
+	regKeyEventA  = 0x04
+	regKPGPIO1    = 0x1d
+	regKPGPIO2    = 0x1e
+	regKPGPIO3    = 0x1f
+	regDebounceD1 = 0x29
+
+	// cfgKEIEN enables the interrupt of the key events, cfgOvrFlowIEN the one
+	// of the overflow of the FIFO, and cfgIntCfg deasserts INT for 50µs when
+	// events remain after it's cleared.
+	cfgKEIEN      = 0x01
+	cfgOvrFlowIEN = 0x08
+	cfgIntCfg     = 0x10
+
+	intK       = 0x01
+	intOvrFlow = 0x08
+
+	// maxKeyCode is the highest key code of the matrix. Higher codes are
+	// GPI events.
+	maxKeyCode = 80
+
+	// eventBufferSize is the size of the channel returned by Start().
+	eventBufferSize = 16
+	// idleTimeout is the longest time the goroutine waits for an interrupt
+	// between checks of Stop().
+	idleTimeout = 250 * time.Millisecond
+)
+
+// KeyEvent is a key press or release.
+type KeyEvent struct {
+	// Row and Col are the indexes of the key's row and column.
+	Row, Col int
+	// Pressed is true if the key was pressed, and false if it was released.
+	Pressed bool
+	// Time is when the change was read.
+	Time time.Time
+}
+
+// Dev is a handle to a TCA8418.
+type Dev struct {
+	c          *i2c.Dev
+	irq        gpio.PinIn
+	rows, cols int
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+	err  error
+	// overflows is the number of times the FIFO overflowed.
+	overflows int
+}
+
+// NewI2C returns a handle to a TCA8418 at addr on b, scanning a matrix of
+// rows by cols keys, from 1x1 to 8x10. irq is the host pin connected to INT,
+// or nil to poll. Pending events are discarded.
+func NewI2C(b i2c.Bus, addr uint16, rows, cols int, irq gpio.PinIn) (*Dev, error) {
+	if rows < 1 || rows > 8 || cols < 1 || cols > 10 {
+		return nil, fmt.Errorf("tca8418: invalid keypad size %dx%d", rows, cols)
+	}
+	d := &Dev{c: &i2c.Dev{Bus: b, Addr: addr}, irq: irq, rows: rows, cols: cols}
+	colMask := uint16(1)<<cols - 1
+	regs := [][2]byte{
+		{regKPGPIO1, byte(1<<rows - 1)},
+		{regKPGPIO2, byte(colMask)},
+		{regKPGPIO3, byte(colMask >> 8)},
+		{regCfg, cfgKEIEN | cfgOvrFlowIEN | cfgIntCfg},
+	}
+	for _, r := range regs {
+		if err := d.write(r[0], r[1]); err != nil {
+			return nil, err
+		}
+	}
+	if irq != nil {
+		if err := irq.In(gpio.PullUp, gpio.FallingEdge); err != nil {
+			return nil, fmt.Errorf("tca8418: %w", err)
+		}
+	}
+	if _, err := d.Scan(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// SetDebounce enables or disables the debouncing of the rows and columns,
+// which delays the events by about 50ms. It's enabled by default.
+func (d *Dev) SetDebounce(on bool) error {
+	var v byte
+	if !on {
+		v = 0xff
+	}
+	for i := byte(0); i < 3; i++ {
+		if err := d.write(regDebounceD1+i, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Scan reads the events queued in the FIFO, and clears the interrupt.
+func (d *Dev) Scan() ([]KeyEvent, error) {
+	n, err := d.read(regKeyLckEC)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var events []KeyEvent
+	for i := 0; i < int(n&0x0f); i++ {
+		v, err := d.read(regKeyEventA)
+		if err != nil {
+			return nil, err
+		}
+		code := int(v & 0x7f)
+		if code == 0 || code > maxKeyCode {
+			continue
+		}
+		events = append(events, KeyEvent{Row: (code - 1) / 10, Col: (code - 1) % 10, Pressed: v&0x80 != 0, Time: now})
+	}
+	stat, err := d.read(regIntStat)
+	if err != nil {
+		return nil, err
+	}
+	if stat&intOvrFlow != 0 {
+		d.mu.Lock()
+		d.overflows++
+		d.mu.Unlock()
+	}
+	if stat != 0 {
+		if err := d.write(regIntStat, stat); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// Overflows returns the number of times the FIFO overflowed, losing events,
+// because it wasn't read in time.
+func (d *Dev) Overflows() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.overflows
+}
+
+// Start starts a goroutine that reads the events, after each interrupt or
+// every interval without an INT pin, and sends them to the returned channel.
+// The channel is closed when Stop() is called, or a read returns an error.
+// See Err().
+func (d *Dev) Start(interval time.Duration) (<-chan KeyEvent, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("tca8418: invalid interval %s", interval)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return nil, errors.New("tca8418: already started")
+	}
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
+	d.err = nil
+	events := make(chan KeyEvent, eventBufferSize)
+	go d.run(interval, events, d.stop, d.done)
+	return events, nil
+}
+
+// Stop stops the goroutine started by Start(), and waits for it to exit.
+func (d *Dev) Stop() {
+	d.mu.Lock()
+	stop, done := d.stop, d.done
+	d.stop = nil
+	d.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Err returns the error that stopped the goroutine, if any.
+func (d *Dev) Err() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.err
+}
+
+// Halt implements conn.Resource. It stops the goroutine started by Start().
+func (d *Dev) Halt() error {
+	d.Stop()
+	return nil
+}
+
+func (d *Dev) String() string {
+	return fmt.Sprintf("TCA8418{%s}", d.c)
+}
+
+func (d *Dev) run(interval time.Duration, events chan<- KeyEvent, stop, done chan struct{}) {
+	defer close(done)
+	defer close(events)
+	for {
+		changes, err := d.Scan()
+		if err != nil {
+			d.mu.Lock()
+			d.err = err
+			d.mu.Unlock()
+			return
+		}
+		for _, ev := range changes {
+			select {
+			case events <- ev:
+			case <-stop:
+				return
+			}
+		}
+		if d.irq != nil {
+			// INT stays asserted until the interrupt is cleared by Scan().
+			d.irq.WaitForEdge(idleTimeout)
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			continue
+		}
+		select {
+		case <-stop:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (d *Dev) read(reg byte) (byte, error) {
+	var r [1]byte
+	if err := d.c.Tx([]byte{reg}, r[:]); err != nil {
+		return 0, fmt.Errorf("tca8418: %w", err)
+	}
+	return r[0], nil
+}
+
+func (d *Dev) write(reg, v byte) error {
+	if err := d.c.Tx([]byte{reg, v}, nil); err != nil {
+		return fmt.Errorf("tca8418: %w", err)
+	}
+	return nil
+}
+
+var _ conn.Resource = &Dev{}
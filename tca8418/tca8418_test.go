@@ -0,0 +1,122 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tca8418
+
+import (
+	"testing"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/i2c/i2ctest"
+)
+
+func reg(r, v byte) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddress, W: []byte{r}, R: []byte{v}}
+}
+
+func wreg(r, v byte) i2ctest.IO {
+	return i2ctest.IO{Addr: DefaultAddress, W: []byte{r, v}}
+}
+
+// initOps is the configuration of a 4x10 keypad, with an empty FIFO.
+var initOps = []i2ctest.IO{
+	wreg(regKPGPIO1, 0x0f),
+	wreg(regKPGPIO2, 0xff),
+	wreg(regKPGPIO3, 0x03),
+	wreg(regCfg, 0x19),
+	reg(regKeyLckEC, 0),
+	reg(regIntStat, 0),
+}
+
+func TestScan(t *testing.T) {
+	ops := append([]i2ctest.IO{}, initOps...)
+	ops = append(ops,
+		reg(regKeyLckEC, 3),
+		// Press of row 0 col 0, release of row 3 col 9, GPI event.
+		reg(regKeyEventA, 0x81),
+		reg(regKeyEventA, 40),
+		reg(regKeyEventA, 0x80|97),
+		reg(regIntStat, intK|intOvrFlow),
+		wreg(regIntStat, intK|intOvrFlow),
+		wreg(regDebounceD1, 0xff),
+		wreg(regDebounceD1+1, 0xff),
+		wreg(regDebounceD1+2, 0xff),
+	)
+	b := &i2ctest.Playback{Ops: ops}
+	d, err := NewI2C(b, DefaultAddress, 4, 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := d.Scan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("%+v", events)
+	}
+	if e := events[0]; e.Row != 0 || e.Col != 0 || !e.Pressed {
+		t.Fatalf("%+v", e)
+	}
+	if e := events[1]; e.Row != 3 || e.Col != 9 || e.Pressed {
+		t.Fatalf("%+v", e)
+	}
+	if d.Overflows() != 1 {
+		t.Fatal(d.Overflows())
+	}
+	if err := d.SetDebounce(false); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewI2C_Invalid(t *testing.T) {
+	if _, err := NewI2C(&i2ctest.Playback{}, DefaultAddress, 9, 1, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestStart(t *testing.T) {
+	ops := append([]i2ctest.IO{}, initOps...)
+	ops = append(ops,
+		reg(regKeyLckEC, 0),
+		reg(regIntStat, 0),
+		reg(regKeyLckEC, 1),
+		reg(regKeyEventA, 0x80|12),
+		reg(regIntStat, intK),
+		wreg(regIntStat, intK),
+	)
+	b := &i2ctest.Playback{Ops: ops, DontPanic: true}
+	irq := &gpiotest.Pin{N: "INT", L: gpio.High, EdgesChan: make(chan gpio.Level)}
+	d, err := NewI2C(b, DefaultAddress, 4, 10, irq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := d.Start(time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Start(time.Millisecond); err == nil {
+		t.Fatal("expected error")
+	}
+	irq.EdgesChan <- gpio.Low
+	e := <-events
+	if e.Row != 1 || e.Col != 1 || !e.Pressed {
+		t.Fatalf("%+v", e)
+	}
+	// The playback runs out of operations after the next interrupt.
+	irq.EdgesChan <- gpio.Low
+	if _, ok := <-events; ok {
+		t.Fatal("events not closed")
+	}
+	if d.Err() == nil {
+		t.Fatal("expected error")
+	}
+	if err := d.Halt(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tca95xx
+
+import (
+	"fmt"
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/conn/v3/pin"
+)
+
+// pinGroup is a group of pins of one port.
+type pinGroup struct {
+	dev         *Dev
+	port        *port
+	pins        []*portpin
+	defaultMask gpio.GPIOValue
+}
+
+// Group returns a gpio.Group that is made up of the specified pins of port.
+// Writes to the group are performed in a single bus transaction. If port or
+// any of the pins is out of range, nil is returned.
+func (d *Dev) Group(port int, pins []int) gpio.Group {
+	if port < 0 || port >= len(d.Pins) || len(pins) == 0 {
+		return nil
+	}
+	grouppins := make([]*portpin, len(pins))
+	for ix, number := range pins {
+		if number < 0 || number >= len(d.Pins[port]) {
+			return nil
+		}
+		grouppins[ix] = d.Pins[port][number].(*portpin)
+	}
+	return &pinGroup{
+		dev:         d,
+		port:        grouppins[0].port,
+		pins:        grouppins,
+		defaultMask: gpio.GPIOValue((1 << len(pins)) - 1),
+	}
+}
+
+// Pins returns the set of pin.Pin that make up that group.
+func (pg *pinGroup) Pins() []pin.Pin {
+	pins := make([]pin.Pin, len(pg.pins))
+	for ix, p := range pg.pins {
+		pins[ix] = p
+	}
+	return pins
+}
+
+// ByOffset returns the pin at offset within the group.
+func (pg *pinGroup) ByOffset(offset int) pin.Pin {
+	return pg.pins[offset]
+}
+
+// ByName returns the pin of the group with name, or nil.
+func (pg *pinGroup) ByName(name string) pin.Pin {
+	for _, p := range pg.pins {
+		if p.Name() == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// ByNumber returns the pin of the group with the pin number on the port, or
+// nil.
+func (pg *pinGroup) ByNumber(number int) pin.Pin {
+	for _, p := range pg.pins {
+		if p.Number() == number {
+			return p
+		}
+	}
+	return nil
+}
+
+// portMask converts a group value to the bits of the port.
+func (pg *pinGroup) portMask(value gpio.GPIOValue) uint8 {
+	var result uint8
+	for ix, p := range pg.pins {
+		if value&(1<<ix) != 0 {
+			result |= 1 << p.pinbit
+		}
+	}
+	return result
+}
+
+// Out writes value to the pins of the group selected by mask, and configures
+// them as outputs. If mask is 0, all pins of the group are written.
+func (pg *pinGroup) Out(value, mask gpio.GPIOValue) error {
+	if mask == 0 {
+		mask = pg.defaultMask
+	} else {
+		mask &= pg.defaultMask
+	}
+	wrMask := pg.portMask(mask)
+	wr := pg.portMask(value & mask)
+	pg.port.mu.Lock()
+	defer pg.port.mu.Unlock()
+	iodir, err := pg.port.iodir.readValue(true)
+	if err != nil {
+		return err
+	}
+	if err = pg.port.iodir.writeValue(iodir&^wrMask, true); err != nil {
+		return err
+	}
+	current, err := pg.port.output.readValue(true)
+	if err != nil {
+		return err
+	}
+	return pg.port.output.writeValue(current&^wrMask|wr, true)
+}
+
+// Read returns the levels of the pins of the group selected by mask. Pins
+// selected by mask are configured as inputs.
+func (pg *pinGroup) Read(mask gpio.GPIOValue) (result gpio.GPIOValue, err error) {
+	if mask == 0 {
+		mask = pg.defaultMask
+	} else {
+		mask &= pg.defaultMask
+	}
+	rMask := pg.portMask(mask)
+	pg.port.mu.Lock()
+	defer pg.port.mu.Unlock()
+	iodir, err := pg.port.iodir.readValue(true)
+	if err != nil {
+		return 0, err
+	}
+	if err = pg.port.iodir.writeValue(iodir|rMask, true); err != nil {
+		return 0, err
+	}
+	v, err := pg.port.input.readValue(false)
+	if err != nil {
+		return 0, err
+	}
+	for ix, p := range pg.pins {
+		if mask&(1<<ix) != 0 && v&(1<<p.pinbit) != 0 {
+			result |= 1 << ix
+		}
+	}
+	return result, nil
+}
+
+// WaitForEdge is not supported. The interrupt output of the device isn't
+// accessible through the bus.
+func (pg *pinGroup) WaitForEdge(timeout time.Duration) (number int, edge gpio.Edge, err error) {
+	return -1, gpio.NoEdge, gpio.ErrGroupFeatureNotImplemented
+}
+
+// Halt sets the pins of the group to inputs.
+func (pg *pinGroup) Halt() error {
+	_, err := pg.Read(0)
+	return err
+}
+
+// String returns the port name and pins of the group.
+func (pg *pinGroup) String() string {
+	s := fmt.Sprintf("%s - [ ", pg.port.name)
+	for _, p := range pg.pins {
+		s += fmt.Sprintf("%d ", p.Number())
+	}
+	return s + "]"
+}
+
+var _ gpio.Group = &pinGroup{}
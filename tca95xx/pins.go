@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"periph.io/x/conn/v3"
@@ -31,6 +32,10 @@ type Pin interface {
 type port struct {
 	name string
 
+	// mu serializes access to the registers of the port, so that the
+	// read-modify-write sequences of different pins don't interleave.
+	mu sync.Mutex
+
 	// GPIO basic registers
 	input  registerCache // input at the pin
 	output registerCache // output control, or flipflop state if read
@@ -56,9 +61,12 @@ func (p *port) pins(count int) []Pin {
 func (p *port) Tx(w, r []byte) (err error) {
 	send := len(w)
 	get := len(r)
-	switch {
-	case send > 0 && get > 0:
+	if send > 0 && get > 0 {
 		return fmt.Errorf("tca95xx: only conn.Half duplex is supported")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch {
 	case send > 0:
 		for i := 0; i < send; i++ {
 			err = p.output.writeValue(w[i], false)
@@ -135,10 +143,14 @@ func (p *portpin) In(pull gpio.Pull, edge gpio.Edge) error {
 	}
 
 	// Set pin to input
+	p.port.mu.Lock()
+	defer p.port.mu.Unlock()
 	return p.port.iodir.getAndSetBit(p.pinbit, true, true)
 }
 
 func (p *portpin) Read() gpio.Level {
+	p.port.mu.Lock()
+	defer p.port.mu.Unlock()
 	v, _ := p.port.input.getBit(p.pinbit, false)
 	if v {
 		return gpio.High
@@ -159,6 +171,8 @@ func (p *portpin) DefaultPull() gpio.Pull {
 }
 
 func (p *portpin) Out(l gpio.Level) error {
+	p.port.mu.Lock()
+	defer p.port.mu.Unlock()
 	err := p.port.iodir.getAndSetBit(p.pinbit, false, true)
 	if err != nil {
 		return err
@@ -171,6 +185,8 @@ func (p *portpin) PWM(duty gpio.Duty, f physic.Frequency) error {
 }
 
 func (p *portpin) Func() pin.Func {
+	p.port.mu.Lock()
+	defer p.port.mu.Unlock()
 	v, _ := p.port.iodir.getBit(p.pinbit, true)
 	if v {
 		return gpio.IN
@@ -192,13 +208,19 @@ func (p *portpin) SetFunc(f pin.Func) error {
 	default:
 		return errors.New("tca95xx: Function not supported: " + string(f))
 	}
+	p.port.mu.Lock()
+	defer p.port.mu.Unlock()
 	return p.port.iodir.getAndSetBit(p.pinbit, v, true)
 }
 
 func (p *portpin) SetPolarityInverted(pol bool) error {
+	p.port.mu.Lock()
+	defer p.port.mu.Unlock()
 	return p.port.ipol.getAndSetBit(p.pinbit, pol, true)
 }
 func (p *portpin) IsPolarityInverted() (bool, error) {
+	p.port.mu.Lock()
+	defer p.port.mu.Unlock()
 	return p.port.ipol.getBit(p.pinbit, true)
 }
 
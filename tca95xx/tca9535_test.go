@@ -289,3 +289,48 @@ func TestTCA9535_fixedValues(t *testing.T) {
 		t.Errorf("PWM should return an error")
 	}
 }
+
+func TestPCA9555_group(t *testing.T) {
+	const address uint16 = 0x21
+	scenario := &i2ctest.Playback{
+		Ops: []i2ctest.IO{
+			// iodir is read on creation
+			{Addr: address, W: []byte{0x06}, R: []byte{0xFF}},
+			{Addr: address, W: []byte{0x07}, R: []byte{0xFF}},
+			// pins 1 and 3 of port 1 are set to output, and written at once
+			{Addr: address, W: []byte{0x07, 0xF5}, R: nil},
+			{Addr: address, W: []byte{0x03}, R: []byte{0x00}},
+			{Addr: address, W: []byte{0x03, 0x08}, R: nil},
+			// input is read
+			{Addr: address, W: []byte{0x07, 0xFF}, R: nil},
+			{Addr: address, W: []byte{0x01}, R: []byte{0x02}},
+		},
+	}
+
+	dev, err := New(scenario, PCA9555, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if dev.Pin(16) != nil || dev.Pin(9).Name() != "PCA9555_21_P1_1" {
+		t.Error("unexpected Pin() result")
+	}
+	if dev.Group(1, []int{8}) != nil {
+		t.Error("expected nil group for invalid pin")
+	}
+	g := dev.Group(1, []int{1, 3})
+	if err = g.Out(0x02, 0); err != nil {
+		t.Fatal(err)
+	}
+	v, err := g.Read(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 0x01 {
+		t.Errorf("expected 0x01, got 0x%x", v)
+	}
+	if err = scenario.Close(); err != nil {
+		t.Error(err)
+	}
+}
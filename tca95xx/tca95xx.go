@@ -3,11 +3,13 @@
 // that can be found in the LICENSE file.
 
 // Package tca95xx provides an interface to the Texas Instruments TCA95 series
-// of 8-bit I²C extenders.
+// of 8-bit I²C extenders, and the register compatible NXP PCA95 series.
 //
 // The following variants are supported:
 //
+//   - PCA9535 - addresses: 0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27
 //   - PCA9536 - address: 0x41
+//   - PCA9555 - addresses: 0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27
 //   - TCA6408A - addresses: 0x20, 0x21
 //   - TCA6416 - addresses: 0x20, 0x21
 //   - TCA6416A - addresses: 0x20, 0x21
@@ -38,6 +40,8 @@ import (
 type Dev struct {
 	Pins  [][]Pin     // Pins is a double array structured as: [port][pin].
 	Conns []conn.Conn // Conns uses the same [port] array structure.
+
+	name string
 }
 
 // New returns a device object that communicates over I²C to the TCA95xx device
@@ -91,11 +95,38 @@ func New(bus i2c.Bus, variant Variant, addr uint16) (*Dev, error) {
 	d := Dev{
 		Pins:  pins,
 		Conns: conns,
+		name:  devicename,
 	}
 
 	return &d, nil
 }
 
+// Pin returns the pin number n of the device, or nil if n is out of range.
+// Pins are numbered 0-7 for port 0, and 8-15 for port 1.
+func (d *Dev) Pin(n int) Pin {
+	if n < 0 || n/8 >= len(d.Pins) || n%8 >= len(d.Pins[n/8]) {
+		return nil
+	}
+	return d.Pins[n/8][n%8]
+}
+
+// Halt implements conn.Resource. It sets all the pins as high-impedance
+// inputs.
+func (d *Dev) Halt() error {
+	for _, port := range d.Pins {
+		for _, pin := range port {
+			if err := pin.Halt(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d *Dev) String() string {
+	return d.name
+}
+
 // Close removes any registration to the device.
 func (d *Dev) Close() error {
 	for _, port := range d.Pins {
@@ -12,7 +12,9 @@ import (
 type Variant string
 
 const (
+	PCA9535  Variant = "PCA9535"  // PCA9535  16-bit I²C extender. Datasheet: https://www.nxp.com/docs/en/data-sheet/PCA9535_PCA9535C.pdf
 	PCA9536  Variant = "PCA9536"  // PCA9536  8-bit I²C extender. Datasheet: https://www.ti.com/lit/gpn/pca9536
+	PCA9555  Variant = "PCA9555"  // PCA9555  16-bit I²C extender. Datasheet: https://www.nxp.com/docs/en/data-sheet/PCA9555.pdf
 	TCA6408A Variant = "TCA6408A" // TCA6408A 8-bit I²C extender. Datasheet: https://www.ti.com/lit/gpn/tca6408a
 	TCA6416  Variant = "TCA6416"  // TCA6416  8-bit I²C extender. Datasheet: https://www.ti.com/lit/gpn/tca6416
 	TCA6416A Variant = "TCA6416A" // TCA6416A 8-bit I²C extender. Datasheet: https://www.ti.com/lit/gpn/tca6416a
@@ -33,7 +35,9 @@ type variant struct {
 }
 
 var variants = map[Variant]variant{
+	PCA9535:  {addStart: 0x20, addEnd: 0x27, pins: 16},
 	PCA9536:  {addStart: 0x41, addEnd: 0x41, pins: 4},
+	PCA9555:  {addStart: 0x20, addEnd: 0x27, pins: 16},
 	TCA6408A: {addStart: 0x20, addEnd: 0x21, pins: 8},
 	TCA6416:  {addStart: 0x20, addEnd: 0x21, pins: 16},
 	TCA6416A: {addStart: 0x20, addEnd: 0x21, pins: 16},
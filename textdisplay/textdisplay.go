@@ -0,0 +1,300 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package textdisplay emulates a character LCD on a monochrome pixel display,
+// so that code written for display.TextDisplay, such as for the HD44780, runs
+// unchanged on cheap OLED or LCD graphic displays.
+//
+// The number of rows and columns is derived from the size of the font. With
+// the default 7x13 font, a 128x64 display has 4 rows of 18 columns. As on
+// character LCDs, rows and columns start at 1.
+//
+// The text is rendered in a frame buffer, and the whole frame is drawn after
+// each call. Drivers that do differential updates, such as ssd1306, only send
+// what changed.
+package textdisplay
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+	"periph.io/x/conn/v3/display"
+	"periph.io/x/devices/v3/ssd1306/image1bit"
+)
+
+// Switcher is implemented by displays that can be turned on and off.
+type Switcher interface {
+	Display(on bool) error
+}
+
+// Dev is a character display emulated on a pixel display.
+type Dev struct {
+	mu     sync.Mutex
+	d      display.Drawer
+	face   font.Face
+	img    *image1bit.VerticalLSB
+	rows   int
+	cols   int
+	cellW  int
+	cellH  int
+	ascent int
+
+	// text is the content of each cell.
+	text       [][]byte
+	row, col   int
+	cursor     display.CursorMode
+	autoScroll bool
+	on         bool
+}
+
+// New returns a character display rendering on d with face, which must be a
+// monospace font. If face is nil, basicfont.Face7x13 is used.
+func New(d display.Drawer, face font.Face) (*Dev, error) {
+	if face == nil {
+		face = basicfont.Face7x13
+	}
+	adv, ok := face.GlyphAdvance('M')
+	if !ok {
+		return nil, errors.New("textdisplay: font without glyph for 'M'")
+	}
+	m := face.Metrics()
+	t := &Dev{
+		d:      d,
+		face:   face,
+		img:    image1bit.NewVerticalLSB(d.Bounds()),
+		cellW:  adv.Ceil(),
+		cellH:  m.Height.Ceil(),
+		ascent: m.Ascent.Ceil(),
+		on:     true,
+	}
+	if t.cellW <= 0 || t.cellH <= 0 {
+		return nil, fmt.Errorf("textdisplay: invalid font size %dx%d", t.cellW, t.cellH)
+	}
+	t.cols = d.Bounds().Dx() / t.cellW
+	t.rows = d.Bounds().Dy() / t.cellH
+	if t.cols == 0 || t.rows == 0 {
+		return nil, fmt.Errorf("textdisplay: font of %dx%d too large for %s", t.cellW, t.cellH, d)
+	}
+	t.text = make([][]byte, t.rows)
+	for i := range t.text {
+		t.text[i] = make([]byte, t.cols)
+	}
+	if err := t.Clear(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// AutoScroll implements display.TextDisplay. When enabled, writing past the
+// end of the last row scrolls the text up by one row. Otherwise, the cursor
+// wraps to the first row.
+func (t *Dev) AutoScroll(enabled bool) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.autoScroll = enabled
+	return nil
+}
+
+// Cols implements display.TextDisplay.
+func (t *Dev) Cols() int {
+	return t.cols
+}
+
+// Rows implements display.TextDisplay.
+func (t *Dev) Rows() int {
+	return t.rows
+}
+
+// MinCol implements display.TextDisplay.
+func (t *Dev) MinCol() int {
+	return 1
+}
+
+// MinRow implements display.TextDisplay.
+func (t *Dev) MinRow() int {
+	return 1
+}
+
+// Clear implements display.TextDisplay.
+func (t *Dev) Clear() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, r := range t.text {
+		for i := range r {
+			r[i] = ' '
+		}
+	}
+	t.row, t.col = 0, 0
+	return t.render()
+}
+
+// Cursor implements display.TextDisplay. The cursor doesn't blink, so
+// display.CursorBlink is rendered as a block.
+func (t *Dev) Cursor(modes ...display.CursorMode) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, mode := range modes {
+		if mode < display.CursorOff || mode > display.CursorBlink {
+			return fmt.Errorf("textdisplay: unexpected cursor: %d", mode)
+		}
+	}
+	if len(modes) != 0 {
+		t.cursor = modes[len(modes)-1]
+	}
+	return t.render()
+}
+
+// Home implements display.TextDisplay.
+func (t *Dev) Home() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.row, t.col = 0, 0
+	return t.render()
+}
+
+// Move implements display.TextDisplay.
+func (t *Dev) Move(dir display.CursorDirection) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch dir {
+	case display.Backward:
+		t.col = max(t.col-1, 0)
+	case display.Forward:
+		t.col = min(t.col+1, t.cols-1)
+	case display.Up:
+		t.row = max(t.row-1, 0)
+	case display.Down:
+		t.row = min(t.row+1, t.rows-1)
+	default:
+		return fmt.Errorf("textdisplay: invalid cursor direction %d", dir)
+	}
+	return t.render()
+}
+
+// MoveTo implements display.TextDisplay.
+func (t *Dev) MoveTo(row, col int) error {
+	if row < 1 || row > t.rows || col < 1 || col > t.cols {
+		return fmt.Errorf("textdisplay: MoveTo(%d,%d) value out of range", row, col)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.row, t.col = row-1, col-1
+	return t.render()
+}
+
+// Display implements display.TextDisplay. If the display doesn't implement
+// Switcher, it is blanked while off.
+func (t *Dev) Display(on bool) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if s, ok := t.d.(Switcher); ok {
+		if err := s.Display(on); err != nil {
+			return err
+		}
+	} else if !on {
+		if err := t.d.Draw(t.d.Bounds(), image1bit.NewVerticalLSB(t.d.Bounds()), image.Point{}); err != nil {
+			return err
+		}
+	}
+	t.on = on
+	return t.render()
+}
+
+func (t *Dev) String() string {
+	return fmt.Sprintf("TextDisplay{%s, %dx%d}", t.d, t.cols, t.rows)
+}
+
+// Write implements display.TextDisplay. '\n' moves the cursor to the start of
+// the next row, and '\r' to the start of the current row.
+func (t *Dev) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, c := range p {
+		switch c {
+		case '\n':
+			t.col = 0
+			t.nextRow()
+		case '\r':
+			t.col = 0
+		default:
+			t.text[t.row][t.col] = c
+			if t.col++; t.col == t.cols {
+				t.col = 0
+				t.nextRow()
+			}
+		}
+	}
+	if err := t.render(); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// WriteString implements display.TextDisplay.
+func (t *Dev) WriteString(text string) (int, error) {
+	return t.Write([]byte(text))
+}
+
+// Halt implements conn.Resource. It halts the display.
+func (t *Dev) Halt() error {
+	return t.d.Halt()
+}
+
+// nextRow moves the cursor down one row, scrolling or wrapping at the
+// bottom. t.mu must be held.
+func (t *Dev) nextRow() {
+	if t.row++; t.row < t.rows {
+		return
+	}
+	if !t.autoScroll {
+		t.row = 0
+		return
+	}
+	t.row = t.rows - 1
+	first := t.text[0]
+	copy(t.text, t.text[1:])
+	for i := range first {
+		first[i] = ' '
+	}
+	t.text[t.rows-1] = first
+}
+
+// render draws the text and the cursor, and updates the display. t.mu must
+// be held.
+func (t *Dev) render() error {
+	for i := range t.img.Pix {
+		t.img.Pix[i] = 0
+	}
+	drawer := font.Drawer{Dst: t.img, Src: &image.Uniform{C: image1bit.On}, Face: t.face}
+	for row, r := range t.text {
+		for col, c := range r {
+			if c != ' ' {
+				drawer.Dot = fixed.P(col*t.cellW, row*t.cellH+t.ascent)
+				drawer.DrawString(string(rune(c)))
+			}
+		}
+	}
+	cell := image.Rect(t.col*t.cellW, t.row*t.cellH, (t.col+1)*t.cellW, (t.row+1)*t.cellH)
+	switch t.cursor {
+	case display.CursorUnderline:
+		t.img.DrawHLine(cell.Min.X, cell.Max.X, cell.Max.Y-1, image1bit.On)
+	case display.CursorBlock, display.CursorBlink:
+		for y := cell.Min.Y; y < cell.Max.Y; y++ {
+			for x := cell.Min.X; x < cell.Max.X; x++ {
+				t.img.SetBit(x, y, !t.img.BitAt(x, y))
+			}
+		}
+	}
+	if !t.on {
+		return nil
+	}
+	return t.d.Draw(t.d.Bounds(), t.img, image.Point{})
+}
+
+var _ display.TextDisplay = &Dev{}
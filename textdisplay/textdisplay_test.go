@@ -0,0 +1,106 @@
+// Copyright 2025 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package textdisplay
+
+import (
+	"image"
+	"testing"
+
+	"periph.io/x/conn/v3/display"
+	"periph.io/x/conn/v3/display/displaytest"
+	"periph.io/x/devices/v3/ssd1306/image1bit"
+)
+
+func newTestDev(t *testing.T) (*Dev, *displaytest.Drawer) {
+	d := &displaytest.Drawer{Img: image.NewNRGBA(image.Rect(0, 0, 128, 64))}
+	tm, err := New(d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tm, d
+}
+
+// lit returns the number of pixels on in r.
+func lit(img *image1bit.VerticalLSB, r image.Rectangle) int {
+	n := 0
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			if img.BitAt(x, y) {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func TestDev(t *testing.T) {
+	tm, d := newTestDev(t)
+	if tm.Rows() != 4 || tm.Cols() != 18 {
+		t.Fatalf("%dx%d", tm.Cols(), tm.Rows())
+	}
+	if s := tm.String(); s != "TextDisplay{Drawer, 18x4}" {
+		t.Errorf("String() = %q", s)
+	}
+	if errs := displaytest.TestTextDisplay(tm, false); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	if err := tm.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tm.MoveTo(2, 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tm.WriteString("X"); err != nil {
+		t.Fatal(err)
+	}
+	cell := image.Rect(2*7, 13, 3*7, 2*13)
+	if lit(tm.img, cell) == 0 || lit(tm.img, tm.img.Rect) != lit(tm.img, cell) {
+		t.Errorf("X not drawn only at (2,3)")
+	}
+	if r, _, _, _ := d.Img.At(cell.Min.X+3, cell.Min.Y+6).RGBA(); r == 0 {
+		t.Errorf("X not drawn on the display")
+	}
+	if err := tm.Cursor(display.CursorBlock); err != nil {
+		t.Fatal(err)
+	}
+	// The cursor is after the X.
+	next := cell.Add(image.Pt(7, 0))
+	if lit(tm.img, next) != 7*13 {
+		t.Errorf("block cursor not drawn")
+	}
+	if err := tm.Cursor(display.CursorUnderline); err != nil {
+		t.Fatal(err)
+	}
+	if lit(tm.img, next) != 7 {
+		t.Errorf("underline cursor not drawn")
+	}
+}
+
+func TestDev_scroll(t *testing.T) {
+	tm, _ := newTestDev(t)
+	if _, err := tm.WriteString("1\n2\n3\n4\n5"); err != nil {
+		t.Fatal(err)
+	}
+	// Without auto scroll, the cursor wrapped to the first row.
+	if got := string(tm.text[0][:1]) + string(tm.text[3][:1]); got != "54" {
+		t.Errorf("rows %q", got)
+	}
+	if err := tm.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tm.AutoScroll(true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tm.WriteString("1\n2\n3\n4\n5"); err != nil {
+		t.Fatal(err)
+	}
+	var got string
+	for _, r := range tm.text {
+		got += string(r[:1])
+	}
+	if got != "2345" || tm.row != 3 || tm.col != 1 {
+		t.Errorf("rows %q, cursor (%d,%d)", got, tm.row, tm.col)
+	}
+}
@@ -7,14 +7,24 @@ package tlv493d
 import (
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"sync"
 	"time"
 
 	"periph.io/x/conn/v3/i2c"
 	"periph.io/x/conn/v3/physic"
+	"periph.io/x/devices/v3/internal/logging"
 )
 
+// logger receives the recoveries of ReadContinuous() from read errors.
+var logger logging.Logger
+
+// SetLogger sets the logger of the package, which discards the records
+// unless it's called.
+func SetLogger(l *slog.Logger) {
+	logger.Set(l)
+}
+
 // I2CAddr is the default I2C address for the TLV493D component.
 const I2CAddr uint16 = 0x5e
 
@@ -407,9 +417,9 @@ func (d *Dev) ReadContinuous(frequency physic.Frequency, precision Precision) (<
 					// Try resetting the sensor to recover from errors
 					if err := d.initialize(true); err == nil {
 						if err := d.SetMode(newMode); err != nil {
-							log.Printf("%s: unable to reset tlv493d mode: %v", d, err)
+							logger.Get().Error("tlv493d: unable to reset mode", "dev", d.String(), "err", err)
 						} else {
-							log.Printf("%s: sensor reset successfully", d)
+							logger.Get().Info("tlv493d: sensor reset successfully", "dev", d.String())
 						}
 					}
 					continue
@@ -4,6 +4,10 @@
 
 // Package tm1637 controls a TM1637 device over GPIO pins.
 //
+// The TM1637 uses a two wire protocol that resembles I²C, but has no device
+// address and sends bits least significant first, so it's bit-banged on two
+// GPIOs rather than driven through an i2c.Bus.
+//
 // # More details
 //
 // See https://periph.io/device/tm1637/ for more details about the device.
@@ -75,6 +75,9 @@ func New(clk gpio.PinOut, data gpio.PinIO) (*Dev, error) {
 type Dev struct {
 	clk  gpio.PinOut
 	data gpio.PinIO
+	// seg is the last segments written, used by SetColon().
+	seg   [6]byte
+	colon bool
 }
 
 func (d *Dev) String() string {
@@ -122,21 +125,57 @@ func (d *Dev) Write(seg []byte) (int, error) {
 	if _, err := d.writeByte(0xC0); err != nil {
 		return 0, err
 	}
+	var buf [6]byte
+	copy(buf[:], seg)
+	if d.colon {
+		buf[colonDigit] |= segmentP
+	}
 	for i := 0; i < 6; i++ {
-		if len(seg) <= i {
-			if _, err := d.writeByte(0); err != nil {
-				return i, err
-			}
-		} else {
-			if _, err := d.writeByte(seg[i]); err != nil {
-				return i, err
-			}
+		if _, err := d.writeByte(buf[i]); err != nil {
+			return min(i, len(seg)), err
 		}
 	}
 	d.stop()
+	d.seg = [6]byte{}
+	copy(d.seg[:], seg)
 	return len(seg), nil
 }
 
+// SetColon turns the colon of 4 digit clock displays on or off, keeping the
+// digits last written. While it's on, Write() lights the colon whatever the
+// P segment of the second digit.
+func (d *Dev) SetColon(on bool) error {
+	d.colon = on
+	_, err := d.Write(d.seg[:])
+	return err
+}
+
+// WriteString displays s, left aligned. Digits, the letters that can be
+// shown on 7 segments, '-', '_' and ' ' are supported; other characters are
+// blank. A '.' lights the P segment of the previous digit, and a ':' after
+// the second digit turns the colon on; otherwise the colon is turned off.
+//
+// It returns the number of digits written.
+func (d *Dev) WriteString(s string) (int, error) {
+	var seg []byte
+	colon := false
+	for _, ch := range s {
+		switch {
+		case ch == ':' && len(seg) == colonDigit+1:
+			colon = true
+		case ch == '.' && len(seg) > 0 && seg[len(seg)-1]&segmentP == 0:
+			seg[len(seg)-1] |= segmentP
+		default:
+			seg = append(seg, charToSegment(ch))
+		}
+	}
+	if len(seg) > 6 {
+		seg = seg[:6]
+	}
+	d.colon = colon
+	return d.Write(seg)
+}
+
 // Halt turns the display off.
 func (d *Dev) Halt() error {
 	b := [6]byte{}
@@ -157,6 +196,37 @@ var digitToSegment = []byte{
 	0x3f, 0x06, 0x5b, 0x4f, 0x66, 0x6d, 0x7d, 0x07, 0x7f, 0x6f, 0x77, 0x7c, 0x39, 0x5e, 0x79, 0x71,
 }
 
+const (
+	// segmentP is the dot or colon following a digit.
+	segmentP = 0x80
+	// colonDigit is the digit whose P segment is the colon of 4 digit
+	// clock displays.
+	colonDigit = 1
+)
+
+// letterToSegment has the letters that can be shown on 7 segments, besides
+// the hex digits.
+var letterToSegment = map[rune]byte{
+	'-': 0x40, '_': 0x08, 'G': 0x3d, 'H': 0x76, 'I': 0x30, 'J': 0x1e,
+	'L': 0x38, 'N': 0x54, 'O': 0x5c, 'P': 0x73, 'R': 0x50, 'S': 0x6d,
+	'T': 0x78, 'U': 0x3e, 'Y': 0x6e,
+}
+
+// charToSegment returns the segments of ch, or 0 if it can't be shown.
+func charToSegment(ch rune) byte {
+	switch {
+	case ch >= '0' && ch <= '9':
+		return digitToSegment[ch-'0']
+	case ch >= 'A' && ch <= 'F':
+		return digitToSegment[ch-'A'+10]
+	case ch >= 'a' && ch <= 'f':
+		return digitToSegment[ch-'a'+10]
+	case ch >= 'a' && ch <= 'z':
+		return letterToSegment[ch-'a'+'A']
+	}
+	return letterToSegment[ch]
+}
+
 func (d *Dev) start() {
 	_ = d.data.Out(gpio.Low)
 	d.sleepHalfCycle()
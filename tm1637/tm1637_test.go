@@ -13,6 +13,7 @@ import (
 
 	"periph.io/x/conn/v3/gpio"
 	"periph.io/x/conn/v3/gpio/gpiotest"
+	"periph.io/x/conn/v3/physic"
 )
 
 func TestNew(t *testing.T) {
@@ -87,3 +88,104 @@ func (f *failPin) Out(l gpio.Level) error {
 func init() {
 	spin = func(time.Duration) {}
 }
+
+func TestWriteString(t *testing.T) {
+	bus := &decoder{}
+	dev, err := New(&decoderClk{bus}, &decoderData{Pin: gpiotest.Pin{}, bus: bus})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := dev.WriteString("12:3.4"); n != 4 || err != nil {
+		t.Fatalf("WriteString returned %d, %v", n, err)
+	}
+	expected := []byte{0x06, 0x5b | 0x80, 0x4f | 0x80, 0x66, 0, 0}
+	if got := bus.lastData(); !bytes.Equal(got, expected) {
+		t.Errorf("got % x, expected % x", got, expected)
+	}
+	if err = dev.SetColon(false); err != nil {
+		t.Fatal(err)
+	}
+	expected[1] &^= 0x80
+	if got := bus.lastData(); !bytes.Equal(got, expected) {
+		t.Errorf("got % x, expected % x", got, expected)
+	}
+	if _, err = dev.WriteString("hELP -"); err != nil {
+		t.Fatal(err)
+	}
+	expected = []byte{0x76, 0x79, 0x38, 0x73, 0, 0x40}
+	if got := bus.lastData(); !bytes.Equal(got, expected) {
+		t.Errorf("got % x, expected % x", got, expected)
+	}
+}
+
+//
+
+// decoder decodes the transactions sent over the clock and data pins.
+type decoder struct {
+	clk, data gpio.Level
+	bits      []gpio.Level
+	// frames are the bytes of each transaction.
+	frames [][]byte
+}
+
+func (d *decoder) setClk(l gpio.Level) {
+	if l && !d.clk {
+		d.bits = append(d.bits, d.data)
+	}
+	d.clk = l
+}
+
+func (d *decoder) setData(l gpio.Level) {
+	if d.clk && d.data && !l {
+		// Start condition.
+		d.bits = nil
+	}
+	if d.clk && !d.data && l {
+		// Stop condition. Each byte is followed by an ACK bit, and the stop
+		// condition raises the clock once more.
+		var frame []byte
+		for i := 0; i+9 <= len(d.bits); i += 9 {
+			var b byte
+			for bit := range 8 {
+				if d.bits[i+bit] {
+					b |= 1 << bit
+				}
+			}
+			frame = append(frame, b)
+		}
+		d.frames = append(d.frames, frame)
+	}
+	d.data = l
+}
+
+// lastData returns the segments of the last write of the display memory.
+func (d *decoder) lastData() []byte {
+	for i := len(d.frames) - 1; i >= 0; i-- {
+		if f := d.frames[i]; len(f) > 0 && f[0] == 0xC0 {
+			return f[1:]
+		}
+	}
+	return nil
+}
+
+type decoderClk struct {
+	bus *decoder
+}
+
+func (c *decoderClk) String() string                        { return "CLK" }
+func (c *decoderClk) Halt() error                           { return nil }
+func (c *decoderClk) Name() string                          { return "CLK" }
+func (c *decoderClk) Number() int                           { return 0 }
+func (c *decoderClk) Function() string                      { return "Out" }
+func (c *decoderClk) PWM(gpio.Duty, physic.Frequency) error { return errors.New("not supported") }
+func (c *decoderClk) Out(l gpio.Level) error                { c.bus.setClk(l); return nil }
+
+type decoderData struct {
+	gpiotest.Pin
+	bus *decoder
+}
+
+func (p *decoderData) Out(l gpio.Level) error {
+	p.bus.setData(l)
+	return nil
+}
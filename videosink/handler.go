@@ -9,15 +9,27 @@ import (
 	"context"
 	"fmt"
 	"image/jpeg"
-	"log"
+	"log/slog"
 	"mime"
 	"net/http"
 	"net/textproto"
 	"net/url"
 	"sync"
 	"time"
+
+	"periph.io/x/devices/v3/internal/logging"
 )
 
+// logger receives the errors of the HTTP requests that can't be returned to
+// the client.
+var logger logging.Logger
+
+// SetLogger sets the logger of the package, which discards the records by
+// default.
+func SetLogger(l *slog.Logger) {
+	logger.Set(l)
+}
+
 // bufferPool stores reusable []byte instances.
 var bufferPool = sync.Pool{
 	New: func() interface{} {
@@ -183,7 +195,7 @@ func (d *Display) grabSnapshot(cfg imageConfig) []byte {
 // the "format" parameter ("?format=png", "?format=jpeg").
 func (d *Display) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if err := r.Body.Close(); err != nil {
-		log.Printf("Closing request body failed: %v", err)
+		logger.Get().Warn("videosink: closing request body failed", "err", err)
 	}
 
 	if r.Method != http.MethodGet {
@@ -54,6 +54,13 @@ type RGBBLController struct {
 
 // Create new LCD display.
 func New(bus i2c.Bus, variant Variant, rows, cols int) (*aip31068.Dev, error) {
+	return NewWithAddress(bus, variant, _LCD_ADDRESS, rows, cols)
+}
+
+// NewWithAddress creates a new LCD display whose controller is at the I²C
+// address addr, rather than the default 0x3e. The address of the RGB
+// backlight controller isn't changed.
+func NewWithAddress(bus i2c.Bus, variant Variant, addr uint16, rows, cols int) (*aip31068.Dev, error) {
 	var bl any
 
 	if variant == LCD1602RGBBacklight {
@@ -65,7 +72,7 @@ func New(bus i2c.Bus, variant Variant, rows, cols int) (*aip31068.Dev, error) {
 	} else if variant == LCD1602DimmableMonoBacklight {
 		return nil, display.ErrNotImplemented
 	}
-	return aip31068.New(bus, _LCD_ADDRESS, bl, rows, cols)
+	return aip31068.New(bus, addr, bl, rows, cols)
 }
 
 func (bl *RGBBLController) String() string {